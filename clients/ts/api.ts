@@ -30,7 +30,7 @@ import { BASE_PATH, COLLECTION_FORMATS, BaseAPI, RequiredError, operationServerM
  */
 export interface Activity {
     /**
-     * 
+     * Globally unique activity ID, derived from the source UID and the ID of the activity within the source.
      * @type {string}
      * @memberof Activity
      */
@@ -89,6 +89,50 @@ export interface Activity {
      * @memberof Activity
      */
     'similarity'?: number;
+    /**
+     * Starred activities are kept by retention rules.
+     * @type {boolean}
+     * @memberof Activity
+     */
+    'starred': boolean;
+    /**
+     * Activities linked by other activities are kept by retention rules as well.
+     * @type {boolean}
+     * @memberof Activity
+     */
+    'referenced': boolean;
+}
+/**
+ * Limits how far back a backfill goes. At least one of the limits is required.
+ * @export
+ * @interface Backfill
+ */
+export interface Backfill {
+    /**
+     * Maximum number of activities to fetch.
+     * @type {number}
+     * @memberof Backfill
+     */
+    'limit'?: number;
+    /**
+     * Maximum age of fetched activities, e.g. "72h" or "30d".
+     * @type {string}
+     * @memberof Backfill
+     */
+    'window'?: string;
+}
+/**
+ * 
+ * @export
+ * @interface BatchResult
+ */
+export interface BatchResult {
+    /**
+     * Number of affected items.
+     * @type {number}
+     * @memberof BatchResult
+     */
+    'count': number;
 }
 /**
  * 
@@ -108,261 +152,2005 @@ export interface CreateSourceRequest {
      * @memberof CreateSourceRequest
      */
     'config': { [key: string]: any; };
+    /**
+     * 
+     * @type {Backfill}
+     * @memberof CreateSourceRequest
+     */
+    'backfill'?: Backfill;
 }
 /**
  * 
  * @export
- * @interface Source
+ * @interface DeadLetter
  */
-export interface Source {
+export interface DeadLetter {
     /**
-     * 
+     * ID of the activity that failed processing.
      * @type {string}
-     * @memberof Source
+     * @memberof DeadLetter
      */
-    'uid': string;
+    'id': string;
     /**
      * 
      * @type {string}
-     * @memberof Source
+     * @memberof DeadLetter
      */
-    'name': string;
+    'source_uid': string;
+    /**
+     * Empty if the stored activity can't be decoded anymore, e.g. if its source type was removed.
+     * @type {string}
+     * @memberof DeadLetter
+     */
+    'title': string;
     /**
      * 
      * @type {string}
-     * @memberof Source
+     * @memberof DeadLetter
      */
     'url': string;
+    /**
+     * Pipeline stage that failed. Retries resume from this stage.
+     * @type {DeadLetterStageEnum}
+     * @memberof DeadLetter
+     */
+    'stage': DeadLetterStageEnum;
+    /**
+     * 
+     * @type {string}
+     * @memberof DeadLetter
+     */
+    'error': string;
+    /**
+     * 
+     * @type {number}
+     * @memberof DeadLetter
+     */
+    'attempts': number;
+    /**
+     * 
+     * @type {string}
+     * @memberof DeadLetter
+     */
+    'failed_at': string;
 }
 
-/**
- * ActivitiesApi - axios parameter creator
- * @export
- */
-export const ActivitiesApiAxiosParamCreator = function (configuration?: Configuration) {
-    return {
-        /**
-         * 
-         * @summary List all activities
-         * @param {*} [options] Override http request option.
-         * @throws {RequiredError}
-         */
-        listAllActivities: async (options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
-            const localVarPath = `/sources/activities`;
-            // use dummy base URL string because the URL constructor only accepts absolute URLs.
-            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
-            let baseOptions;
-            if (configuration) {
-                baseOptions = configuration.baseOptions;
-            }
-
-            const localVarRequestOptions = { method: 'GET', ...baseOptions, ...options};
-            const localVarHeaderParameter = {} as any;
-            const localVarQueryParameter = {} as any;
-
-
-    
-            setSearchParams(localVarUrlObj, localVarQueryParameter);
-            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
-            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
-
-            return {
-                url: toPathString(localVarUrlObj),
-                options: localVarRequestOptions,
-            };
-        },
-        /**
-         * 
-         * @summary Search activities
-         * @param {string} [query] Semantic search query text
-         * @param {string} [sources] Filter by source UIDs (comma-separated)
-         * @param {number} [minSimilarity] Minimum similarity score (0-1). Can only be used when &#x60;query&#x60; is provided.
-         * @param {number} [limit] Maximum number of results to return
-         * @param {SearchActivitiesSortByEnum} [sortBy] Field to sort results by
-         * @param {*} [options] Override http request option.
-         * @throws {RequiredError}
-         */
-        searchActivities: async (query?: string, sources?: string, minSimilarity?: number, limit?: number, sortBy?: SearchActivitiesSortByEnum, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
-            const localVarPath = `/activities/search`;
-            // use dummy base URL string because the URL constructor only accepts absolute URLs.
-            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
-            let baseOptions;
-            if (configuration) {
-                baseOptions = configuration.baseOptions;
-            }
-
-            const localVarRequestOptions = { method: 'GET', ...baseOptions, ...options};
-            const localVarHeaderParameter = {} as any;
-            const localVarQueryParameter = {} as any;
-
-            if (query !== undefined) {
-                localVarQueryParameter['query'] = query;
-            }
-
-            if (sources !== undefined) {
-                localVarQueryParameter['sources'] = sources;
-            }
-
-            if (minSimilarity !== undefined) {
-                localVarQueryParameter['min_similarity'] = minSimilarity;
-            }
-
-            if (limit !== undefined) {
-                localVarQueryParameter['limit'] = limit;
-            }
-
-            if (sortBy !== undefined) {
-                localVarQueryParameter['sort_by'] = sortBy;
-            }
-
-
-    
-            setSearchParams(localVarUrlObj, localVarQueryParameter);
-            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
-            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
-
-            return {
-                url: toPathString(localVarUrlObj),
-                options: localVarRequestOptions,
-            };
-        },
-    }
-};
+export const DeadLetterStageEnum = {
+    Summarize: 'summarize',
+    Embed: 'embed',
+    Store: 'store'
+} as const;
 
+export type DeadLetterStageEnum = typeof DeadLetterStageEnum[keyof typeof DeadLetterStageEnum];
 /**
- * ActivitiesApi - functional programming interface
+ * 
  * @export
+ * @interface OPMLImportEntry
  */
-export const ActivitiesApiFp = function(configuration?: Configuration) {
-    const localVarAxiosParamCreator = ActivitiesApiAxiosParamCreator(configuration)
-    return {
-        /**
-         * 
-         * @summary List all activities
-         * @param {*} [options] Override http request option.
-         * @throws {RequiredError}
-         */
-        async listAllActivities(options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<Array<Activity>>> {
-            const localVarAxiosArgs = await localVarAxiosParamCreator.listAllActivities(options);
-            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
-            const localVarOperationServerBasePath = operationServerMap['ActivitiesApi.listAllActivities']?.[localVarOperationServerIndex]?.url;
-            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
-        },
-        /**
-         * 
-         * @summary Search activities
-         * @param {string} [query] Semantic search query text
-         * @param {string} [sources] Filter by source UIDs (comma-separated)
-         * @param {number} [minSimilarity] Minimum similarity score (0-1). Can only be used when &#x60;query&#x60; is provided.
-         * @param {number} [limit] Maximum number of results to return
-         * @param {SearchActivitiesSortByEnum} [sortBy] Field to sort results by
-         * @param {*} [options] Override http request option.
-         * @throws {RequiredError}
-         */
-        async searchActivities(query?: string, sources?: string, minSimilarity?: number, limit?: number, sortBy?: SearchActivitiesSortByEnum, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<Array<Activity>>> {
-            const localVarAxiosArgs = await localVarAxiosParamCreator.searchActivities(query, sources, minSimilarity, limit, sortBy, options);
-            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
-            const localVarOperationServerBasePath = operationServerMap['ActivitiesApi.searchActivities']?.[localVarOperationServerIndex]?.url;
-            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
-        },
-    }
-};
+export interface OPMLImportEntry {
+    /**
+     * 
+     * @type {string}
+     * @memberof OPMLImportEntry
+     */
+    'title': string;
+    /**
+     * 
+     * @type {string}
+     * @memberof OPMLImportEntry
+     */
+    'feed_url': string;
+    /**
+     * UID of the created or existing source, omitted if the feed couldn't be mapped to a source.
+     * @type {string}
+     * @memberof OPMLImportEntry
+     */
+    'source_uid'?: string;
+    /**
+     * 
+     * @type {OPMLImportEntryStatusEnum}
+     * @memberof OPMLImportEntry
+     */
+    'status': OPMLImportEntryStatusEnum;
+    /**
+     * 
+     * @type {string}
+     * @memberof OPMLImportEntry
+     */
+    'error'?: string;
+}
 
-/**
- * ActivitiesApi - factory interface
- * @export
- */
-export const ActivitiesApiFactory = function (configuration?: Configuration, basePath?: string, axios?: AxiosInstance) {
-    const localVarFp = ActivitiesApiFp(configuration)
-    return {
-        /**
-         * 
-         * @summary List all activities
-         * @param {*} [options] Override http request option.
-         * @throws {RequiredError}
-         */
-        listAllActivities(options?: RawAxiosRequestConfig): AxiosPromise<Array<Activity>> {
-            return localVarFp.listAllActivities(options).then((request) => request(axios, basePath));
-        },
-        /**
-         * 
-         * @summary Search activities
-         * @param {string} [query] Semantic search query text
-         * @param {string} [sources] Filter by source UIDs (comma-separated)
-         * @param {number} [minSimilarity] Minimum similarity score (0-1). Can only be used when &#x60;query&#x60; is provided.
-         * @param {number} [limit] Maximum number of results to return
-         * @param {SearchActivitiesSortByEnum} [sortBy] Field to sort results by
-         * @param {*} [options] Override http request option.
-         * @throws {RequiredError}
-         */
-        searchActivities(query?: string, sources?: string, minSimilarity?: number, limit?: number, sortBy?: SearchActivitiesSortByEnum, options?: RawAxiosRequestConfig): AxiosPromise<Array<Activity>> {
-            return localVarFp.searchActivities(query, sources, minSimilarity, limit, sortBy, options).then((request) => request(axios, basePath));
-        },
-    };
-};
+export const OPMLImportEntryStatusEnum = {
+    Created: 'created',
+    Exists: 'exists',
+    Failed: 'failed'
+} as const;
 
+export type OPMLImportEntryStatusEnum = typeof OPMLImportEntryStatusEnum[keyof typeof OPMLImportEntryStatusEnum];
 /**
- * ActivitiesApi - object-oriented interface
+ * 
  * @export
- * @class ActivitiesApi
- * @extends {BaseAPI}
+ * @interface OPMLImportReport
  */
-export class ActivitiesApi extends BaseAPI {
+export interface OPMLImportReport {
     /**
      * 
-     * @summary List all activities
-     * @param {*} [options] Override http request option.
-     * @throws {RequiredError}
-     * @memberof ActivitiesApi
+     * @type {number}
+     * @memberof OPMLImportReport
      */
-    public listAllActivities(options?: RawAxiosRequestConfig) {
-        return ActivitiesApiFp(this.configuration).listAllActivities(options).then((request) => request(this.axios, this.basePath));
-    }
-
+    'created': number;
+    /**
+     * Number of feeds whose source already existed.
+     * @type {number}
+     * @memberof OPMLImportReport
+     */
+    'exists': number;
     /**
      * 
-     * @summary Search activities
-     * @param {string} [query] Semantic search query text
-     * @param {string} [sources] Filter by source UIDs (comma-separated)
-     * @param {number} [minSimilarity] Minimum similarity score (0-1). Can only be used when &#x60;query&#x60; is provided.
-     * @param {number} [limit] Maximum number of results to return
-     * @param {SearchActivitiesSortByEnum} [sortBy] Field to sort results by
-     * @param {*} [options] Override http request option.
-     * @throws {RequiredError}
-     * @memberof ActivitiesApi
+     * @type {number}
+     * @memberof OPMLImportReport
      */
-    public searchActivities(query?: string, sources?: string, minSimilarity?: number, limit?: number, sortBy?: SearchActivitiesSortByEnum, options?: RawAxiosRequestConfig) {
-        return ActivitiesApiFp(this.configuration).searchActivities(query, sources, minSimilarity, limit, sortBy, options).then((request) => request(this.axios, this.basePath));
-    }
+    'failed': number;
+    /**
+     * 
+     * @type {Array<OPMLImportEntry>}
+     * @memberof OPMLImportReport
+     */
+    'entries': Array<OPMLImportEntry>;
 }
-
 /**
+ * 
  * @export
+ * @interface PipelineStats
  */
-export const SearchActivitiesSortByEnum = {
-    Similarity: 'similarity',
-    CreatedDate: 'created_date'
+export interface PipelineStats {
+    /**
+     * Number of pending and running jobs.
+     * @type {number}
+     * @memberof PipelineStats
+     */
+    'queue_depth': number;
+    /**
+     * Queue depth at which ingestion stops accepting new activities.
+     * @type {number}
+     * @memberof PipelineStats
+     */
+    'queue_capacity': number;
+    /**
+     * Number of jobs that exhausted their retries.
+     * @type {number}
+     * @memberof PipelineStats
+     */
+    'failed_jobs': number;
+    /**
+     * 
+     * @type {number}
+     * @memberof PipelineStats
+     */
+    'workers': number;
+    /**
+     * 
+     * @type {number}
+     * @memberof PipelineStats
+     */
+    'busy_workers': number;
+    /**
+     * 
+     * @type {Array<StageStats>}
+     * @memberof PipelineStats
+     */
+    'stages': Array<StageStats>;
+    /**
+     * Number of times ingestion waited for the queue to drain.
+     * @type {number}
+     * @memberof PipelineStats
+     */
+    'throttled_enqueues': number;
+    /**
+     * Number of activities dropped because the queue stayed full.
+     * @type {number}
+     * @memberof PipelineStats
+     */
+    'dropped_activities': number;
+}
+/**
+ * 
+ * @export
+ * @interface PreviewActivity
+ */
+export interface PreviewActivity {
+    /**
+     * 
+     * @type {string}
+     * @memberof PreviewActivity
+     */
+    'title': string;
+    /**
+     * 
+     * @type {string}
+     * @memberof PreviewActivity
+     */
+    'url': string;
+    /**
+     * 
+     * @type {string}
+     * @memberof PreviewActivity
+     */
+    'created_at': string;
+    /**
+     * Start of the activity body. Empty if the body, e.g. a linked article, wasn't fetched in time.
+     * @type {string}
+     * @memberof PreviewActivity
+     */
+    'body_excerpt': string;
+}
+/**
+ * 
+ * @export
+ * @interface RetentionPreview
+ */
+export interface RetentionPreview {
+    /**
+     * Whether expired activities are deleted, or only their embeddings are dropped.
+     * @type {RetentionPreviewModeEnum}
+     * @memberof RetentionPreview
+     */
+    'mode': RetentionPreviewModeEnum;
+    /**
+     * 
+     * @type {boolean}
+     * @memberof RetentionPreview
+     */
+    'keep_starred': boolean;
+    /**
+     * Whether activities whose URL is referenced by other activities are kept.
+     * @type {boolean}
+     * @memberof RetentionPreview
+     */
+    'keep_referenced': boolean;
+    /**
+     * Rules of sources first, then of source types, then the default.
+     * @type {Array<RetentionRule>}
+     * @memberof RetentionPreview
+     */
+    'rules': Array<RetentionRule>;
+    /**
+     * 
+     * @type {RetentionStats}
+     * @memberof RetentionPreview
+     */
+    'total': RetentionStats;
+    /**
+     * 
+     * @type {RetentionRun}
+     * @memberof RetentionPreview
+     */
+    'last_run'?: RetentionRun;
+}
+
+export const RetentionPreviewModeEnum = {
+    Delete: 'delete',
+    Archive: 'archive'
 } as const;
-export type SearchActivitiesSortByEnum = typeof SearchActivitiesSortByEnum[keyof typeof SearchActivitiesSortByEnum];
 
+export type RetentionPreviewModeEnum = typeof RetentionPreviewModeEnum[keyof typeof RetentionPreviewModeEnum];
+/**
+ * 
+ * @export
+ * @interface RetentionRule
+ */
+export interface RetentionRule {
+    /**
+     * Set for rules of a source.
+     * @type {string}
+     * @memberof RetentionRule
+     */
+    'source_uid'?: string;
+    /**
+     * Set for rules of a source type. Rules without a source or type are the default.
+     * @type {string}
+     * @memberof RetentionRule
+     */
+    'source_type'?: string;
+    /**
+     * Retention like 30d.
+     * @type {string}
+     * @memberof RetentionRule
+     */
+    'retention': string;
+    /**
+     * Activities created before are expired.
+     * @type {string}
+     * @memberof RetentionRule
+     */
+    'created_before': string;
+    /**
+     * 
+     * @type {RetentionStats}
+     * @memberof RetentionRule
+     */
+    'expired': RetentionStats;
+}
+/**
+ * 
+ * @export
+ * @interface RetentionRun
+ */
+export interface RetentionRun {
+    /**
+     * 
+     * @type {string}
+     * @memberof RetentionRun
+     */
+    'started_at': string;
+    /**
+     * 
+     * @type {string}
+     * @memberof RetentionRun
+     */
+    'finished_at': string;
+    /**
+     * 
+     * @type {RetentionStats}
+     * @memberof RetentionRun
+     */
+    'pruned': RetentionStats;
+    /**
+     * Error the run stopped at.
+     * @type {string}
+     * @memberof RetentionRun
+     */
+    'error'?: string;
+}
+/**
+ * 
+ * @export
+ * @interface RetentionStats
+ */
+export interface RetentionStats {
+    /**
+     * 
+     * @type {number}
+     * @memberof RetentionStats
+     */
+    'activities': number;
+    /**
+     * Estimated storage of the activities, or of their embeddings when archiving.
+     * @type {number}
+     * @memberof RetentionStats
+     */
+    'bytes': number;
+}
+/**
+ * 
+ * @export
+ * @interface Source
+ */
+export interface Source {
+    /**
+     * 
+     * @type {string}
+     * @memberof Source
+     */
+    'uid': string;
+    /**
+     * 
+     * @type {string}
+     * @memberof Source
+     */
+    'name': string;
+    /**
+     * 
+     * @type {string}
+     * @memberof Source
+     */
+    'url': string;
+    /**
+     * False if the source is paused and not polled.
+     * @type {boolean}
+     * @memberof Source
+     */
+    'enabled': boolean;
+    /**
+     * True if the source is defined in the sources file and can't be changed through the API.
+     * @type {boolean}
+     * @memberof Source
+     */
+    'managed': boolean;
+    /**
+     * 
+     * @type {Array<string>}
+     * @memberof Source
+     */
+    'tags'?: Array<string>;
+    /**
+     * Token of the URL activities are pushed to, `POST /ingest/{ingest_token}`. Only set for `webhook` sources.
+     * @type {string}
+     * @memberof Source
+     */
+    'ingest_token'?: string;
+    /**
+     * 
+     * @type {SourceHealth}
+     * @memberof Source
+     */
+    'health'?: SourceHealth;
+}
+/**
+ * 
+ * @export
+ * @interface SourceHealth
+ */
+export interface SourceHealth {
+    /**
+     * Derived from the number of consecutive failed fetches.
+     * @type {SourceHealthStatusEnum}
+     * @memberof SourceHealth
+     */
+    'status': SourceHealthStatusEnum;
+    /**
+     * 
+     * @type {number}
+     * @memberof SourceHealth
+     */
+    'consecutive_failures': number;
+    /**
+     * Number of activities produced by the last fetch.
+     * @type {number}
+     * @memberof SourceHealth
+     */
+    'last_items': number;
+    /**
+     * 
+     * @type {string}
+     * @memberof SourceHealth
+     */
+    'last_success_at'?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof SourceHealth
+     */
+    'last_error'?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof SourceHealth
+     */
+    'last_error_at'?: string;
+}
+
+export const SourceHealthStatusEnum = {
+    Healthy: 'healthy',
+    Degraded: 'degraded',
+    Failing: 'failing'
+} as const;
 
+export type SourceHealthStatusEnum = typeof SourceHealthStatusEnum[keyof typeof SourceHealthStatusEnum];
 /**
- * PagesApi - axios parameter creator
+ * 
  * @export
+ * @interface SourcePreview
  */
-export const PagesApiAxiosParamCreator = function (configuration?: Configuration) {
-    return {
-        /**
-         * 
-         * @summary Get page HTML
-         * @param {string} config Base64 encoded JSON string for feed config
+export interface SourcePreview {
+    /**
+     * UID the source would have once created.
+     * @type {string}
+     * @memberof SourcePreview
+     */
+    'uid': string;
+    /**
+     * 
+     * @type {Array<PreviewActivity>}
+     * @memberof SourcePreview
+     */
+    'activities': Array<PreviewActivity>;
+    /**
+     * Errors reported by the fetch. Activities fetched before an error are still included.
+     * @type {Array<string>}
+     * @memberof SourcePreview
+     */
+    'errors': Array<string>;
+}
+/**
+ * 
+ * @export
+ * @interface SourceRun
+ */
+export interface SourceRun {
+    /**
+     * 
+     * @type {string}
+     * @memberof SourceRun
+     */
+    'started_at': string;
+    /**
+     * 
+     * @type {string}
+     * @memberof SourceRun
+     */
+    'finished_at': string;
+    /**
+     * Number of activities produced by the fetch.
+     * @type {number}
+     * @memberof SourceRun
+     */
+    'items': number;
+    /**
+     * Last error reported during the fetch, omitted if the fetch succeeded.
+     * @type {string}
+     * @memberof SourceRun
+     */
+    'error'?: string;
+}
+/**
+ * 
+ * @export
+ * @interface SourceType
+ */
+export interface SourceType {
+    /**
+     * 
+     * @type {string}
+     * @memberof SourceType
+     */
+    'type': string;
+    /**
+     * 
+     * @type {string}
+     * @memberof SourceType
+     */
+    'description': string;
+    /**
+     * JSON Schema of the source config.
+     * @type {{ [key: string]: any; }}
+     * @memberof SourceType
+     */
+    'schema': { [key: string]: any; };
+    /**
+     * 
+     * @type {Array<{ [key: string]: any; }>}
+     * @memberof SourceType
+     */
+    'examples': Array<{ [key: string]: any; }>;
+}
+/**
+ * 
+ * @export
+ * @interface StageStats
+ */
+export interface StageStats {
+    /**
+     * 
+     * @type {StageStatsStageEnum}
+     * @memberof StageStats
+     */
+    'stage': StageStatsStageEnum;
+    /**
+     * 
+     * @type {number}
+     * @memberof StageStats
+     */
+    'concurrency': number;
+    /**
+     * 
+     * @type {number}
+     * @memberof StageStats
+     */
+    'in_flight': number;
+    /**
+     * 
+     * @type {number}
+     * @memberof StageStats
+     */
+    'processed': number;
+    /**
+     * 
+     * @type {number}
+     * @memberof StageStats
+     */
+    'failed': number;
+}
+
+export const StageStatsStageEnum = {
+    Summarize: 'summarize',
+    Embed: 'embed',
+    Store: 'store'
+} as const;
+
+export type StageStatsStageEnum = typeof StageStatsStageEnum[keyof typeof StageStatsStageEnum];
+/**
+ * Source config fields to change. Fields set to null are reset to their defaults.
+ * @export
+ * @interface UpdateSourceRequest
+ */
+export interface UpdateSourceRequest {
+}
+/**
+ * 
+ * @export
+ * @interface ValidationError
+ */
+export interface ValidationError {
+    /**
+     * JSON path of the invalid field, e.g. `config.repository`. Omitted if the error isn't about a single field.
+     * @type {string}
+     * @memberof ValidationError
+     */
+    'field'?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof ValidationError
+     */
+    'message': string;
+}
+/**
+ * 
+ * @export
+ * @interface ValidationErrors
+ */
+export interface ValidationErrors {
+    /**
+     * 
+     * @type {Array<ValidationError>}
+     * @memberof ValidationErrors
+     */
+    'errors': Array<ValidationError>;
+}
+
+/**
+ * ActivitiesApi - axios parameter creator
+ * @export
+ */
+export const ActivitiesApiAxiosParamCreator = function (configuration?: Configuration) {
+    return {
+        /**
+         * 
+         * @summary List all activities
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        listAllActivities: async (options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            const localVarPath = `/sources/activities`;
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'GET', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+
+    
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * 
+         * @summary Search activities
+         * @param {string} [query] Semantic search query text
+         * @param {string} [sources] Filter by source UIDs (comma-separated)
+         * @param {number} [minSimilarity] Minimum similarity score (0-1). Can only be used when &#x60;query&#x60; is provided.
+         * @param {number} [limit] Maximum number of results to return
+         * @param {SearchActivitiesSortByEnum} [sortBy] Field to sort results by
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        searchActivities: async (query?: string, sources?: string, minSimilarity?: number, limit?: number, sortBy?: SearchActivitiesSortByEnum, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            const localVarPath = `/activities/search`;
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'GET', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+            if (query !== undefined) {
+                localVarQueryParameter['query'] = query;
+            }
+
+            if (sources !== undefined) {
+                localVarQueryParameter['sources'] = sources;
+            }
+
+            if (minSimilarity !== undefined) {
+                localVarQueryParameter['min_similarity'] = minSimilarity;
+            }
+
+            if (limit !== undefined) {
+                localVarQueryParameter['limit'] = limit;
+            }
+
+            if (sortBy !== undefined) {
+                localVarQueryParameter['sort_by'] = sortBy;
+            }
+
+
+    
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * Starred activities are kept by retention rules.
+         * @summary Star an activity
+         * @param {string} uid 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        starActivity: async (uid: string, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            // verify required parameter 'uid' is not null or undefined
+            assertParamExists('starActivity', 'uid', uid)
+            const localVarPath = `/activities/{uid}/star`
+                .replace(`{${"uid"}}`, encodeURIComponent(String(uid)));
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'PUT', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+
+    
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * 
+         * @summary Unstar an activity
+         * @param {string} uid 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        unstarActivity: async (uid: string, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            // verify required parameter 'uid' is not null or undefined
+            assertParamExists('unstarActivity', 'uid', uid)
+            const localVarPath = `/activities/{uid}/star`
+                .replace(`{${"uid"}}`, encodeURIComponent(String(uid)));
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'DELETE', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+
+    
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+    }
+};
+
+/**
+ * ActivitiesApi - functional programming interface
+ * @export
+ */
+export const ActivitiesApiFp = function(configuration?: Configuration) {
+    const localVarAxiosParamCreator = ActivitiesApiAxiosParamCreator(configuration)
+    return {
+        /**
+         * 
+         * @summary List all activities
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async listAllActivities(options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<Array<Activity>>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.listAllActivities(options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['ActivitiesApi.listAllActivities']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * 
+         * @summary Search activities
+         * @param {string} [query] Semantic search query text
+         * @param {string} [sources] Filter by source UIDs (comma-separated)
+         * @param {number} [minSimilarity] Minimum similarity score (0-1). Can only be used when &#x60;query&#x60; is provided.
+         * @param {number} [limit] Maximum number of results to return
+         * @param {SearchActivitiesSortByEnum} [sortBy] Field to sort results by
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async searchActivities(query?: string, sources?: string, minSimilarity?: number, limit?: number, sortBy?: SearchActivitiesSortByEnum, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<Array<Activity>>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.searchActivities(query, sources, minSimilarity, limit, sortBy, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['ActivitiesApi.searchActivities']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * Starred activities are kept by retention rules.
+         * @summary Star an activity
+         * @param {string} uid 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async starActivity(uid: string, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<void>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.starActivity(uid, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['ActivitiesApi.starActivity']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * 
+         * @summary Unstar an activity
+         * @param {string} uid 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async unstarActivity(uid: string, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<void>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.unstarActivity(uid, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['ActivitiesApi.unstarActivity']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+    }
+};
+
+/**
+ * ActivitiesApi - factory interface
+ * @export
+ */
+export const ActivitiesApiFactory = function (configuration?: Configuration, basePath?: string, axios?: AxiosInstance) {
+    const localVarFp = ActivitiesApiFp(configuration)
+    return {
+        /**
+         * 
+         * @summary List all activities
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        listAllActivities(options?: RawAxiosRequestConfig): AxiosPromise<Array<Activity>> {
+            return localVarFp.listAllActivities(options).then((request) => request(axios, basePath));
+        },
+        /**
+         * 
+         * @summary Search activities
+         * @param {string} [query] Semantic search query text
+         * @param {string} [sources] Filter by source UIDs (comma-separated)
+         * @param {number} [minSimilarity] Minimum similarity score (0-1). Can only be used when &#x60;query&#x60; is provided.
+         * @param {number} [limit] Maximum number of results to return
+         * @param {SearchActivitiesSortByEnum} [sortBy] Field to sort results by
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        searchActivities(query?: string, sources?: string, minSimilarity?: number, limit?: number, sortBy?: SearchActivitiesSortByEnum, options?: RawAxiosRequestConfig): AxiosPromise<Array<Activity>> {
+            return localVarFp.searchActivities(query, sources, minSimilarity, limit, sortBy, options).then((request) => request(axios, basePath));
+        },
+        /**
+         * Starred activities are kept by retention rules.
+         * @summary Star an activity
+         * @param {string} uid 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        starActivity(uid: string, options?: RawAxiosRequestConfig): AxiosPromise<void> {
+            return localVarFp.starActivity(uid, options).then((request) => request(axios, basePath));
+        },
+        /**
+         * 
+         * @summary Unstar an activity
+         * @param {string} uid 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        unstarActivity(uid: string, options?: RawAxiosRequestConfig): AxiosPromise<void> {
+            return localVarFp.unstarActivity(uid, options).then((request) => request(axios, basePath));
+        },
+    };
+};
+
+/**
+ * ActivitiesApi - object-oriented interface
+ * @export
+ * @class ActivitiesApi
+ * @extends {BaseAPI}
+ */
+export class ActivitiesApi extends BaseAPI {
+    /**
+     * 
+     * @summary List all activities
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof ActivitiesApi
+     */
+    public listAllActivities(options?: RawAxiosRequestConfig) {
+        return ActivitiesApiFp(this.configuration).listAllActivities(options).then((request) => request(this.axios, this.basePath));
+    }
+
+    /**
+     * 
+     * @summary Search activities
+     * @param {string} [query] Semantic search query text
+     * @param {string} [sources] Filter by source UIDs (comma-separated)
+     * @param {number} [minSimilarity] Minimum similarity score (0-1). Can only be used when &#x60;query&#x60; is provided.
+     * @param {number} [limit] Maximum number of results to return
+     * @param {SearchActivitiesSortByEnum} [sortBy] Field to sort results by
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof ActivitiesApi
+     */
+    public searchActivities(query?: string, sources?: string, minSimilarity?: number, limit?: number, sortBy?: SearchActivitiesSortByEnum, options?: RawAxiosRequestConfig) {
+        return ActivitiesApiFp(this.configuration).searchActivities(query, sources, minSimilarity, limit, sortBy, options).then((request) => request(this.axios, this.basePath));
+    }
+
+    /**
+     * Starred activities are kept by retention rules.
+     * @summary Star an activity
+     * @param {string} uid 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof ActivitiesApi
+     */
+    public starActivity(uid: string, options?: RawAxiosRequestConfig) {
+        return ActivitiesApiFp(this.configuration).starActivity(uid, options).then((request) => request(this.axios, this.basePath));
+    }
+
+    /**
+     * 
+     * @summary Unstar an activity
+     * @param {string} uid 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof ActivitiesApi
+     */
+    public unstarActivity(uid: string, options?: RawAxiosRequestConfig) {
+        return ActivitiesApiFp(this.configuration).unstarActivity(uid, options).then((request) => request(this.axios, this.basePath));
+    }
+}
+
+/**
+ * @export
+ */
+export const SearchActivitiesSortByEnum = {
+    Similarity: 'similarity',
+    CreatedDate: 'created_date'
+} as const;
+export type SearchActivitiesSortByEnum = typeof SearchActivitiesSortByEnum[keyof typeof SearchActivitiesSortByEnum];
+
+
+/**
+ * AdminApi - axios parameter creator
+ * @export
+ */
+export const AdminApiAxiosParamCreator = function (configuration?: Configuration) {
+    return {
+        /**
+         * 
+         * @summary Discard all dead letters
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        discardAllDeadLetters: async (options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            const localVarPath = `/admin/dead-letters`;
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'DELETE', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+
+    
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * 
+         * @summary Discard a dead letter
+         * @param {string} id 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        discardDeadLetter: async (id: string, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            // verify required parameter 'id' is not null or undefined
+            assertParamExists('discardDeadLetter', 'id', id)
+            const localVarPath = `/admin/dead-letters/{id}`
+                .replace(`{${"id"}}`, encodeURIComponent(String(id)));
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'DELETE', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+
+    
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * 
+         * @summary Get ingestion pipeline stats
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        getPipelineStats: async (options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            const localVarPath = `/admin/pipeline`;
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'GET', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+
+    
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * Lists the retention rules and the activities that expired under them, which the next run prunes.
+         * @summary Preview expired activities
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        getRetention: async (options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            const localVarPath = `/admin/retention`;
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'GET', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+
+    
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * 
+         * @summary List activities that failed processing
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        listDeadLetters: async (options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            const localVarPath = `/admin/dead-letters`;
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'GET', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+
+    
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * 
+         * @summary Retry all dead letters
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        retryAllDeadLetters: async (options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            const localVarPath = `/admin/dead-letters/retry`;
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'POST', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+
+    
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * 
+         * @summary Retry a dead letter
+         * @param {string} id 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        retryDeadLetter: async (id: string, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            // verify required parameter 'id' is not null or undefined
+            assertParamExists('retryDeadLetter', 'id', id)
+            const localVarPath = `/admin/dead-letters/{id}/retry`
+                .replace(`{${"id"}}`, encodeURIComponent(String(id)));
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'POST', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+
+    
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+    }
+};
+
+/**
+ * AdminApi - functional programming interface
+ * @export
+ */
+export const AdminApiFp = function(configuration?: Configuration) {
+    const localVarAxiosParamCreator = AdminApiAxiosParamCreator(configuration)
+    return {
+        /**
+         * 
+         * @summary Discard all dead letters
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async discardAllDeadLetters(options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<BatchResult>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.discardAllDeadLetters(options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['AdminApi.discardAllDeadLetters']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * 
+         * @summary Discard a dead letter
+         * @param {string} id 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async discardDeadLetter(id: string, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<void>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.discardDeadLetter(id, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['AdminApi.discardDeadLetter']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * 
+         * @summary Get ingestion pipeline stats
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async getPipelineStats(options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<PipelineStats>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.getPipelineStats(options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['AdminApi.getPipelineStats']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * Lists the retention rules and the activities that expired under them, which the next run prunes.
+         * @summary Preview expired activities
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async getRetention(options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<RetentionPreview>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.getRetention(options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['AdminApi.getRetention']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * 
+         * @summary List activities that failed processing
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async listDeadLetters(options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<Array<DeadLetter>>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.listDeadLetters(options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['AdminApi.listDeadLetters']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * 
+         * @summary Retry all dead letters
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async retryAllDeadLetters(options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<BatchResult>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.retryAllDeadLetters(options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['AdminApi.retryAllDeadLetters']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * 
+         * @summary Retry a dead letter
+         * @param {string} id 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async retryDeadLetter(id: string, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<void>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.retryDeadLetter(id, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['AdminApi.retryDeadLetter']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+    }
+};
+
+/**
+ * AdminApi - factory interface
+ * @export
+ */
+export const AdminApiFactory = function (configuration?: Configuration, basePath?: string, axios?: AxiosInstance) {
+    const localVarFp = AdminApiFp(configuration)
+    return {
+        /**
+         * 
+         * @summary Discard all dead letters
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        discardAllDeadLetters(options?: RawAxiosRequestConfig): AxiosPromise<BatchResult> {
+            return localVarFp.discardAllDeadLetters(options).then((request) => request(axios, basePath));
+        },
+        /**
+         * 
+         * @summary Discard a dead letter
+         * @param {string} id 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        discardDeadLetter(id: string, options?: RawAxiosRequestConfig): AxiosPromise<void> {
+            return localVarFp.discardDeadLetter(id, options).then((request) => request(axios, basePath));
+        },
+        /**
+         * 
+         * @summary Get ingestion pipeline stats
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        getPipelineStats(options?: RawAxiosRequestConfig): AxiosPromise<PipelineStats> {
+            return localVarFp.getPipelineStats(options).then((request) => request(axios, basePath));
+        },
+        /**
+         * Lists the retention rules and the activities that expired under them, which the next run prunes.
+         * @summary Preview expired activities
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        getRetention(options?: RawAxiosRequestConfig): AxiosPromise<RetentionPreview> {
+            return localVarFp.getRetention(options).then((request) => request(axios, basePath));
+        },
+        /**
+         * 
+         * @summary List activities that failed processing
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        listDeadLetters(options?: RawAxiosRequestConfig): AxiosPromise<Array<DeadLetter>> {
+            return localVarFp.listDeadLetters(options).then((request) => request(axios, basePath));
+        },
+        /**
+         * 
+         * @summary Retry all dead letters
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        retryAllDeadLetters(options?: RawAxiosRequestConfig): AxiosPromise<BatchResult> {
+            return localVarFp.retryAllDeadLetters(options).then((request) => request(axios, basePath));
+        },
+        /**
+         * 
+         * @summary Retry a dead letter
+         * @param {string} id 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        retryDeadLetter(id: string, options?: RawAxiosRequestConfig): AxiosPromise<void> {
+            return localVarFp.retryDeadLetter(id, options).then((request) => request(axios, basePath));
+        },
+    };
+};
+
+/**
+ * AdminApi - object-oriented interface
+ * @export
+ * @class AdminApi
+ * @extends {BaseAPI}
+ */
+export class AdminApi extends BaseAPI {
+    /**
+     * 
+     * @summary Discard all dead letters
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof AdminApi
+     */
+    public discardAllDeadLetters(options?: RawAxiosRequestConfig) {
+        return AdminApiFp(this.configuration).discardAllDeadLetters(options).then((request) => request(this.axios, this.basePath));
+    }
+
+    /**
+     * 
+     * @summary Discard a dead letter
+     * @param {string} id 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof AdminApi
+     */
+    public discardDeadLetter(id: string, options?: RawAxiosRequestConfig) {
+        return AdminApiFp(this.configuration).discardDeadLetter(id, options).then((request) => request(this.axios, this.basePath));
+    }
+
+    /**
+     * 
+     * @summary Get ingestion pipeline stats
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof AdminApi
+     */
+    public getPipelineStats(options?: RawAxiosRequestConfig) {
+        return AdminApiFp(this.configuration).getPipelineStats(options).then((request) => request(this.axios, this.basePath));
+    }
+
+    /**
+     * Lists the retention rules and the activities that expired under them, which the next run prunes.
+     * @summary Preview expired activities
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof AdminApi
+     */
+    public getRetention(options?: RawAxiosRequestConfig) {
+        return AdminApiFp(this.configuration).getRetention(options).then((request) => request(this.axios, this.basePath));
+    }
+
+    /**
+     * 
+     * @summary List activities that failed processing
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof AdminApi
+     */
+    public listDeadLetters(options?: RawAxiosRequestConfig) {
+        return AdminApiFp(this.configuration).listDeadLetters(options).then((request) => request(this.axios, this.basePath));
+    }
+
+    /**
+     * 
+     * @summary Retry all dead letters
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof AdminApi
+     */
+    public retryAllDeadLetters(options?: RawAxiosRequestConfig) {
+        return AdminApiFp(this.configuration).retryAllDeadLetters(options).then((request) => request(this.axios, this.basePath));
+    }
+
+    /**
+     * 
+     * @summary Retry a dead letter
+     * @param {string} id 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof AdminApi
+     */
+    public retryDeadLetter(id: string, options?: RawAxiosRequestConfig) {
+        return AdminApiFp(this.configuration).retryDeadLetter(id, options).then((request) => request(this.axios, this.basePath));
+    }
+}
+
+
+
+/**
+ * PagesApi - axios parameter creator
+ * @export
+ */
+export const PagesApiAxiosParamCreator = function (configuration?: Configuration) {
+    return {
+        /**
+         * 
+         * @summary Get page HTML
+         * @param {string} config Base64 encoded JSON string for feed config
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        getPage: async (config: string, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            // verify required parameter 'config' is not null or undefined
+            assertParamExists('getPage', 'config', config)
+            const localVarPath = `/page`;
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'GET', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+            if (config !== undefined) {
+                localVarQueryParameter['config'] = config;
+            }
+
+
+    
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+    }
+};
+
+/**
+ * PagesApi - functional programming interface
+ * @export
+ */
+export const PagesApiFp = function(configuration?: Configuration) {
+    const localVarAxiosParamCreator = PagesApiAxiosParamCreator(configuration)
+    return {
+        /**
+         * 
+         * @summary Get page HTML
+         * @param {string} config Base64 encoded JSON string for feed config
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async getPage(config: string, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<string>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.getPage(config, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['PagesApi.getPage']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+    }
+};
+
+/**
+ * PagesApi - factory interface
+ * @export
+ */
+export const PagesApiFactory = function (configuration?: Configuration, basePath?: string, axios?: AxiosInstance) {
+    const localVarFp = PagesApiFp(configuration)
+    return {
+        /**
+         * 
+         * @summary Get page HTML
+         * @param {string} config Base64 encoded JSON string for feed config
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        getPage(config: string, options?: RawAxiosRequestConfig): AxiosPromise<string> {
+            return localVarFp.getPage(config, options).then((request) => request(axios, basePath));
+        },
+    };
+};
+
+/**
+ * PagesApi - object-oriented interface
+ * @export
+ * @class PagesApi
+ * @extends {BaseAPI}
+ */
+export class PagesApi extends BaseAPI {
+    /**
+     * 
+     * @summary Get page HTML
+     * @param {string} config Base64 encoded JSON string for feed config
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof PagesApi
+     */
+    public getPage(config: string, options?: RawAxiosRequestConfig) {
+        return PagesApiFp(this.configuration).getPage(config, options).then((request) => request(this.axios, this.basePath));
+    }
+}
+
+
+
+/**
+ * SourcesApi - axios parameter creator
+ * @export
+ */
+export const SourcesApiAxiosParamCreator = function (configuration?: Configuration) {
+    return {
+        /**
+         * Pages through activities older than those fetched by polling, in the background. Backfills are throttled, so that they don&#39;t delay activities of polled sources. Supported by &#x60;github-issues&#x60;, &#x60;github-releases&#x60;, &#x60;reddit-subreddit&#x60;, &#x60;mastodon-account&#x60; and &#x60;mastodon-tag&#x60; sources.
+         * @summary Fetch the history of a source
+         * @param {string} uid 
+         * @param {Backfill} backfill 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        backfillSource: async (uid: string, backfill: Backfill, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            // verify required parameter 'uid' is not null or undefined
+            assertParamExists('backfillSource', 'uid', uid)
+            // verify required parameter 'backfill' is not null or undefined
+            assertParamExists('backfillSource', 'backfill', backfill)
+            const localVarPath = `/sources/{uid}/backfill`
+                .replace(`{${"uid"}}`, encodeURIComponent(String(uid)));
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'POST', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+
+    
+            localVarHeaderParameter['Content-Type'] = 'application/json';
+
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+            localVarRequestOptions.data = serializeDataIfNeeded(backfill, localVarRequestOptions, configuration)
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * 
+         * @summary Create a new source
+         * @param {CreateSourceRequest} createSourceRequest 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        createSource: async (createSourceRequest: CreateSourceRequest, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            // verify required parameter 'createSourceRequest' is not null or undefined
+            assertParamExists('createSource', 'createSourceRequest', createSourceRequest)
+            const localVarPath = `/sources`;
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'POST', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+
+    
+            localVarHeaderParameter['Content-Type'] = 'application/json';
+
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+            localVarRequestOptions.data = serializeDataIfNeeded(createSourceRequest, localVarRequestOptions, configuration)
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * 
+         * @summary Delete source
+         * @param {string} uid 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        deleteSource: async (uid: string, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            // verify required parameter 'uid' is not null or undefined
+            assertParamExists('deleteSource', 'uid', uid)
+            const localVarPath = `/sources/{uid}`
+                .replace(`{${"uid"}}`, encodeURIComponent(String(uid)));
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'DELETE', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+
+    
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * Exports all sources as feeds that other readers can subscribe to. The full source configs are kept in Pulse-specific outline attributes, so the export can be imported into another Pulse instance. Secrets like tokens and webhook secrets are replaced with &#x60;REDACTED&#x60;, and must be filled in before the export is imported.
+         * @summary Export sources as OPML
+         * @param {ExportOPMLGroupByEnum} [groupBy] Folder the sources are grouped into
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        exportOPML: async (groupBy?: ExportOPMLGroupByEnum, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            const localVarPath = `/sources/export/opml`;
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'GET', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+            if (groupBy !== undefined) {
+                localVarQueryParameter['group_by'] = groupBy;
+            }
+
+
+    
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * 
+         * @summary Get source by UID
+         * @param {string} uid 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        getSource: async (uid: string, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            // verify required parameter 'uid' is not null or undefined
+            assertParamExists('getSource', 'uid', uid)
+            const localVarPath = `/sources/{uid}`
+                .replace(`{${"uid"}}`, encodeURIComponent(String(uid)));
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'GET', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+
+    
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * Creates a source for every feed in the OPML document. Feeds of GitHub releases, Reddit, Mastodon and Lobsters are created as their native source types, other feeds as &#x60;rss-feed&#x60; sources. Folders and categories of a feed become tags of its source.
+         * @summary Import sources from OPML
+         * @param {string} body 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        importOPML: async (body: string, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            // verify required parameter 'body' is not null or undefined
+            assertParamExists('importOPML', 'body', body)
+            const localVarPath = `/sources/import/opml`;
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'POST', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+
+    
+            localVarHeaderParameter['Content-Type'] = 'text/x-opml';
+
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+            localVarRequestOptions.data = serializeDataIfNeeded(body, localVarRequestOptions, configuration)
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * Maps the JSON payload to activities with the mapping of the &#x60;webhook&#x60; source with the ingest token, and queues them for processing. The token is generated when the source is created, and returned as &#x60;ingest_token&#x60; of the source. The payload must also be signed with the HMAC-SHA256 of the body, using the secret of the source, sent as a hex string in the signature header of the source (&#x60;X-Signature-256&#x60; by default).
+         * @summary Push activities to a webhook source
+         * @param {string} ingestToken 
+         * @param {object} body 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        ingestActivities: async (ingestToken: string, body: object, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            // verify required parameter 'ingestToken' is not null or undefined
+            assertParamExists('ingestActivities', 'ingestToken', ingestToken)
+            // verify required parameter 'body' is not null or undefined
+            assertParamExists('ingestActivities', 'body', body)
+            const localVarPath = `/ingest/{ingest_token}`
+                .replace(`{${"ingest_token"}}`, encodeURIComponent(String(ingestToken)));
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'POST', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+
+    
+            localVarHeaderParameter['Content-Type'] = 'application/json';
+
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+            localVarRequestOptions.data = serializeDataIfNeeded(body, localVarRequestOptions, configuration)
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * 
+         * @summary List recent fetches of a source
+         * @param {string} uid 
+         * @param {number} [limit] Maximum number of runs to return
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        listSourceRuns: async (uid: string, limit?: number, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            // verify required parameter 'uid' is not null or undefined
+            assertParamExists('listSourceRuns', 'uid', uid)
+            const localVarPath = `/sources/{uid}/runs`
+                .replace(`{${"uid"}}`, encodeURIComponent(String(uid)));
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'GET', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+            if (limit !== undefined) {
+                localVarQueryParameter['limit'] = limit;
+            }
+
+
+    
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * Lists every source type with a JSON Schema of its config and example configs. Configs of created sources are validated against the schema of their type.
+         * @summary List source types
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        listSourceTypes: async (options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            const localVarPath = `/source-types`;
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'GET', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+
+    
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * 
+         * @summary List all sources
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        listSources: async (options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            const localVarPath = `/sources`;
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'GET', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+
+    
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * Stops fetching new activities until the source is resumed. The source config and its activities are kept, so paused sources are still listed and searchable.
+         * @summary Pause polling of a source
+         * @param {string} uid 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        pauseSource: async (uid: string, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            // verify required parameter 'uid' is not null or undefined
+            assertParamExists('pauseSource', 'uid', uid)
+            const localVarPath = `/sources/{uid}/pause`
+                .replace(`{${"uid"}}`, encodeURIComponent(String(uid)));
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'POST', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+
+    
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * Runs a single fetch of the source config and returns the activities it produces, without creating the source or summarizing, embedding and storing its activities.
+         * @summary Preview the activities of a source
+         * @param {CreateSourceRequest} createSourceRequest 
          * @param {*} [options] Override http request option.
          * @throws {RequiredError}
          */
-        getPage: async (config: string, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
-            // verify required parameter 'config' is not null or undefined
-            assertParamExists('getPage', 'config', config)
-            const localVarPath = `/page`;
+        previewSource: async (createSourceRequest: CreateSourceRequest, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            // verify required parameter 'createSourceRequest' is not null or undefined
+            assertParamExists('previewSource', 'createSourceRequest', createSourceRequest)
+            const localVarPath = `/sources/preview`;
             // use dummy base URL string because the URL constructor only accepts absolute URLs.
             const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
             let baseOptions;
@@ -370,110 +2158,75 @@ export const PagesApiAxiosParamCreator = function (configuration?: Configuration
                 baseOptions = configuration.baseOptions;
             }
 
-            const localVarRequestOptions = { method: 'GET', ...baseOptions, ...options};
+            const localVarRequestOptions = { method: 'POST', ...baseOptions, ...options};
             const localVarHeaderParameter = {} as any;
             const localVarQueryParameter = {} as any;
 
-            if (config !== undefined) {
-                localVarQueryParameter['config'] = config;
-            }
-
 
     
+            localVarHeaderParameter['Content-Type'] = 'application/json';
+
             setSearchParams(localVarUrlObj, localVarQueryParameter);
             let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
             localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+            localVarRequestOptions.data = serializeDataIfNeeded(createSourceRequest, localVarRequestOptions, configuration)
 
             return {
                 url: toPathString(localVarUrlObj),
                 options: localVarRequestOptions,
             };
         },
-    }
-};
-
-/**
- * PagesApi - functional programming interface
- * @export
- */
-export const PagesApiFp = function(configuration?: Configuration) {
-    const localVarAxiosParamCreator = PagesApiAxiosParamCreator(configuration)
-    return {
         /**
-         * 
-         * @summary Get page HTML
-         * @param {string} config Base64 encoded JSON string for feed config
+         * Maps &#x60;issues&#x60;, &#x60;issue_comment&#x60;, &#x60;pull_request&#x60; and &#x60;discussion&#x60; events to the &#x60;github-issues&#x60; source of the repository, &#x60;pull_request&#x60; and &#x60;pull_request_review&#x60; events to its &#x60;github-pull-requests&#x60; source, and &#x60;release&#x60; events to its &#x60;github-releases&#x60; source. Deliveries must be signed with the &#x60;webhook_secret&#x60; of the source, or the &#x60;GITHUB_WEBHOOK_SECRET&#x60; environment variable. Other events, and events of repositories without a source, are ignored.
+         * @summary Receive a GitHub webhook delivery
+         * @param {object} body 
          * @param {*} [options] Override http request option.
          * @throws {RequiredError}
          */
-        async getPage(config: string, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<string>> {
-            const localVarAxiosArgs = await localVarAxiosParamCreator.getPage(config, options);
-            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
-            const localVarOperationServerBasePath = operationServerMap['PagesApi.getPage']?.[localVarOperationServerIndex]?.url;
-            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
-        },
-    }
-};
+        receiveGithubWebhook: async (body: object, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            // verify required parameter 'body' is not null or undefined
+            assertParamExists('receiveGithubWebhook', 'body', body)
+            const localVarPath = `/webhooks/github`;
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
 
-/**
- * PagesApi - factory interface
- * @export
- */
-export const PagesApiFactory = function (configuration?: Configuration, basePath?: string, axios?: AxiosInstance) {
-    const localVarFp = PagesApiFp(configuration)
-    return {
-        /**
-         * 
-         * @summary Get page HTML
-         * @param {string} config Base64 encoded JSON string for feed config
-         * @param {*} [options] Override http request option.
-         * @throws {RequiredError}
-         */
-        getPage(config: string, options?: RawAxiosRequestConfig): AxiosPromise<string> {
-            return localVarFp.getPage(config, options).then((request) => request(axios, basePath));
-        },
-    };
-};
+            const localVarRequestOptions = { method: 'POST', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
 
-/**
- * PagesApi - object-oriented interface
- * @export
- * @class PagesApi
- * @extends {BaseAPI}
- */
-export class PagesApi extends BaseAPI {
-    /**
-     * 
-     * @summary Get page HTML
-     * @param {string} config Base64 encoded JSON string for feed config
-     * @param {*} [options] Override http request option.
-     * @throws {RequiredError}
-     * @memberof PagesApi
-     */
-    public getPage(config: string, options?: RawAxiosRequestConfig) {
-        return PagesApiFp(this.configuration).getPage(config, options).then((request) => request(this.axios, this.basePath));
-    }
-}
 
+    
+            localVarHeaderParameter['Content-Type'] = 'application/json';
 
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+            localVarRequestOptions.data = serializeDataIfNeeded(body, localVarRequestOptions, configuration)
 
-/**
- * SourcesApi - axios parameter creator
- * @export
- */
-export const SourcesApiAxiosParamCreator = function (configuration?: Configuration) {
-    return {
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
         /**
-         * 
-         * @summary Create a new source
-         * @param {CreateSourceRequest} createSourceRequest 
+         * Called by the WebSub hub of a subscribed &#x60;rss-feed&#x60; source with new and updated items of the feed. Content that isn&#39;t signed with the secret of the subscription is acknowledged, but ignored.
+         * @summary Receive feed content from a WebSub hub
+         * @param {string} sourceUid 
+         * @param {File} body 
          * @param {*} [options] Override http request option.
          * @throws {RequiredError}
          */
-        createSource: async (createSourceRequest: CreateSourceRequest, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
-            // verify required parameter 'createSourceRequest' is not null or undefined
-            assertParamExists('createSource', 'createSourceRequest', createSourceRequest)
-            const localVarPath = `/sources`;
+        receiveWebSub: async (sourceUid: string, body: File, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            // verify required parameter 'sourceUid' is not null or undefined
+            assertParamExists('receiveWebSub', 'sourceUid', sourceUid)
+            // verify required parameter 'body' is not null or undefined
+            assertParamExists('receiveWebSub', 'body', body)
+            const localVarPath = `/websub/{source_uid}`
+                .replace(`{${"source_uid"}}`, encodeURIComponent(String(sourceUid)));
             // use dummy base URL string because the URL constructor only accepts absolute URLs.
             const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
             let baseOptions;
@@ -487,12 +2240,12 @@ export const SourcesApiAxiosParamCreator = function (configuration?: Configurati
 
 
     
-            localVarHeaderParameter['Content-Type'] = 'application/json';
+            localVarHeaderParameter['Content-Type'] = '*/*';
 
             setSearchParams(localVarUrlObj, localVarQueryParameter);
             let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
             localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
-            localVarRequestOptions.data = serializeDataIfNeeded(createSourceRequest, localVarRequestOptions, configuration)
+            localVarRequestOptions.data = serializeDataIfNeeded(body, localVarRequestOptions, configuration)
 
             return {
                 url: toPathString(localVarUrlObj),
@@ -501,15 +2254,15 @@ export const SourcesApiAxiosParamCreator = function (configuration?: Configurati
         },
         /**
          * 
-         * @summary Delete source
+         * @summary Resume polling of a paused source
          * @param {string} uid 
          * @param {*} [options] Override http request option.
          * @throws {RequiredError}
          */
-        deleteSource: async (uid: string, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+        resumeSource: async (uid: string, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
             // verify required parameter 'uid' is not null or undefined
-            assertParamExists('deleteSource', 'uid', uid)
-            const localVarPath = `/sources/{uid}`
+            assertParamExists('resumeSource', 'uid', uid)
+            const localVarPath = `/sources/{uid}/resume`
                 .replace(`{${"uid"}}`, encodeURIComponent(String(uid)));
             // use dummy base URL string because the URL constructor only accepts absolute URLs.
             const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
@@ -518,7 +2271,7 @@ export const SourcesApiAxiosParamCreator = function (configuration?: Configurati
                 baseOptions = configuration.baseOptions;
             }
 
-            const localVarRequestOptions = { method: 'DELETE', ...baseOptions, ...options};
+            const localVarRequestOptions = { method: 'POST', ...baseOptions, ...options};
             const localVarHeaderParameter = {} as any;
             const localVarQueryParameter = {} as any;
 
@@ -534,15 +2287,18 @@ export const SourcesApiAxiosParamCreator = function (configuration?: Configurati
             };
         },
         /**
-         * 
-         * @summary Get source by UID
+         * Applies a JSON merge patch to the source config and restarts polling with the new config. Fields that define the source UID can&#39;t be changed, since the activities of the source are attached to its UID.
+         * @summary Update source config
          * @param {string} uid 
+         * @param {UpdateSourceRequest} updateSourceRequest 
          * @param {*} [options] Override http request option.
          * @throws {RequiredError}
          */
-        getSource: async (uid: string, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+        updateSource: async (uid: string, updateSourceRequest: UpdateSourceRequest, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
             // verify required parameter 'uid' is not null or undefined
-            assertParamExists('getSource', 'uid', uid)
+            assertParamExists('updateSource', 'uid', uid)
+            // verify required parameter 'updateSourceRequest' is not null or undefined
+            assertParamExists('updateSource', 'updateSourceRequest', updateSourceRequest)
             const localVarPath = `/sources/{uid}`
                 .replace(`{${"uid"}}`, encodeURIComponent(String(uid)));
             // use dummy base URL string because the URL constructor only accepts absolute URLs.
@@ -552,10 +2308,76 @@ export const SourcesApiAxiosParamCreator = function (configuration?: Configurati
                 baseOptions = configuration.baseOptions;
             }
 
+            const localVarRequestOptions = { method: 'PATCH', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+
+    
+            localVarHeaderParameter['Content-Type'] = 'application/json';
+
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+            localVarRequestOptions.data = serializeDataIfNeeded(updateSourceRequest, localVarRequestOptions, configuration)
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * Called by the WebSub hub of an &#x60;rss-feed&#x60; source to verify a subscription or unsubscription, or to report that a subscription was denied.
+         * @summary Verify a WebSub subscription
+         * @param {string} sourceUid 
+         * @param {VerifyWebSubHub.modeEnum} hub.mode 
+         * @param {string} hub.topic 
+         * @param {string} [hub.challenge] 
+         * @param {number} [hub.leaseSeconds] 
+         * @param {string} [hub.reason] 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        verifyWebSub: async (sourceUid: string, hub.mode: VerifyWebSubHub.modeEnum, hub.topic: string, hub.challenge?: string, hub.leaseSeconds?: number, hub.reason?: string, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            // verify required parameter 'sourceUid' is not null or undefined
+            assertParamExists('verifyWebSub', 'sourceUid', sourceUid)
+            // verify required parameter 'hub.mode' is not null or undefined
+            assertParamExists('verifyWebSub', 'hub.mode', hub.mode)
+            // verify required parameter 'hub.topic' is not null or undefined
+            assertParamExists('verifyWebSub', 'hub.topic', hub.topic)
+            const localVarPath = `/websub/{source_uid}`
+                .replace(`{${"source_uid"}}`, encodeURIComponent(String(sourceUid)));
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
             const localVarRequestOptions = { method: 'GET', ...baseOptions, ...options};
             const localVarHeaderParameter = {} as any;
             const localVarQueryParameter = {} as any;
 
+            if (hub.mode !== undefined) {
+                localVarQueryParameter['hub.mode'] = hub.mode;
+            }
+
+            if (hub.topic !== undefined) {
+                localVarQueryParameter['hub.topic'] = hub.topic;
+            }
+
+            if (hub.challenge !== undefined) {
+                localVarQueryParameter['hub.challenge'] = hub.challenge;
+            }
+
+            if (hub.leaseSeconds !== undefined) {
+                localVarQueryParameter['hub.lease_seconds'] = hub.leaseSeconds;
+            }
+
+            if (hub.reason !== undefined) {
+                localVarQueryParameter['hub.reason'] = hub.reason;
+            }
+
 
     
             setSearchParams(localVarUrlObj, localVarQueryParameter);
@@ -567,95 +2389,243 @@ export const SourcesApiAxiosParamCreator = function (configuration?: Configurati
                 options: localVarRequestOptions,
             };
         },
+    }
+};
+
+/**
+ * SourcesApi - functional programming interface
+ * @export
+ */
+export const SourcesApiFp = function(configuration?: Configuration) {
+    const localVarAxiosParamCreator = SourcesApiAxiosParamCreator(configuration)
+    return {
+        /**
+         * Pages through activities older than those fetched by polling, in the background. Backfills are throttled, so that they don&#39;t delay activities of polled sources. Supported by &#x60;github-issues&#x60;, &#x60;github-releases&#x60;, &#x60;reddit-subreddit&#x60;, &#x60;mastodon-account&#x60; and &#x60;mastodon-tag&#x60; sources.
+         * @summary Fetch the history of a source
+         * @param {string} uid 
+         * @param {Backfill} backfill 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async backfillSource(uid: string, backfill: Backfill, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<void>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.backfillSource(uid, backfill, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['SourcesApi.backfillSource']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * 
+         * @summary Create a new source
+         * @param {CreateSourceRequest} createSourceRequest 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async createSource(createSourceRequest: CreateSourceRequest, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<Source>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.createSource(createSourceRequest, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['SourcesApi.createSource']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * 
+         * @summary Delete source
+         * @param {string} uid 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async deleteSource(uid: string, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<void>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.deleteSource(uid, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['SourcesApi.deleteSource']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * Exports all sources as feeds that other readers can subscribe to. The full source configs are kept in Pulse-specific outline attributes, so the export can be imported into another Pulse instance. Secrets like tokens and webhook secrets are replaced with &#x60;REDACTED&#x60;, and must be filled in before the export is imported.
+         * @summary Export sources as OPML
+         * @param {ExportOPMLGroupByEnum} [groupBy] Folder the sources are grouped into
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async exportOPML(groupBy?: ExportOPMLGroupByEnum, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<string>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.exportOPML(groupBy, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['SourcesApi.exportOPML']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * 
+         * @summary Get source by UID
+         * @param {string} uid 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async getSource(uid: string, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<Source>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.getSource(uid, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['SourcesApi.getSource']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * Creates a source for every feed in the OPML document. Feeds of GitHub releases, Reddit, Mastodon and Lobsters are created as their native source types, other feeds as &#x60;rss-feed&#x60; sources. Folders and categories of a feed become tags of its source.
+         * @summary Import sources from OPML
+         * @param {string} body 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async importOPML(body: string, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<OPMLImportReport>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.importOPML(body, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['SourcesApi.importOPML']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * Maps the JSON payload to activities with the mapping of the &#x60;webhook&#x60; source with the ingest token, and queues them for processing. The token is generated when the source is created, and returned as &#x60;ingest_token&#x60; of the source. The payload must also be signed with the HMAC-SHA256 of the body, using the secret of the source, sent as a hex string in the signature header of the source (&#x60;X-Signature-256&#x60; by default).
+         * @summary Push activities to a webhook source
+         * @param {string} ingestToken 
+         * @param {object} body 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async ingestActivities(ingestToken: string, body: object, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<BatchResult>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.ingestActivities(ingestToken, body, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['SourcesApi.ingestActivities']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * 
+         * @summary List recent fetches of a source
+         * @param {string} uid 
+         * @param {number} [limit] Maximum number of runs to return
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async listSourceRuns(uid: string, limit?: number, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<Array<SourceRun>>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.listSourceRuns(uid, limit, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['SourcesApi.listSourceRuns']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * Lists every source type with a JSON Schema of its config and example configs. Configs of created sources are validated against the schema of their type.
+         * @summary List source types
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async listSourceTypes(options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<Array<SourceType>>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.listSourceTypes(options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['SourcesApi.listSourceTypes']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * 
+         * @summary List all sources
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async listSources(options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<Array<Source>>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.listSources(options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['SourcesApi.listSources']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
         /**
-         * 
-         * @summary List all sources
+         * Stops fetching new activities until the source is resumed. The source config and its activities are kept, so paused sources are still listed and searchable.
+         * @summary Pause polling of a source
+         * @param {string} uid 
          * @param {*} [options] Override http request option.
          * @throws {RequiredError}
          */
-        listSources: async (options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
-            const localVarPath = `/sources`;
-            // use dummy base URL string because the URL constructor only accepts absolute URLs.
-            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
-            let baseOptions;
-            if (configuration) {
-                baseOptions = configuration.baseOptions;
-            }
-
-            const localVarRequestOptions = { method: 'GET', ...baseOptions, ...options};
-            const localVarHeaderParameter = {} as any;
-            const localVarQueryParameter = {} as any;
-
-
-    
-            setSearchParams(localVarUrlObj, localVarQueryParameter);
-            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
-            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
-
-            return {
-                url: toPathString(localVarUrlObj),
-                options: localVarRequestOptions,
-            };
+        async pauseSource(uid: string, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<Source>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.pauseSource(uid, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['SourcesApi.pauseSource']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
         },
-    }
-};
-
-/**
- * SourcesApi - functional programming interface
- * @export
- */
-export const SourcesApiFp = function(configuration?: Configuration) {
-    const localVarAxiosParamCreator = SourcesApiAxiosParamCreator(configuration)
-    return {
         /**
-         * 
-         * @summary Create a new source
+         * Runs a single fetch of the source config and returns the activities it produces, without creating the source or summarizing, embedding and storing its activities.
+         * @summary Preview the activities of a source
          * @param {CreateSourceRequest} createSourceRequest 
          * @param {*} [options] Override http request option.
          * @throws {RequiredError}
          */
-        async createSource(createSourceRequest: CreateSourceRequest, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<Source>> {
-            const localVarAxiosArgs = await localVarAxiosParamCreator.createSource(createSourceRequest, options);
+        async previewSource(createSourceRequest: CreateSourceRequest, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<SourcePreview>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.previewSource(createSourceRequest, options);
             const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
-            const localVarOperationServerBasePath = operationServerMap['SourcesApi.createSource']?.[localVarOperationServerIndex]?.url;
+            const localVarOperationServerBasePath = operationServerMap['SourcesApi.previewSource']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * Maps &#x60;issues&#x60;, &#x60;issue_comment&#x60;, &#x60;pull_request&#x60; and &#x60;discussion&#x60; events to the &#x60;github-issues&#x60; source of the repository, &#x60;pull_request&#x60; and &#x60;pull_request_review&#x60; events to its &#x60;github-pull-requests&#x60; source, and &#x60;release&#x60; events to its &#x60;github-releases&#x60; source. Deliveries must be signed with the &#x60;webhook_secret&#x60; of the source, or the &#x60;GITHUB_WEBHOOK_SECRET&#x60; environment variable. Other events, and events of repositories without a source, are ignored.
+         * @summary Receive a GitHub webhook delivery
+         * @param {object} body 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async receiveGithubWebhook(body: object, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<BatchResult>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.receiveGithubWebhook(body, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['SourcesApi.receiveGithubWebhook']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * Called by the WebSub hub of a subscribed &#x60;rss-feed&#x60; source with new and updated items of the feed. Content that isn&#39;t signed with the secret of the subscription is acknowledged, but ignored.
+         * @summary Receive feed content from a WebSub hub
+         * @param {string} sourceUid 
+         * @param {File} body 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async receiveWebSub(sourceUid: string, body: File, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<void>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.receiveWebSub(sourceUid, body, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['SourcesApi.receiveWebSub']?.[localVarOperationServerIndex]?.url;
             return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
         },
         /**
          * 
-         * @summary Delete source
+         * @summary Resume polling of a paused source
          * @param {string} uid 
          * @param {*} [options] Override http request option.
          * @throws {RequiredError}
          */
-        async deleteSource(uid: string, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<void>> {
-            const localVarAxiosArgs = await localVarAxiosParamCreator.deleteSource(uid, options);
+        async resumeSource(uid: string, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<Source>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.resumeSource(uid, options);
             const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
-            const localVarOperationServerBasePath = operationServerMap['SourcesApi.deleteSource']?.[localVarOperationServerIndex]?.url;
+            const localVarOperationServerBasePath = operationServerMap['SourcesApi.resumeSource']?.[localVarOperationServerIndex]?.url;
             return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
         },
         /**
-         * 
-         * @summary Get source by UID
+         * Applies a JSON merge patch to the source config and restarts polling with the new config. Fields that define the source UID can&#39;t be changed, since the activities of the source are attached to its UID.
+         * @summary Update source config
          * @param {string} uid 
+         * @param {UpdateSourceRequest} updateSourceRequest 
          * @param {*} [options] Override http request option.
          * @throws {RequiredError}
          */
-        async getSource(uid: string, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<Source>> {
-            const localVarAxiosArgs = await localVarAxiosParamCreator.getSource(uid, options);
+        async updateSource(uid: string, updateSourceRequest: UpdateSourceRequest, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<Source>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.updateSource(uid, updateSourceRequest, options);
             const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
-            const localVarOperationServerBasePath = operationServerMap['SourcesApi.getSource']?.[localVarOperationServerIndex]?.url;
+            const localVarOperationServerBasePath = operationServerMap['SourcesApi.updateSource']?.[localVarOperationServerIndex]?.url;
             return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
         },
         /**
-         * 
-         * @summary List all sources
+         * Called by the WebSub hub of an &#x60;rss-feed&#x60; source to verify a subscription or unsubscription, or to report that a subscription was denied.
+         * @summary Verify a WebSub subscription
+         * @param {string} sourceUid 
+         * @param {VerifyWebSubHub.modeEnum} hub.mode 
+         * @param {string} hub.topic 
+         * @param {string} [hub.challenge] 
+         * @param {number} [hub.leaseSeconds] 
+         * @param {string} [hub.reason] 
          * @param {*} [options] Override http request option.
          * @throws {RequiredError}
          */
-        async listSources(options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<Array<Source>>> {
-            const localVarAxiosArgs = await localVarAxiosParamCreator.listSources(options);
+        async verifyWebSub(sourceUid: string, hub.mode: VerifyWebSubHub.modeEnum, hub.topic: string, hub.challenge?: string, hub.leaseSeconds?: number, hub.reason?: string, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<string>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.verifyWebSub(sourceUid, hub.mode, hub.topic, hub.challenge, hub.leaseSeconds, hub.reason, options);
             const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
-            const localVarOperationServerBasePath = operationServerMap['SourcesApi.listSources']?.[localVarOperationServerIndex]?.url;
+            const localVarOperationServerBasePath = operationServerMap['SourcesApi.verifyWebSub']?.[localVarOperationServerIndex]?.url;
             return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
         },
     }
@@ -668,6 +2638,17 @@ export const SourcesApiFp = function(configuration?: Configuration) {
 export const SourcesApiFactory = function (configuration?: Configuration, basePath?: string, axios?: AxiosInstance) {
     const localVarFp = SourcesApiFp(configuration)
     return {
+        /**
+         * Pages through activities older than those fetched by polling, in the background. Backfills are throttled, so that they don&#39;t delay activities of polled sources. Supported by &#x60;github-issues&#x60;, &#x60;github-releases&#x60;, &#x60;reddit-subreddit&#x60;, &#x60;mastodon-account&#x60; and &#x60;mastodon-tag&#x60; sources.
+         * @summary Fetch the history of a source
+         * @param {string} uid 
+         * @param {Backfill} backfill 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        backfillSource(uid: string, backfill: Backfill, options?: RawAxiosRequestConfig): AxiosPromise<void> {
+            return localVarFp.backfillSource(uid, backfill, options).then((request) => request(axios, basePath));
+        },
         /**
          * 
          * @summary Create a new source
@@ -688,6 +2669,16 @@ export const SourcesApiFactory = function (configuration?: Configuration, basePa
         deleteSource(uid: string, options?: RawAxiosRequestConfig): AxiosPromise<void> {
             return localVarFp.deleteSource(uid, options).then((request) => request(axios, basePath));
         },
+        /**
+         * Exports all sources as feeds that other readers can subscribe to. The full source configs are kept in Pulse-specific outline attributes, so the export can be imported into another Pulse instance. Secrets like tokens and webhook secrets are replaced with &#x60;REDACTED&#x60;, and must be filled in before the export is imported.
+         * @summary Export sources as OPML
+         * @param {ExportOPMLGroupByEnum} [groupBy] Folder the sources are grouped into
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        exportOPML(groupBy?: ExportOPMLGroupByEnum, options?: RawAxiosRequestConfig): AxiosPromise<string> {
+            return localVarFp.exportOPML(groupBy, options).then((request) => request(axios, basePath));
+        },
         /**
          * 
          * @summary Get source by UID
@@ -698,6 +2689,47 @@ export const SourcesApiFactory = function (configuration?: Configuration, basePa
         getSource(uid: string, options?: RawAxiosRequestConfig): AxiosPromise<Source> {
             return localVarFp.getSource(uid, options).then((request) => request(axios, basePath));
         },
+        /**
+         * Creates a source for every feed in the OPML document. Feeds of GitHub releases, Reddit, Mastodon and Lobsters are created as their native source types, other feeds as &#x60;rss-feed&#x60; sources. Folders and categories of a feed become tags of its source.
+         * @summary Import sources from OPML
+         * @param {string} body 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        importOPML(body: string, options?: RawAxiosRequestConfig): AxiosPromise<OPMLImportReport> {
+            return localVarFp.importOPML(body, options).then((request) => request(axios, basePath));
+        },
+        /**
+         * Maps the JSON payload to activities with the mapping of the &#x60;webhook&#x60; source with the ingest token, and queues them for processing. The token is generated when the source is created, and returned as &#x60;ingest_token&#x60; of the source. The payload must also be signed with the HMAC-SHA256 of the body, using the secret of the source, sent as a hex string in the signature header of the source (&#x60;X-Signature-256&#x60; by default).
+         * @summary Push activities to a webhook source
+         * @param {string} ingestToken 
+         * @param {object} body 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        ingestActivities(ingestToken: string, body: object, options?: RawAxiosRequestConfig): AxiosPromise<BatchResult> {
+            return localVarFp.ingestActivities(ingestToken, body, options).then((request) => request(axios, basePath));
+        },
+        /**
+         * 
+         * @summary List recent fetches of a source
+         * @param {string} uid 
+         * @param {number} [limit] Maximum number of runs to return
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        listSourceRuns(uid: string, limit?: number, options?: RawAxiosRequestConfig): AxiosPromise<Array<SourceRun>> {
+            return localVarFp.listSourceRuns(uid, limit, options).then((request) => request(axios, basePath));
+        },
+        /**
+         * Lists every source type with a JSON Schema of its config and example configs. Configs of created sources are validated against the schema of their type.
+         * @summary List source types
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        listSourceTypes(options?: RawAxiosRequestConfig): AxiosPromise<Array<SourceType>> {
+            return localVarFp.listSourceTypes(options).then((request) => request(axios, basePath));
+        },
         /**
          * 
          * @summary List all sources
@@ -707,6 +2739,83 @@ export const SourcesApiFactory = function (configuration?: Configuration, basePa
         listSources(options?: RawAxiosRequestConfig): AxiosPromise<Array<Source>> {
             return localVarFp.listSources(options).then((request) => request(axios, basePath));
         },
+        /**
+         * Stops fetching new activities until the source is resumed. The source config and its activities are kept, so paused sources are still listed and searchable.
+         * @summary Pause polling of a source
+         * @param {string} uid 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        pauseSource(uid: string, options?: RawAxiosRequestConfig): AxiosPromise<Source> {
+            return localVarFp.pauseSource(uid, options).then((request) => request(axios, basePath));
+        },
+        /**
+         * Runs a single fetch of the source config and returns the activities it produces, without creating the source or summarizing, embedding and storing its activities.
+         * @summary Preview the activities of a source
+         * @param {CreateSourceRequest} createSourceRequest 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        previewSource(createSourceRequest: CreateSourceRequest, options?: RawAxiosRequestConfig): AxiosPromise<SourcePreview> {
+            return localVarFp.previewSource(createSourceRequest, options).then((request) => request(axios, basePath));
+        },
+        /**
+         * Maps &#x60;issues&#x60;, &#x60;issue_comment&#x60;, &#x60;pull_request&#x60; and &#x60;discussion&#x60; events to the &#x60;github-issues&#x60; source of the repository, &#x60;pull_request&#x60; and &#x60;pull_request_review&#x60; events to its &#x60;github-pull-requests&#x60; source, and &#x60;release&#x60; events to its &#x60;github-releases&#x60; source. Deliveries must be signed with the &#x60;webhook_secret&#x60; of the source, or the &#x60;GITHUB_WEBHOOK_SECRET&#x60; environment variable. Other events, and events of repositories without a source, are ignored.
+         * @summary Receive a GitHub webhook delivery
+         * @param {object} body 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        receiveGithubWebhook(body: object, options?: RawAxiosRequestConfig): AxiosPromise<BatchResult> {
+            return localVarFp.receiveGithubWebhook(body, options).then((request) => request(axios, basePath));
+        },
+        /**
+         * Called by the WebSub hub of a subscribed &#x60;rss-feed&#x60; source with new and updated items of the feed. Content that isn&#39;t signed with the secret of the subscription is acknowledged, but ignored.
+         * @summary Receive feed content from a WebSub hub
+         * @param {string} sourceUid 
+         * @param {File} body 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        receiveWebSub(sourceUid: string, body: File, options?: RawAxiosRequestConfig): AxiosPromise<void> {
+            return localVarFp.receiveWebSub(sourceUid, body, options).then((request) => request(axios, basePath));
+        },
+        /**
+         * 
+         * @summary Resume polling of a paused source
+         * @param {string} uid 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        resumeSource(uid: string, options?: RawAxiosRequestConfig): AxiosPromise<Source> {
+            return localVarFp.resumeSource(uid, options).then((request) => request(axios, basePath));
+        },
+        /**
+         * Applies a JSON merge patch to the source config and restarts polling with the new config. Fields that define the source UID can&#39;t be changed, since the activities of the source are attached to its UID.
+         * @summary Update source config
+         * @param {string} uid 
+         * @param {UpdateSourceRequest} updateSourceRequest 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        updateSource(uid: string, updateSourceRequest: UpdateSourceRequest, options?: RawAxiosRequestConfig): AxiosPromise<Source> {
+            return localVarFp.updateSource(uid, updateSourceRequest, options).then((request) => request(axios, basePath));
+        },
+        /**
+         * Called by the WebSub hub of an &#x60;rss-feed&#x60; source to verify a subscription or unsubscription, or to report that a subscription was denied.
+         * @summary Verify a WebSub subscription
+         * @param {string} sourceUid 
+         * @param {VerifyWebSubHub.modeEnum} hub.mode 
+         * @param {string} hub.topic 
+         * @param {string} [hub.challenge] 
+         * @param {number} [hub.leaseSeconds] 
+         * @param {string} [hub.reason] 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        verifyWebSub(sourceUid: string, hub.mode: VerifyWebSubHub.modeEnum, hub.topic: string, hub.challenge?: string, hub.leaseSeconds?: number, hub.reason?: string, options?: RawAxiosRequestConfig): AxiosPromise<string> {
+            return localVarFp.verifyWebSub(sourceUid, hub.mode, hub.topic, hub.challenge, hub.leaseSeconds, hub.reason, options).then((request) => request(axios, basePath));
+        },
     };
 };
 
@@ -717,6 +2826,19 @@ export const SourcesApiFactory = function (configuration?: Configuration, basePa
  * @extends {BaseAPI}
  */
 export class SourcesApi extends BaseAPI {
+    /**
+     * Pages through activities older than those fetched by polling, in the background. Backfills are throttled, so that they don&#39;t delay activities of polled sources. Supported by &#x60;github-issues&#x60;, &#x60;github-releases&#x60;, &#x60;reddit-subreddit&#x60;, &#x60;mastodon-account&#x60; and &#x60;mastodon-tag&#x60; sources.
+     * @summary Fetch the history of a source
+     * @param {string} uid 
+     * @param {Backfill} backfill 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof SourcesApi
+     */
+    public backfillSource(uid: string, backfill: Backfill, options?: RawAxiosRequestConfig) {
+        return SourcesApiFp(this.configuration).backfillSource(uid, backfill, options).then((request) => request(this.axios, this.basePath));
+    }
+
     /**
      * 
      * @summary Create a new source
@@ -741,6 +2863,18 @@ export class SourcesApi extends BaseAPI {
         return SourcesApiFp(this.configuration).deleteSource(uid, options).then((request) => request(this.axios, this.basePath));
     }
 
+    /**
+     * Exports all sources as feeds that other readers can subscribe to. The full source configs are kept in Pulse-specific outline attributes, so the export can be imported into another Pulse instance. Secrets like tokens and webhook secrets are replaced with &#x60;REDACTED&#x60;, and must be filled in before the export is imported.
+     * @summary Export sources as OPML
+     * @param {ExportOPMLGroupByEnum} [groupBy] Folder the sources are grouped into
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof SourcesApi
+     */
+    public exportOPML(groupBy?: ExportOPMLGroupByEnum, options?: RawAxiosRequestConfig) {
+        return SourcesApiFp(this.configuration).exportOPML(groupBy, options).then((request) => request(this.axios, this.basePath));
+    }
+
     /**
      * 
      * @summary Get source by UID
@@ -753,6 +2887,55 @@ export class SourcesApi extends BaseAPI {
         return SourcesApiFp(this.configuration).getSource(uid, options).then((request) => request(this.axios, this.basePath));
     }
 
+    /**
+     * Creates a source for every feed in the OPML document. Feeds of GitHub releases, Reddit, Mastodon and Lobsters are created as their native source types, other feeds as &#x60;rss-feed&#x60; sources. Folders and categories of a feed become tags of its source.
+     * @summary Import sources from OPML
+     * @param {string} body 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof SourcesApi
+     */
+    public importOPML(body: string, options?: RawAxiosRequestConfig) {
+        return SourcesApiFp(this.configuration).importOPML(body, options).then((request) => request(this.axios, this.basePath));
+    }
+
+    /**
+     * Maps the JSON payload to activities with the mapping of the &#x60;webhook&#x60; source with the ingest token, and queues them for processing. The token is generated when the source is created, and returned as &#x60;ingest_token&#x60; of the source. The payload must also be signed with the HMAC-SHA256 of the body, using the secret of the source, sent as a hex string in the signature header of the source (&#x60;X-Signature-256&#x60; by default).
+     * @summary Push activities to a webhook source
+     * @param {string} ingestToken 
+     * @param {object} body 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof SourcesApi
+     */
+    public ingestActivities(ingestToken: string, body: object, options?: RawAxiosRequestConfig) {
+        return SourcesApiFp(this.configuration).ingestActivities(ingestToken, body, options).then((request) => request(this.axios, this.basePath));
+    }
+
+    /**
+     * 
+     * @summary List recent fetches of a source
+     * @param {string} uid 
+     * @param {number} [limit] Maximum number of runs to return
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof SourcesApi
+     */
+    public listSourceRuns(uid: string, limit?: number, options?: RawAxiosRequestConfig) {
+        return SourcesApiFp(this.configuration).listSourceRuns(uid, limit, options).then((request) => request(this.axios, this.basePath));
+    }
+
+    /**
+     * Lists every source type with a JSON Schema of its config and example configs. Configs of created sources are validated against the schema of their type.
+     * @summary List source types
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof SourcesApi
+     */
+    public listSourceTypes(options?: RawAxiosRequestConfig) {
+        return SourcesApiFp(this.configuration).listSourceTypes(options).then((request) => request(this.axios, this.basePath));
+    }
+
     /**
      * 
      * @summary List all sources
@@ -763,7 +2946,116 @@ export class SourcesApi extends BaseAPI {
     public listSources(options?: RawAxiosRequestConfig) {
         return SourcesApiFp(this.configuration).listSources(options).then((request) => request(this.axios, this.basePath));
     }
+
+    /**
+     * Stops fetching new activities until the source is resumed. The source config and its activities are kept, so paused sources are still listed and searchable.
+     * @summary Pause polling of a source
+     * @param {string} uid 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof SourcesApi
+     */
+    public pauseSource(uid: string, options?: RawAxiosRequestConfig) {
+        return SourcesApiFp(this.configuration).pauseSource(uid, options).then((request) => request(this.axios, this.basePath));
+    }
+
+    /**
+     * Runs a single fetch of the source config and returns the activities it produces, without creating the source or summarizing, embedding and storing its activities.
+     * @summary Preview the activities of a source
+     * @param {CreateSourceRequest} createSourceRequest 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof SourcesApi
+     */
+    public previewSource(createSourceRequest: CreateSourceRequest, options?: RawAxiosRequestConfig) {
+        return SourcesApiFp(this.configuration).previewSource(createSourceRequest, options).then((request) => request(this.axios, this.basePath));
+    }
+
+    /**
+     * Maps &#x60;issues&#x60;, &#x60;issue_comment&#x60;, &#x60;pull_request&#x60; and &#x60;discussion&#x60; events to the &#x60;github-issues&#x60; source of the repository, &#x60;pull_request&#x60; and &#x60;pull_request_review&#x60; events to its &#x60;github-pull-requests&#x60; source, and &#x60;release&#x60; events to its &#x60;github-releases&#x60; source. Deliveries must be signed with the &#x60;webhook_secret&#x60; of the source, or the &#x60;GITHUB_WEBHOOK_SECRET&#x60; environment variable. Other events, and events of repositories without a source, are ignored.
+     * @summary Receive a GitHub webhook delivery
+     * @param {object} body 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof SourcesApi
+     */
+    public receiveGithubWebhook(body: object, options?: RawAxiosRequestConfig) {
+        return SourcesApiFp(this.configuration).receiveGithubWebhook(body, options).then((request) => request(this.axios, this.basePath));
+    }
+
+    /**
+     * Called by the WebSub hub of a subscribed &#x60;rss-feed&#x60; source with new and updated items of the feed. Content that isn&#39;t signed with the secret of the subscription is acknowledged, but ignored.
+     * @summary Receive feed content from a WebSub hub
+     * @param {string} sourceUid 
+     * @param {File} body 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof SourcesApi
+     */
+    public receiveWebSub(sourceUid: string, body: File, options?: RawAxiosRequestConfig) {
+        return SourcesApiFp(this.configuration).receiveWebSub(sourceUid, body, options).then((request) => request(this.axios, this.basePath));
+    }
+
+    /**
+     * 
+     * @summary Resume polling of a paused source
+     * @param {string} uid 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof SourcesApi
+     */
+    public resumeSource(uid: string, options?: RawAxiosRequestConfig) {
+        return SourcesApiFp(this.configuration).resumeSource(uid, options).then((request) => request(this.axios, this.basePath));
+    }
+
+    /**
+     * Applies a JSON merge patch to the source config and restarts polling with the new config. Fields that define the source UID can&#39;t be changed, since the activities of the source are attached to its UID.
+     * @summary Update source config
+     * @param {string} uid 
+     * @param {UpdateSourceRequest} updateSourceRequest 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof SourcesApi
+     */
+    public updateSource(uid: string, updateSourceRequest: UpdateSourceRequest, options?: RawAxiosRequestConfig) {
+        return SourcesApiFp(this.configuration).updateSource(uid, updateSourceRequest, options).then((request) => request(this.axios, this.basePath));
+    }
+
+    /**
+     * Called by the WebSub hub of an &#x60;rss-feed&#x60; source to verify a subscription or unsubscription, or to report that a subscription was denied.
+     * @summary Verify a WebSub subscription
+     * @param {string} sourceUid 
+     * @param {VerifyWebSubHub.modeEnum} hub.mode 
+     * @param {string} hub.topic 
+     * @param {string} [hub.challenge] 
+     * @param {number} [hub.leaseSeconds] 
+     * @param {string} [hub.reason] 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof SourcesApi
+     */
+    public verifyWebSub(sourceUid: string, hub.mode: VerifyWebSubHub.modeEnum, hub.topic: string, hub.challenge?: string, hub.leaseSeconds?: number, hub.reason?: string, options?: RawAxiosRequestConfig) {
+        return SourcesApiFp(this.configuration).verifyWebSub(sourceUid, hub.mode, hub.topic, hub.challenge, hub.leaseSeconds, hub.reason, options).then((request) => request(this.axios, this.basePath));
+    }
 }
 
+/**
+ * @export
+ */
+export const ExportOPMLGroupByEnum = {
+    Type: 'type',
+    Tag: 'tag'
+} as const;
+export type ExportOPMLGroupByEnum = typeof ExportOPMLGroupByEnum[keyof typeof ExportOPMLGroupByEnum];
+/**
+ * @export
+ */
+export const VerifyWebSubHub.modeEnum = {
+    Subscribe: 'subscribe',
+    Unsubscribe: 'unsubscribe',
+    Denied: 'denied'
+} as const;
+export type VerifyWebSubHub.modeEnum = typeof VerifyWebSubHub.modeEnum[keyof typeof VerifyWebSubHub.modeEnum];
+
 
 
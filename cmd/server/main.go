@@ -5,11 +5,14 @@ import (
 	"fmt"
 	"github.com/glanceapp/glance/pkg/api"
 	"github.com/glanceapp/glance/pkg/config"
+	_ "github.com/glanceapp/glance/pkg/sources/all"
 	"github.com/glanceapp/glance/pkg/storage/postgres"
 	"github.com/joho/godotenv"
 	"github.com/rs/zerolog"
 	"log"
 	"os"
+	"os/signal"
+	"syscall"
 )
 
 func main() {
@@ -38,14 +41,29 @@ func run() error {
 		return fmt.Errorf("connect to database: %w", err)
 	}
 
-	server, err := api.NewServer(&logger, &cfg.APIConfig, db)
+	server, err := api.NewServer(&logger, &cfg.APIConfig, &cfg.SourcesConfig, db)
 	if err != nil {
 		return fmt.Errorf("create server: %w", err)
 	}
 
+	go reloadOnHangup(&logger, server)
+
 	if err := server.Start(); err != nil {
 		return fmt.Errorf("start server: %w", err)
 	}
 
 	return nil
 }
+
+// reloadOnHangup reconciles the sources file whenever the process receives SIGHUP.
+func reloadOnHangup(logger *zerolog.Logger, server *api.Server) {
+	hangup := make(chan os.Signal, 1)
+	signal.Notify(hangup, syscall.SIGHUP)
+
+	for range hangup {
+		logger.Info().Msg("Received SIGHUP, reconciling sources file")
+		if err := server.ReconcileSources(); err != nil {
+			logger.Error().Err(err).Msg("Failed to reconcile sources file")
+		}
+	}
+}
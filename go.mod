@@ -7,6 +7,7 @@ toolchain go1.24.2
 require (
 	entgo.io/ent v0.14.4
 	github.com/alexferrari88/gohn v0.8.0
+	github.com/getkin/kin-openapi v0.127.0
 	github.com/google/go-github/v72 v72.0.0
 	github.com/jackc/pgx/v5 v5.7.2
 	github.com/joeshaw/envdecode v0.0.0-20200121155833-099f1fc765bd
@@ -33,7 +34,6 @@ require (
 	github.com/araddon/dateparse v0.0.0-20210429162001-6b43995a97de // indirect
 	github.com/bmatcuk/doublestar v1.3.4 // indirect
 	github.com/dprotaso/go-yit v0.0.0-20220510233725-9ba8df137936 // indirect
-	github.com/getkin/kin-openapi v0.127.0 // indirect
 	github.com/go-openapi/inflect v0.21.0 // indirect
 	github.com/go-openapi/jsonpointer v0.21.0 // indirect
 	github.com/go-openapi/jsonreference v0.20.0 // indirect
@@ -13,12 +13,59 @@ import (
 	"github.com/oapi-codegen/runtime"
 )
 
+// Defines values for DeadLetterStage.
+const (
+	DeadLetterStageEmbed     DeadLetterStage = "embed"
+	DeadLetterStageStore     DeadLetterStage = "store"
+	DeadLetterStageSummarize DeadLetterStage = "summarize"
+)
+
+// Defines values for OPMLImportEntryStatus.
+const (
+	Created OPMLImportEntryStatus = "created"
+	Exists  OPMLImportEntryStatus = "exists"
+	Failed  OPMLImportEntryStatus = "failed"
+)
+
+// Defines values for RetentionPreviewMode.
+const (
+	Archive RetentionPreviewMode = "archive"
+	Delete  RetentionPreviewMode = "delete"
+)
+
+// Defines values for SourceHealthStatus.
+const (
+	Degraded SourceHealthStatus = "degraded"
+	Failing  SourceHealthStatus = "failing"
+	Healthy  SourceHealthStatus = "healthy"
+)
+
+// Defines values for StageStatsStage.
+const (
+	StageStatsStageEmbed     StageStatsStage = "embed"
+	StageStatsStageStore     StageStatsStage = "store"
+	StageStatsStageSummarize StageStatsStage = "summarize"
+)
+
 // Defines values for SearchActivitiesParamsSortBy.
 const (
 	CreatedDate SearchActivitiesParamsSortBy = "created_date"
 	Similarity  SearchActivitiesParamsSortBy = "similarity"
 )
 
+// Defines values for ExportOPMLParamsGroupBy.
+const (
+	Tag  ExportOPMLParamsGroupBy = "tag"
+	Type ExportOPMLParamsGroupBy = "type"
+)
+
+// Defines values for VerifyWebSubParamsHubMode.
+const (
+	Denied      VerifyWebSubParamsHubMode = "denied"
+	Subscribe   VerifyWebSubParamsHubMode = "subscribe"
+	Unsubscribe VerifyWebSubParamsHubMode = "unsubscribe"
+)
+
 // Activity defines model for Activity.
 type Activity struct {
 	Body      string    `json:"body"`
@@ -28,28 +75,266 @@ type Activity struct {
 	FullSummary string `json:"full_summary"`
 	ImageUrl    string `json:"image_url"`
 
+	// Referenced Activities linked by other activities are kept by retention rules as well.
+	Referenced bool `json:"referenced"`
+
 	// ShortSummary One-line short plain text summary.
 	ShortSummary string `json:"short_summary"`
 
 	// Similarity Similarity score (0-1) when using semantic search
 	Similarity *float32 `json:"similarity,omitempty"`
 	SourceUid  string   `json:"source_uid"`
-	Title      string   `json:"title"`
-	Uid        string   `json:"uid"`
-	Url        string   `json:"url"`
+
+	// Starred Starred activities are kept by retention rules.
+	Starred bool   `json:"starred"`
+	Title   string `json:"title"`
+
+	// Uid Globally unique activity ID, derived from the source UID and the ID of the activity within the source.
+	Uid string `json:"uid"`
+	Url string `json:"url"`
+}
+
+// Backfill Limits how far back a backfill goes. At least one of the limits is required.
+type Backfill struct {
+	// Limit Maximum number of activities to fetch.
+	Limit *int `json:"limit,omitempty"`
+
+	// Window Maximum age of fetched activities, e.g. "72h" or "30d".
+	Window *string `json:"window,omitempty"`
+}
+
+// BatchResult defines model for BatchResult.
+type BatchResult struct {
+	// Count Number of affected items.
+	Count int `json:"count"`
 }
 
 // CreateSourceRequest defines model for CreateSourceRequest.
 type CreateSourceRequest struct {
-	Config map[string]interface{} `json:"config"`
-	Type   string                 `json:"type"`
+	// Backfill Limits how far back a backfill goes. At least one of the limits is required.
+	Backfill *Backfill              `json:"backfill,omitempty"`
+	Config   map[string]interface{} `json:"config"`
+	Type     string                 `json:"type"`
+}
+
+// DeadLetter defines model for DeadLetter.
+type DeadLetter struct {
+	Attempts int       `json:"attempts"`
+	Error    string    `json:"error"`
+	FailedAt time.Time `json:"failed_at"`
+
+	// Id ID of the activity that failed processing.
+	Id        string `json:"id"`
+	SourceUid string `json:"source_uid"`
+
+	// Stage Pipeline stage that failed. Retries resume from this stage.
+	Stage DeadLetterStage `json:"stage"`
+
+	// Title Empty if the stored activity can't be decoded anymore, e.g. if its source type was removed.
+	Title string `json:"title"`
+	Url   string `json:"url"`
+}
+
+// DeadLetterStage Pipeline stage that failed. Retries resume from this stage.
+type DeadLetterStage string
+
+// OPMLImportEntry defines model for OPMLImportEntry.
+type OPMLImportEntry struct {
+	Error   *string `json:"error,omitempty"`
+	FeedUrl string  `json:"feed_url"`
+
+	// SourceUid UID of the created or existing source, omitted if the feed couldn't be mapped to a source.
+	SourceUid *string               `json:"source_uid,omitempty"`
+	Status    OPMLImportEntryStatus `json:"status"`
+	Title     string                `json:"title"`
+}
+
+// OPMLImportEntryStatus defines model for OPMLImportEntry.Status.
+type OPMLImportEntryStatus string
+
+// OPMLImportReport defines model for OPMLImportReport.
+type OPMLImportReport struct {
+	Created int               `json:"created"`
+	Entries []OPMLImportEntry `json:"entries"`
+
+	// Exists Number of feeds whose source already existed.
+	Exists int `json:"exists"`
+	Failed int `json:"failed"`
+}
+
+// PipelineStats defines model for PipelineStats.
+type PipelineStats struct {
+	BusyWorkers int `json:"busy_workers"`
+
+	// DroppedActivities Number of activities dropped because the queue stayed full.
+	DroppedActivities int64 `json:"dropped_activities"`
+
+	// FailedJobs Number of jobs that exhausted their retries.
+	FailedJobs int `json:"failed_jobs"`
+
+	// QueueCapacity Queue depth at which ingestion stops accepting new activities.
+	QueueCapacity int `json:"queue_capacity"`
+
+	// QueueDepth Number of pending and running jobs.
+	QueueDepth int          `json:"queue_depth"`
+	Stages     []StageStats `json:"stages"`
+
+	// ThrottledEnqueues Number of times ingestion waited for the queue to drain.
+	ThrottledEnqueues int64 `json:"throttled_enqueues"`
+	Workers           int   `json:"workers"`
+}
+
+// PreviewActivity defines model for PreviewActivity.
+type PreviewActivity struct {
+	// BodyExcerpt Start of the activity body. Empty if the body, e.g. a linked article, wasn't fetched in time.
+	BodyExcerpt string    `json:"body_excerpt"`
+	CreatedAt   time.Time `json:"created_at"`
+	Title       string    `json:"title"`
+	Url         string    `json:"url"`
+}
+
+// RetentionPreview defines model for RetentionPreview.
+type RetentionPreview struct {
+	// KeepReferenced Whether activities whose URL is referenced by other activities are kept.
+	KeepReferenced bool          `json:"keep_referenced"`
+	KeepStarred    bool          `json:"keep_starred"`
+	LastRun        *RetentionRun `json:"last_run,omitempty"`
+
+	// Mode Whether expired activities are deleted, or only their embeddings are dropped.
+	Mode RetentionPreviewMode `json:"mode"`
+
+	// Rules Rules of sources first, then of source types, then the default.
+	Rules []RetentionRule `json:"rules"`
+	Total RetentionStats  `json:"total"`
+}
+
+// RetentionPreviewMode Whether expired activities are deleted, or only their embeddings are dropped.
+type RetentionPreviewMode string
+
+// RetentionRule defines model for RetentionRule.
+type RetentionRule struct {
+	// CreatedBefore Activities created before are expired.
+	CreatedBefore time.Time      `json:"created_before"`
+	Expired       RetentionStats `json:"expired"`
+
+	// Retention Retention like 30d.
+	Retention string `json:"retention"`
+
+	// SourceType Set for rules of a source type. Rules without a source or type are the default.
+	SourceType *string `json:"source_type,omitempty"`
+
+	// SourceUid Set for rules of a source.
+	SourceUid *string `json:"source_uid,omitempty"`
+}
+
+// RetentionRun defines model for RetentionRun.
+type RetentionRun struct {
+	// Error Error the run stopped at.
+	Error      *string        `json:"error,omitempty"`
+	FinishedAt time.Time      `json:"finished_at"`
+	Pruned     RetentionStats `json:"pruned"`
+	StartedAt  time.Time      `json:"started_at"`
+}
+
+// RetentionStats defines model for RetentionStats.
+type RetentionStats struct {
+	Activities int `json:"activities"`
+
+	// Bytes Estimated storage of the activities, or of their embeddings when archiving.
+	Bytes int64 `json:"bytes"`
 }
 
 // Source defines model for Source.
 type Source struct {
-	Name string `json:"name"`
-	Uid  string `json:"uid"`
-	Url  string `json:"url"`
+	// Enabled False if the source is paused and not polled.
+	Enabled bool          `json:"enabled"`
+	Health  *SourceHealth `json:"health,omitempty"`
+
+	// IngestToken Token of the URL activities are pushed to, `POST /ingest/{ingest_token}`. Only set for `webhook` sources.
+	IngestToken *string `json:"ingest_token,omitempty"`
+
+	// Managed True if the source is defined in the sources file and can't be changed through the API.
+	Managed bool      `json:"managed"`
+	Name    string    `json:"name"`
+	Tags    *[]string `json:"tags,omitempty"`
+	Uid     string    `json:"uid"`
+	Url     string    `json:"url"`
+}
+
+// SourceHealth defines model for SourceHealth.
+type SourceHealth struct {
+	ConsecutiveFailures int        `json:"consecutive_failures"`
+	LastError           *string    `json:"last_error,omitempty"`
+	LastErrorAt         *time.Time `json:"last_error_at,omitempty"`
+
+	// LastItems Number of activities produced by the last fetch.
+	LastItems     int        `json:"last_items"`
+	LastSuccessAt *time.Time `json:"last_success_at,omitempty"`
+
+	// Status Derived from the number of consecutive failed fetches.
+	Status SourceHealthStatus `json:"status"`
+}
+
+// SourceHealthStatus Derived from the number of consecutive failed fetches.
+type SourceHealthStatus string
+
+// SourcePreview defines model for SourcePreview.
+type SourcePreview struct {
+	Activities []PreviewActivity `json:"activities"`
+
+	// Errors Errors reported by the fetch. Activities fetched before an error are still included.
+	Errors []string `json:"errors"`
+
+	// Uid UID the source would have once created.
+	Uid string `json:"uid"`
+}
+
+// SourceRun defines model for SourceRun.
+type SourceRun struct {
+	// Error Last error reported during the fetch, omitted if the fetch succeeded.
+	Error      *string   `json:"error,omitempty"`
+	FinishedAt time.Time `json:"finished_at"`
+
+	// Items Number of activities produced by the fetch.
+	Items     int       `json:"items"`
+	StartedAt time.Time `json:"started_at"`
+}
+
+// SourceType defines model for SourceType.
+type SourceType struct {
+	Description string                   `json:"description"`
+	Examples    []map[string]interface{} `json:"examples"`
+
+	// Schema JSON Schema of the source config.
+	Schema map[string]interface{} `json:"schema"`
+	Type   string                 `json:"type"`
+}
+
+// StageStats defines model for StageStats.
+type StageStats struct {
+	Concurrency int             `json:"concurrency"`
+	Failed      int64           `json:"failed"`
+	InFlight    int             `json:"in_flight"`
+	Processed   int64           `json:"processed"`
+	Stage       StageStatsStage `json:"stage"`
+}
+
+// StageStatsStage defines model for StageStats.Stage.
+type StageStatsStage string
+
+// UpdateSourceRequest Source config fields to change. Fields set to null are reset to their defaults.
+type UpdateSourceRequest map[string]interface{}
+
+// ValidationError defines model for ValidationError.
+type ValidationError struct {
+	// Field JSON path of the invalid field, e.g. `config.repository`. Omitted if the error isn't about a single field.
+	Field   *string `json:"field,omitempty"`
+	Message string  `json:"message"`
+}
+
+// ValidationErrors defines model for ValidationErrors.
+type ValidationErrors struct {
+	Errors []ValidationError `json:"errors"`
 }
 
 // SearchActivitiesParams defines parameters for SearchActivities.
@@ -73,23 +358,104 @@ type SearchActivitiesParams struct {
 // SearchActivitiesParamsSortBy defines parameters for SearchActivities.
 type SearchActivitiesParamsSortBy string
 
+// IngestActivitiesJSONBody defines parameters for IngestActivities.
+type IngestActivitiesJSONBody = interface{}
+
 // GetPageParams defines parameters for GetPage.
 type GetPageParams struct {
 	// Config Base64 encoded JSON string for feed config
 	Config string `form:"config" json:"config"`
 }
 
+// ExportOPMLParams defines parameters for ExportOPML.
+type ExportOPMLParams struct {
+	// GroupBy Folder the sources are grouped into
+	GroupBy *ExportOPMLParamsGroupBy `form:"group_by,omitempty" json:"group_by,omitempty"`
+}
+
+// ExportOPMLParamsGroupBy defines parameters for ExportOPML.
+type ExportOPMLParamsGroupBy string
+
+// ListSourceRunsParams defines parameters for ListSourceRuns.
+type ListSourceRunsParams struct {
+	// Limit Maximum number of runs to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// ReceiveGithubWebhookJSONBody defines parameters for ReceiveGithubWebhook.
+type ReceiveGithubWebhookJSONBody = interface{}
+
+// VerifyWebSubParams defines parameters for VerifyWebSub.
+type VerifyWebSubParams struct {
+	HubMode         VerifyWebSubParamsHubMode `form:"hub.mode" json:"hub.mode"`
+	HubTopic        string                    `form:"hub.topic" json:"hub.topic"`
+	HubChallenge    *string                   `form:"hub.challenge,omitempty" json:"hub.challenge,omitempty"`
+	HubLeaseSeconds *int                      `form:"hub.lease_seconds,omitempty" json:"hub.lease_seconds,omitempty"`
+	HubReason       *string                   `form:"hub.reason,omitempty" json:"hub.reason,omitempty"`
+}
+
+// VerifyWebSubParamsHubMode defines parameters for VerifyWebSub.
+type VerifyWebSubParamsHubMode string
+
+// IngestActivitiesJSONRequestBody defines body for IngestActivities for application/json ContentType.
+type IngestActivitiesJSONRequestBody = IngestActivitiesJSONBody
+
 // CreateSourceJSONRequestBody defines body for CreateSource for application/json ContentType.
 type CreateSourceJSONRequestBody = CreateSourceRequest
 
+// PreviewSourceJSONRequestBody defines body for PreviewSource for application/json ContentType.
+type PreviewSourceJSONRequestBody = CreateSourceRequest
+
+// UpdateSourceJSONRequestBody defines body for UpdateSource for application/json ContentType.
+type UpdateSourceJSONRequestBody = UpdateSourceRequest
+
+// BackfillSourceJSONRequestBody defines body for BackfillSource for application/json ContentType.
+type BackfillSourceJSONRequestBody = Backfill
+
+// ReceiveGithubWebhookJSONRequestBody defines body for ReceiveGithubWebhook for application/json ContentType.
+type ReceiveGithubWebhookJSONRequestBody = ReceiveGithubWebhookJSONBody
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 	// Search activities
 	// (GET /activities/search)
 	SearchActivities(w http.ResponseWriter, r *http.Request, params SearchActivitiesParams)
+	// Unstar an activity
+	// (DELETE /activities/{uid}/star)
+	UnstarActivity(w http.ResponseWriter, r *http.Request, uid string)
+	// Star an activity
+	// (PUT /activities/{uid}/star)
+	StarActivity(w http.ResponseWriter, r *http.Request, uid string)
+	// Discard all dead letters
+	// (DELETE /admin/dead-letters)
+	DiscardAllDeadLetters(w http.ResponseWriter, r *http.Request)
+	// List activities that failed processing
+	// (GET /admin/dead-letters)
+	ListDeadLetters(w http.ResponseWriter, r *http.Request)
+	// Retry all dead letters
+	// (POST /admin/dead-letters/retry)
+	RetryAllDeadLetters(w http.ResponseWriter, r *http.Request)
+	// Discard a dead letter
+	// (DELETE /admin/dead-letters/{id})
+	DiscardDeadLetter(w http.ResponseWriter, r *http.Request, id string)
+	// Retry a dead letter
+	// (POST /admin/dead-letters/{id}/retry)
+	RetryDeadLetter(w http.ResponseWriter, r *http.Request, id string)
+	// Get ingestion pipeline stats
+	// (GET /admin/pipeline)
+	GetPipelineStats(w http.ResponseWriter, r *http.Request)
+	// Preview expired activities
+	// (GET /admin/retention)
+	GetRetention(w http.ResponseWriter, r *http.Request)
+	// Push activities to a webhook source
+	// (POST /ingest/{ingest_token})
+	IngestActivities(w http.ResponseWriter, r *http.Request, ingestToken string)
 	// Get page HTML
 	// (GET /page)
 	GetPage(w http.ResponseWriter, r *http.Request, params GetPageParams)
+	// List source types
+	// (GET /source-types)
+	ListSourceTypes(w http.ResponseWriter, r *http.Request)
 	// List all sources
 	// (GET /sources)
 	ListSources(w http.ResponseWriter, r *http.Request)
@@ -99,12 +465,45 @@ type ServerInterface interface {
 	// List all activities
 	// (GET /sources/activities)
 	ListAllActivities(w http.ResponseWriter, r *http.Request)
+	// Export sources as OPML
+	// (GET /sources/export/opml)
+	ExportOPML(w http.ResponseWriter, r *http.Request, params ExportOPMLParams)
+	// Import sources from OPML
+	// (POST /sources/import/opml)
+	ImportOPML(w http.ResponseWriter, r *http.Request)
+	// Preview the activities of a source
+	// (POST /sources/preview)
+	PreviewSource(w http.ResponseWriter, r *http.Request)
 	// Delete source
 	// (DELETE /sources/{uid})
 	DeleteSource(w http.ResponseWriter, r *http.Request, uid string)
 	// Get source by UID
 	// (GET /sources/{uid})
 	GetSource(w http.ResponseWriter, r *http.Request, uid string)
+	// Update source config
+	// (PATCH /sources/{uid})
+	UpdateSource(w http.ResponseWriter, r *http.Request, uid string)
+	// Fetch the history of a source
+	// (POST /sources/{uid}/backfill)
+	BackfillSource(w http.ResponseWriter, r *http.Request, uid string)
+	// Pause polling of a source
+	// (POST /sources/{uid}/pause)
+	PauseSource(w http.ResponseWriter, r *http.Request, uid string)
+	// Resume polling of a paused source
+	// (POST /sources/{uid}/resume)
+	ResumeSource(w http.ResponseWriter, r *http.Request, uid string)
+	// List recent fetches of a source
+	// (GET /sources/{uid}/runs)
+	ListSourceRuns(w http.ResponseWriter, r *http.Request, uid string, params ListSourceRunsParams)
+	// Receive a GitHub webhook delivery
+	// (POST /webhooks/github)
+	ReceiveGithubWebhook(w http.ResponseWriter, r *http.Request)
+	// Verify a WebSub subscription
+	// (GET /websub/{source_uid})
+	VerifyWebSub(w http.ResponseWriter, r *http.Request, sourceUid string, params VerifyWebSubParams)
+	// Receive feed content from a WebSub hub
+	// (POST /websub/{source_uid})
+	ReceiveWebSub(w http.ResponseWriter, r *http.Request, sourceUid string)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -175,31 +574,114 @@ func (siw *ServerInterfaceWrapper) SearchActivities(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// GetPage operation middleware
-func (siw *ServerInterfaceWrapper) GetPage(w http.ResponseWriter, r *http.Request) {
+// UnstarActivity operation middleware
+func (siw *ServerInterfaceWrapper) UnstarActivity(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetPageParams
+	// ------------- Path parameter "uid" -------------
+	var uid string
 
-	// ------------- Required query parameter "config" -------------
+	err = runtime.BindStyledParameterWithOptions("simple", "uid", r.PathValue("uid"), &uid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uid", Err: err})
+		return
+	}
 
-	if paramValue := r.URL.Query().Get("config"); paramValue != "" {
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UnstarActivity(w, r, uid)
+	}))
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "config"})
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// StarActivity operation middleware
+func (siw *ServerInterfaceWrapper) StarActivity(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "uid" -------------
+	var uid string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "uid", r.PathValue("uid"), &uid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uid", Err: err})
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "config", r.URL.Query(), &params.Config)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StarActivity(w, r, uid)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DiscardAllDeadLetters operation middleware
+func (siw *ServerInterfaceWrapper) DiscardAllDeadLetters(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DiscardAllDeadLetters(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListDeadLetters operation middleware
+func (siw *ServerInterfaceWrapper) ListDeadLetters(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListDeadLetters(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RetryAllDeadLetters operation middleware
+func (siw *ServerInterfaceWrapper) RetryAllDeadLetters(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RetryAllDeadLetters(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DiscardDeadLetter operation middleware
+func (siw *ServerInterfaceWrapper) DiscardDeadLetter(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "config", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetPage(w, r, params)
+		siw.Handler.DiscardDeadLetter(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -209,11 +691,22 @@ func (siw *ServerInterfaceWrapper) GetPage(w http.ResponseWriter, r *http.Reques
 	handler.ServeHTTP(w, r)
 }
 
-// ListSources operation middleware
-func (siw *ServerInterfaceWrapper) ListSources(w http.ResponseWriter, r *http.Request) {
+// RetryDeadLetter operation middleware
+func (siw *ServerInterfaceWrapper) RetryDeadLetter(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListSources(w, r)
+		siw.Handler.RetryDeadLetter(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -223,11 +716,11 @@ func (siw *ServerInterfaceWrapper) ListSources(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// CreateSource operation middleware
-func (siw *ServerInterfaceWrapper) CreateSource(w http.ResponseWriter, r *http.Request) {
+// GetPipelineStats operation middleware
+func (siw *ServerInterfaceWrapper) GetPipelineStats(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateSource(w, r)
+		siw.Handler.GetPipelineStats(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -237,11 +730,11 @@ func (siw *ServerInterfaceWrapper) CreateSource(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// ListAllActivities operation middleware
-func (siw *ServerInterfaceWrapper) ListAllActivities(w http.ResponseWriter, r *http.Request) {
+// GetRetention operation middleware
+func (siw *ServerInterfaceWrapper) GetRetention(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListAllActivities(w, r)
+		siw.Handler.GetRetention(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -251,22 +744,22 @@ func (siw *ServerInterfaceWrapper) ListAllActivities(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteSource operation middleware
-func (siw *ServerInterfaceWrapper) DeleteSource(w http.ResponseWriter, r *http.Request) {
+// IngestActivities operation middleware
+func (siw *ServerInterfaceWrapper) IngestActivities(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "uid" -------------
-	var uid string
+	// ------------- Path parameter "ingest_token" -------------
+	var ingestToken string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "uid", r.PathValue("uid"), &uid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "ingest_token", r.PathValue("ingest_token"), &ingestToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uid", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ingest_token", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteSource(w, r, uid)
+		siw.Handler.IngestActivities(w, r, ingestToken)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -276,22 +769,449 @@ func (siw *ServerInterfaceWrapper) DeleteSource(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// GetSource operation middleware
-func (siw *ServerInterfaceWrapper) GetSource(w http.ResponseWriter, r *http.Request) {
+// GetPage operation middleware
+func (siw *ServerInterfaceWrapper) GetPage(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "uid" -------------
-	var uid string
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPageParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "uid", r.PathValue("uid"), &uid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	// ------------- Required query parameter "config" -------------
+
+	if paramValue := r.URL.Query().Get("config"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "config"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "config", r.URL.Query(), &params.Config)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uid", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "config", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetSource(w, r, uid)
+		siw.Handler.GetPage(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListSourceTypes operation middleware
+func (siw *ServerInterfaceWrapper) ListSourceTypes(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListSourceTypes(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListSources operation middleware
+func (siw *ServerInterfaceWrapper) ListSources(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListSources(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateSource operation middleware
+func (siw *ServerInterfaceWrapper) CreateSource(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateSource(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListAllActivities operation middleware
+func (siw *ServerInterfaceWrapper) ListAllActivities(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListAllActivities(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ExportOPML operation middleware
+func (siw *ServerInterfaceWrapper) ExportOPML(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportOPMLParams
+
+	// ------------- Optional query parameter "group_by" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "group_by", r.URL.Query(), &params.GroupBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "group_by", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExportOPML(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ImportOPML operation middleware
+func (siw *ServerInterfaceWrapper) ImportOPML(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ImportOPML(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PreviewSource operation middleware
+func (siw *ServerInterfaceWrapper) PreviewSource(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PreviewSource(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteSource operation middleware
+func (siw *ServerInterfaceWrapper) DeleteSource(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "uid" -------------
+	var uid string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "uid", r.PathValue("uid"), &uid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uid", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteSource(w, r, uid)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSource operation middleware
+func (siw *ServerInterfaceWrapper) GetSource(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "uid" -------------
+	var uid string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "uid", r.PathValue("uid"), &uid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uid", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSource(w, r, uid)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateSource operation middleware
+func (siw *ServerInterfaceWrapper) UpdateSource(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "uid" -------------
+	var uid string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "uid", r.PathValue("uid"), &uid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uid", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateSource(w, r, uid)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// BackfillSource operation middleware
+func (siw *ServerInterfaceWrapper) BackfillSource(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "uid" -------------
+	var uid string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "uid", r.PathValue("uid"), &uid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uid", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.BackfillSource(w, r, uid)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PauseSource operation middleware
+func (siw *ServerInterfaceWrapper) PauseSource(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "uid" -------------
+	var uid string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "uid", r.PathValue("uid"), &uid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uid", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PauseSource(w, r, uid)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ResumeSource operation middleware
+func (siw *ServerInterfaceWrapper) ResumeSource(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "uid" -------------
+	var uid string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "uid", r.PathValue("uid"), &uid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uid", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ResumeSource(w, r, uid)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListSourceRuns operation middleware
+func (siw *ServerInterfaceWrapper) ListSourceRuns(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "uid" -------------
+	var uid string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "uid", r.PathValue("uid"), &uid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uid", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListSourceRunsParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListSourceRuns(w, r, uid, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ReceiveGithubWebhook operation middleware
+func (siw *ServerInterfaceWrapper) ReceiveGithubWebhook(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReceiveGithubWebhook(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// VerifyWebSub operation middleware
+func (siw *ServerInterfaceWrapper) VerifyWebSub(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "source_uid" -------------
+	var sourceUid string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "source_uid", r.PathValue("source_uid"), &sourceUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "source_uid", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params VerifyWebSubParams
+
+	// ------------- Required query parameter "hub.mode" -------------
+
+	if paramValue := r.URL.Query().Get("hub.mode"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "hub.mode"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "hub.mode", r.URL.Query(), &params.HubMode)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "hub.mode", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "hub.topic" -------------
+
+	if paramValue := r.URL.Query().Get("hub.topic"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "hub.topic"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "hub.topic", r.URL.Query(), &params.HubTopic)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "hub.topic", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "hub.challenge" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "hub.challenge", r.URL.Query(), &params.HubChallenge)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "hub.challenge", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "hub.lease_seconds" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "hub.lease_seconds", r.URL.Query(), &params.HubLeaseSeconds)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "hub.lease_seconds", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "hub.reason" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "hub.reason", r.URL.Query(), &params.HubReason)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "hub.reason", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.VerifyWebSub(w, r, sourceUid, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ReceiveWebSub operation middleware
+func (siw *ServerInterfaceWrapper) ReceiveWebSub(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "source_uid" -------------
+	var sourceUid string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "source_uid", r.PathValue("source_uid"), &sourceUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "source_uid", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReceiveWebSub(w, r, sourceUid)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -422,12 +1342,34 @@ func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.H
 	}
 
 	m.HandleFunc("GET "+options.BaseURL+"/activities/search", wrapper.SearchActivities)
+	m.HandleFunc("DELETE "+options.BaseURL+"/activities/{uid}/star", wrapper.UnstarActivity)
+	m.HandleFunc("PUT "+options.BaseURL+"/activities/{uid}/star", wrapper.StarActivity)
+	m.HandleFunc("DELETE "+options.BaseURL+"/admin/dead-letters", wrapper.DiscardAllDeadLetters)
+	m.HandleFunc("GET "+options.BaseURL+"/admin/dead-letters", wrapper.ListDeadLetters)
+	m.HandleFunc("POST "+options.BaseURL+"/admin/dead-letters/retry", wrapper.RetryAllDeadLetters)
+	m.HandleFunc("DELETE "+options.BaseURL+"/admin/dead-letters/{id}", wrapper.DiscardDeadLetter)
+	m.HandleFunc("POST "+options.BaseURL+"/admin/dead-letters/{id}/retry", wrapper.RetryDeadLetter)
+	m.HandleFunc("GET "+options.BaseURL+"/admin/pipeline", wrapper.GetPipelineStats)
+	m.HandleFunc("GET "+options.BaseURL+"/admin/retention", wrapper.GetRetention)
+	m.HandleFunc("POST "+options.BaseURL+"/ingest/{ingest_token}", wrapper.IngestActivities)
 	m.HandleFunc("GET "+options.BaseURL+"/page", wrapper.GetPage)
+	m.HandleFunc("GET "+options.BaseURL+"/source-types", wrapper.ListSourceTypes)
 	m.HandleFunc("GET "+options.BaseURL+"/sources", wrapper.ListSources)
 	m.HandleFunc("POST "+options.BaseURL+"/sources", wrapper.CreateSource)
 	m.HandleFunc("GET "+options.BaseURL+"/sources/activities", wrapper.ListAllActivities)
+	m.HandleFunc("GET "+options.BaseURL+"/sources/export/opml", wrapper.ExportOPML)
+	m.HandleFunc("POST "+options.BaseURL+"/sources/import/opml", wrapper.ImportOPML)
+	m.HandleFunc("POST "+options.BaseURL+"/sources/preview", wrapper.PreviewSource)
 	m.HandleFunc("DELETE "+options.BaseURL+"/sources/{uid}", wrapper.DeleteSource)
 	m.HandleFunc("GET "+options.BaseURL+"/sources/{uid}", wrapper.GetSource)
+	m.HandleFunc("PATCH "+options.BaseURL+"/sources/{uid}", wrapper.UpdateSource)
+	m.HandleFunc("POST "+options.BaseURL+"/sources/{uid}/backfill", wrapper.BackfillSource)
+	m.HandleFunc("POST "+options.BaseURL+"/sources/{uid}/pause", wrapper.PauseSource)
+	m.HandleFunc("POST "+options.BaseURL+"/sources/{uid}/resume", wrapper.ResumeSource)
+	m.HandleFunc("GET "+options.BaseURL+"/sources/{uid}/runs", wrapper.ListSourceRuns)
+	m.HandleFunc("POST "+options.BaseURL+"/webhooks/github", wrapper.ReceiveGithubWebhook)
+	m.HandleFunc("GET "+options.BaseURL+"/websub/{source_uid}", wrapper.VerifyWebSub)
+	m.HandleFunc("POST "+options.BaseURL+"/websub/{source_uid}", wrapper.ReceiveWebSub)
 
 	return m
 }
@@ -16,6 +16,8 @@ type Config struct {
 	AssetsPath string `env:"SERVER_ASSETS_PATH,default=./assets"`
 	BaseURL    string `env:"SERVER_BASE_URL,default=/"`
 	FaviconURL string `env:"SERVER_FAVICON_URL,default="`
+	// PagesFile is a Glance-style YAML config of the pages served at /{slug}.
+	PagesFile string `env:"SERVER_PAGES_FILE,default=./config/root.yml"`
 
 	createdAt time.Time
 }
@@ -28,6 +30,7 @@ func NewDefaultConfig() Config {
 		AssetsPath: "./assets",
 		BaseURL:    "/",
 		FaviconURL: "",
+		PagesFile:  "./config/root.yml",
 		createdAt:  time.Now(),
 	}
 
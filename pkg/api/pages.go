@@ -0,0 +1,113 @@
+package api
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"strings"
+
+	"github.com/glanceapp/glance/pkg/sources"
+	"github.com/glanceapp/glance/pkg/widgets"
+)
+
+// loadPages loads the configured pages and adds the sources their widgets show.
+// A missing pages file is not an error, since the API works without pages.
+func (s *Server) loadPages() error {
+	if s.config.PagesFile == "" {
+		return nil
+	}
+
+	config, err := widgets.LoadPagesConfig(s.config.PagesFile)
+	if errors.Is(err, fs.ErrNotExist) {
+		s.logger.Warn().Str("file", s.config.PagesFile).Msg("Pages file not found, no pages are served")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("load pages: %w", err)
+	}
+
+	added := 0
+	var undefined []string
+	for _, source := range config.Sources {
+		err := s.registry.Add(source)
+		if errors.Is(err, sources.ErrSourceExists) {
+			continue
+		}
+		// In strict mode, sources can only be added through the sources file, so pages can only show sources defined there.
+		if errors.Is(err, sources.ErrSourceManaged) {
+			if existing, _ := s.registry.Source(source.UID()); existing == nil {
+				undefined = append(undefined, source.UID())
+			}
+			continue
+		}
+		if err != nil {
+			// The widget shows no activities of the source, but the rest of the page still works.
+			s.logger.Error().Err(err).Str("source", source.UID()).Msg("Failed to add page source")
+			continue
+		}
+		added++
+	}
+
+	if len(undefined) > 0 {
+		return fmt.Errorf(
+			"pages show sources that aren't defined in the sources file, which is required by SOURCES_FILE_STRICT: %s",
+			strings.Join(undefined, ", "),
+		)
+	}
+
+	s.pages = config.Pages
+	s.logger.Info().Msgf("Loaded %d pages, added %d of %d sources", len(config.Pages), added, len(config.Sources))
+
+	return nil
+}
+
+func (s *Server) registerPageHandlers(mux *http.ServeMux) {
+	if len(s.pages) == 0 {
+		return
+	}
+
+	mux.HandleFunc("GET /{$}", func(w http.ResponseWriter, r *http.Request) {
+		s.renderPage(w, s.pages[0])
+	})
+
+	mux.HandleFunc("GET /{slug}", func(w http.ResponseWriter, r *http.Request) {
+		slug := r.PathValue("slug")
+		for _, page := range s.pages {
+			if page.Slug == slug {
+				s.renderPage(w, page)
+				return
+			}
+		}
+
+		http.NotFound(w, r)
+	})
+}
+
+func (s *Server) renderPage(w http.ResponseWriter, page *widgets.Page) {
+	themePresets := widgets.DefaultThemePresets()
+	data := templateData{
+		Page:           page,
+		Pages:          s.pages,
+		Config:         s.config,
+		Theme:          themePresets[0],
+		ThemePresets:   themePresets,
+		SourceRegistry: s.registry,
+	}
+
+	// Widgets keep render state, so a page can't be rendered by concurrent requests.
+	s.pagesMu.Lock()
+	var responseBytes bytes.Buffer
+	err := pageTemplate.Execute(&responseBytes, data)
+	s.pagesMu.Unlock()
+	if err != nil {
+		s.internalError(w, err, "execute template")
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if _, err := w.Write(responseBytes.Bytes()); err != nil {
+		s.logger.Err(err).Msg("write response")
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"io"
 	"net/http"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/glanceapp/glance/pkg/sources/activities/types"
@@ -21,6 +22,8 @@ import (
 	"github.com/tmc/langchaingo/llms/openai"
 
 	"github.com/glanceapp/glance/pkg/sources"
+	"github.com/glanceapp/glance/pkg/sources/github"
+	"github.com/glanceapp/glance/pkg/utils"
 	"github.com/glanceapp/glance/pkg/widgets"
 	"github.com/glanceapp/glance/web"
 	"github.com/rs/zerolog"
@@ -31,6 +34,15 @@ var openapiSpecYaml string
 
 const StaticAssetsCacheDuration = 24 * time.Hour
 
+// maxOPMLSize limits the size of imported OPML documents.
+const maxOPMLSize = 10 << 20
+
+// maxIngestSize limits the size of payloads pushed to webhook sources.
+const maxIngestSize = 1 << 20
+
+// maxGithubWebhookSize is the maximum size of GitHub webhook payloads.
+const maxGithubWebhookSize = 25 << 20
+
 var (
 	pageTemplate        = web.MustParseTemplate("page.html", "document.html", "footer.html", "page-content.html")
 	pageContentTemplate = web.MustParseTemplate("page-content.html")
@@ -42,11 +54,15 @@ type Server struct {
 	createdAt time.Time
 	config    *Config
 	http      http.Server
+
+	// pages are loaded from the pages file and rendered one at a time.
+	pages   []*widgets.Page
+	pagesMu sync.Mutex
 }
 
 var _ ServerInterface = (*Server)(nil)
 
-func NewServer(logger *zerolog.Logger, cfg *Config, db *postgres.DB) (*Server, error) {
+func NewServer(logger *zerolog.Logger, cfg *Config, sourcesCfg *sources.Config, db *postgres.DB) (*Server, error) {
 	summarizerModel, err := openai.New(
 		openai.WithModel("gpt-4o-mini"),
 	)
@@ -63,12 +79,23 @@ func NewServer(logger *zerolog.Logger, cfg *Config, db *postgres.DB) (*Server, e
 
 	registry := sources.NewRegistry(
 		logger,
+		*sourcesCfg,
 		nlp.NewSummarizer(summarizerModel),
 		nlp.NewEmbedder(embedderModel),
 		postgres.NewActivityRepository(db),
 		postgres.NewSourceRepository(db),
+		postgres.NewJobRepository(db),
+		postgres.NewWebSubRepository(db),
 	)
 
+	if err := registry.Restore(); err != nil {
+		return nil, fmt.Errorf("restore sources: %w", err)
+	}
+
+	if _, err := registry.ReconcileSourcesFile(); err != nil {
+		return nil, fmt.Errorf("reconcile sources file: %w", err)
+	}
+
 	mux := http.NewServeMux()
 
 	server := &Server{
@@ -82,7 +109,12 @@ func NewServer(logger *zerolog.Logger, cfg *Config, db *postgres.DB) (*Server, e
 		},
 	}
 
+	if err := server.loadPages(); err != nil {
+		return nil, err
+	}
+
 	HandlerFromMux(server, mux)
+	server.registerPageHandlers(mux)
 	server.registerFileHandlers(mux)
 	server.registerApiDocsHandlers(mux)
 
@@ -92,7 +124,7 @@ func NewServer(logger *zerolog.Logger, cfg *Config, db *postgres.DB) (*Server, e
 func corsMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Access-Control-Allow-Origin", "http://localhost:3000")
-		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
+		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE, OPTIONS")
 		w.Header().Set("Access-Control-Allow-Headers", "Content-Type")
 
 		if r.Method == "OPTIONS" {
@@ -158,9 +190,16 @@ func (s *Server) Stop() error {
 	return s.http.Close()
 }
 
+// ReconcileSources applies changes of the sources file.
+func (s *Server) ReconcileSources() error {
+	_, err := s.registry.ReconcileSourcesFile()
+	return err
+}
+
 type templateData struct {
 	Config         *Config
 	Page           *widgets.Page
+	Pages          []*widgets.Page
 	Theme          widgets.Theme
 	ThemePresets   []widgets.Theme
 	SourceRegistry *sources.Registry
@@ -176,28 +215,10 @@ func (s *Server) GetPage(w http.ResponseWriter, r *http.Request, params GetPageP
 	page, err := widgets.NewPageFromJSON(configJson)
 	if err != nil {
 		s.badRequest(w, err, "deserialize page")
-	}
-
-	themePresets := widgets.DefaultThemePresets()
-	data := templateData{
-		Page:           page,
-		Config:         s.config,
-		Theme:          themePresets[0],
-		ThemePresets:   themePresets,
-		SourceRegistry: s.registry,
-	}
-
-	var responseBytes bytes.Buffer
-	err = pageTemplate.Execute(&responseBytes, data)
-	if err != nil {
-		s.internalError(w, err, "execute template")
 		return
 	}
 
-	_, err = w.Write(responseBytes.Bytes())
-	if err != nil {
-		s.logger.Err(err).Msg("write response")
-	}
+	s.renderPage(w, page)
 }
 
 func (s *Server) ListAllActivities(w http.ResponseWriter, r *http.Request) {
@@ -217,7 +238,13 @@ func (s *Server) ListSources(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	s.serializeRes(w, serializeSources(out))
+	states, err := s.sourceStates()
+	if err != nil {
+		s.internalError(w, err, "get source states")
+		return
+	}
+
+	s.serializeRes(w, serializeSources(out, states))
 }
 
 func (s *Server) CreateSource(w http.ResponseWriter, r *http.Request) {
@@ -229,22 +256,368 @@ func (s *Server) CreateSource(w http.ResponseWriter, r *http.Request) {
 	}
 
 	out, err := deserializeCreateSourceRequest(req)
+	var validationErrs sources.ValidationErrors
+	if errors.As(err, &validationErrs) {
+		s.invalidSource(w, validationErrs)
+		return
+	}
 	if err != nil {
 		s.badRequest(w, err, "deserialize request")
 		return
 	}
 
+	var backfill *sources.BackfillOptions
+	if req.Backfill != nil {
+		backfill, err = deserializeBackfill(*req.Backfill)
+		if err != nil {
+			s.badRequest(w, err, "deserialize backfill")
+			return
+		}
+		if _, ok := out.(sources.Backfiller); !ok {
+			s.badRequest(w, sources.ErrBackfillUnsupported, "deserialize backfill")
+			return
+		}
+	}
+
 	err = s.registry.Add(out)
+	if errors.Is(err, sources.ErrSourceExists) || errors.Is(err, sources.ErrSourceManaged) {
+		s.conflict(w, err, "add source")
+		return
+	}
+	if errors.Is(err, sources.ErrInvalidSource) {
+		s.invalidSource(w, sources.ValidationErrors{{Message: err.Error()}})
+		return
+	}
 	if err != nil {
 		s.internalError(w, err, "add source")
 		return
 	}
 
-	s.serializeRes(w, deserializeSource(out))
+	if backfill != nil {
+		if err := s.registry.Backfill(out.UID(), *backfill); err != nil {
+			s.internalError(w, err, "backfill source")
+			return
+		}
+	}
+
+	states, err := s.sourceStates()
+	if err != nil {
+		s.internalError(w, err, "get source states")
+		return
+	}
+
+	s.serializeRes(w, deserializeSource(out, states))
+}
+
+func (s *Server) UpdateSource(w http.ResponseWriter, r *http.Request, uid string) {
+	var req UpdateSourceRequest
+	err := deserializeReq(r, &req)
+	if err != nil {
+		s.badRequest(w, err, "deserialize request")
+		return
+	}
+
+	patch, err := json.Marshal(req)
+	if err != nil {
+		s.badRequest(w, err, "serialize patch")
+		return
+	}
+
+	out, err := s.registry.Update(uid, patch)
+	if err != nil {
+		var uidErr *sources.UIDChangeError
+		var validationErrs sources.ValidationErrors
+		switch {
+		case errors.Is(err, sources.ErrSourceNotFound):
+			s.notFound(w, err, "update source")
+		case errors.Is(err, sources.ErrSourceManaged):
+			s.conflict(w, err, "update source")
+		case errors.As(err, &validationErrs):
+			s.invalidSource(w, validationErrs)
+		case errors.Is(err, sources.ErrInvalidSource):
+			s.invalidSource(w, sources.ValidationErrors{{Message: err.Error()}})
+		case errors.As(err, &uidErr):
+			s.badRequest(w, err, "update source")
+		default:
+			s.internalError(w, err, "update source")
+		}
+		return
+	}
+
+	states, err := s.sourceStates()
+	if err != nil {
+		s.internalError(w, err, "get source states")
+		return
+	}
+
+	s.serializeRes(w, deserializeSource(out, states))
+}
+
+func (s *Server) PauseSource(w http.ResponseWriter, r *http.Request, uid string) {
+	out, err := s.registry.Pause(uid)
+	if errors.Is(err, sources.ErrSourceNotFound) {
+		s.notFound(w, err, "pause source")
+		return
+	}
+	if err != nil {
+		s.internalError(w, err, "pause source")
+		return
+	}
+
+	states, err := s.sourceStates()
+	if err != nil {
+		s.internalError(w, err, "get source states")
+		return
+	}
+
+	s.serializeRes(w, deserializeSource(out, states))
+}
+
+func (s *Server) BackfillSource(w http.ResponseWriter, r *http.Request, uid string) {
+	var req Backfill
+	if err := deserializeReq(r, &req); err != nil {
+		s.badRequest(w, err, "deserialize request")
+		return
+	}
+
+	opts, err := deserializeBackfill(req)
+	if err != nil {
+		s.badRequest(w, err, "deserialize backfill")
+		return
+	}
+
+	err = s.registry.Backfill(uid, *opts)
+	if err != nil {
+		switch {
+		case errors.Is(err, sources.ErrSourceNotFound):
+			s.notFound(w, err, "backfill source")
+		case errors.Is(err, sources.ErrBackfillRunning):
+			s.conflict(w, err, "backfill source")
+		case errors.Is(err, sources.ErrBackfillUnsupported), errors.Is(err, sources.ErrInvalidSource):
+			s.badRequest(w, err, "backfill source")
+		default:
+			s.internalError(w, err, "backfill source")
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (s *Server) ResumeSource(w http.ResponseWriter, r *http.Request, uid string) {
+	out, err := s.registry.Resume(uid)
+	if err != nil {
+		switch {
+		case errors.Is(err, sources.ErrSourceNotFound):
+			s.notFound(w, err, "resume source")
+		case errors.Is(err, sources.ErrInvalidSource):
+			s.badRequest(w, err, "resume source")
+		default:
+			s.internalError(w, err, "resume source")
+		}
+		return
+	}
+
+	states, err := s.sourceStates()
+	if err != nil {
+		s.internalError(w, err, "get source states")
+		return
+	}
+
+	s.serializeRes(w, deserializeSource(out, states))
+}
+
+func (s *Server) PreviewSource(w http.ResponseWriter, r *http.Request) {
+	var req CreateSourceRequest
+	if err := deserializeReq(r, &req); err != nil {
+		s.badRequest(w, err, "deserialize request")
+		return
+	}
+
+	source, err := deserializeCreateSourceRequest(req)
+	var validationErrs sources.ValidationErrors
+	if errors.As(err, &validationErrs) {
+		s.invalidSource(w, validationErrs)
+		return
+	}
+	if err != nil {
+		s.badRequest(w, err, "deserialize request")
+		return
+	}
+
+	if err := source.Initialize(); err != nil {
+		s.invalidSource(w, sources.ValidationErrors{{Message: err.Error()}})
+		return
+	}
+
+	preview := sources.PreviewSource(r.Context(), source)
+
+	s.serializeRes(w, serializePreview(source, preview))
+}
+
+func (s *Server) ListSourceTypes(w http.ResponseWriter, r *http.Request) {
+	res, err := serializeSourceTypes(sources.SourceTypes())
+	if err != nil {
+		s.internalError(w, err, "serialize source types")
+		return
+	}
+
+	s.serializeRes(w, res)
+}
+
+func (s *Server) ImportOPML(w http.ResponseWriter, r *http.Request) {
+	entries, err := s.registry.ImportOPML(http.MaxBytesReader(w, r.Body, maxOPMLSize))
+	if errors.Is(err, sources.ErrSourceManaged) {
+		s.conflict(w, err, "import OPML")
+		return
+	}
+	if err != nil {
+		s.badRequest(w, err, "import OPML")
+		return
+	}
+
+	s.serializeRes(w, serializeOPMLImportReport(entries))
+}
+
+func (s *Server) IngestActivities(w http.ResponseWriter, r *http.Request, ingestToken string) {
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxIngestSize))
+	if err != nil {
+		s.badRequest(w, err, "read payload")
+		return
+	}
+
+	n, err := s.registry.IngestByToken(ingestToken, r.Header, body)
+	if err != nil {
+		s.ingestError(w, err)
+		return
+	}
+
+	s.accepted(w, BatchResult{Count: n})
+}
+
+func (s *Server) ReceiveGithubWebhook(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxGithubWebhookSize))
+	if err != nil {
+		s.badRequest(w, err, "read payload")
+		return
+	}
+
+	uids, err := github.WebhookSourceUIDs(r.Header, body)
+	if err != nil {
+		s.badRequest(w, err, "route GitHub webhook")
+		return
+	}
+
+	// Events that aren't mapped to activities, like pings, are acknowledged.
+	total := 0
+	for _, uid := range uids {
+		n, err := s.registry.Ingest(uid, r.Header, body)
+		// Webhooks of organizations are delivered for every repository, not only those with sources.
+		if errors.Is(err, sources.ErrSourceNotFound) {
+			continue
+		}
+		if err != nil {
+			s.ingestError(w, err)
+			return
+		}
+		total += n
+	}
+
+	s.accepted(w, BatchResult{Count: total})
+}
+
+func (s *Server) VerifyWebSub(w http.ResponseWriter, r *http.Request, sourceUid string, params VerifyWebSubParams) {
+	req := sources.WebSubVerification{
+		Mode:  string(params.HubMode),
+		Topic: params.HubTopic,
+	}
+	if params.HubChallenge != nil {
+		req.Challenge = *params.HubChallenge
+	}
+	if params.HubLeaseSeconds != nil {
+		req.LeaseSeconds = *params.HubLeaseSeconds
+	}
+	if params.HubReason != nil {
+		req.Reason = *params.HubReason
+	}
+
+	challenge, err := s.registry.VerifyWebSub(sourceUid, req)
+	if errors.Is(err, sources.ErrSubscriptionNotFound) {
+		s.notFound(w, err, "verify WebSub subscription")
+		return
+	}
+	if errors.Is(err, sources.ErrInvalidPayload) {
+		s.badRequest(w, err, "verify WebSub subscription")
+		return
+	}
+	if err != nil {
+		s.internalError(w, err, "verify WebSub subscription")
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain")
+	_, _ = io.WriteString(w, challenge)
+}
+
+func (s *Server) ReceiveWebSub(w http.ResponseWriter, r *http.Request, sourceUid string) {
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxIngestSize))
+	if err != nil {
+		s.badRequest(w, err, "read WebSub content")
+		return
+	}
+
+	_, err = s.registry.ReceiveWebSub(sourceUid, r.Header, body)
+	switch {
+	case err == nil:
+	case errors.Is(err, sources.ErrSubscriptionNotFound):
+		s.notFound(w, err, "receive WebSub content")
+		return
+	case errors.Is(err, sources.ErrQueueFull):
+		s.logger.Err(err).Msg("receive WebSub content")
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	default:
+		// Hubs must not retry content that fails verification or can't be parsed.
+		s.logger.Err(err).Msg("receive WebSub content")
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (s *Server) ExportOPML(w http.ResponseWriter, r *http.Request, params ExportOPMLParams) {
+	groupBy := sources.OPMLGroupByType
+	if params.GroupBy != nil {
+		groupBy = sources.OPMLGroupBy(*params.GroupBy)
+	}
+
+	if groupBy != sources.OPMLGroupByType && groupBy != sources.OPMLGroupByTag {
+		s.badRequest(w, fmt.Errorf("unknown grouping: %s", groupBy), "export OPML")
+		return
+	}
+
+	var out bytes.Buffer
+	if err := s.registry.ExportOPML(&out, groupBy); err != nil {
+		s.internalError(w, err, "export OPML")
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/x-opml")
+	w.Header().Set("Content-Disposition", `attachment; filename="pulse-sources.opml"`)
+	if _, err := w.Write(out.Bytes()); err != nil {
+		s.logger.Err(err).Msg("write response")
+	}
 }
 
 func (s *Server) DeleteSource(w http.ResponseWriter, r *http.Request, uid string) {
 	err := s.registry.Remove(uid)
+	if errors.Is(err, sources.ErrSourceNotFound) {
+		s.notFound(w, err, "remove source")
+		return
+	}
+	if errors.Is(err, sources.ErrSourceManaged) {
+		s.conflict(w, err, "remove source")
+		return
+	}
 	if err != nil {
 		s.internalError(w, err, "remove source")
 		return
@@ -256,11 +629,64 @@ func (s *Server) DeleteSource(w http.ResponseWriter, r *http.Request, uid string
 func (s *Server) GetSource(w http.ResponseWriter, r *http.Request, uid string) {
 	out, err := s.registry.Source(uid)
 	if err != nil {
-		s.internalError(w, err, "remove source")
+		s.internalError(w, err, "get source")
 		return
 	}
 
-	s.serializeRes(w, deserializeSource(out))
+	if out == nil {
+		s.notFound(w, fmt.Errorf("source '%s' not found", uid), "get source")
+		return
+	}
+
+	health, err := s.registry.Health(uid)
+	if err != nil {
+		s.internalError(w, err, "get source health")
+		return
+	}
+
+	states, err := s.sourceStates()
+	if err != nil {
+		s.internalError(w, err, "get source states")
+		return
+	}
+
+	res := deserializeSource(out, states)
+	if health != nil {
+		res.Health = serializeSourceHealth(health)
+	}
+
+	s.serializeRes(w, res)
+}
+
+func (s *Server) ListSourceRuns(w http.ResponseWriter, r *http.Request, uid string, params ListSourceRunsParams) {
+	limit := 20
+	if params.Limit != nil {
+		if *params.Limit < 1 || *params.Limit > 100 {
+			s.badRequest(w, fmt.Errorf("limit must be between 1 and 100"), "validate limit")
+			return
+		}
+		limit = *params.Limit
+	}
+
+	// The runs of sources that can't be decoded explain why they aren't polled.
+	source, err := s.registry.Source(uid)
+	if err != nil && !errors.Is(err, sources.ErrUndecodableSource) {
+		s.internalError(w, err, "get source")
+		return
+	}
+
+	if source == nil && err == nil {
+		s.notFound(w, fmt.Errorf("source '%s' not found", uid), "get source")
+		return
+	}
+
+	out, err := s.registry.Runs(uid, limit)
+	if err != nil {
+		s.internalError(w, err, "list source runs")
+		return
+	}
+
+	s.serializeRes(w, serializeSourceRuns(out))
 }
 
 func (s *Server) SearchActivities(w http.ResponseWriter, r *http.Request, params SearchActivitiesParams) {
@@ -303,6 +729,108 @@ func (s *Server) SearchActivities(w http.ResponseWriter, r *http.Request, params
 	s.serializeRes(w, serializeActivities(results))
 }
 
+func (s *Server) StarActivity(w http.ResponseWriter, r *http.Request, uid string) {
+	s.setStarred(w, uid, true)
+}
+
+func (s *Server) UnstarActivity(w http.ResponseWriter, r *http.Request, uid string) {
+	s.setStarred(w, uid, false)
+}
+
+func (s *Server) setStarred(w http.ResponseWriter, uid string, starred bool) {
+	err := s.registry.SetStarred(uid, starred)
+	if errors.Is(err, sources.ErrActivityNotFound) {
+		s.notFound(w, err, "set starred")
+		return
+	}
+	if err != nil {
+		s.internalError(w, err, "set starred")
+		return
+	}
+
+	s.serializeRes(w, nil)
+}
+
+func (s *Server) GetRetention(w http.ResponseWriter, r *http.Request) {
+	out, err := s.registry.RetentionPreview()
+	if err != nil {
+		s.internalError(w, err, "preview retention")
+		return
+	}
+
+	s.serializeRes(w, serializeRetentionPreview(out))
+}
+
+func (s *Server) GetPipelineStats(w http.ResponseWriter, r *http.Request) {
+	out, err := s.registry.PipelineStats()
+	if err != nil {
+		s.internalError(w, err, "get pipeline stats")
+		return
+	}
+
+	s.serializeRes(w, serializePipelineStats(out))
+}
+
+func (s *Server) ListDeadLetters(w http.ResponseWriter, r *http.Request) {
+	out, err := s.registry.DeadLetters()
+	if err != nil {
+		s.internalError(w, err, "list dead letters")
+		return
+	}
+
+	s.serializeRes(w, serializeDeadLetters(out))
+}
+
+func (s *Server) RetryDeadLetter(w http.ResponseWriter, r *http.Request, id string) {
+	n, err := s.registry.RetryDeadLetters(id)
+	if err != nil {
+		s.internalError(w, err, "retry dead letter")
+		return
+	}
+
+	if n == 0 {
+		s.notFound(w, fmt.Errorf("dead letter '%s' not found", id), "retry dead letter")
+		return
+	}
+
+	s.serializeRes(w, nil)
+}
+
+func (s *Server) RetryAllDeadLetters(w http.ResponseWriter, r *http.Request) {
+	n, err := s.registry.RetryDeadLetters()
+	if err != nil {
+		s.internalError(w, err, "retry dead letters")
+		return
+	}
+
+	s.serializeRes(w, BatchResult{Count: n})
+}
+
+func (s *Server) DiscardDeadLetter(w http.ResponseWriter, r *http.Request, id string) {
+	n, err := s.registry.DiscardDeadLetters(id)
+	if err != nil {
+		s.internalError(w, err, "discard dead letter")
+		return
+	}
+
+	if n == 0 {
+		s.notFound(w, fmt.Errorf("dead letter '%s' not found", id), "discard dead letter")
+		return
+	}
+
+	s.serializeRes(w, nil)
+}
+
+func (s *Server) DiscardAllDeadLetters(w http.ResponseWriter, r *http.Request) {
+	n, err := s.registry.DiscardDeadLetters()
+	if err != nil {
+		s.internalError(w, err, "discard dead letters")
+		return
+	}
+
+	s.serializeRes(w, BatchResult{Count: n})
+}
+
 func deserializeReq[Req any](r *http.Request, req *Req) error {
 	contentType := r.Header.Get("Content-Type")
 	if contentType != "application/json" {
@@ -346,27 +874,93 @@ func (s *Server) badRequest(w http.ResponseWriter, err error, msg string) {
 	http.Error(w, err.Error(), http.StatusBadRequest)
 }
 
+func (s *Server) conflict(w http.ResponseWriter, err error, msg string) {
+	s.logger.Err(err).Msg(msg)
+	http.Error(w, err.Error(), http.StatusConflict)
+}
+
+// invalidSource responds with the validation errors of a source config.
+func (s *Server) invalidSource(w http.ResponseWriter, errs sources.ValidationErrors) {
+	s.logger.Err(errs).Msg("validate source")
+
+	out := ValidationErrors{Errors: make([]ValidationError, len(errs))}
+	for i, e := range errs {
+		out.Errors[i] = ValidationError{Message: e.Message}
+		if e.Field != "" {
+			out.Errors[i].Field = &e.Field
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusBadRequest)
+	if err := json.NewEncoder(w).Encode(out); err != nil {
+		s.logger.Err(err).Msg("write response")
+	}
+}
+
+// ingestError responds with the status of an error returned for pushed activities.
+func (s *Server) ingestError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, sources.ErrSourceNotFound):
+		s.notFound(w, err, "ingest activities")
+	case errors.Is(err, sources.ErrInvalidSignature):
+		s.logger.Err(err).Msg("ingest activities")
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+	case errors.Is(err, sources.ErrSourcePaused):
+		s.conflict(w, err, "ingest activities")
+	case errors.Is(err, sources.ErrQueueFull):
+		s.logger.Err(err).Msg("ingest activities")
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+	case errors.Is(err, sources.ErrPushUnsupported), errors.Is(err, sources.ErrInvalidPayload), errors.Is(err, sources.ErrInvalidSource):
+		s.badRequest(w, err, "ingest activities")
+	default:
+		s.internalError(w, err, "ingest activities")
+	}
+}
+
+// accepted responds with 202 Accepted and the serialized response.
+func (s *Server) accepted(w http.ResponseWriter, res any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusAccepted)
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		s.logger.Err(err).Msg("serialize response")
+	}
+}
+
 func (s *Server) notFound(w http.ResponseWriter, err error, msg string) {
 	s.logger.Err(err).Msg(msg)
 	http.Error(w, err.Error(), http.StatusNotFound)
 }
 
-func deserializeCreateSourceRequest(req CreateSourceRequest) (sources.Source, error) {
-	source, err := sources.NewSource(req.Type)
-	if err != nil {
-		return nil, fmt.Errorf("create source: %w", err)
+func deserializeBackfill(in Backfill) (*sources.BackfillOptions, error) {
+	out := &sources.BackfillOptions{}
+
+	if in.Limit != nil {
+		out.Limit = *in.Limit
 	}
 
+	if in.Window != nil {
+		window, err := types.ParseDuration(*in.Window)
+		if err != nil {
+			return nil, fmt.Errorf("%w: window: %w", sources.ErrInvalidBackfill, err)
+		}
+		out.Window = window
+	}
+
+	if err := out.Validate(); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+func deserializeCreateSourceRequest(req CreateSourceRequest) (sources.Source, error) {
 	configBytes, err := json.Marshal(req.Config)
 	if err != nil {
 		return nil, fmt.Errorf("marshal config: %w", err)
 	}
 
-	if err := json.Unmarshal(configBytes, source); err != nil {
-		return nil, fmt.Errorf("unmarshal config: %w", err)
-	}
-
-	return source, nil
+	return sources.DecodeSource(req.Type, configBytes)
 }
 
 func serializeActivities(in []*types.DecoratedActivity) []Activity {
@@ -388,29 +982,284 @@ func serializeActivity(in *types.DecoratedActivity) Activity {
 		ShortSummary: in.Summary.ShortSummary,
 		SourceUid:    in.SourceUID(),
 		Title:        in.Title(),
-		Uid:          in.UID(),
+		Uid:          in.ID(),
 		Url:          in.URL(),
 		Similarity:   &in.Similarity,
+		Starred:      in.Starred,
+		Referenced:   in.Referenced,
 	}
 }
 
-func serializeSources(in []sources.Source) []Source {
+// maxExcerptLength is the number of characters of activity bodies shown in previews.
+const maxExcerptLength = 500
+
+func serializeSourceTypes(in []sources.SourceType) ([]SourceType, error) {
+	out := make([]SourceType, 0, len(in))
+
+	for _, t := range in {
+		schemaBytes, err := json.Marshal(t.Schema)
+		if err != nil {
+			return nil, fmt.Errorf("marshal schema: %w", err)
+		}
+
+		var schema map[string]interface{}
+		if err := json.Unmarshal(schemaBytes, &schema); err != nil {
+			return nil, fmt.Errorf("unmarshal schema: %w", err)
+		}
+
+		out = append(out, SourceType{
+			Type:        t.Type,
+			Description: t.Description,
+			Schema:      schema,
+			Examples:    t.Examples,
+		})
+	}
+
+	return out, nil
+}
+
+func serializePreview(source sources.Source, in *sources.Preview) SourcePreview {
+	out := SourcePreview{
+		Uid:        source.UID(),
+		Activities: make([]PreviewActivity, 0, len(in.Activities)),
+		Errors:     make([]string, 0, len(in.Errors)),
+	}
+
+	for i, act := range in.Activities {
+		excerpt, _ := utils.LimitStringLength(strings.TrimSpace(in.Bodies[i]), maxExcerptLength)
+		out.Activities = append(out.Activities, PreviewActivity{
+			Title:       act.Title(),
+			Url:         act.URL(),
+			CreatedAt:   act.CreatedAt(),
+			BodyExcerpt: excerpt,
+		})
+	}
+	out.Errors = append(out.Errors, in.Errors...)
+
+	return out
+}
+
+func serializeSources(in []sources.Source, states sourceStates) []Source {
 	out := make([]Source, 0, len(in))
 
 	for _, e := range in {
-		out = append(out, deserializeSource(e))
+		out = append(out, deserializeSource(e, states))
 	}
 
 	return out
 
 }
 
-func deserializeSource(in sources.Source) Source {
+// sourceStates holds the persisted flags of sources, which are not part of their config.
+type sourceStates struct {
+	paused  map[string]bool
+	managed map[string]bool
+}
+
+func (s *Server) sourceStates() (sourceStates, error) {
+	paused, err := s.registry.Paused()
+	if err != nil {
+		return sourceStates{}, fmt.Errorf("list paused sources: %w", err)
+	}
+
+	managed, err := s.registry.Managed()
+	if err != nil {
+		return sourceStates{}, fmt.Errorf("list managed sources: %w", err)
+	}
+
+	return sourceStates{paused: paused, managed: managed}, nil
+}
+
+func deserializeSource(in sources.Source, states sourceStates) Source {
 	return Source{
-		Uid:  in.UID(),
-		Url:  in.URL(),
-		Name: in.Name(),
+		Uid:         in.UID(),
+		Url:         in.URL(),
+		Name:        in.Name(),
+		Enabled:     !states.paused[in.UID()],
+		Managed:     states.managed[in.UID()],
+		Tags:        serializeTags(sources.Tags(in)),
+		IngestToken: serializeIngestToken(in),
+	}
+}
+
+func serializeIngestToken(in sources.Source) *string {
+	receiver, ok := in.(sources.TokenReceiver)
+	if !ok || receiver.IngestToken() == "" {
+		return nil
+	}
+
+	token := receiver.IngestToken()
+	return &token
+}
+
+func serializeTags(in []string) *[]string {
+	if len(in) == 0 {
+		return nil
+	}
+	return &in
+}
+
+func serializeOPMLImportReport(in []sources.OPMLImportEntry) OPMLImportReport {
+	out := OPMLImportReport{
+		Entries: make([]OPMLImportEntry, 0, len(in)),
+	}
+
+	for _, e := range in {
+		entry := OPMLImportEntry{
+			Title:   e.Title,
+			FeedUrl: e.FeedURL,
+			Status:  OPMLImportEntryStatus(e.Status),
+		}
+		if e.SourceUID != "" {
+			entry.SourceUid = &e.SourceUID
+		}
+		if e.Error != "" {
+			entry.Error = &e.Error
+		}
+		out.Entries = append(out.Entries, entry)
+
+		switch e.Status {
+		case sources.OPMLImportCreated:
+			out.Created++
+		case sources.OPMLImportExists:
+			out.Exists++
+		case sources.OPMLImportFailed:
+			out.Failed++
+		}
+	}
+
+	return out
+}
+
+func serializePipelineStats(in *sources.PipelineStats) PipelineStats {
+	stages := make([]StageStats, 0, len(in.Stages))
+	for _, stage := range in.Stages {
+		stages = append(stages, StageStats{
+			Stage:       StageStatsStage(stage.Stage),
+			Concurrency: stage.Concurrency,
+			InFlight:    stage.InFlight,
+			Processed:   stage.Processed,
+			Failed:      stage.Failed,
+		})
+	}
+
+	return PipelineStats{
+		QueueDepth:        in.QueueDepth,
+		QueueCapacity:     in.QueueCapacity,
+		FailedJobs:        in.FailedJobs,
+		Workers:           in.Workers,
+		BusyWorkers:       in.BusyWorkers,
+		Stages:            stages,
+		ThrottledEnqueues: in.ThrottledEnqueues,
+		DroppedActivities: in.DroppedActivities,
+	}
+}
+
+func serializeSourceHealth(in *types.SourceHealth) *SourceHealth {
+	out := &SourceHealth{
+		Status:              SourceHealthStatus(in.Status()),
+		ConsecutiveFailures: in.ConsecutiveFailures,
+		LastItems:           in.LastItems,
+	}
+
+	if !in.LastSuccessAt.IsZero() {
+		out.LastSuccessAt = &in.LastSuccessAt
+	}
+
+	if in.LastError != "" {
+		out.LastError = &in.LastError
+	}
+
+	if !in.LastErrorAt.IsZero() {
+		out.LastErrorAt = &in.LastErrorAt
+	}
+
+	return out
+}
+
+func serializeSourceRuns(in []*types.SourceRun) []SourceRun {
+	out := make([]SourceRun, 0, len(in))
+
+	for _, run := range in {
+		res := SourceRun{
+			StartedAt:  run.StartedAt,
+			FinishedAt: run.FinishedAt,
+			Items:      run.Items,
+		}
+		if run.Failed() {
+			res.Error = &run.Error
+		}
+		out = append(out, res)
 	}
+
+	return out
+}
+
+func serializeRetentionPreview(in *sources.RetentionPreview) RetentionPreview {
+	out := RetentionPreview{
+		Mode:           RetentionPreviewMode(in.Mode),
+		KeepStarred:    in.KeepStarred,
+		KeepReferenced: in.KeepReferenced,
+		Rules:          make([]RetentionRule, 0, len(in.Rules)),
+		Total:          serializeRetentionStats(in.Total),
+	}
+
+	for _, rule := range in.Rules {
+		res := RetentionRule{
+			Retention:     rule.Retention.String(),
+			CreatedBefore: rule.CreatedBefore,
+			Expired:       serializeRetentionStats(rule.Expired),
+		}
+		if rule.SourceUID != "" {
+			res.SourceUid = &rule.SourceUID
+		}
+		if rule.SourceType != "" {
+			res.SourceType = &rule.SourceType
+		}
+		out.Rules = append(out.Rules, res)
+	}
+
+	if run := in.LastRun; run != nil {
+		out.LastRun = &RetentionRun{
+			StartedAt:  run.StartedAt,
+			FinishedAt: run.FinishedAt,
+			Pruned:     serializeRetentionStats(run.Pruned),
+		}
+		if run.Error != "" {
+			out.LastRun.Error = &run.Error
+		}
+	}
+
+	return out
+}
+
+func serializeRetentionStats(in types.RetentionStats) RetentionStats {
+	return RetentionStats{
+		Activities: in.Activities,
+		Bytes:      in.Bytes,
+	}
+}
+
+func serializeDeadLetters(in []*types.DeadLetter) []DeadLetter {
+	out := make([]DeadLetter, 0, len(in))
+
+	for _, l := range in {
+		letter := DeadLetter{
+			Id:        l.ID,
+			SourceUid: l.SourceUID,
+			Stage:     DeadLetterStage(l.Stage),
+			Error:     l.Error,
+			Attempts:  l.Attempts,
+			FailedAt:  l.FailedAt,
+		}
+		if l.Activity != nil {
+			letter.Title = l.Activity.Title()
+			letter.Url = l.Activity.URL()
+		}
+		out = append(out, letter)
+	}
+
+	return out
 }
 
 func deserializeSortBy(in *SearchActivitiesParamsSortBy) (types.SortBy, error) {
@@ -3,13 +3,15 @@ package config
 import (
 	"fmt"
 	"github.com/glanceapp/glance/pkg/api"
+	"github.com/glanceapp/glance/pkg/sources"
 	"github.com/glanceapp/glance/pkg/storage/postgres"
 	"github.com/joeshaw/envdecode"
 )
 
 type Config struct {
-	DBConfig  postgres.Config `env:""`
-	APIConfig api.Config      `env:""`
+	DBConfig      postgres.Config `env:""`
+	APIConfig     api.Config      `env:""`
+	SourcesConfig sources.Config  `env:""`
 }
 
 func Load() (*Config, error) {
@@ -21,5 +23,9 @@ func Load() (*Config, error) {
 
 	cfg.APIConfig.Init()
 
+	if err := cfg.SourcesConfig.Validate(); err != nil {
+		return nil, fmt.Errorf("validate sources config: %w", err)
+	}
+
 	return &cfg, nil
 }
@@ -0,0 +1,40 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// ContentHasher can be implemented by activities whose Body is expensive to compute
+// (e.g. fetches the referenced article), to hash cheaper fields that identify the content instead.
+type ContentHasher interface {
+	ContentHash() string
+}
+
+// ContentHash returns a hash of the activity content, used to detect changed activities
+// without re-running the summarizer on every poll.
+func ContentHash(activity Activity) string {
+	if h, ok := activity.(ContentHasher); ok {
+		return h.ContentHash()
+	}
+
+	return HashContent(activity.Title(), activity.Body(), activity.URL(), activity.ImageURL())
+}
+
+// HashContent returns a hex encoded SHA-256 hash of the given fields.
+func HashContent(fields ...string) string {
+	h := sha256.New()
+	for _, f := range fields {
+		h.Write([]byte(f))
+		// Separate fields, so that moving text between fields changes the hash.
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// ActivityID returns the globally unique ID of an activity.
+// Activity UIDs are only unique within their source, so the ID is namespaced by the source UID.
+func ActivityID(sourceUID, uid string) string {
+	h := sha256.Sum256([]byte(sourceUID + "\n" + uid))
+	return hex.EncodeToString(h[:])
+}
@@ -0,0 +1,53 @@
+package types
+
+import "time"
+
+// SourceStatus is the health of a source derived from its recent fetches.
+type SourceStatus string
+
+const (
+	SourceStatusHealthy  SourceStatus = "healthy"
+	SourceStatusDegraded SourceStatus = "degraded"
+	SourceStatusFailing  SourceStatus = "failing"
+)
+
+// failingThreshold is the number of consecutive failed fetches after which a source is failing.
+const failingThreshold = 3
+
+// SourceRun is the outcome of a single fetch of a source.
+type SourceRun struct {
+	SourceUID  string
+	StartedAt  time.Time
+	FinishedAt time.Time
+	// Items is the number of activities the source produced.
+	Items int
+	// Error is the last error reported during the fetch, empty if the fetch succeeded.
+	Error string
+}
+
+func (r *SourceRun) Failed() bool {
+	return r.Error != ""
+}
+
+// SourceHealth summarizes the recent fetches of a source.
+type SourceHealth struct {
+	// LastSuccessAt is zero if the source was never fetched successfully.
+	LastSuccessAt time.Time
+	LastError     string
+	LastErrorAt   time.Time
+	// ConsecutiveFailures is reset by every successful fetch.
+	ConsecutiveFailures int
+	// LastItems is the number of activities produced by the last fetch.
+	LastItems int
+}
+
+func (h *SourceHealth) Status() SourceStatus {
+	switch {
+	case h.ConsecutiveFailures == 0:
+		return SourceStatusHealthy
+	case h.ConsecutiveFailures < failingThreshold:
+		return SourceStatusDegraded
+	default:
+		return SourceStatusFailing
+	}
+}
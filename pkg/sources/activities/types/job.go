@@ -0,0 +1,46 @@
+package types
+
+import "time"
+
+// JobStage is the next step of the ingestion pipeline to run for an activity.
+type JobStage string
+
+const (
+	JobStageSummarize JobStage = "summarize"
+	JobStageEmbed     JobStage = "embed"
+	JobStageStore     JobStage = "store"
+)
+
+// Job tracks the progress of an activity through the ingestion pipeline.
+type Job struct {
+	ID       string
+	Activity Activity
+	Stage    JobStage
+	// Attempts is the number of times the job was claimed by a worker.
+	Attempts int
+	// Summary is set once the summarize stage completes.
+	Summary *ActivitySummary
+	// Embedding is set once the embed stage completes.
+	Embedding []float32
+}
+
+// JobCounts is the number of jobs in each state.
+type JobCounts struct {
+	Pending int
+	Running int
+	// Failed is the number of dead letters.
+	Failed int
+}
+
+// DeadLetter is a job that failed on every attempt.
+type DeadLetter struct {
+	ID        string
+	SourceUID string
+	// Activity is nil if the stored activity can't be decoded anymore, e.g. if its source type was removed.
+	Activity Activity
+	// Stage is the stage that failed.
+	Stage    JobStage
+	Error    string
+	Attempts int
+	FailedAt time.Time
+}
@@ -0,0 +1,132 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Retention is how long activities are kept after they were created, (de)serialized as a string like "30d".
+// Zero inherits the retention of the source type, and RetentionForever keeps activities indefinitely.
+type Retention time.Duration
+
+const RetentionForever Retention = -1
+
+// ParseRetention parses a duration like "30d", or "forever".
+func ParseRetention(in string) (Retention, error) {
+	in = strings.TrimSpace(in)
+	if in == "forever" {
+		return RetentionForever, nil
+	}
+
+	d, err := ParseDuration(in)
+	if err != nil {
+		return 0, fmt.Errorf("invalid retention: %s", in)
+	}
+	if d < 0 {
+		return 0, fmt.Errorf("retention must not be negative: %s", in)
+	}
+
+	return Retention(d), nil
+}
+
+// Finite reports whether activities expire.
+func (r Retention) Finite() bool {
+	return r > 0
+}
+
+func (r Retention) String() string {
+	switch {
+	case r == RetentionForever:
+		return "forever"
+	case r == 0:
+		return ""
+	case time.Duration(r)%(24*time.Hour) == 0:
+		return fmt.Sprintf("%dd", time.Duration(r)/(24*time.Hour))
+	default:
+		return time.Duration(r).String()
+	}
+}
+
+// Decode parses environment variables like "90d" or "forever".
+func (r *Retention) Decode(in string) error {
+	out, err := ParseRetention(in)
+	if err != nil {
+		return err
+	}
+	*r = out
+	return nil
+}
+
+func (r Retention) MarshalJSON() ([]byte, error) {
+	return json.Marshal(r.String())
+}
+
+func (r *Retention) UnmarshalJSON(data []byte) error {
+	var raw *string
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return fmt.Errorf("invalid retention: %s", string(data))
+	}
+
+	if raw == nil {
+		*r = 0
+		return nil
+	}
+
+	return r.Decode(*raw)
+}
+
+// RetentionFilter selects stored activities that expired under a retention rule.
+// Empty fields don't filter.
+type RetentionFilter struct {
+	SourceUID  string
+	SourceType string
+	// ExcludeSourceUIDs and ExcludeSourceTypes skip activities covered by more specific rules.
+	ExcludeSourceUIDs  []string
+	ExcludeSourceTypes []string
+	// CreatedBefore is the cutoff of the rule.
+	CreatedBefore  time.Time
+	KeepStarred    bool
+	KeepReferenced bool
+	// Archived includes activities that were already archived.
+	Archived bool
+}
+
+// RetentionStats counts pruned activities and the storage they take up.
+type RetentionStats struct {
+	Activities int
+	// Bytes is an estimate of the storage reclaimed by deleting or archiving the activities.
+	Bytes int64
+}
+
+func (s *RetentionStats) Add(other RetentionStats) {
+	s.Activities += other.Activities
+	s.Bytes += other.Bytes
+}
+
+// maxReferencedURLs bounds the URLs an activity references, so that link dumps don't mark lots of activities.
+const maxReferencedURLs = 50
+
+var linkPattern = regexp.MustCompile(`https?://[^\s<>"'()\[\]]+`)
+
+// ReferencedURLs returns the URL of an activity and the URLs linked from its body.
+// Other activities with these URLs are referenced by the activity, like a release discussed in a Reddit post,
+// and are kept by retention rules with RetentionFilter.KeepReferenced.
+func ReferencedURLs(url, body string) []string {
+	seen := make(map[string]bool)
+	var out []string
+	for _, u := range append([]string{url}, linkPattern.FindAllString(body, -1)...) {
+		u = strings.TrimRight(u, ".,;:!?*_")
+		if u == "" || seen[u] {
+			continue
+		}
+		seen[u] = true
+		out = append(out, u)
+		if len(out) == maxReferencedURLs {
+			break
+		}
+	}
+	return out
+}
@@ -0,0 +1,121 @@
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseRetention(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    Retention
+		wantErr bool
+	}{
+		{in: "", want: 0},
+		{in: "forever", want: RetentionForever},
+		{in: " forever ", want: RetentionForever},
+		{in: "30d", want: Retention(30 * 24 * time.Hour)},
+		{in: "12h", want: Retention(12 * time.Hour)},
+		{in: "-1d", wantErr: true},
+		{in: "-1h", wantErr: true},
+		{in: "always", wantErr: true},
+		{in: "Forever", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseRetention(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, want error %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseRetention(%q) = %s, want %s", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRetentionString(t *testing.T) {
+	tests := []struct {
+		in   Retention
+		want string
+	}{
+		{in: 0, want: ""},
+		{in: RetentionForever, want: "forever"},
+		{in: Retention(24 * time.Hour), want: "1d"},
+		{in: Retention(30 * 24 * time.Hour), want: "30d"},
+		{in: Retention(36 * time.Hour), want: "36h0m0s"},
+		{in: Retention(90 * time.Minute), want: "1h30m0s"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := tt.in.String(); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+
+			// Strings parse back to the same retention.
+			parsed, err := ParseRetention(tt.want)
+			if err != nil {
+				t.Fatalf("ParseRetention(%q): %v", tt.want, err)
+			}
+			if parsed != tt.in {
+				t.Errorf("ParseRetention(%q) = %s, want %s", tt.want, parsed, tt.in)
+			}
+		})
+	}
+}
+
+func TestReferencedURLs(t *testing.T) {
+	var many strings.Builder
+	for i := range maxReferencedURLs + 10 {
+		fmt.Fprintf(&many, "https://example.com/%d ", i)
+	}
+
+	tests := []struct {
+		name    string
+		url     string
+		body    string
+		want    []string
+		wantLen int
+	}{
+		{name: "no body", url: "https://example.com/a", want: []string{"https://example.com/a"}},
+		{name: "no URL", body: "See https://example.com/b", want: []string{"https://example.com/b"}},
+		{
+			name: "links",
+			url:  "https://example.com/a",
+			body: `Released https://example.com/b, see <a href="https://example.com/c?x=1">notes</a>.`,
+			want: []string{"https://example.com/a", "https://example.com/b", "https://example.com/c?x=1"},
+		},
+		{
+			name: "markdown",
+			body: "[notes](https://example.com/b) and **https://example.com/c**!",
+			want: []string{"https://example.com/b", "https://example.com/c"},
+		},
+		{
+			name: "duplicates",
+			url:  "https://example.com/a",
+			body: "https://example.com/a. https://example.com/b https://example.com/b",
+			want: []string{"https://example.com/a", "https://example.com/b"},
+		},
+		{name: "bounded", body: many.String(), wantLen: maxReferencedURLs},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ReferencedURLs(tt.url, tt.body)
+			if tt.wantLen > 0 {
+				if len(got) != tt.wantLen {
+					t.Errorf("got %d URLs, want %d", len(got), tt.wantLen)
+				}
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ReferencedURLs() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
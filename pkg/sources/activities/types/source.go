@@ -0,0 +1,90 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SourceBase holds the configuration shared by all source types.
+// It is embedded in each source config, so its fields are part of the source JSON.
+type SourceBase struct {
+	// Interval overrides the default polling interval of the source type.
+	Interval Duration `json:"interval,omitempty" jsonschema_description:"Polling interval like 30m or 1d, overriding the default of the source type."`
+	// Tags group sources, e.g. into OPML folders.
+	Tags []string `json:"tags,omitempty" jsonschema_description:"Tags to group the source by."`
+	// Retention overrides how long activities of the source are kept.
+	Retention Retention `json:"retention,omitempty" jsonschema_description:"How long activities are kept, like 30d or forever, overriding the retention of the source type."`
+}
+
+func (b *SourceBase) PollInterval() time.Duration {
+	return time.Duration(b.Interval)
+}
+
+func (b *SourceBase) SourceTags() []string {
+	return b.Tags
+}
+
+func (b *SourceBase) SourceRetention() Retention {
+	return b.Retention
+}
+
+// Duration is a time.Duration that is (de)serialized as a string like "30m" or "1d".
+type Duration time.Duration
+
+func (d Duration) MarshalJSON() ([]byte, error) {
+	if d == 0 {
+		return json.Marshal("")
+	}
+	return json.Marshal(time.Duration(d).String())
+}
+
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var raw any
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	switch v := raw.(type) {
+	case nil:
+		*d = 0
+	case float64:
+		// Plain numbers are interpreted as seconds.
+		*d = Duration(time.Duration(v) * time.Second)
+	case string:
+		parsed, err := ParseDuration(v)
+		if err != nil {
+			return err
+		}
+		*d = Duration(parsed)
+	default:
+		return fmt.Errorf("invalid duration: %s", string(data))
+	}
+
+	return nil
+}
+
+// ParseDuration is like time.ParseDuration, but also accepts a day suffix (e.g. "1d").
+func ParseDuration(in string) (time.Duration, error) {
+	in = strings.TrimSpace(in)
+	if in == "" {
+		return 0, nil
+	}
+
+	if days, ok := strings.CutSuffix(in, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration: %s", in)
+		}
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+
+	out, err := time.ParseDuration(in)
+	if err != nil {
+		return 0, fmt.Errorf("invalid duration: %s", in)
+	}
+
+	return out, nil
+}
@@ -28,6 +28,15 @@ type DecoratedActivity struct {
 	Summary    *ActivitySummary
 	Embedding  []float32
 	Similarity float32
+	// Starred activities are kept by retention rules.
+	Starred bool
+	// Referenced activities are linked by other activities, and kept by retention rules as well.
+	Referenced bool
+}
+
+// ID returns the globally unique ID of the activity.
+func (a *DecoratedActivity) ID() string {
+	return ActivityID(a.SourceUID(), a.UID())
 }
 
 type SortBy string
@@ -0,0 +1,25 @@
+package types
+
+import "time"
+
+// WebSubscription is a subscription of a source to pushed updates from a WebSub hub.
+type WebSubscription struct {
+	SourceUID string
+	Hub       string
+	Topic     string
+	// Secret is the secret the hub signs distributed content with.
+	Secret string
+	// Mode is the last mode requested from the hub, which it verifies.
+	Mode string
+	// Active is true once the hub verified the subscription.
+	Active bool
+	// ExpiresAt is the end of the lease granted by the hub, zero until the subscription is verified.
+	ExpiresAt time.Time
+	// RenewAt is when the subscription is renewed, well before the lease expires.
+	RenewAt time.Time
+}
+
+// Pushed reports whether activities of the source are pushed by the hub.
+func (s *WebSubscription) Pushed(now time.Time) bool {
+	return s.Mode == "subscribe" && s.Active && now.Before(s.ExpiresAt)
+}
@@ -0,0 +1,14 @@
+// Package all registers the built-in source types.
+// A custom main can import it alongside packages that register additional source types.
+package all
+
+import (
+	_ "github.com/glanceapp/glance/pkg/sources/changedetection"
+	_ "github.com/glanceapp/glance/pkg/sources/github"
+	_ "github.com/glanceapp/glance/pkg/sources/hackernews"
+	_ "github.com/glanceapp/glance/pkg/sources/lobsters"
+	_ "github.com/glanceapp/glance/pkg/sources/mastodon"
+	_ "github.com/glanceapp/glance/pkg/sources/reddit"
+	_ "github.com/glanceapp/glance/pkg/sources/rss"
+	_ "github.com/glanceapp/glance/pkg/sources/webhook"
+)
@@ -0,0 +1,216 @@
+package sources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/glanceapp/glance/pkg/sources/activities/types"
+)
+
+const (
+	// maxBackfillLimit caps the number of activities fetched by a single backfill,
+	// since each of them is summarized and embedded.
+	maxBackfillLimit = 1000
+	// maxBackfillPages stops backfills of sources whose pages are mostly filtered out.
+	maxBackfillPages = 100
+)
+
+var (
+	ErrBackfillUnsupported = errors.New("source type doesn't support backfill")
+	ErrBackfillRunning     = errors.New("backfill is already running")
+	ErrInvalidBackfill     = errors.New("invalid backfill options")
+)
+
+// Backfiller is implemented by sources that can page through activities older than those fetched by Stream.
+type Backfiller interface {
+	// BackfillPage fetches the page of activities at the cursor, where an empty cursor is the first page.
+	// It returns the cursor of the next page, or an empty cursor after the last page.
+	BackfillPage(ctx context.Context, cursor string) (activities []types.Activity, next string, err error)
+}
+
+// BackfillOptions limit how far back a backfill goes. At least one of them must be set.
+type BackfillOptions struct {
+	// Limit is the maximum number of activities to fetch.
+	Limit int
+	// Window is the maximum age of fetched activities.
+	Window time.Duration
+}
+
+func (o BackfillOptions) Validate() error {
+	if o.Limit < 0 || o.Window < 0 {
+		return fmt.Errorf("%w: limit and window must not be negative", ErrInvalidBackfill)
+	}
+
+	if o.Limit == 0 && o.Window == 0 {
+		return fmt.Errorf("%w: limit or window is required", ErrInvalidBackfill)
+	}
+
+	if o.Limit > maxBackfillLimit {
+		return fmt.Errorf("%w: limit must be at most %d", ErrInvalidBackfill, maxBackfillLimit)
+	}
+
+	return nil
+}
+
+// Backfill starts fetching the history of the source in the background.
+// Backfills run one page at a time with a delay between pages, and wait while the job queue
+// is more than half full, so that activities of polled sources are still processed promptly.
+func (r *Registry) Backfill(uid string, opts BackfillOptions) error {
+	if err := opts.Validate(); err != nil {
+		return err
+	}
+
+	source, err := r.sourceRepo.GetByID(uid)
+	if err != nil {
+		return fmt.Errorf("get source: %w", err)
+	}
+
+	if source == nil {
+		return fmt.Errorf("source '%s': %w", uid, ErrSourceNotFound)
+	}
+
+	backfiller, ok := source.(Backfiller)
+	if !ok {
+		return fmt.Errorf("source '%s': %w", uid, ErrBackfillUnsupported)
+	}
+
+	if err := source.Initialize(); err != nil {
+		return fmt.Errorf("%w: initialize source: %w", ErrInvalidSource, err)
+	}
+
+	r.backfillsMu.Lock()
+	defer r.backfillsMu.Unlock()
+
+	if r.backfills[uid] {
+		return fmt.Errorf("source '%s': %w", uid, ErrBackfillRunning)
+	}
+	r.backfills[uid] = true
+
+	go func() {
+		defer func() {
+			r.backfillsMu.Lock()
+			delete(r.backfills, uid)
+			r.backfillsMu.Unlock()
+		}()
+
+		r.runBackfill(uid, backfiller, opts)
+	}()
+
+	return nil
+}
+
+func (r *Registry) runBackfill(uid string, source Backfiller, opts BackfillOptions) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-r.done:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	// Only a limited number of backfills run at once, the rest wait for their turn.
+	select {
+	case r.backfillSlots <- struct{}{}:
+	case <-ctx.Done():
+		return
+	}
+	defer func() { <-r.backfillSlots }()
+
+	logger := r.logger.With().Str("source", uid).Logger()
+	logger.Info().Int("limit", opts.Limit).Dur("window", opts.Window).Msg("Starting backfill")
+
+	items, pages, err := r.backfillPages(ctx, source, opts)
+	if err != nil && !errors.Is(err, context.Canceled) {
+		logger.Error().Err(err).Int("items", items).Int("pages", pages).Msg("Backfill failed")
+		return
+	}
+
+	logger.Info().Int("items", items).Int("pages", pages).Msg("Finished backfill")
+}
+
+// backfillPages forwards activities of the source page by page, until the backfill options are met.
+func (r *Registry) backfillPages(ctx context.Context, source Backfiller, opts BackfillOptions) (items, pages int, err error) {
+	var since time.Time
+	if opts.Window > 0 {
+		since = time.Now().Add(-opts.Window)
+	}
+
+	cursor := ""
+	for pages < maxBackfillPages {
+		if pages > 0 {
+			select {
+			case <-time.After(r.config.BackfillPageDelay):
+			case <-ctx.Done():
+				return items, pages, ctx.Err()
+			}
+		}
+
+		if err := r.waitForBackfillCapacity(ctx); err != nil {
+			return items, pages, err
+		}
+
+		var page []types.Activity
+		var next string
+		err := r.config.RetryPolicy().Do(ctx, func(ctx context.Context) error {
+			var err error
+			page, next, err = source.BackfillPage(ctx, cursor)
+			return err
+		})
+		if err != nil {
+			return items, pages, fmt.Errorf("fetch page %d: %w", pages+1, err)
+		}
+		pages++
+
+		inWindow := 0
+		for _, act := range page {
+			if !since.IsZero() && act.CreatedAt().Before(since) {
+				continue
+			}
+			inWindow++
+
+			select {
+			case r.activityQueue <- act:
+			case <-ctx.Done():
+				return items, pages, ctx.Err()
+			}
+
+			items++
+			if opts.Limit > 0 && items >= opts.Limit {
+				return items, pages, nil
+			}
+		}
+
+		// Pages are ordered newest first, so no later page is within the window either.
+		if next == "" || inWindow == 0 {
+			return items, pages, nil
+		}
+		cursor = next
+	}
+
+	return items, pages, nil
+}
+
+// waitForBackfillCapacity blocks while the job queue is more than half full,
+// leaving the rest of its capacity to activities of polled sources.
+func (r *Registry) waitForBackfillCapacity(ctx context.Context) error {
+	for {
+		counts, err := r.jobRepo.Counts()
+		if err != nil {
+			return fmt.Errorf("count jobs: %w", err)
+		}
+
+		if counts.Pending+counts.Running < max(r.config.QueueCapacity/2, 1) {
+			return nil
+		}
+
+		select {
+		case <-time.After(jobPollInterval):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
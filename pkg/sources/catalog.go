@@ -0,0 +1,132 @@
+package sources
+
+import (
+	"fmt"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+
+	"github.com/glanceapp/glance/pkg/sources/activities/types"
+)
+
+// defaultPollInterval is used for source types that don't specify their own.
+const defaultPollInterval = time.Hour
+
+// SourceType describes a source type. Source packages register their types from init(),
+// so that importing a package is enough to make its source types available.
+type SourceType struct {
+	Type        string
+	Description string
+	// NewSource returns a source with the default config of the type.
+	NewSource func() Source
+	// NewActivity returns an empty activity of the type, which stored activities are decoded into.
+	NewActivity func() types.Activity
+	// PollInterval is the default polling interval of sources of the type.
+	PollInterval time.Duration
+	Examples     []map[string]any
+	// FromFeedURL optionally returns a source that fetches the feed, or nil if the type can't fetch it.
+	// It lets OPML imports create sources of the type instead of generic feed sources.
+	FromFeedURL func(u *url.URL, base types.SourceBase) Source
+	// AcceptsAnyFeed marks a FromFeedURL that accepts every feed.
+	// It is only tried if no other type accepts the feed.
+	AcceptsAnyFeed bool
+
+	// Schema is a JSON Schema of the source config, reflected from the source struct on registration.
+	Schema *openapi3.Schema
+}
+
+var catalog = struct {
+	sync.RWMutex
+	types []*SourceType
+	byID  map[string]*SourceType
+}{
+	byID: make(map[string]*SourceType),
+}
+
+// RegisterSourceType makes the source type available. It panics if the type is registered twice
+// or is incomplete, since that is a programming error.
+func RegisterSourceType(t SourceType) {
+	if t.Type == "" || t.NewSource == nil || t.NewActivity == nil {
+		panic("sources: source type must have a type, a source and an activity factory")
+	}
+
+	schema, err := reflectSchema(t.NewSource())
+	if err != nil {
+		panic(fmt.Sprintf("sources: source type '%s': %s", t.Type, err))
+	}
+	t.Schema = schema
+
+	catalog.Lock()
+	defer catalog.Unlock()
+
+	if _, ok := catalog.byID[t.Type]; ok {
+		panic(fmt.Sprintf("sources: source type '%s' is registered twice", t.Type))
+	}
+
+	catalog.types = append(catalog.types, &t)
+	catalog.byID[t.Type] = &t
+}
+
+// SourceTypes returns all registered source types, in the order they were registered.
+func SourceTypes() []SourceType {
+	catalog.RLock()
+	defer catalog.RUnlock()
+
+	out := make([]SourceType, len(catalog.types))
+	for i, t := range catalog.types {
+		out[i] = *t
+	}
+	return out
+}
+
+func lookupSourceType(sourceType string) (*SourceType, error) {
+	catalog.RLock()
+	defer catalog.RUnlock()
+
+	t, ok := catalog.byID[sourceType]
+	if !ok {
+		return nil, fmt.Errorf("unknown source type: %s", sourceType)
+	}
+	return t, nil
+}
+
+func NewSource(sourceType string) (Source, error) {
+	t, err := lookupSourceType(sourceType)
+	if err != nil {
+		return nil, err
+	}
+
+	return t.NewSource(), nil
+}
+
+func NewActivity(sourceType string) (types.Activity, error) {
+	t, err := lookupSourceType(sourceType)
+	if err != nil {
+		return nil, err
+	}
+
+	return t.NewActivity(), nil
+}
+
+// ConfigSchema returns the JSON Schema of the config of the source type.
+func ConfigSchema(sourceType string) (*openapi3.Schema, error) {
+	t, err := lookupSourceType(sourceType)
+	if err != nil {
+		return nil, err
+	}
+
+	return t.Schema, nil
+}
+
+// DefaultPollInterval returns the polling interval used for sources of the given type
+// that don't specify their own.
+func DefaultPollInterval(sourceType string) time.Duration {
+	t, err := lookupSourceType(sourceType)
+	if err != nil || t.PollInterval <= 0 {
+		return defaultPollInterval
+	}
+
+	return t.PollInterval
+}
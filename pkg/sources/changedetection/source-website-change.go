@@ -7,17 +7,30 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/glanceapp/glance/pkg/sources"
 	"github.com/glanceapp/glance/pkg/sources/activities/types"
 	"github.com/glanceapp/glance/pkg/utils"
 )
 
 const TypeChangedetectionWebsite = "changedetection-website-change"
 
+func init() {
+	sources.RegisterSourceType(sources.SourceType{
+		Type:         TypeChangedetectionWebsite,
+		Description:  "Changes of a website watched by changedetection.io.",
+		NewSource:    func() sources.Source { return NewSourceWebsiteChange() },
+		NewActivity:  func() types.Activity { return NewWebsiteChange() },
+		PollInterval: time.Hour,
+		Examples:     []map[string]any{{"watch": "2c4d0c4a-0b3e-4c7e-9f4a-8a1f2b3c4d5e", "token": "<api key>"}},
+	})
+}
+
 type SourceWebsiteChange struct {
-	WatchUUID   string `json:"watch"`
-	InstanceURL string `json:"instance_url"`
-	Token       string `json:"token"`
-	Limit       int    `json:"limit"`
+	types.SourceBase
+	WatchUUID   string `json:"watch" jsonschema:"required" jsonschema_description:"UUID of the changedetection.io watch."`
+	InstanceURL string `json:"instance_url" jsonschema:"default=https://www.changedetection.io"`
+	Token       string `json:"token" jsonschema:"secret" jsonschema_description:"changedetection.io API key."`
+	Limit       int    `json:"limit" jsonschema:"default=10"`
 }
 
 func NewSourceWebsiteChange() *SourceWebsiteChange {
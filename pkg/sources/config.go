@@ -0,0 +1,147 @@
+package sources
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/glanceapp/glance/pkg/sources/activities/types"
+	"github.com/glanceapp/glance/pkg/utils"
+)
+
+type Config struct {
+	// Workers is the number of goroutines processing pipeline jobs.
+	Workers int `env:"PIPELINE_WORKERS,default=4"`
+	// QueueCapacity is the maximum number of queued jobs before sources are throttled.
+	QueueCapacity int `env:"PIPELINE_QUEUE_CAPACITY,default=1000"`
+	// EnqueueTimeout is how long a source may wait for queue capacity before its fetch is aborted.
+	EnqueueTimeout time.Duration `env:"PIPELINE_ENQUEUE_TIMEOUT,default=5m"`
+	// SummarizeConcurrency limits the number of concurrent summarizer (LLM) calls.
+	SummarizeConcurrency int `env:"PIPELINE_SUMMARIZE_CONCURRENCY,default=2"`
+	// EmbedConcurrency limits the number of concurrent embedder calls.
+	EmbedConcurrency int `env:"PIPELINE_EMBED_CONCURRENCY,default=4"`
+	// StoreConcurrency limits the number of concurrent activity writes.
+	StoreConcurrency int `env:"PIPELINE_STORE_CONCURRENCY,default=4"`
+
+	// RetryMaxAttempts is the number of attempts of a source fetch, summarizer or embedder call.
+	RetryMaxAttempts int           `env:"RETRY_MAX_ATTEMPTS,default=3"`
+	RetryBaseDelay   time.Duration `env:"RETRY_BASE_DELAY,default=2s"`
+	// RetryMaxDelay caps the backoff. Calls are not retried if the server asks to wait for longer.
+	RetryMaxDelay time.Duration `env:"RETRY_MAX_DELAY,default=1m"`
+	// BreakerThreshold is the number of consecutive failed calls after which a circuit breaker opens.
+	BreakerThreshold int `env:"CIRCUIT_BREAKER_THRESHOLD,default=3"`
+	// BreakerCoolDown is how long an open circuit breaker rejects calls.
+	BreakerCoolDown time.Duration `env:"CIRCUIT_BREAKER_COOLDOWN,default=30m"`
+
+	// RateLimits overrides the default per-host budgets of outbound source requests,
+	// e.g. "api.github.com=5000/1h,mastodon.social=300/5m".
+	RateLimits utils.RateLimitConfig `env:"RATE_LIMITS"`
+
+	// BackfillConcurrency limits the number of sources whose history is fetched at once.
+	BackfillConcurrency int `env:"BACKFILL_CONCURRENCY,default=1"`
+	// BackfillPageDelay is the delay between fetching pages of a backfill.
+	BackfillPageDelay time.Duration `env:"BACKFILL_PAGE_DELAY,default=10s"`
+
+	// RetentionDefault is how long activities are kept, unless overridden for their source type or source.
+	RetentionDefault types.Retention `env:"RETENTION_DEFAULT,default=forever"`
+	// RetentionTypes overrides the default retention per source type, e.g. "reddit-subreddit=30d,github-releases=forever".
+	RetentionTypes RetentionRules `env:"RETENTION_TYPES"`
+	// RetentionKeepStarred keeps starred activities regardless of their retention.
+	RetentionKeepStarred bool `env:"RETENTION_KEEP_STARRED,default=true"`
+	// RetentionKeepReferenced keeps activities referenced by other activities regardless of their retention.
+	RetentionKeepReferenced bool `env:"RETENTION_KEEP_REFERENCED,default=true"`
+	// RetentionMode is RetentionModeDelete or RetentionModeArchive.
+	RetentionMode string `env:"RETENTION_MODE,default=delete"`
+	// RetentionInterval is the interval between runs that prune expired activities.
+	RetentionInterval time.Duration `env:"RETENTION_INTERVAL,default=1h"`
+	// RetentionBatchSize is the number of activities pruned at once.
+	RetentionBatchSize int `env:"RETENTION_BATCH_SIZE,default=500"`
+
+	// PublicURL is the URL the server is reachable at by WebSub hubs, e.g. https://pulse.example.com.
+	// Feeds are only subscribed to if it's set.
+	PublicURL string `env:"PUBLIC_URL"`
+
+	// SourcesFile is a YAML or JSON file of sources that is reconciled on startup and on SIGHUP.
+	SourcesFile string `env:"SOURCES_FILE"`
+	// SourcesFileDryRun only logs the changes reconciliation would make.
+	SourcesFileDryRun bool `env:"SOURCES_FILE_DRY_RUN,default=false"`
+	// SourcesFileStrict rejects sources that are not defined in the sources file.
+	SourcesFileStrict bool `env:"SOURCES_FILE_STRICT,default=false"`
+}
+
+func (c *Config) RetryPolicy() utils.RetryPolicy {
+	return utils.RetryPolicy{
+		MaxAttempts: c.RetryMaxAttempts,
+		BaseDelay:   c.RetryBaseDelay,
+		MaxDelay:    c.RetryMaxDelay,
+	}
+}
+
+func (c *Config) NewCircuitBreaker() *utils.CircuitBreaker {
+	return utils.NewCircuitBreaker(c.BreakerThreshold, c.BreakerCoolDown)
+}
+
+func (c *Config) Validate() error {
+	if c.Workers < 1 {
+		return fmt.Errorf("workers must be at least 1")
+	}
+
+	if c.QueueCapacity < 1 {
+		return fmt.Errorf("queue capacity must be at least 1")
+	}
+
+	if c.EnqueueTimeout <= 0 {
+		return fmt.Errorf("enqueue timeout must be positive")
+	}
+
+	if c.SummarizeConcurrency < 1 || c.EmbedConcurrency < 1 || c.StoreConcurrency < 1 {
+		return fmt.Errorf("stage concurrency must be at least 1")
+	}
+
+	if c.RetryMaxAttempts < 1 {
+		return fmt.Errorf("retry max attempts must be at least 1")
+	}
+
+	if c.RetryBaseDelay <= 0 || c.RetryMaxDelay < c.RetryBaseDelay {
+		return fmt.Errorf("retry delays must be positive, with max delay not below base delay")
+	}
+
+	if c.BreakerThreshold < 1 {
+		return fmt.Errorf("circuit breaker threshold must be at least 1")
+	}
+
+	if c.BackfillConcurrency < 1 {
+		return fmt.Errorf("backfill concurrency must be at least 1")
+	}
+
+	if c.BackfillPageDelay < 0 {
+		return fmt.Errorf("backfill page delay must not be negative")
+	}
+
+	if c.RetentionMode != RetentionModeDelete && c.RetentionMode != RetentionModeArchive {
+		return fmt.Errorf("retention mode must be %q or %q", RetentionModeDelete, RetentionModeArchive)
+	}
+
+	for sourceType := range c.RetentionTypes {
+		if _, err := lookupSourceType(sourceType); err != nil {
+			return fmt.Errorf("retention rule: %w", err)
+		}
+	}
+
+	if c.RetentionInterval <= 0 || c.RetentionBatchSize < 1 {
+		return fmt.Errorf("retention interval must be positive, and batch size at least 1")
+	}
+
+	if c.PublicURL != "" {
+		u, err := url.Parse(c.PublicURL)
+		if err != nil || u.Scheme != "http" && u.Scheme != "https" || u.Host == "" {
+			return fmt.Errorf("public URL must be an absolute http(s) URL")
+		}
+	}
+
+	if c.SourcesFileStrict && c.SourcesFile == "" {
+		return fmt.Errorf("strict mode requires a sources file")
+	}
+
+	return nil
+}
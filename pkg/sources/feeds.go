@@ -0,0 +1,48 @@
+package sources
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/glanceapp/glance/pkg/sources/activities/types"
+)
+
+// SourceFromFeedURL returns the source that fetches the given feed.
+// Feeds of services with a native source type (GitHub releases, Reddit, Mastodon, Lobsters)
+// are mapped to that type, since it provides richer activities. Other feeds are fetched as RSS.
+func SourceFromFeedURL(feedURL string, base types.SourceBase) (Source, error) {
+	u, err := url.Parse(strings.TrimSpace(feedURL))
+	if err != nil {
+		return nil, fmt.Errorf("parse feed URL: %w", err)
+	}
+
+	if u.Scheme != "http" && u.Scheme != "https" || u.Host == "" {
+		return nil, fmt.Errorf("feed URL must be an absolute http(s) URL: %s", feedURL)
+	}
+
+	all := SourceTypes()
+	for _, anyFeed := range []bool{false, true} {
+		for _, t := range all {
+			if t.FromFeedURL == nil || t.AcceptsAnyFeed != anyFeed {
+				continue
+			}
+			if s := t.FromFeedURL(u, base); s != nil {
+				return s, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("no source type fetches the feed: %s", feedURL)
+}
+
+// FeedURL returns a feed URL that other feed readers can subscribe to for the source,
+// or the web URL of the source if it has no equivalent feed.
+func FeedURL(source Source) string {
+	if s, ok := source.(interface{ SubscriptionURL() string }); ok {
+		if out := s.SubscriptionURL(); out != "" {
+			return out
+		}
+	}
+	return source.URL()
+}
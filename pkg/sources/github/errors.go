@@ -0,0 +1,37 @@
+package github
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/glanceapp/glance/pkg/utils"
+	"github.com/google/go-github/v72/github"
+)
+
+// wrapError converts GitHub API errors to utils.HTTPError,
+// so that the retry policy can honor rate limit resets.
+func wrapError(err error) error {
+	var rateLimitErr *github.RateLimitError
+	if errors.As(err, &rateLimitErr) {
+		return httpError(rateLimitErr.Response, rateLimitErr.Message, time.Until(rateLimitErr.Rate.Reset.Time))
+	}
+
+	var abuseErr *github.AbuseRateLimitError
+	if errors.As(err, &abuseErr) {
+		return httpError(abuseErr.Response, abuseErr.Message, abuseErr.GetRetryAfter())
+	}
+
+	var responseErr *github.ErrorResponse
+	if errors.As(err, &responseErr) {
+		return httpError(responseErr.Response, responseErr.Message, 0)
+	}
+
+	return err
+}
+
+func httpError(response *http.Response, message string, wait time.Duration) error {
+	out := utils.NewHTTPError(response, message)
+	out.Wait = max(out.Wait, wait)
+	return out
+}
@@ -0,0 +1,18 @@
+package github
+
+import (
+	"strconv"
+
+	"github.com/google/go-github/v72/github"
+)
+
+// backfillPageSize is the maximum page size of the GitHub REST API.
+const backfillPageSize = 100
+
+// nextPage returns the cursor of the page after the response, or an empty cursor on the last page.
+func nextPage(res *github.Response) string {
+	if res == nil || res.NextPage == 0 {
+		return ""
+	}
+	return strconv.Itoa(res.NextPage)
+}
@@ -4,21 +4,39 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/glanceapp/glance/pkg/sources"
 	"github.com/glanceapp/glance/pkg/sources/activities/types"
+	"github.com/glanceapp/glance/pkg/utils"
 
 	"github.com/google/go-github/v72/github"
 )
 
 const TypeGithubIssues = "github-issues"
 
+func init() {
+	sources.RegisterSourceType(sources.SourceType{
+		Type:         TypeGithubIssues,
+		Description:  "Recently updated issues and pull requests of a GitHub repository.",
+		NewSource:    func() sources.Source { return NewIssuesSource() },
+		NewActivity:  func() types.Activity { return NewIssue() },
+		PollInterval: 30 * time.Minute,
+		Examples:     []map[string]any{{"Repository": "golang/go"}},
+	})
+}
+
 type SourceIssues struct {
-	Repository string `json:"Repository"`
-	Token      string `json:"token"`
-	client     *github.Client
+	types.SourceBase
+	Repository string `json:"Repository" jsonschema:"required,pattern=^[^/]+/[^/]+$" jsonschema_description:"Repository like owner/name."`
+	Token      string `json:"token" jsonschema:"secret" jsonschema_description:"GitHub token, defaults to the GITHUB_TOKEN environment variable."`
+	// WebhookSecret verifies webhook deliveries of issues, comments, pull requests and discussions.
+	WebhookSecret string `json:"webhook_secret" jsonschema:"secret" jsonschema_description:"Secret of the repository webhook, defaults to the GITHUB_WEBHOOK_SECRET environment variable."`
+	client        *github.Client
 }
 
 func NewIssuesSource() *SourceIssues {
@@ -66,10 +84,13 @@ func (s *SourceIssues) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// Issue is an issue, pull request or discussion of the repository,
+// or a comment on one of them if Comment is set.
 type Issue struct {
-	Repository string        `json:"Repository"`
-	Issue      *github.Issue `json:"issue"`
-	SourceID   string        `json:"source_id"`
+	Repository string               `json:"Repository"`
+	Issue      *github.Issue        `json:"issue"`
+	Comment    *github.IssueComment `json:"comment,omitempty"`
+	SourceID   string               `json:"source_id"`
 }
 
 func NewIssue() *Issue {
@@ -100,6 +121,9 @@ func (i *Issue) UnmarshalJSON(data []byte) error {
 }
 
 func (i *Issue) UID() string {
+	if i.Comment != nil {
+		return fmt.Sprintf("issue-%d-comment-%d", i.Issue.GetNumber(), i.Comment.GetID())
+	}
 	return fmt.Sprintf("issue-%d", i.Issue.GetNumber())
 }
 
@@ -108,14 +132,23 @@ func (i *Issue) SourceUID() string {
 }
 
 func (i *Issue) Title() string {
+	if i.Comment != nil {
+		return fmt.Sprintf("%s commented on #%d: %s", i.Comment.GetUser().GetLogin(), i.Issue.GetNumber(), i.Issue.GetTitle())
+	}
 	return i.Issue.GetTitle()
 }
 
 func (i *Issue) Body() string {
+	if i.Comment != nil {
+		return i.Comment.GetBody()
+	}
 	return i.Issue.GetBody()
 }
 
 func (i *Issue) URL() string {
+	if i.Comment != nil {
+		return i.Comment.GetHTMLURL()
+	}
 	return i.Issue.GetHTMLURL()
 }
 
@@ -129,6 +162,9 @@ func (i *Issue) ImageURL() string {
 }
 
 func (i *Issue) CreatedAt() time.Time {
+	if i.Comment != nil {
+		return i.Comment.GetUpdatedAt().Time
+	}
 	return i.Issue.GetUpdatedAt().Time
 }
 
@@ -139,9 +175,9 @@ func (s *SourceIssues) Initialize() error {
 	}
 
 	if token != "" {
-		s.client = github.NewClient(nil).WithAuthToken(token)
+		s.client = github.NewClient(utils.NewHTTPClient(0)).WithAuthToken(token)
 	} else {
-		s.client = github.NewClient(nil)
+		s.client = github.NewClient(utils.NewHTTPClient(0))
 	}
 
 	return nil
@@ -151,7 +187,7 @@ func (s *SourceIssues) Stream(ctx context.Context, feed chan<- types.Activity, e
 	activities, err := s.fetchIssueActivities(ctx, s.client, s.Repository)
 
 	if err != nil {
-		errs <- err
+		errs <- wrapError(err)
 		return
 	}
 
@@ -160,23 +196,101 @@ func (s *SourceIssues) Stream(ctx context.Context, feed chan<- types.Activity, e
 	}
 }
 
+// Receive maps webhook deliveries of issue, comment, pull request and discussion events to issues.
+// Comments are received as a comment activity, in addition to the updated issue. Deleted items are skipped.
+func (s *SourceIssues) Receive(header http.Header, body []byte) ([]types.Activity, error) {
+	event, err := parseWebhook(webhookSecret(s.WebhookSecret), s.Repository, header, body)
+	if err != nil {
+		return nil, err
+	}
+
+	var issue *github.Issue
+	var comment *github.IssueComment
+	switch e := event.(type) {
+	case *github.IssuesEvent:
+		if e.GetAction() != "deleted" {
+			issue = e.GetIssue()
+		}
+	case *github.IssueCommentEvent:
+		if e.GetAction() != "deleted" {
+			issue = e.GetIssue()
+			comment = e.GetComment()
+		}
+	case *github.PullRequestEvent:
+		issue = pullRequestIssue(e.GetPullRequest())
+	case *github.DiscussionEvent:
+		if e.GetAction() != "deleted" {
+			issue = discussionIssue(e.GetDiscussion())
+		}
+	}
+
+	if issue == nil || issue.Number == nil {
+		return nil, nil
+	}
+
+	out := []types.Activity{&Issue{
+		Issue:      issue,
+		SourceID:   s.UID(),
+		Repository: s.Repository,
+	}}
+
+	if comment != nil && comment.ID != nil {
+		out = append(out, &Issue{
+			Issue:      issue,
+			Comment:    comment,
+			SourceID:   s.UID(),
+			Repository: s.Repository,
+		})
+	}
+
+	return out, nil
+}
+
+// BackfillPage lists issues by last update, following the page numbers of the GitHub API.
+func (s *SourceIssues) BackfillPage(ctx context.Context, cursor string) ([]types.Activity, string, error) {
+	page := 1
+	if cursor != "" {
+		var err error
+		if page, err = strconv.Atoi(cursor); err != nil {
+			return nil, "", fmt.Errorf("invalid page cursor: %s", cursor)
+		}
+	}
+
+	issues, res, err := s.listIssues(ctx, s.client, s.Repository, github.ListOptions{Page: page, PerPage: backfillPageSize})
+	if err != nil {
+		return nil, "", wrapError(err)
+	}
+
+	out := make([]types.Activity, len(issues))
+	for i, issue := range issues {
+		out[i] = issue
+	}
+
+	return out, nextPage(res), nil
+}
+
 func (s *SourceIssues) fetchIssueActivities(ctx context.Context, client *github.Client, repository string) ([]*Issue, error) {
+	activities, _, err := s.listIssues(ctx, client, repository, github.ListOptions{PerPage: 10})
+	return activities, err
+}
+
+func (s *SourceIssues) listIssues(ctx context.Context, client *github.Client, repository string, opts github.ListOptions) ([]*Issue, *github.Response, error) {
 	activities := make([]*Issue, 0)
 
 	parts := strings.Split(repository, "/")
 	if len(parts) != 2 {
-		return nil, fmt.Errorf("invalid Repository format: %s", repository)
+		return nil, nil, fmt.Errorf("invalid Repository format: %s", repository)
 	}
 	owner, repo := parts[0], parts[1]
 
-	issues, _, err := client.Issues.ListByRepo(ctx, owner, repo, &github.IssueListByRepoOptions{
+	issues, res, err := client.Issues.ListByRepo(ctx, owner, repo, &github.IssueListByRepoOptions{
 		State:       "all",
 		Sort:        "updated",
 		Direction:   "desc",
-		ListOptions: github.ListOptions{PerPage: 10},
+		ListOptions: opts,
 	})
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	for _, issue := range issues {
@@ -187,5 +301,5 @@ func (s *SourceIssues) fetchIssueActivities(ctx context.Context, client *github.
 		})
 	}
 
-	return activities, nil
+	return activities, res, nil
 }
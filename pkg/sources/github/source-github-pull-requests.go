@@ -0,0 +1,580 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/glanceapp/glance/pkg/sources"
+	"github.com/glanceapp/glance/pkg/sources/activities/types"
+	"github.com/glanceapp/glance/pkg/utils"
+
+	"github.com/google/go-github/v72/github"
+)
+
+const TypeGithubPullRequests = "github-pull-requests"
+
+const (
+	// pullRequestPageSize is smaller than the API maximum, since files and reviews are fetched for every pull request.
+	pullRequestPageSize = 30
+	// streamedPullRequests is the number of recently updated pull requests fetched by each poll.
+	streamedPullRequests = 10
+	// maxListedFiles is the number of changed files listed in the body of a pull request.
+	maxListedFiles = 30
+	// receiveTimeout bounds the API calls made while receiving a webhook delivery, which GitHub gives up on after 10s.
+	receiveTimeout = 8 * time.Second
+)
+
+func init() {
+	sources.RegisterSourceType(sources.SourceType{
+		Type:         TypeGithubPullRequests,
+		Description:  "Recently updated pull requests of a GitHub repository, with their changed files, reviews and merge status.",
+		NewSource:    func() sources.Source { return NewPullRequestsSource() },
+		NewActivity:  func() types.Activity { return NewPullRequest() },
+		PollInterval: 30 * time.Minute,
+		Examples: []map[string]any{
+			{"Repository": "golang/go"},
+			{"Repository": "golang/go", "state": "closed", "base": "master", "labels": []string{"release-blocker"}, "drafts": "exclude"},
+		},
+	})
+}
+
+type SourcePullRequests struct {
+	types.SourceBase
+	Repository string   `json:"Repository" jsonschema:"required,pattern=^[^/]+/[^/]+$" jsonschema_description:"Repository like owner/name."`
+	Token      string   `json:"token" jsonschema:"secret" jsonschema_description:"GitHub token, defaults to the GITHUB_TOKEN environment variable."`
+	State      string   `json:"state" jsonschema:"enum=open,enum=closed,enum=all"`
+	Base       string   `json:"base" jsonschema_description:"Only pull requests into this base branch."`
+	Labels     []string `json:"labels" jsonschema_description:"Only pull requests with all of these labels."`
+	Author     string   `json:"author" jsonschema_description:"Only pull requests opened by this user."`
+	Drafts     string   `json:"drafts" jsonschema:"enum=include,enum=exclude,enum=only"`
+	// WebhookSecret verifies webhook deliveries of pull request and review events.
+	WebhookSecret string `json:"webhook_secret" jsonschema:"secret" jsonschema_description:"Secret of the repository webhook, defaults to the GITHUB_WEBHOOK_SECRET environment variable."`
+	client        *github.Client
+
+	// polled holds the pull requests of the last poll by number, so that the files and reviews
+	// of pull requests that weren't updated since aren't fetched again.
+	polledMu sync.Mutex
+	polled   map[int]*PullRequest
+}
+
+func NewPullRequestsSource() *SourcePullRequests {
+	return &SourcePullRequests{
+		State:  "all",
+		Drafts: "include",
+	}
+}
+
+func (s *SourcePullRequests) UID() string {
+	return fmt.Sprintf("%s/%s", s.Type(), s.Repository)
+}
+
+func (s *SourcePullRequests) Name() string {
+	return fmt.Sprintf("Pull Requests (%s)", s.Repository)
+}
+
+func (s *SourcePullRequests) URL() string {
+	return fmt.Sprintf("https://github.com/%s/pulls", s.Repository)
+}
+
+func (s *SourcePullRequests) Type() string {
+	return TypeGithubPullRequests
+}
+
+func (s *SourcePullRequests) MarshalJSON() ([]byte, error) {
+	type Alias SourcePullRequests
+	return json.Marshal(&struct {
+		*Alias
+		Type string `json:"type"`
+	}{
+		Alias: (*Alias)(s),
+		Type:  s.Type(),
+	})
+}
+
+func (s *SourcePullRequests) UnmarshalJSON(data []byte) error {
+	type Alias SourcePullRequests
+	aux := &struct {
+		*Alias
+		Type string `json:"type"`
+	}{
+		Alias: (*Alias)(s),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (s *SourcePullRequests) Initialize() error {
+	if len(strings.Split(s.Repository, "/")) != 2 {
+		return fmt.Errorf("invalid Repository format: %s", s.Repository)
+	}
+
+	if s.State == "" {
+		s.State = "all"
+	}
+	if !slices.Contains([]string{"open", "closed", "all"}, s.State) {
+		return fmt.Errorf("state must be open, closed or all")
+	}
+
+	if s.Drafts == "" {
+		s.Drafts = "include"
+	}
+	if !slices.Contains([]string{"include", "exclude", "only"}, s.Drafts) {
+		return fmt.Errorf("drafts must be include, exclude or only")
+	}
+
+	token := s.Token
+	if token == "" {
+		token = os.Getenv("GITHUB_TOKEN")
+	}
+
+	if token != "" {
+		s.client = github.NewClient(utils.NewHTTPClient(0)).WithAuthToken(token)
+	} else {
+		s.client = github.NewClient(utils.NewHTTPClient(0))
+	}
+
+	return nil
+}
+
+func (s *SourcePullRequests) Stream(ctx context.Context, feed chan<- types.Activity, errs chan<- error) {
+	pulls, _, err := s.listPullRequests(ctx, github.ListOptions{PerPage: streamedPullRequests})
+	if err != nil {
+		errs <- wrapError(err)
+		return
+	}
+
+	polled := make(map[int]*PullRequest, len(pulls))
+	for _, pull := range pulls {
+		polled[pull.PullRequest.GetNumber()] = pull
+	}
+
+	s.polledMu.Lock()
+	s.polled = polled
+	s.polledMu.Unlock()
+
+	for _, pull := range pulls {
+		feed <- pull
+	}
+}
+
+// BackfillPage lists pull requests by last update, following the page numbers of the GitHub API.
+func (s *SourcePullRequests) BackfillPage(ctx context.Context, cursor string) ([]types.Activity, string, error) {
+	page := 1
+	if cursor != "" {
+		var err error
+		if page, err = strconv.Atoi(cursor); err != nil {
+			return nil, "", fmt.Errorf("invalid page cursor: %s", cursor)
+		}
+	}
+
+	pulls, res, err := s.listPullRequests(ctx, github.ListOptions{Page: page, PerPage: pullRequestPageSize})
+	if err != nil {
+		return nil, "", wrapError(err)
+	}
+
+	out := make([]types.Activity, len(pulls))
+	for i, pull := range pulls {
+		out[i] = pull
+	}
+
+	return out, nextPage(res), nil
+}
+
+// Receive maps webhook deliveries of pull request and review events to pull requests.
+// The pull request is fetched again, so that it has the same changed files and reviews as when it's polled.
+func (s *SourcePullRequests) Receive(header http.Header, body []byte) ([]types.Activity, error) {
+	event, err := parseWebhook(webhookSecret(s.WebhookSecret), s.Repository, header, body)
+	if err != nil {
+		return nil, err
+	}
+
+	var pr *github.PullRequest
+	switch e := event.(type) {
+	case *github.PullRequestEvent:
+		pr = e.GetPullRequest()
+	case *github.PullRequestReviewEvent:
+		pr = e.GetPullRequest()
+	}
+
+	if pr == nil || pr.Number == nil || !s.matches(pr) {
+		return nil, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), receiveTimeout)
+	defer cancel()
+
+	owner, repo := s.ownerRepo()
+	if latest, _, err := s.client.PullRequests.Get(ctx, owner, repo, pr.GetNumber()); err == nil {
+		pr = latest
+	}
+
+	pull, err := s.newPullRequest(ctx, pr.GetNumber(), pr.GetUpdatedAt(), pr)
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	return []types.Activity{pull}, nil
+}
+
+// listPullRequests lists the pull requests that pass the filters of the source, by last update.
+// Filters the pull requests API doesn't support are applied with the search API instead,
+// so that a page isn't mostly filtered out.
+func (s *SourcePullRequests) listPullRequests(ctx context.Context, opts github.ListOptions) ([]*PullRequest, *github.Response, error) {
+	if len(s.Labels) > 0 || s.Author != "" || s.Drafts != "include" {
+		return s.searchPullRequests(ctx, opts)
+	}
+
+	owner, repo := s.ownerRepo()
+
+	prs, res, err := s.client.PullRequests.List(ctx, owner, repo, &github.PullRequestListOptions{
+		State:       s.State,
+		Base:        s.Base,
+		Sort:        "updated",
+		Direction:   "desc",
+		ListOptions: opts,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	out := make([]*PullRequest, 0, len(prs))
+	for _, pr := range prs {
+		if !s.matches(pr) {
+			continue
+		}
+
+		pull, err := s.newPullRequest(ctx, pr.GetNumber(), pr.GetUpdatedAt(), pr)
+		if err != nil {
+			return nil, nil, err
+		}
+		out = append(out, pull)
+	}
+
+	return out, res, nil
+}
+
+func (s *SourcePullRequests) searchPullRequests(ctx context.Context, opts github.ListOptions) ([]*PullRequest, *github.Response, error) {
+	result, res, err := s.client.Search.Issues(ctx, s.searchQuery(), &github.SearchOptions{
+		Sort:        "updated",
+		Order:       "desc",
+		ListOptions: opts,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	out := make([]*PullRequest, 0, len(result.Issues))
+	for _, issue := range result.Issues {
+		pull, err := s.newPullRequest(ctx, issue.GetNumber(), issue.GetUpdatedAt(), nil)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		// Search results may lag behind, so the filters are checked again on the fetched pull request.
+		if s.matches(pull.PullRequest) {
+			out = append(out, pull)
+		}
+	}
+
+	return out, res, nil
+}
+
+// searchQuery returns the search API query of the filters of the source.
+func (s *SourcePullRequests) searchQuery() string {
+	terms := []string{"is:pr", "repo:" + s.Repository}
+
+	if s.State != "all" {
+		terms = append(terms, "state:"+s.State)
+	}
+	if s.Base != "" {
+		terms = append(terms, "base:"+s.Base)
+	}
+	for _, label := range s.Labels {
+		terms = append(terms, "label:"+strconv.Quote(label))
+	}
+	if s.Author != "" {
+		terms = append(terms, "author:"+s.Author)
+	}
+	switch s.Drafts {
+	case "exclude":
+		terms = append(terms, "draft:false")
+	case "only":
+		terms = append(terms, "draft:true")
+	}
+
+	return strings.Join(terms, " ")
+}
+
+// matches reports whether the pull request passes the filters of the source.
+func (s *SourcePullRequests) matches(pr *github.PullRequest) bool {
+	switch s.State {
+	case "open", "closed":
+		if pr.GetState() != s.State {
+			return false
+		}
+	}
+
+	if s.Base != "" && pr.GetBase().GetRef() != s.Base {
+		return false
+	}
+
+	if s.Author != "" && !strings.EqualFold(pr.GetUser().GetLogin(), s.Author) {
+		return false
+	}
+
+	switch s.Drafts {
+	case "exclude":
+		if pr.GetDraft() {
+			return false
+		}
+	case "only":
+		if !pr.GetDraft() {
+			return false
+		}
+	}
+
+	for _, want := range s.Labels {
+		if !slices.ContainsFunc(pr.Labels, func(l *github.Label) bool { return strings.EqualFold(l.GetName(), want) }) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// newPullRequest fetches the pull request with the number, and its changed files and reviews.
+// Pull requests of the last poll that weren't updated since are reused. The pull request is only fetched
+// if pr is nil or lacks the totals of its changes, which pull requests listed by the API do.
+func (s *SourcePullRequests) newPullRequest(ctx context.Context, number int, updatedAt github.Timestamp, pr *github.PullRequest) (*PullRequest, error) {
+	s.polledMu.Lock()
+	cached, ok := s.polled[number]
+	s.polledMu.Unlock()
+
+	if ok && cached.PullRequest.GetUpdatedAt().Equal(updatedAt) {
+		return cached, nil
+	}
+
+	owner, repo := s.ownerRepo()
+
+	if pr == nil || pr.ChangedFiles == nil {
+		var err error
+		if pr, _, err = s.client.PullRequests.Get(ctx, owner, repo, number); err != nil {
+			return nil, fmt.Errorf("get #%d: %w", number, err)
+		}
+	}
+
+	files, _, err := s.client.PullRequests.ListFiles(ctx, owner, repo, pr.GetNumber(), &github.ListOptions{PerPage: backfillPageSize})
+	if err != nil {
+		return nil, fmt.Errorf("list files of #%d: %w", pr.GetNumber(), err)
+	}
+
+	reviews, _, err := s.client.PullRequests.ListReviews(ctx, owner, repo, pr.GetNumber(), &github.ListOptions{PerPage: backfillPageSize})
+	if err != nil {
+		return nil, fmt.Errorf("list reviews of #%d: %w", pr.GetNumber(), err)
+	}
+
+	out := &PullRequest{
+		Repository:  s.Repository,
+		PullRequest: pr,
+		SourceID:    s.UID(),
+		ReviewState: reviewState(reviews),
+	}
+
+	for _, f := range files {
+		out.Files = append(out.Files, ChangedFile{
+			Filename:  f.GetFilename(),
+			Status:    f.GetStatus(),
+			Additions: f.GetAdditions(),
+			Deletions: f.GetDeletions(),
+		})
+	}
+
+	return out, nil
+}
+
+func (s *SourcePullRequests) ownerRepo() (string, string) {
+	owner, repo, _ := strings.Cut(s.Repository, "/")
+	return owner, repo
+}
+
+// reviewState combines the latest review of each reviewer into "changes_requested", "approved", "commented",
+// or an empty string if the pull request wasn't reviewed.
+func reviewState(reviews []*github.PullRequestReview) string {
+	latest := make(map[string]string)
+	commented := false
+
+	// Reviews are listed oldest first. Comments don't replace the approval or change request of a reviewer,
+	// and dismissing a review withdraws it, so the reviewer only counts as commented if they also commented.
+	for _, review := range reviews {
+		switch state := review.GetState(); state {
+		case "APPROVED", "CHANGES_REQUESTED":
+			latest[review.GetUser().GetLogin()] = state
+		case "DISMISSED":
+			delete(latest, review.GetUser().GetLogin())
+		case "COMMENTED":
+			commented = true
+		}
+	}
+
+	states := make([]string, 0, len(latest))
+	for _, state := range latest {
+		states = append(states, state)
+	}
+
+	switch {
+	case slices.Contains(states, "CHANGES_REQUESTED"):
+		return "changes_requested"
+	case slices.Contains(states, "APPROVED"):
+		return "approved"
+	case commented:
+		return "commented"
+	default:
+		return ""
+	}
+}
+
+// ChangedFile summarizes a file changed by a pull request.
+type ChangedFile struct {
+	Filename  string `json:"filename"`
+	Status    string `json:"status"`
+	Additions int    `json:"additions"`
+	Deletions int    `json:"deletions"`
+}
+
+type PullRequest struct {
+	Repository  string              `json:"Repository"`
+	PullRequest *github.PullRequest `json:"pull_request"`
+	Files       []ChangedFile       `json:"files"`
+	ReviewState string              `json:"review_state"`
+	SourceID    string              `json:"source_id"`
+}
+
+func NewPullRequest() *PullRequest {
+	return &PullRequest{}
+}
+
+func (p *PullRequest) SourceType() string {
+	return TypeGithubPullRequests
+}
+
+func (p *PullRequest) MarshalJSON() ([]byte, error) {
+	type Alias PullRequest
+	return json.Marshal(&struct {
+		*Alias
+	}{
+		Alias: (*Alias)(p),
+	})
+}
+
+func (p *PullRequest) UnmarshalJSON(data []byte) error {
+	type Alias PullRequest
+	aux := &struct {
+		*Alias
+	}{
+		Alias: (*Alias)(p),
+	}
+	return json.Unmarshal(data, &aux)
+}
+
+func (p *PullRequest) UID() string {
+	return fmt.Sprintf("pull-%d", p.PullRequest.GetNumber())
+}
+
+func (p *PullRequest) SourceUID() string {
+	return p.SourceID
+}
+
+func (p *PullRequest) Title() string {
+	return p.PullRequest.GetTitle()
+}
+
+// Body is the description of the pull request, followed by its status and changed files,
+// so that summaries describe what actually changed.
+func (p *PullRequest) Body() string {
+	var b strings.Builder
+
+	if description := strings.TrimSpace(p.PullRequest.GetBody()); description != "" {
+		b.WriteString(description)
+		b.WriteString("\n\n")
+	}
+
+	fmt.Fprintf(&b, "Status: %s\n", p.status())
+
+	switch p.ReviewState {
+	case "approved":
+		b.WriteString("Review: approved\n")
+	case "changes_requested":
+		b.WriteString("Review: changes requested\n")
+	case "commented":
+		b.WriteString("Review: commented\n")
+	default:
+		b.WriteString("Review: not reviewed\n")
+	}
+
+	// Only the first page of files is listed, so the totals are taken from the pull request,
+	// except for activities stored before they were.
+	changed, additions, deletions := p.PullRequest.GetChangedFiles(), p.PullRequest.GetAdditions(), p.PullRequest.GetDeletions()
+	if p.PullRequest.ChangedFiles == nil {
+		changed = len(p.Files)
+		for _, f := range p.Files {
+			additions += f.Additions
+			deletions += f.Deletions
+		}
+	}
+	fmt.Fprintf(&b, "Changed files: %d (+%d -%d)\n", changed, additions, deletions)
+
+	for i, f := range p.Files {
+		if i == maxListedFiles {
+			fmt.Fprintf(&b, "- and %d more\n", changed-maxListedFiles)
+			break
+		}
+		fmt.Fprintf(&b, "- %s (%s, +%d -%d)\n", f.Filename, f.Status, f.Additions, f.Deletions)
+	}
+
+	return strings.TrimSpace(b.String())
+}
+
+func (p *PullRequest) status() string {
+	pr := p.PullRequest
+	base := pr.GetBase().GetRef()
+
+	switch {
+	case pr.GetMerged() || pr.MergedAt != nil:
+		return fmt.Sprintf("merged into %s", base)
+	case pr.GetState() == "closed":
+		return "closed without merging"
+	case pr.GetDraft():
+		return fmt.Sprintf("draft, targeting %s", base)
+	default:
+		return fmt.Sprintf("open, targeting %s", base)
+	}
+}
+
+func (p *PullRequest) URL() string {
+	return p.PullRequest.GetHTMLURL()
+}
+
+func (p *PullRequest) ImageURL() string {
+	return fmt.Sprintf(
+		"https://opengraph.githubassets.com/%d/%s/pull/%d",
+		p.PullRequest.GetUpdatedAt().Unix(),
+		p.Repository,
+		p.PullRequest.GetNumber(),
+	)
+}
+
+// CreatedAt is the creation time of the pull request, which retention and expiry are based on.
+// Updated pull requests are still processed again, since updates change their content hash.
+func (p *PullRequest) CreatedAt() time.Time {
+	return p.PullRequest.GetCreatedAt().Time
+}
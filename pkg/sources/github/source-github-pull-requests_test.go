@@ -0,0 +1,164 @@
+package github
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v72/github"
+)
+
+func TestReviewState(t *testing.T) {
+	review := func(user, state string) *github.PullRequestReview {
+		return &github.PullRequestReview{User: &github.User{Login: github.Ptr(user)}, State: github.Ptr(state)}
+	}
+
+	tests := []struct {
+		name    string
+		reviews []*github.PullRequestReview
+		want    string
+	}{
+		{name: "no reviews", want: ""},
+		{name: "commented", reviews: []*github.PullRequestReview{review("a", "COMMENTED")}, want: "commented"},
+		{name: "approved", reviews: []*github.PullRequestReview{review("a", "APPROVED")}, want: "approved"},
+		{name: "changes requested", reviews: []*github.PullRequestReview{review("a", "CHANGES_REQUESTED")}, want: "changes_requested"},
+		{
+			name:    "comment after approval",
+			reviews: []*github.PullRequestReview{review("a", "APPROVED"), review("a", "COMMENTED")},
+			want:    "approved",
+		},
+		{
+			name:    "approval after change request",
+			reviews: []*github.PullRequestReview{review("a", "CHANGES_REQUESTED"), review("a", "APPROVED")},
+			want:    "approved",
+		},
+		{
+			name:    "change request of another reviewer",
+			reviews: []*github.PullRequestReview{review("a", "APPROVED"), review("b", "CHANGES_REQUESTED")},
+			want:    "changes_requested",
+		},
+		{
+			name:    "dismissed",
+			reviews: []*github.PullRequestReview{review("a", "CHANGES_REQUESTED"), review("a", "DISMISSED")},
+			want:    "",
+		},
+		{
+			name:    "dismissed after comment",
+			reviews: []*github.PullRequestReview{review("a", "COMMENTED"), review("a", "CHANGES_REQUESTED"), review("a", "DISMISSED")},
+			want:    "commented",
+		},
+		{
+			name:    "dismissed review of another reviewer",
+			reviews: []*github.PullRequestReview{review("a", "APPROVED"), review("b", "CHANGES_REQUESTED"), review("b", "DISMISSED")},
+			want:    "approved",
+		},
+		{name: "pending", reviews: []*github.PullRequestReview{review("a", "PENDING")}, want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := reviewState(tt.reviews); got != tt.want {
+				t.Errorf("reviewState() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSearchQuery(t *testing.T) {
+	tests := []struct {
+		name   string
+		source *SourcePullRequests
+		want   string
+	}{
+		{
+			name:   "state",
+			source: &SourcePullRequests{Repository: "golang/go", State: "open", Drafts: "include"},
+			want:   "is:pr repo:golang/go state:open",
+		},
+		{
+			name:   "all states",
+			source: &SourcePullRequests{Repository: "golang/go", State: "all", Drafts: "include"},
+			want:   "is:pr repo:golang/go",
+		},
+		{
+			name: "filters",
+			source: &SourcePullRequests{
+				Repository: "golang/go",
+				State:      "closed",
+				Base:       "master",
+				Labels:     []string{"bug", "needs review"},
+				Author:     "gopher",
+				Drafts:     "exclude",
+			},
+			want: `is:pr repo:golang/go state:closed base:master label:"bug" label:"needs review" author:gopher draft:false`,
+		},
+		{
+			name:   "only drafts",
+			source: &SourcePullRequests{Repository: "golang/go", State: "open", Drafts: "only"},
+			want:   "is:pr repo:golang/go state:open draft:true",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.source.searchQuery(); got != tt.want {
+				t.Errorf("searchQuery() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPullRequestBodyTotals(t *testing.T) {
+	files := []ChangedFile{
+		{Filename: "a.go", Status: "modified", Additions: 3, Deletions: 1},
+		{Filename: "b.go", Status: "added", Additions: 10},
+	}
+
+	tests := []struct {
+		name  string
+		pr    *github.PullRequest
+		files []ChangedFile
+		want  string
+	}{
+		{
+			name:  "totals of the pull request",
+			pr:    &github.PullRequest{ChangedFiles: github.Ptr(120), Additions: github.Ptr(500), Deletions: github.Ptr(40)},
+			files: files,
+			want:  "Changed files: 120 (+500 -40)",
+		},
+		{
+			name:  "totals of the listed files",
+			pr:    &github.PullRequest{},
+			files: files,
+			want:  "Changed files: 2 (+13 -1)",
+		},
+		{
+			name: "no files",
+			pr:   &github.PullRequest{ChangedFiles: github.Ptr(0), Additions: github.Ptr(0), Deletions: github.Ptr(0)},
+			want: "Changed files: 0 (+0 -0)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &PullRequest{PullRequest: tt.pr, Files: tt.files}
+			if body := p.Body(); !slices.Contains(strings.Split(body, "\n"), tt.want) {
+				t.Errorf("Body() = %q, want it to contain %q", body, tt.want)
+			}
+		})
+	}
+}
+
+func TestPullRequestCreatedAt(t *testing.T) {
+	created := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	p := &PullRequest{PullRequest: &github.PullRequest{
+		CreatedAt: &github.Timestamp{Time: created},
+		UpdatedAt: &github.Timestamp{Time: created.Add(48 * time.Hour)},
+	}}
+
+	// Retention and expiry are based on the creation time, not on the last update.
+	if got := p.CreatedAt(); !got.Equal(created) {
+		t.Errorf("CreatedAt() = %s, want %s", got, created)
+	}
+}
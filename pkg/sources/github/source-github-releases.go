@@ -4,22 +4,42 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/url"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/glanceapp/glance/pkg/sources"
 	"github.com/glanceapp/glance/pkg/sources/activities/types"
+	"github.com/glanceapp/glance/pkg/utils"
 
 	"github.com/google/go-github/v72/github"
 )
 
 const TypeGithubReleases = "github-releases"
 
+func init() {
+	sources.RegisterSourceType(sources.SourceType{
+		Type:         TypeGithubReleases,
+		Description:  "Releases of a GitHub repository.",
+		NewSource:    func() sources.Source { return NewReleaseSource() },
+		NewActivity:  func() types.Activity { return NewRelease() },
+		PollInterval: 6 * time.Hour,
+		Examples:     []map[string]any{{"Repository": "golang/go"}},
+		FromFeedURL:  releaseSourceFromFeedURL,
+	})
+}
+
 type SourceRelease struct {
-	Repository       string `json:"Repository"`
-	Token            string `json:"token"`
+	types.SourceBase
+	Repository       string `json:"Repository" jsonschema:"required,pattern=^[^/]+/[^/]+$" jsonschema_description:"Repository like owner/name."`
+	Token            string `json:"token" jsonschema:"secret" jsonschema_description:"GitHub token, defaults to the GITHUB_TOKEN environment variable."`
 	IncludePreleases bool   `json:"include_prereleases"`
-	client           *github.Client
+	// WebhookSecret verifies webhook deliveries of release events.
+	WebhookSecret string `json:"webhook_secret" jsonschema:"secret" jsonschema_description:"Secret of the repository webhook, defaults to the GITHUB_WEBHOOK_SECRET environment variable."`
+	client        *github.Client
 }
 
 func NewReleaseSource() *SourceRelease {
@@ -44,11 +64,30 @@ func (s *SourceRelease) Type() string {
 	return TypeGithubReleases
 }
 
+// SubscriptionURL returns the Atom feed of the releases.
+func (s *SourceRelease) SubscriptionURL() string {
+	return fmt.Sprintf("https://github.com/%s/releases.atom", s.Repository)
+}
+
+// releaseSourceFromFeedURL maps https://github.com/{owner}/{repo}/releases.atom feeds.
+func releaseSourceFromFeedURL(u *url.URL, base types.SourceBase) sources.Source {
+	host := strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+	path := strings.Split(strings.Trim(u.Path, "/"), "/")
+	if host != "github.com" || len(path) != 3 || path[2] != "releases.atom" {
+		return nil
+	}
+
+	out := NewReleaseSource()
+	out.SourceBase = base
+	out.Repository = path[0] + "/" + path[1]
+	return out
+}
+
 func (s *SourceRelease) Stream(ctx context.Context, feed chan<- types.Activity, errs chan<- error) {
 	release, err := s.fetchLatestGithubRelease(ctx)
 
 	if err != nil {
-		errs <- err
+		errs <- wrapError(err)
 		return
 	}
 
@@ -63,9 +102,9 @@ func (s *SourceRelease) Initialize() error {
 	}
 
 	if token != "" {
-		s.client = github.NewClient(nil).WithAuthToken(token)
+		s.client = github.NewClient(utils.NewHTTPClient(0)).WithAuthToken(token)
 	} else {
-		s.client = github.NewClient(nil)
+		s.client = github.NewClient(utils.NewHTTPClient(0))
 	}
 
 	return nil
@@ -162,6 +201,76 @@ func (r *Release) CreatedAt() time.Time {
 	return r.Release.GetPublishedAt().Time
 }
 
+// Receive maps webhook deliveries of release events to releases.
+// Drafts and deleted releases are skipped, and so are prereleases unless they are included.
+func (s *SourceRelease) Receive(header http.Header, body []byte) ([]types.Activity, error) {
+	event, err := parseWebhook(webhookSecret(s.WebhookSecret), s.Repository, header, body)
+	if err != nil {
+		return nil, err
+	}
+
+	e, ok := event.(*github.ReleaseEvent)
+	if !ok {
+		return nil, nil
+	}
+
+	release := e.GetRelease()
+	switch {
+	case e.GetAction() == "deleted" || e.GetAction() == "unpublished":
+		return nil, nil
+	case release == nil || release.TagName == nil || release.GetDraft():
+		return nil, nil
+	case release.GetPrerelease() && !s.IncludePreleases:
+		return nil, nil
+	}
+
+	return []types.Activity{&Release{
+		Release:    release,
+		Repository: s.Repository,
+		SourceID:   s.UID(),
+	}}, nil
+}
+
+// BackfillPage lists releases newest first, following the page numbers of the GitHub API.
+// Drafts are skipped, and so are prereleases unless they are included.
+func (s *SourceRelease) BackfillPage(ctx context.Context, cursor string) ([]types.Activity, string, error) {
+	page := 1
+	if cursor != "" {
+		var err error
+		if page, err = strconv.Atoi(cursor); err != nil {
+			return nil, "", fmt.Errorf("invalid page cursor: %s", cursor)
+		}
+	}
+
+	parts := strings.Split(s.Repository, "/")
+	if len(parts) != 2 {
+		return nil, "", fmt.Errorf("invalid Repository format: %s", s.Repository)
+	}
+	owner, repo := parts[0], parts[1]
+
+	releases, res, err := s.client.Repositories.ListReleases(ctx, owner, repo, &github.ListOptions{
+		Page:    page,
+		PerPage: backfillPageSize,
+	})
+	if err != nil {
+		return nil, "", wrapError(err)
+	}
+
+	out := make([]types.Activity, 0, len(releases))
+	for _, release := range releases {
+		if release.GetDraft() || release.GetPrerelease() && !s.IncludePreleases {
+			continue
+		}
+		out = append(out, &Release{
+			Release:    release,
+			Repository: s.Repository,
+			SourceID:   s.UID(),
+		})
+	}
+
+	return out, nextPage(res), nil
+}
+
 func (s *SourceRelease) fetchLatestGithubRelease(ctx context.Context) (*Release, error) {
 	parts := strings.Split(s.Repository, "/")
 	if len(parts) != 2 {
@@ -0,0 +1,120 @@
+package github
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/glanceapp/glance/pkg/sources"
+	"github.com/glanceapp/glance/pkg/utils"
+
+	"github.com/google/go-github/v72/github"
+)
+
+// webhookSecret returns the secret GitHub webhooks of the source are signed with,
+// which defaults to the GITHUB_WEBHOOK_SECRET environment variable.
+func webhookSecret(secret string) string {
+	if secret != "" {
+		return secret
+	}
+	return os.Getenv("GITHUB_WEBHOOK_SECRET")
+}
+
+// WebhookSourceUIDs returns the UIDs of the sources that receive a GitHub webhook delivery,
+// or none for events that aren't mapped to activities.
+func WebhookSourceUIDs(header http.Header, payload []byte) ([]string, error) {
+	var sourceTypes []string
+	switch header.Get(github.EventTypeHeader) {
+	case "issues", "issue_comment", "discussion":
+		sourceTypes = []string{TypeGithubIssues}
+	case "pull_request":
+		sourceTypes = []string{TypeGithubIssues, TypeGithubPullRequests}
+	case "pull_request_review":
+		sourceTypes = []string{TypeGithubPullRequests}
+	case "release":
+		sourceTypes = []string{TypeGithubReleases}
+	default:
+		return nil, nil
+	}
+
+	var body struct {
+		Repository struct {
+			FullName string `json:"full_name"`
+		} `json:"repository"`
+	}
+	if err := json.Unmarshal(payload, &body); err != nil {
+		return nil, fmt.Errorf("%w: %w", sources.ErrInvalidPayload, err)
+	}
+
+	if body.Repository.FullName == "" {
+		return nil, fmt.Errorf("%w: repository is missing", sources.ErrInvalidPayload)
+	}
+
+	uids := make([]string, len(sourceTypes))
+	for i, sourceType := range sourceTypes {
+		uids[i] = fmt.Sprintf("%s/%s", sourceType, body.Repository.FullName)
+	}
+
+	return uids, nil
+}
+
+// parseWebhook verifies the signature of a GitHub webhook delivery and parses its event.
+func parseWebhook(secret, repository string, header http.Header, body []byte) (any, error) {
+	if secret == "" {
+		return nil, fmt.Errorf("%w: no GitHub webhook secret is configured", sources.ErrPushUnsupported)
+	}
+
+	if !utils.VerifyHMAC([]byte(secret), body, header.Get(github.SHA256SignatureHeader)) {
+		return nil, fmt.Errorf("%w: %s header doesn't match the payload", sources.ErrInvalidSignature, github.SHA256SignatureHeader)
+	}
+
+	event, err := github.ParseWebHook(header.Get(github.EventTypeHeader), body)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", sources.ErrInvalidPayload, err)
+	}
+
+	// Every supported event has a repository.
+	if e, ok := event.(interface{ GetRepo() *github.Repository }); ok {
+		if !strings.EqualFold(e.GetRepo().GetFullName(), repository) {
+			return nil, fmt.Errorf("%w: event of repository %s", sources.ErrInvalidPayload, e.GetRepo().GetFullName())
+		}
+	}
+
+	return event, nil
+}
+
+// pullRequestIssue returns the pull request as an issue, like the issues API lists them.
+func pullRequestIssue(pr *github.PullRequest) *github.Issue {
+	return &github.Issue{
+		Number:    pr.Number,
+		State:     pr.State,
+		Title:     pr.Title,
+		Body:      pr.Body,
+		User:      pr.User,
+		Labels:    pr.Labels,
+		HTMLURL:   pr.HTMLURL,
+		CreatedAt: pr.CreatedAt,
+		UpdatedAt: pr.UpdatedAt,
+		ClosedAt:  pr.ClosedAt,
+		PullRequestLinks: &github.PullRequestLinks{
+			URL:     pr.URL,
+			HTMLURL: pr.HTMLURL,
+		},
+	}
+}
+
+// discussionIssue returns the discussion as an issue, since discussions share the numbers of issues.
+func discussionIssue(d *github.Discussion) *github.Issue {
+	return &github.Issue{
+		Number:    d.Number,
+		State:     d.State,
+		Title:     d.Title,
+		Body:      d.Body,
+		User:      d.User,
+		HTMLURL:   d.HTMLURL,
+		CreatedAt: d.CreatedAt,
+		UpdatedAt: d.UpdatedAt,
+	}
+}
@@ -7,7 +7,9 @@ import (
 	"log/slog"
 	"time"
 
+	"github.com/glanceapp/glance/pkg/sources"
 	"github.com/glanceapp/glance/pkg/sources/activities/types"
+	"github.com/glanceapp/glance/pkg/utils"
 
 	"github.com/alexferrari88/gohn/pkg/gohn"
 	"github.com/go-shiori/go-readability"
@@ -15,8 +17,20 @@ import (
 
 const TypeHackerNewsPosts = "hackernews-posts"
 
+func init() {
+	sources.RegisterSourceType(sources.SourceType{
+		Type:         TypeHackerNewsPosts,
+		Description:  "Posts on a Hacker News front page.",
+		NewSource:    func() sources.Source { return NewSourcePosts() },
+		NewActivity:  func() types.Activity { return NewPost() },
+		PollInterval: 15 * time.Minute,
+		Examples:     []map[string]any{{"feed_name": "top"}},
+	})
+}
+
 type SourcePosts struct {
-	FeedName string `json:"feed_name"`
+	types.SourceBase
+	FeedName string `json:"feed_name" jsonschema:"required,enum=top,enum=new,enum=best"`
 	client   *gohn.Client
 }
 
@@ -40,6 +54,14 @@ func (s *SourcePosts) Type() string {
 	return TypeHackerNewsPosts
 }
 
+// SubscriptionURL returns the feed of the front page, the only feed HackerNews publishes.
+func (s *SourcePosts) SubscriptionURL() string {
+	if s.FeedName == "top" {
+		return "https://news.ycombinator.com/rss"
+	}
+	return ""
+}
+
 type Post struct {
 	Post     *gohn.Item `json:"post"`
 	SourceID string     `json:"source_id"`
@@ -97,6 +119,11 @@ func (p *Post) Body() string {
 	return body
 }
 
+// ContentHash avoids fetching the referenced article just to detect changes.
+func (p *Post) ContentHash() string {
+	return types.HashContent(p.Title(), p.URL())
+}
+
 func (p *Post) URL() string {
 	if p.Post.URL != nil {
 		return *p.Post.URL
@@ -118,7 +145,7 @@ func (s *SourcePosts) Initialize() error {
 	}
 
 	var err error
-	s.client, err = gohn.NewClient(nil)
+	s.client, err = gohn.NewClient(utils.NewHTTPClient(0))
 	if err != nil {
 		return fmt.Errorf("init client: %v", err)
 	}
@@ -130,7 +157,7 @@ func (s *SourcePosts) Stream(ctx context.Context, feed chan<- types.Activity, er
 	posts, err := s.fetchHackerNewsPosts(ctx)
 
 	if err != nil {
-		errs <- fmt.Errorf("fetch posts: %v", err)
+		errs <- fmt.Errorf("fetch posts: %w", err)
 		return
 	}
 
@@ -0,0 +1,169 @@
+package sources
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/glanceapp/glance/pkg/sources/activities/types"
+)
+
+// maxIngestWait bounds the wait for queue capacity while ingesting pushed activities,
+// since the sender waits for the response and usually gives up after a few seconds.
+const maxIngestWait = 10 * time.Second
+
+var (
+	ErrPushUnsupported  = errors.New("source type doesn't accept pushed activities")
+	ErrInvalidSignature = errors.New("invalid signature")
+	ErrInvalidPayload   = errors.New("invalid payload")
+	ErrSourcePaused     = errors.New("source is paused")
+	ErrQueueFull        = errors.New("queue is full")
+)
+
+// Receiver is implemented by sources that accept activities pushed to them, instead of or in addition to polling.
+type Receiver interface {
+	// Receive verifies a pushed payload and converts it into activities.
+	// It returns ErrInvalidSignature for payloads that aren't signed with the secret of the source,
+	// and ErrInvalidPayload for payloads that can't be converted.
+	Receive(header http.Header, body []byte) ([]types.Activity, error)
+}
+
+// TokenReceiver is implemented by receivers that are pushed to at POST /ingest/{ingest_token}, instead of a URL derived from their UID.
+// The token is random and generated when the source is created, so that the URL can't be guessed.
+type TokenReceiver interface {
+	Receiver
+	IngestToken() string
+	SetIngestToken(token string)
+}
+
+// ensureIngestToken sets the ingest token of a TokenReceiver without one,
+// to the token of the previous config of the source if there is one, and to a new random token otherwise.
+// It returns true if the token was set.
+func ensureIngestToken(source, previous Source) bool {
+	receiver, ok := source.(TokenReceiver)
+	if !ok || receiver.IngestToken() != "" {
+		return false
+	}
+
+	if previous, ok := previous.(TokenReceiver); ok && previous.IngestToken() != "" {
+		receiver.SetIngestToken(previous.IngestToken())
+		return true
+	}
+
+	b := make([]byte, 32)
+	_, _ = rand.Read(b)
+	receiver.SetIngestToken(hex.EncodeToString(b))
+	return true
+}
+
+// Polled reports whether the source should be polled.
+// Sources that only receive pushed activities opt out with a Polled method.
+func Polled(source Source) bool {
+	if s, ok := source.(interface{ Polled() bool }); ok {
+		return s.Polled()
+	}
+	return true
+}
+
+// Ingest forwards activities pushed to the source into the ingestion pipeline,
+// and returns the number of forwarded activities.
+// The UID is matched case-insensitively, since senders like GitHub name repositories in their canonical case,
+// which may differ from the case in the source config. Activities are forwarded to every matching source.
+func (r *Registry) Ingest(uid string, header http.Header, body []byte) (int, error) {
+	matching, err := r.sourceRepo.GetByIDFold(uid)
+	if err != nil {
+		return 0, fmt.Errorf("get source: %w", err)
+	}
+
+	if len(matching) == 0 {
+		return 0, fmt.Errorf("source '%s': %w", uid, ErrSourceNotFound)
+	}
+
+	total := 0
+	for _, source := range matching {
+		n, err := r.ingest(source, header, body)
+		total += n
+		if err != nil {
+			return total, err
+		}
+	}
+
+	return total, nil
+}
+
+// IngestByToken forwards activities pushed to the TokenReceiver with the ingest token, like Ingest.
+func (r *Registry) IngestByToken(token string, header http.Header, body []byte) (int, error) {
+	source, err := r.sourceRepo.GetByIngestToken(token)
+	if err != nil {
+		return 0, fmt.Errorf("get source: %w", err)
+	}
+
+	// Doesn't include the token in the error, since it's as secret as the source.
+	if source == nil {
+		return 0, fmt.Errorf("ingest token: %w", ErrSourceNotFound)
+	}
+
+	return r.ingest(source, header, body)
+}
+
+func (r *Registry) ingest(source Source, header http.Header, body []byte) (int, error) {
+	uid := source.UID()
+
+	receiver, ok := source.(Receiver)
+	if !ok {
+		return 0, fmt.Errorf("source '%s': %w", uid, ErrPushUnsupported)
+	}
+
+	if err := source.Initialize(); err != nil {
+		return 0, fmt.Errorf("%w: initialize source: %w", ErrInvalidSource, err)
+	}
+
+	activities, err := receiver.Receive(header, body)
+	if err != nil {
+		return 0, err
+	}
+
+	// Check after verifying the payload, so that unsigned requests can't tell whether a source is paused.
+	paused, err := r.sourceRepo.Paused()
+	if err != nil {
+		return 0, fmt.Errorf("list paused sources: %w", err)
+	}
+	if paused[uid] {
+		return 0, fmt.Errorf("source '%s': %w", uid, ErrSourcePaused)
+	}
+
+	return r.push(uid, activities)
+}
+
+// push forwards pushed activities of the source into the ingestion pipeline, and records the push as a run.
+func (r *Registry) push(uid string, activities []types.Activity) (int, error) {
+	run := &types.SourceRun{
+		SourceUID: uid,
+		StartedAt: time.Now(),
+	}
+	defer func() {
+		run.FinishedAt = time.Now()
+		r.recordRun(run)
+	}()
+
+	timeout := time.NewTimer(min(r.config.EnqueueTimeout, maxIngestWait))
+	defer timeout.Stop()
+
+	for _, act := range activities {
+		select {
+		case r.activityQueue <- act:
+			run.Items++
+		case <-timeout.C:
+			err := fmt.Errorf("source '%s': %w, dropped %d activities", uid, ErrQueueFull, len(activities)-run.Items)
+			run.Error = err.Error()
+			return run.Items, err
+		case <-r.done:
+			return run.Items, fmt.Errorf("registry is stopped")
+		}
+	}
+
+	return run.Items, nil
+}
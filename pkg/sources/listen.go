@@ -0,0 +1,148 @@
+package sources
+
+import (
+	"context"
+	"time"
+
+	"github.com/glanceapp/glance/pkg/sources/activities/types"
+)
+
+// listenStable is how long a connection must stay up for reconnects to start over from the base delay.
+const listenStable = time.Minute
+
+// Listener is implemented by sources that can keep a long-lived connection to receive updates as they happen.
+// Polling continues as a fallback, and only slows down once the connection delivers updates.
+type Listener interface {
+	Source
+	// Listening reports whether the source is configured to keep a connection.
+	Listening() bool
+	// Listen forwards updates until the connection fails or the context is canceled.
+	// It's called again with a backoff delay after it returns.
+	Listen(ctx context.Context, updates chan<- Update) error
+}
+
+// Update is an update received from a Listener.
+type Update struct {
+	// Activity is a new or edited activity.
+	Activity types.Activity
+	// Deleted is the UID of a deleted activity, which is removed from storage.
+	Deleted string
+}
+
+// listenAfter connects to the source in the background after the delay, if it's configured to listen,
+// and keeps reconnecting until stopListening is called. It replaces the previous connection of the source.
+func (r *Registry) listenAfter(source Listener, delay time.Duration) {
+	uid := source.UID()
+	r.stopListening(uid)
+
+	if !source.Listening() {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	r.listenersMu.Lock()
+	r.listeners[uid] = cancel
+	r.listenersMu.Unlock()
+
+	go func() {
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return
+		case <-r.done:
+			return
+		}
+
+		r.listen(ctx, source)
+	}()
+}
+
+// stopListening closes the connection of the source, if any.
+func (r *Registry) stopListening(uid string) {
+	r.listenersMu.Lock()
+	defer r.listenersMu.Unlock()
+
+	if cancel, ok := r.listeners[uid]; ok {
+		cancel()
+		delete(r.listeners, uid)
+	}
+}
+
+func (r *Registry) listen(ctx context.Context, source Listener) {
+	uid := source.UID()
+	policy := r.config.RetryPolicy()
+
+	for attempt := 1; ; attempt++ {
+		connectedAt := time.Now()
+		err := r.listenOnce(ctx, source)
+
+		// Updates missed while disconnected are polled, until the next connection delivers updates.
+		r.scheduler.SetPushed(uid, false)
+
+		if ctx.Err() != nil {
+			return
+		}
+
+		if time.Since(connectedAt) >= listenStable {
+			attempt = 1
+		}
+
+		delay, _ := policy.Backoff(attempt, err)
+		r.logger.Warn().Err(err).Str("source", uid).Dur("retry_in", delay).Msg("Source connection closed, reconnecting")
+
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return
+		case <-r.done:
+			return
+		}
+	}
+}
+
+// listenOnce runs a single connection of the source, and forwards its updates to the ingestion pipeline.
+func (r *Registry) listenOnce(ctx context.Context, source Listener) error {
+	uid := source.UID()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	updates := make(chan Update)
+	done := make(chan error, 1)
+	go func() {
+		done <- source.Listen(ctx, updates)
+	}()
+
+	pushed := false
+	for {
+		select {
+		case err := <-done:
+			return err
+		case <-ctx.Done():
+			return nil
+		case <-r.done:
+			return nil
+		case u := <-updates:
+			if !pushed {
+				pushed = true
+				r.scheduler.SetPushed(uid, true)
+			}
+
+			if u.Deleted != "" {
+				if err := r.activityRepo.Remove(types.ActivityID(uid, u.Deleted)); err != nil {
+					r.logger.Error().Err(err).Str("source", uid).Str("activity", u.Deleted).Msg("Failed to remove deleted activity")
+				}
+				continue
+			}
+
+			select {
+			case r.activityQueue <- u.Activity:
+			case <-ctx.Done():
+				return nil
+			case <-r.done:
+				return nil
+			}
+		}
+	}
+}
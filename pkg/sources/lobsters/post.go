@@ -5,6 +5,7 @@ import (
 	"log/slog"
 	"time"
 
+	"github.com/glanceapp/glance/pkg/sources/activities/types"
 	"github.com/go-shiori/go-readability"
 )
 
@@ -66,6 +67,11 @@ func (p *Post) Body() string {
 	return body
 }
 
+// ContentHash avoids fetching the referenced article just to detect changes.
+func (p *Post) ContentHash() string {
+	return types.HashContent(p.Title(), p.Post.URL)
+}
+
 func (p *Post) URL() string {
 	return p.Post.URL
 }
@@ -4,16 +4,33 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/url"
+	"strings"
+	"time"
 
+	"github.com/glanceapp/glance/pkg/sources"
 	"github.com/glanceapp/glance/pkg/sources/activities/types"
 )
 
 const TypeLobstersFeed = "lobsters-feed"
 
+func init() {
+	sources.RegisterSourceType(sources.SourceType{
+		Type:         TypeLobstersFeed,
+		Description:  "Stories on the front page or the newest stories of a Lobsters instance.",
+		NewSource:    func() sources.Source { return NewSourceFeed() },
+		NewActivity:  func() types.Activity { return NewPost() },
+		PollInterval: 30 * time.Minute,
+		Examples:     []map[string]any{{"feed": "hottest"}},
+		FromFeedURL:  feedSourceFromFeedURL,
+	})
+}
+
 type SourceFeed struct {
+	types.SourceBase
 	InstanceURL string `json:"instance_url"`
 	CustomURL   string `json:"custom_url"`
-	FeedName    string `json:"feed"`
+	FeedName    string `json:"feed" jsonschema:"required,enum=hottest,enum=newest"`
 	client      *LobstersClient
 }
 
@@ -39,6 +56,33 @@ func (s *SourceFeed) Type() string {
 	return TypeLobstersFeed
 }
 
+func (s *SourceFeed) SubscriptionURL() string {
+	if s.FeedName == "newest" {
+		return fmt.Sprintf("%s/newest.rss", strings.TrimRight(s.InstanceURL, "/"))
+	}
+	return fmt.Sprintf("%s/rss", strings.TrimRight(s.InstanceURL, "/"))
+}
+
+// feedSourceFromFeedURL maps https://lobste.rs/rss and https://lobste.rs/newest.rss feeds.
+func feedSourceFromFeedURL(u *url.URL, base types.SourceBase) sources.Source {
+	path := strings.Trim(u.Path, "/")
+	if !isLobsters(u) || path != "rss" && path != "hottest.rss" && path != "newest.rss" {
+		return nil
+	}
+
+	out := NewSourceFeed()
+	out.SourceBase = base
+	out.FeedName = "hottest"
+	if path == "newest.rss" {
+		out.FeedName = "newest"
+	}
+	return out
+}
+
+func isLobsters(u *url.URL) bool {
+	return strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.") == "lobste.rs"
+}
+
 func (s *SourceFeed) Initialize() error {
 	if s.FeedName != "hottest" && s.FeedName != "newest" {
 		return fmt.Errorf("feed name must be one of: 'hottest', 'newest'")
@@ -4,16 +4,33 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/url"
+	"strings"
+	"time"
 
+	"github.com/glanceapp/glance/pkg/sources"
 	"github.com/glanceapp/glance/pkg/sources/activities/types"
 )
 
 const TypeLobstersTag = "lobsters-tag"
 
+func init() {
+	sources.RegisterSourceType(sources.SourceType{
+		Type:         TypeLobstersTag,
+		Description:  "Stories with a tag on a Lobsters instance.",
+		NewSource:    func() sources.Source { return NewSourceTag() },
+		NewActivity:  func() types.Activity { return NewPost() },
+		PollInterval: 30 * time.Minute,
+		Examples:     []map[string]any{{"tag": "go"}},
+		FromFeedURL:  tagSourceFromFeedURL,
+	})
+}
+
 type SourceTag struct {
+	types.SourceBase
 	InstanceURL string `json:"instance_url"`
 	CustomURL   string `json:"custom_url"`
-	Tag         string `json:"tag"`
+	Tag         string `json:"tag" jsonschema:"required"`
 	client      *LobstersClient
 }
 
@@ -39,6 +56,23 @@ func (s *SourceTag) Type() string {
 	return TypeLobstersTag
 }
 
+func (s *SourceTag) SubscriptionURL() string {
+	return fmt.Sprintf("%s/t/%s.rss", strings.TrimRight(s.InstanceURL, "/"), s.Tag)
+}
+
+// tagSourceFromFeedURL maps https://lobste.rs/t/{tag}.rss feeds.
+func tagSourceFromFeedURL(u *url.URL, base types.SourceBase) sources.Source {
+	path := strings.Split(strings.Trim(u.Path, "/"), "/")
+	if !isLobsters(u) || len(path) != 2 || path[0] != "t" || !strings.HasSuffix(path[1], ".rss") {
+		return nil
+	}
+
+	out := NewSourceTag()
+	out.SourceBase = base
+	out.Tag = strings.TrimSuffix(path[1], ".rss")
+	return out
+}
+
 func (s *SourceTag) Stream(ctx context.Context, feed chan<- types.Activity, errs chan<- error) {
 	var stories []*Story
 	var err error
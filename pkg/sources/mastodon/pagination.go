@@ -0,0 +1,28 @@
+package mastodon
+
+import (
+	"github.com/glanceapp/glance/pkg/sources/activities/types"
+
+	"github.com/mattn/go-mastodon"
+)
+
+// backfillPageSize is the maximum page size of Mastodon timelines.
+const backfillPageSize = 40
+
+// nextMaxID returns the cursor of the page after a request with the given pagination,
+// or an empty cursor on the last page.
+func nextMaxID(pg *mastodon.Pagination, cursor string, statuses int) string {
+	// The pagination is left unchanged if the response has no Link header.
+	if statuses == 0 || string(pg.MaxID) == cursor {
+		return ""
+	}
+	return string(pg.MaxID)
+}
+
+func postActivities(statuses []*mastodon.Status, sourceType, sourceUID string) []types.Activity {
+	out := make([]types.Activity, len(statuses))
+	for i, status := range statuses {
+		out[i] = &Post{Status: status, SourceTyp: sourceType, SourceID: sourceUID}
+	}
+	return out
+}
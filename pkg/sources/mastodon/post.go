@@ -53,24 +53,24 @@ func (p *Post) SourceUID() string {
 }
 
 func (p *Post) Title() string {
-	if p.Status.Card != nil {
-		return p.Status.Card.Title
+	if card := p.content().Card; card != nil {
+		return card.Title
 	}
 
 	return oneLineTitle(p.Body(), 50)
 }
 
 func (p *Post) Body() string {
-	return extractTextFromHTML(p.Status.Content)
+	return extractTextFromHTML(p.content().Content)
 }
 
 func (p *Post) URL() string {
-	return p.Status.URL
+	return p.content().URL
 }
 
 func (p *Post) ImageURL() string {
-	if len(p.Status.MediaAttachments) > 0 {
-		return p.Status.MediaAttachments[0].URL
+	if media := p.content().MediaAttachments; len(media) > 0 {
+		return media[0].URL
 	}
 	return ""
 }
@@ -79,6 +79,14 @@ func (p *Post) CreatedAt() time.Time {
 	return p.Status.CreatedAt
 }
 
+// content returns the boosted status of boosts, which have no content of their own.
+func (p *Post) content() *mastodon.Status {
+	if p.Status.Reblog != nil {
+		return p.Status.Reblog
+	}
+	return p.Status
+}
+
 func extractTextFromHTML(htmlStr string) string {
 	doc, err := html.Parse(strings.NewReader(htmlStr))
 	if err != nil {
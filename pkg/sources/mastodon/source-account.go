@@ -4,7 +4,11 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/url"
+	"strings"
+	"time"
 
+	"github.com/glanceapp/glance/pkg/sources"
 	"github.com/glanceapp/glance/pkg/sources/activities/types"
 
 	"github.com/mattn/go-mastodon"
@@ -12,9 +16,24 @@ import (
 
 const TypeMastodonAccount = "mastodon-account"
 
+func init() {
+	sources.RegisterSourceType(sources.SourceType{
+		Type:         TypeMastodonAccount,
+		Description:  "Statuses posted by a Mastodon account.",
+		NewSource:    func() sources.Source { return NewSourceAccount() },
+		NewActivity:  func() types.Activity { return NewPost() },
+		PollInterval: 15 * time.Minute,
+		Examples:     []map[string]any{{"instance_url": "https://mastodon.social", "account": "Gargron@mastodon.social"}},
+		FromFeedURL:  accountSourceFromFeedURL,
+	})
+}
+
 type SourceAccount struct {
+	types.SourceBase
 	InstanceURL string `json:"instance_url"`
-	Account     string `json:"account"`
+	Account     string `json:"account" jsonschema:"required" jsonschema_description:"Account handle like user@instance."`
+	AccessToken string `json:"access_token,omitempty" jsonschema:"secret" jsonschema_description:"Access token of the account, or of an account following it, required for streaming."`
+	Streaming   string `json:"streaming,omitempty" jsonschema_description:"Receive statuses from the streaming API over sse or websocket, instead of only polling."`
 	client      *mastodon.Client
 }
 
@@ -40,12 +59,35 @@ func (s *SourceAccount) Type() string {
 	return TypeMastodonAccount
 }
 
+func (s *SourceAccount) SubscriptionURL() string {
+	account, _, _ := strings.Cut(strings.TrimPrefix(s.Account, "@"), "@")
+	return fmt.Sprintf("%s/@%s.rss", strings.TrimRight(s.InstanceURL, "/"), account)
+}
+
+// accountSourceFromFeedURL maps https://{instance}/@{account}.rss feeds.
+func accountSourceFromFeedURL(u *url.URL, base types.SourceBase) sources.Source {
+	path := strings.Trim(u.Path, "/")
+	if strings.Contains(path, "/") || !strings.HasPrefix(path, "@") || !strings.HasSuffix(path, ".rss") {
+		return nil
+	}
+
+	out := NewSourceAccount()
+	out.SourceBase = base
+	out.InstanceURL = fmt.Sprintf("%s://%s", u.Scheme, u.Host)
+	out.Account = fmt.Sprintf("%s@%s", strings.TrimSuffix(path[1:], ".rss"), u.Host)
+	return out
+}
+
 func (s *SourceAccount) Initialize() error {
-	s.client = mastodon.NewClient(&mastodon.Config{
-		Server:       s.InstanceURL,
-		ClientID:     "pulse-feed-aggregation",
-		ClientSecret: "pulse-feed-aggregation",
-	})
+	if err := validateStreaming(s.Streaming); err != nil {
+		return err
+	}
+	// Mastodon has no stream of an account, so the home timeline of a follower is streamed instead.
+	if s.Streaming != "" && s.AccessToken == "" {
+		return fmt.Errorf("streaming requires an access token")
+	}
+
+	s.client = newClient(s.InstanceURL, s.AccessToken)
 
 	return nil
 }
@@ -69,6 +111,22 @@ func (s *SourceAccount) Stream(ctx context.Context, feed chan<- types.Activity,
 	}
 }
 
+func (s *SourceAccount) Listening() bool {
+	return s.Streaming != ""
+}
+
+// Listen forwards statuses and boosts of the account from the "user" stream of the access token,
+// as they're posted, edited and deleted.
+func (s *SourceAccount) Listen(ctx context.Context, updates chan<- sources.Update) error {
+	account, err := s.fetchAccount(ctx)
+	if err != nil {
+		return fmt.Errorf("fetch account: %w", err)
+	}
+
+	return listen(ctx, s, newClient(s.InstanceURL, s.AccessToken), s.Streaming, "", updates,
+		func(status *mastodon.Status) bool { return status.Account.ID == account.ID })
+}
+
 func (s *SourceAccount) fetchAccount(ctx context.Context) (*mastodon.Account, error) {
 	accounts, err := s.client.Search(ctx, s.Account, false)
 	if err != nil {
@@ -82,6 +140,22 @@ func (s *SourceAccount) fetchAccount(ctx context.Context) (*mastodon.Account, er
 	return accounts.Accounts[0], nil
 }
 
+// BackfillPage lists statuses of the account newest first, following the "max_id" cursor of the Mastodon API.
+func (s *SourceAccount) BackfillPage(ctx context.Context, cursor string) ([]types.Activity, string, error) {
+	account, err := s.fetchAccount(ctx)
+	if err != nil {
+		return nil, "", fmt.Errorf("fetch account: %w", err)
+	}
+
+	pg := &mastodon.Pagination{MaxID: mastodon.ID(cursor), Limit: backfillPageSize}
+	statuses, err := s.client.GetAccountStatuses(ctx, account.ID, pg)
+	if err != nil {
+		return nil, "", fmt.Errorf("fetch account statuses: %w", err)
+	}
+
+	return postActivities(statuses, s.Type(), s.UID()), nextMaxID(pg, cursor, len(statuses)), nil
+}
+
 func (s *SourceAccount) fetchAccountPosts(ctx context.Context, accountID mastodon.ID, limit int64) ([]*Post, error) {
 	statuses, err := s.client.GetAccountStatuses(ctx, accountID, &mastodon.Pagination{
 		Limit: limit,
@@ -4,7 +4,11 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/url"
+	"strings"
+	"time"
 
+	"github.com/glanceapp/glance/pkg/sources"
 	"github.com/glanceapp/glance/pkg/sources/activities/types"
 
 	"github.com/mattn/go-mastodon"
@@ -12,9 +16,24 @@ import (
 
 const TypeMastodonTag = "mastodon-tag"
 
+func init() {
+	sources.RegisterSourceType(sources.SourceType{
+		Type:         TypeMastodonTag,
+		Description:  "Statuses with a hashtag on a Mastodon instance.",
+		NewSource:    func() sources.Source { return NewSourceTag() },
+		NewActivity:  func() types.Activity { return NewPost() },
+		PollInterval: 15 * time.Minute,
+		Examples:     []map[string]any{{"instance_url": "https://mastodon.social", "tag": "golang"}},
+		FromFeedURL:  tagSourceFromFeedURL,
+	})
+}
+
 type SourceTag struct {
+	types.SourceBase
 	InstanceURL string `json:"instance_url"`
-	Tag         string `json:"tag"`
+	Tag         string `json:"tag" jsonschema:"required" jsonschema_description:"Hashtag without the leading #."`
+	AccessToken string `json:"access_token,omitempty" jsonschema:"secret" jsonschema_description:"Access token of an account on the instance, which most instances require for streaming."`
+	Streaming   string `json:"streaming,omitempty" jsonschema_description:"Receive statuses from the streaming API over sse or websocket, instead of only polling."`
 }
 
 func NewSourceTag() *SourceTag {
@@ -39,6 +58,24 @@ func (s *SourceTag) Type() string {
 	return TypeMastodonTag
 }
 
+func (s *SourceTag) SubscriptionURL() string {
+	return fmt.Sprintf("%s/tags/%s.rss", strings.TrimRight(s.InstanceURL, "/"), s.Tag)
+}
+
+// tagSourceFromFeedURL maps https://{instance}/tags/{tag}.rss feeds.
+func tagSourceFromFeedURL(u *url.URL, base types.SourceBase) sources.Source {
+	path := strings.Split(strings.Trim(u.Path, "/"), "/")
+	if len(path) != 2 || path[0] != "tags" || !strings.HasSuffix(path[1], ".rss") {
+		return nil
+	}
+
+	out := NewSourceTag()
+	out.SourceBase = base
+	out.InstanceURL = fmt.Sprintf("%s://%s", u.Scheme, u.Host)
+	out.Tag = strings.TrimSuffix(path[1], ".rss")
+	return out
+}
+
 func (s *SourceTag) Initialize() error {
 	if s.InstanceURL == "" {
 		return fmt.Errorf("instance URL is required")
@@ -47,15 +84,11 @@ func (s *SourceTag) Initialize() error {
 		return fmt.Errorf("hashtag is required")
 	}
 
-	return nil
+	return validateStreaming(s.Streaming)
 }
 
 func (s *SourceTag) Stream(ctx context.Context, feed chan<- types.Activity, errs chan<- error) {
-	client := mastodon.NewClient(&mastodon.Config{
-		Server:       s.InstanceURL,
-		ClientID:     "pulse-feed-aggregation",
-		ClientSecret: "pulse-feed-aggregation",
-	})
+	client := newClient(s.InstanceURL, s.AccessToken)
 
 	limit := 15
 	posts, err := s.fetchHashtagPosts(client, limit)
@@ -69,6 +102,29 @@ func (s *SourceTag) Stream(ctx context.Context, feed chan<- types.Activity, errs
 	}
 }
 
+// BackfillPage lists statuses with the hashtag newest first, following the "max_id" cursor of the Mastodon API.
+func (s *SourceTag) BackfillPage(ctx context.Context, cursor string) ([]types.Activity, string, error) {
+	client := newClient(s.InstanceURL, s.AccessToken)
+
+	pg := &mastodon.Pagination{MaxID: mastodon.ID(cursor), Limit: backfillPageSize}
+	statuses, err := client.GetTimelineHashtag(ctx, s.Tag, false, pg)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get hashtag timeline: %w", err)
+	}
+
+	return postActivities(statuses, s.Type(), s.UID()), nextMaxID(pg, cursor, len(statuses)), nil
+}
+
+func (s *SourceTag) Listening() bool {
+	return s.Streaming != ""
+}
+
+// Listen forwards statuses of the "hashtag" stream of the instance as they're posted, edited and deleted.
+func (s *SourceTag) Listen(ctx context.Context, updates chan<- sources.Update) error {
+	return listen(ctx, s, newClient(s.InstanceURL, s.AccessToken), s.Streaming, s.Tag, updates,
+		func(status *mastodon.Status) bool { return true })
+}
+
 func (s *SourceTag) fetchHashtagPosts(client *mastodon.Client, limit int) ([]*Post, error) {
 	statuses, err := client.GetTimelineHashtag(context.Background(), s.Tag, false, &mastodon.Pagination{
 		Limit: int64(limit),
@@ -0,0 +1,150 @@
+package mastodon
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync/atomic"
+
+	"github.com/glanceapp/glance/pkg/sources"
+	"github.com/glanceapp/glance/pkg/utils"
+
+	"github.com/mattn/go-mastodon"
+)
+
+// Transports of the streaming API. Sources without one are only polled.
+const (
+	StreamingSSE       = "sse"
+	StreamingWebSocket = "websocket"
+)
+
+func newClient(instanceURL, accessToken string) *mastodon.Client {
+	client := mastodon.NewClient(&mastodon.Config{
+		Server:       instanceURL,
+		ClientID:     "pulse-feed-aggregation",
+		ClientSecret: "pulse-feed-aggregation",
+		AccessToken:  accessToken,
+	})
+	client.Transport = utils.RateLimits.Transport(nil)
+	return client
+}
+
+func validateStreaming(streaming string) error {
+	switch streaming {
+	case "", StreamingSSE, StreamingWebSocket:
+		return nil
+	default:
+		return fmt.Errorf("streaming must be %q or %q", StreamingSSE, StreamingWebSocket)
+	}
+}
+
+// openStream opens the "hashtag" stream of the tag, or the "user" stream of the access token.
+func openStream(ctx context.Context, client *mastodon.Client, streaming, tag string) (chan mastodon.Event, error) {
+	if streaming == StreamingWebSocket {
+		ws := client.NewWSClient()
+		if tag != "" {
+			return ws.StreamingWSHashtag(ctx, tag, false)
+		}
+		return ws.StreamingWSUser(ctx)
+	}
+
+	client.Transport = &streamOnce{next: client.Transport}
+	if tag != "" {
+		return client.StreamingHashtag(ctx, tag, false)
+	}
+	return client.StreamingUser(ctx)
+}
+
+// streamOnce fails repeated streaming requests of a client. The SSE client reconnects immediately
+// once the server closes the stream, while reconnects should back off instead.
+type streamOnce struct {
+	next   http.RoundTripper
+	opened atomic.Bool
+}
+
+func (t *streamOnce) RoundTrip(req *http.Request) (*http.Response, error) {
+	if strings.Contains(req.URL.Path, "/api/v1/streaming") && t.opened.Swap(true) {
+		return nil, errors.New("stream closed by server")
+	}
+	return t.next.RoundTrip(req)
+}
+
+// listen forwards new, edited and deleted statuses of the stream until it fails or the context is canceled.
+// New and edited statuses are forwarded as posts of the source if the filter accepts them.
+func listen(
+	ctx context.Context,
+	source sources.Source,
+	client *mastodon.Client,
+	streaming, tag string,
+	updates chan<- sources.Update,
+	filter func(status *mastodon.Status) bool,
+) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	events, err := openStream(ctx, client, streaming, tag)
+	if err != nil {
+		return fmt.Errorf("open stream: %w", err)
+	}
+	// The client keeps sending errors until it notices the canceled context and closes the stream.
+	defer func() {
+		go func() {
+			for range events {
+			}
+		}()
+	}()
+
+	toPost := func(status *mastodon.Status) *Post {
+		return &Post{Status: status, SourceTyp: source.Type(), SourceID: source.UID()}
+	}
+
+	send := func(u sources.Update) error {
+		select {
+		case updates <- u:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	for {
+		var event mastodon.Event
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case e, ok := <-events:
+			if !ok {
+				return errors.New("stream closed")
+			}
+			event = e
+		}
+
+		var err error
+		switch e := event.(type) {
+		case *mastodon.UpdateEvent:
+			if filter(e.Status) {
+				err = send(sources.Update{Activity: toPost(e.Status)})
+			}
+		case *mastodon.UpdateEditEvent:
+			if filter(e.Status) {
+				err = send(sources.Update{Activity: toPost(e.Status)})
+			}
+		case *mastodon.DeleteEvent:
+			err = send(sources.Update{Deleted: string(e.ID)})
+		case *mastodon.ErrorEvent:
+			// Statuses that fail to decode are skipped, rather than dropping the connection.
+			var syntaxErr *json.SyntaxError
+			var typeErr *json.UnmarshalTypeError
+			if errors.As(e.Err, &syntaxErr) || errors.As(e.Err, &typeErr) {
+				continue
+			}
+			return fmt.Errorf("stream: %w", e.Err)
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
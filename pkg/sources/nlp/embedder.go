@@ -19,7 +19,7 @@ func NewEmbedder(client embeddings.EmbedderClient) *ActivityEmbedder {
 func (e *ActivityEmbedder) Embed(ctx context.Context, summary *types.ActivitySummary) ([]float32, error) {
 	out, err := e.embedder.EmbedQuery(ctx, summary.FullSummary)
 	if err != nil {
-		return nil, err
+		return nil, wrapError(err)
 	}
 	return out, nil
 }
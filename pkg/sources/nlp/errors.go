@@ -0,0 +1,27 @@
+package nlp
+
+import (
+	"regexp"
+	"strconv"
+
+	"github.com/glanceapp/glance/pkg/utils"
+)
+
+// statusCodePattern matches the errors langchaingo returns for unexpected response statuses of the OpenAI API.
+var statusCodePattern = regexp.MustCompile(`unexpected status code: (\d{3})`)
+
+// wrapError converts errors of failed API responses to utils.HTTPError, so that requests rejected
+// for their content (e.g. exceeding the context length) aren't retried, nor counted as provider failures.
+func wrapError(err error) error {
+	match := statusCodePattern.FindStringSubmatch(err.Error())
+	if match == nil {
+		return err
+	}
+
+	code, _ := strconv.Atoi(match[1])
+	return &utils.HTTPError{
+		StatusCode: code,
+		URL:        "the OpenAI API",
+		Body:       err.Error(),
+	}
+}
@@ -86,7 +86,7 @@ INPUT ACTIVITY TO PROCESS
 		prompt.String(),
 	)
 	if err != nil {
-		return nil, fmt.Errorf("generate completion: %w", err)
+		return nil, fmt.Errorf("generate completion: %w", wrapError(err))
 	}
 
 	// Parser expects backsticks but the output usually doesn't contain them
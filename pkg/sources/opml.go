@@ -0,0 +1,348 @@
+package sources
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+
+	"github.com/glanceapp/glance/pkg/sources/activities/types"
+)
+
+type OPMLImportStatus string
+
+const (
+	OPMLImportCreated OPMLImportStatus = "created"
+	OPMLImportExists  OPMLImportStatus = "exists"
+	OPMLImportFailed  OPMLImportStatus = "failed"
+)
+
+// OPMLImportEntry is the outcome of importing a single feed outline.
+type OPMLImportEntry struct {
+	Title     string
+	FeedURL   string
+	SourceUID string
+	Status    OPMLImportStatus
+	Error     string
+}
+
+// OPMLGroupBy selects the folders of exported sources.
+type OPMLGroupBy string
+
+const (
+	OPMLGroupByType OPMLGroupBy = "type"
+	OPMLGroupByTag  OPMLGroupBy = "tag"
+)
+
+type opmlDocument struct {
+	XMLName xml.Name    `xml:"opml"`
+	Version string      `xml:"version,attr"`
+	Head    opmlHead    `xml:"head"`
+	Body    []*opmlItem `xml:"body>outline"`
+}
+
+type opmlHead struct {
+	Title       string `xml:"title,omitempty"`
+	DateCreated string `xml:"dateCreated,omitempty"`
+}
+
+type opmlItem struct {
+	Text     string `xml:"text,attr"`
+	Title    string `xml:"title,attr,omitempty"`
+	Type     string `xml:"type,attr,omitempty"`
+	XMLURL   string `xml:"xmlUrl,attr,omitempty"`
+	HTMLURL  string `xml:"htmlUrl,attr,omitempty"`
+	Category string `xml:"category,attr,omitempty"`
+	// PulseType and PulseConfig preserve the full source config when moving between Pulse instances.
+	// Other readers ignore unknown attributes.
+	PulseType   string      `xml:"pulseType,attr,omitempty"`
+	PulseConfig string      `xml:"pulseConfig,attr,omitempty"`
+	Items       []*opmlItem `xml:"outline"`
+}
+
+// ImportOPML creates a source for every feed in the OPML document.
+// Folders and categories of a feed become tags of its source.
+// An error is only returned if the document can't be parsed, failures of single feeds are reported in their entry.
+func (r *Registry) ImportOPML(in io.Reader) ([]OPMLImportEntry, error) {
+	if r.config.SourcesFileStrict {
+		return nil, fmt.Errorf("import sources: %w", ErrSourceManaged)
+	}
+
+	var doc opmlDocument
+	if err := xml.NewDecoder(in).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("parse OPML: %w", err)
+	}
+
+	var out []OPMLImportEntry
+	var walk func(items []*opmlItem, folders []string)
+	walk = func(items []*opmlItem, folders []string) {
+		for _, item := range items {
+			if item.XMLURL == "" && item.PulseType == "" {
+				walk(item.Items, append(slices.Clip(folders), item.label()))
+				continue
+			}
+			out = append(out, r.importOutline(item, folders))
+		}
+	}
+	walk(doc.Body, nil)
+
+	return out, nil
+}
+
+func (r *Registry) importOutline(item *opmlItem, folders []string) OPMLImportEntry {
+	entry := OPMLImportEntry{
+		Title:   item.label(),
+		FeedURL: item.XMLURL,
+	}
+
+	source, err := item.source(folders)
+	if err != nil {
+		entry.Status = OPMLImportFailed
+		entry.Error = err.Error()
+		return entry
+	}
+	entry.SourceUID = source.UID()
+
+	err = r.Add(source)
+	switch {
+	case errors.Is(err, ErrSourceExists):
+		entry.Status = OPMLImportExists
+	case err != nil:
+		entry.Status = OPMLImportFailed
+		entry.Error = err.Error()
+	default:
+		entry.Status = OPMLImportCreated
+	}
+
+	return entry
+}
+
+func (i *opmlItem) label() string {
+	if i.Title != "" {
+		return i.Title
+	}
+	return i.Text
+}
+
+func (i *opmlItem) source(folders []string) (Source, error) {
+	// Sources exported by Pulse are restored as they were, including their tags.
+	// Their secrets were redacted on export, and must be filled in again.
+	if i.PulseType != "" {
+		if err := checkRedacted(i.PulseType, []byte(i.PulseConfig)); err != nil {
+			return nil, err
+		}
+		return DecodeSource(i.PulseType, []byte(i.PulseConfig))
+	}
+
+	return SourceFromFeedURL(i.XMLURL, types.SourceBase{Tags: opmlTags(folders, i.Category)})
+}
+
+// opmlTags merges the enclosing folders with the category attribute,
+// which is a comma-separated list of slash-delimited category paths.
+func opmlTags(folders []string, category string) []string {
+	var out []string
+	seen := make(map[string]bool)
+
+	add := func(tag string) {
+		tag = strings.TrimSpace(tag)
+		if tag != "" && !seen[tag] {
+			seen[tag] = true
+			out = append(out, tag)
+		}
+	}
+
+	for _, folder := range folders {
+		add(folder)
+	}
+	for _, path := range strings.Split(category, ",") {
+		for _, tag := range strings.Split(path, "/") {
+			add(tag)
+		}
+	}
+
+	return out
+}
+
+// ExportOPML writes all sources as an OPML document, with one folder per source type or tag.
+// Sources with several tags appear in each of their folders, untagged sources are not put in a folder.
+func (r *Registry) ExportOPML(w io.Writer, groupBy OPMLGroupBy) error {
+	all, err := r.sourceRepo.List()
+	if err != nil {
+		return fmt.Errorf("list sources: %w", err)
+	}
+
+	sort.Slice(all, func(i, j int) bool {
+		return all[i].Name() < all[j].Name()
+	})
+
+	var ungrouped []*opmlItem
+	folders := make(map[string]*opmlItem)
+	for _, source := range all {
+		item, err := newOPMLItem(source)
+		if err != nil {
+			return err
+		}
+
+		var groups []string
+		switch groupBy {
+		case OPMLGroupByTag:
+			groups = Tags(source)
+		case OPMLGroupByType:
+			groups = []string{source.Type()}
+		default:
+			return fmt.Errorf("unknown OPML grouping: %s", groupBy)
+		}
+
+		if len(groups) == 0 {
+			ungrouped = append(ungrouped, item)
+		}
+		for _, group := range groups {
+			if folders[group] == nil {
+				folders[group] = &opmlItem{Text: group, Title: group}
+			}
+			folders[group].Items = append(folders[group].Items, item)
+		}
+	}
+
+	doc := opmlDocument{
+		Version: "2.0",
+		Head: opmlHead{
+			Title:       "Pulse sources",
+			DateCreated: time.Now().UTC().Format(time.RFC1123Z),
+		},
+	}
+	for _, folder := range folders {
+		doc.Body = append(doc.Body, folder)
+	}
+	sort.Slice(doc.Body, func(i, j int) bool {
+		return doc.Body[i].Text < doc.Body[j].Text
+	})
+	doc.Body = append(doc.Body, ungrouped...)
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return fmt.Errorf("encode OPML: %w", err)
+	}
+
+	return nil
+}
+
+func newOPMLItem(source Source) (*opmlItem, error) {
+	config, err := redactSecrets(source)
+	if err != nil {
+		return nil, err
+	}
+
+	return &opmlItem{
+		Text:        source.Name(),
+		Title:       source.Name(),
+		Type:        "rss",
+		XMLURL:      FeedURL(source),
+		HTMLURL:     source.URL(),
+		Category:    strings.Join(Tags(source), ","),
+		PulseType:   source.Type(),
+		PulseConfig: string(config),
+	}, nil
+}
+
+// redactedSecret replaces the values of secret config fields in exported OPML documents,
+// so that exports don't leak credentials.
+const redactedSecret = "REDACTED"
+
+// redactSecrets marshals the config of the source, with its secret fields redacted.
+func redactSecrets(source Source) ([]byte, error) {
+	schema, err := ConfigSchema(source.Type())
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := json.Marshal(source)
+	if err != nil {
+		return nil, fmt.Errorf("marshal source: %w", err)
+	}
+
+	var value any
+	if err := json.Unmarshal(config, &value); err != nil {
+		return nil, fmt.Errorf("unmarshal source: %w", err)
+	}
+
+	walkSecrets(schema, value, "config", func(object map[string]any, key, _ string) {
+		object[key] = redactedSecret
+	})
+
+	return json.Marshal(value)
+}
+
+// checkRedacted reports the secret fields of the config that are still redacted.
+func checkRedacted(sourceType string, config []byte) error {
+	schema, err := ConfigSchema(sourceType)
+	if err != nil {
+		return ValidationErrors{{Field: "type", Message: err.Error()}}
+	}
+
+	var value any
+	if err := json.Unmarshal(config, &value); err != nil {
+		return ValidationErrors{{Field: "config", Message: err.Error()}}
+	}
+	normalizeKeys(schema, value)
+
+	var out ValidationErrors
+	walkSecrets(schema, value, "config", func(object map[string]any, key, field string) {
+		if object[key] == redactedSecret {
+			out = append(out, ValidationError{Field: field, Message: "secret was redacted on export and must be supplied again"})
+		}
+	})
+	sort.Slice(out, func(i, j int) bool { return out[i].Field < out[j].Field })
+
+	if len(out) > 0 {
+		return out
+	}
+	return nil
+}
+
+// walkSecrets calls fn for the non-empty string values of secret fields of the config value.
+// Values of secret objects, like HTTP headers, are each treated as secrets.
+func walkSecrets(schema *openapi3.Schema, value any, path string, fn func(object map[string]any, key, field string)) {
+	object, ok := value.(map[string]any)
+	if !ok || schema == nil {
+		return
+	}
+
+	for name, property := range schema.Properties {
+		v, ok := object[name]
+		if !ok || property.Value == nil {
+			continue
+		}
+		field := path + "." + name
+
+		if !property.Value.WriteOnly {
+			walkSecrets(property.Value, v, field, fn)
+			continue
+		}
+
+		switch v := v.(type) {
+		case string:
+			if v != "" {
+				fn(object, name, field)
+			}
+		case map[string]any:
+			for key, item := range v {
+				if s, ok := item.(string); ok && s != "" {
+					fn(v, key, field+"."+key)
+				}
+			}
+		}
+	}
+}
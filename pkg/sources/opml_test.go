@@ -0,0 +1,33 @@
+package sources
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestOPMLTags(t *testing.T) {
+	tests := []struct {
+		name     string
+		folders  []string
+		category string
+		want     []string
+	}{
+		{name: "empty", want: nil},
+		{name: "folders", folders: []string{"Tech", "Go"}, want: []string{"Tech", "Go"}},
+		{name: "category", category: "news", want: []string{"news"}},
+		{name: "category path", category: "/Tech/Go", want: []string{"Tech", "Go"}},
+		{name: "several categories", category: "Tech/Go, News", want: []string{"Tech", "Go", "News"}},
+		{name: "folders before categories", folders: []string{"Blogs"}, category: "Tech", want: []string{"Blogs", "Tech"}},
+		{name: "duplicates", folders: []string{"Tech", "Tech"}, category: "Tech/Go,/Go", want: []string{"Tech", "Go"}},
+		{name: "blank parts", folders: []string{" "}, category: " , //, Go ", want: []string{"Go"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := opmlTags(tt.folders, tt.category)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("opmlTags(%q, %q) = %q, want %q", tt.folders, tt.category, got, tt.want)
+			}
+		})
+	}
+}
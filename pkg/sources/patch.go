@@ -0,0 +1,151 @@
+package sources
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/glanceapp/glance/pkg/utils"
+)
+
+var (
+	ErrSourceNotFound = errors.New("source not found")
+	ErrSourceExists   = errors.New("source already exists")
+	ErrSourceManaged  = errors.New("managed by the sources file")
+	ErrInvalidSource  = errors.New("invalid source config")
+	// ErrUndecodableSource is returned for persisted sources whose config can't be decoded anymore.
+	ErrUndecodableSource = errors.New("stored source config can't be decoded")
+)
+
+// UIDChangeError is returned for updates that change the fields the source UID is derived from.
+// Such sources are different sources, so they must be created instead.
+type UIDChangeError struct {
+	Fields []string
+	OldUID string
+	NewUID string
+}
+
+func (e *UIDChangeError) Error() string {
+	return fmt.Sprintf(
+		"fields %s define the source UID and can't be changed (UID would change from '%s' to '%s'), create a new source instead",
+		strings.Join(e.Fields, ", "), e.OldUID, e.NewUID,
+	)
+}
+
+// Update applies a JSON merge patch to the config of the source and restarts its polling.
+// The UID of the source must not change, so that its activities stay attached to it.
+func (r *Registry) Update(uid string, patch []byte) (Source, error) {
+	existing, err := r.sourceRepo.GetByID(uid)
+	if err != nil {
+		return nil, fmt.Errorf("get source: %w", err)
+	}
+
+	if existing == nil {
+		return nil, fmt.Errorf("source '%s': %w", uid, ErrSourceNotFound)
+	}
+
+	if err := r.checkUnmanaged(uid); err != nil {
+		return nil, err
+	}
+
+	updated, err := patchSource(existing, patch)
+	if err != nil {
+		return nil, err
+	}
+
+	if updated.UID() != uid {
+		return nil, &UIDChangeError{
+			Fields: uidFields(existing, patch),
+			OldUID: uid,
+			NewUID: updated.UID(),
+		}
+	}
+
+	if err := r.replace(updated); err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+// replace stores the new config of an existing source and restarts its polling.
+func (r *Registry) replace(updated Source) error {
+	// Keeps the ingest URL of the source, unless the new config sets another token.
+	previous, _ := r.sourceRepo.GetByID(updated.UID())
+	ensureIngestToken(updated, previous)
+
+	if err := updated.Initialize(); err != nil {
+		return fmt.Errorf("%w: initialize source: %w", ErrInvalidSource, err)
+	}
+
+	if err := r.sourceRepo.Update(updated); err != nil {
+		return fmt.Errorf("update source: %w", err)
+	}
+	r.invalidateRetention(updated.UID())
+
+	paused, err := r.sourceRepo.Paused()
+	if err != nil {
+		return fmt.Errorf("list paused sources: %w", err)
+	}
+
+	// Replaces the schedule of the previous config. Paused sources pick up the new config when resumed.
+	if !paused[updated.UID()] {
+		r.scheduler.Schedule(updated)
+		r.subscribe(updated)
+	}
+
+	return nil
+}
+
+func patchSource(source Source, patch []byte) (Source, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(patch, &fields); err != nil {
+		return nil, fmt.Errorf("%w: patch must be a JSON object: %w", ErrInvalidSource, err)
+	}
+
+	if raw, ok := fields["type"]; ok {
+		var sourceType string
+		if err := json.Unmarshal(raw, &sourceType); err != nil || sourceType != source.Type() {
+			return nil, fmt.Errorf("%w: source type can't be changed", ErrInvalidSource)
+		}
+	}
+
+	current, err := json.Marshal(source)
+	if err != nil {
+		return nil, fmt.Errorf("marshal source: %w", err)
+	}
+
+	merged, err := utils.MergePatch(current, patch)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidSource, err)
+	}
+
+	// Patched configs are validated like new ones.
+	return DecodeSource(source.Type(), merged)
+}
+
+// uidFields returns the patched fields that change the UID of the source.
+func uidFields(source Source, patch []byte) []string {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(patch, &fields); err != nil {
+		return nil
+	}
+
+	var out []string
+	for name, value := range fields {
+		single, err := json.Marshal(map[string]json.RawMessage{name: value})
+		if err != nil {
+			continue
+		}
+
+		patched, err := patchSource(source, single)
+		if err != nil || patched.UID() != source.UID() {
+			out = append(out, name)
+		}
+	}
+
+	sort.Strings(out)
+	return out
+}
@@ -0,0 +1,203 @@
+package sources
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/glanceapp/glance/pkg/sources/activities/types"
+)
+
+const typeTestRepo = "test-repo"
+
+func init() {
+	RegisterSourceType(SourceType{
+		Type:         typeTestRepo,
+		Description:  "Fake source of the tests, whose UID is derived from the owner and the repository.",
+		NewSource:    func() Source { return newTestRepo() },
+		NewActivity:  func() types.Activity { return &testActivity{} },
+		PollInterval: time.Hour,
+	})
+}
+
+type testRepo struct {
+	types.SourceBase
+	Owner string `json:"owner" jsonschema:"required"`
+	Repo  string `json:"repo" jsonschema:"required"`
+	State string `json:"state" jsonschema:"enum=open,enum=closed"`
+	Limit int    `json:"limit"`
+}
+
+func newTestRepo() *testRepo {
+	return &testRepo{State: "open", Limit: 10}
+}
+
+func (s *testRepo) UID() string {
+	return fmt.Sprintf("%s/%s/%s", s.Type(), s.Owner, s.Repo)
+}
+
+func (s *testRepo) Type() string {
+	return typeTestRepo
+}
+
+func (s *testRepo) Name() string {
+	return s.Owner + "/" + s.Repo
+}
+
+func (s *testRepo) URL() string {
+	return "https://example.com/" + s.Owner + "/" + s.Repo
+}
+
+func (s *testRepo) Initialize() error {
+	return nil
+}
+
+func (s *testRepo) Stream(ctx context.Context, feed chan<- types.Activity, errs chan<- error) {}
+
+func (s *testRepo) MarshalJSON() ([]byte, error) {
+	type Alias testRepo
+	return json.Marshal(&struct {
+		*Alias
+		Type string `json:"type"`
+	}{
+		Alias: (*Alias)(s),
+		Type:  s.Type(),
+	})
+}
+
+func (s *testRepo) UnmarshalJSON(data []byte) error {
+	type Alias testRepo
+	aux := &struct {
+		*Alias
+	}{
+		Alias: (*Alias)(s),
+	}
+	return json.Unmarshal(data, aux)
+}
+
+type testActivity struct {
+	ID string `json:"id"`
+}
+
+func (a *testActivity) UID() string                  { return a.ID }
+func (a *testActivity) SourceUID() string            { return "" }
+func (a *testActivity) SourceType() string           { return typeTestRepo }
+func (a *testActivity) Title() string                { return "" }
+func (a *testActivity) Body() string                 { return "" }
+func (a *testActivity) URL() string                  { return "" }
+func (a *testActivity) ImageURL() string             { return "" }
+func (a *testActivity) CreatedAt() time.Time         { return time.Time{} }
+func (a *testActivity) MarshalJSON() ([]byte, error) { return json.Marshal(*a) }
+func (a *testActivity) UnmarshalJSON(data []byte) error {
+	type Alias testActivity
+	return json.Unmarshal(data, (*Alias)(a))
+}
+
+// testSourceStore holds the sources of the tests. Methods that aren't overridden panic.
+type testSourceStore struct {
+	sourceStore
+	sources map[string]Source
+	managed map[string]bool
+}
+
+func (s *testSourceStore) GetByID(uid string) (Source, error) {
+	return s.sources[uid], nil
+}
+
+func (s *testSourceStore) Managed() (map[string]bool, error) {
+	return s.managed, nil
+}
+
+func TestPatchSource(t *testing.T) {
+	tests := []struct {
+		name    string
+		patch   string
+		want    *testRepo
+		wantErr bool
+	}{
+		{name: "changes field", patch: `{"state":"closed"}`, want: &testRepo{Owner: "a", Repo: "b", State: "closed", Limit: 5}},
+		{name: "null resets field to default", patch: `{"limit":null}`, want: &testRepo{Owner: "a", Repo: "b", State: "open", Limit: 10}},
+		{name: "same type", patch: `{"type":"test-repo","limit":1}`, want: &testRepo{Owner: "a", Repo: "b", State: "open", Limit: 1}},
+		{name: "changes type", patch: `{"type":"rss"}`, wantErr: true},
+		{name: "not an object", patch: `["state"]`, wantErr: true},
+		{name: "invalid JSON", patch: `{"state":`, wantErr: true},
+		{name: "invalid config", patch: `{"state":"merged"}`, wantErr: true},
+		{name: "removes required field", patch: `{"owner":null}`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			source := &testRepo{Owner: "a", Repo: "b", State: "open", Limit: 5}
+
+			got, err := patchSource(source, []byte(tt.patch))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, want error %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidSource) {
+					t.Errorf("err = %v, want %v", err, ErrInvalidSource)
+				}
+				return
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("patchSource() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRegistryUpdateUIDChange(t *testing.T) {
+	existing := &testRepo{Owner: "a", Repo: "b", State: "open"}
+
+	tests := []struct {
+		name       string
+		uid        string
+		patch      string
+		managed    bool
+		wantErr    error
+		wantFields []string
+	}{
+		{name: "unknown source", uid: "test-repo/x/y", patch: `{"limit":1}`, wantErr: ErrSourceNotFound},
+		{name: "managed source", uid: existing.UID(), patch: `{"limit":1}`, managed: true, wantErr: ErrSourceManaged},
+		{name: "changes type", uid: existing.UID(), patch: `{"type":"rss"}`, wantErr: ErrInvalidSource},
+		{name: "changes UID field", uid: existing.UID(), patch: `{"repo":"c","limit":1}`, wantFields: []string{"repo"}},
+		{name: "changes UID fields", uid: existing.UID(), patch: `{"owner":"c","repo":"d","state":"closed"}`, wantFields: []string{"owner", "repo"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Registry{sourceRepo: &testSourceStore{
+				sources: map[string]Source{existing.UID(): existing},
+				managed: map[string]bool{existing.UID(): tt.managed},
+			}}
+
+			_, err := r.Update(tt.uid, []byte(tt.patch))
+			if err == nil {
+				t.Fatal("err = nil, want error")
+			}
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("err = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+
+			var uidErr *UIDChangeError
+			if !errors.As(err, &uidErr) {
+				t.Fatalf("err = %v, want UIDChangeError", err)
+			}
+			if uidErr.OldUID != existing.UID() {
+				t.Errorf("OldUID = %s, want %s", uidErr.OldUID, existing.UID())
+			}
+			if !reflect.DeepEqual(uidErr.Fields, tt.wantFields) {
+				t.Errorf("Fields = %v, want %v", uidErr.Fields, tt.wantFields)
+			}
+		})
+	}
+}
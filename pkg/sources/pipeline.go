@@ -0,0 +1,413 @@
+package sources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/glanceapp/glance/pkg/sources/activities/types"
+	"github.com/glanceapp/glance/pkg/utils"
+)
+
+const (
+	// jobPollInterval is how often idle workers check for jobs queued by other replicas
+	// or jobs whose retry delay has passed.
+	jobPollInterval = 5 * time.Second
+	// jobLease is how long a claimed job may run before it is considered abandoned.
+	jobLease = 10 * time.Minute
+	// maxJobAttempts is the number of attempts after which a job is marked as failed.
+	maxJobAttempts = 5
+	// jobRetryDelay is the delay before the first retry, doubled on each further attempt.
+	jobRetryDelay = 30 * time.Second
+)
+
+// PipelineStats describes the load of the ingestion pipeline.
+type PipelineStats struct {
+	// QueueDepth is the number of pending and running jobs.
+	QueueDepth    int
+	QueueCapacity int
+	FailedJobs    int
+	Workers       int
+	BusyWorkers   int
+	Stages        []StageStats
+	// ThrottledEnqueues is the number of times ingestion waited for queue capacity.
+	ThrottledEnqueues int64
+	// DroppedActivities is the number of activities dropped because the queue stayed full.
+	DroppedActivities int64
+}
+
+type StageStats struct {
+	Stage       types.JobStage
+	Concurrency int
+	InFlight    int
+	Processed   int64
+	Failed      int64
+}
+
+// stageLimiter limits the concurrency of a pipeline stage and tracks its utilization.
+type stageLimiter struct {
+	stage     types.JobStage
+	slots     chan struct{}
+	inFlight  atomic.Int64
+	processed atomic.Int64
+	failed    atomic.Int64
+}
+
+func newStageLimiter(stage types.JobStage, concurrency int) *stageLimiter {
+	return &stageLimiter{
+		stage: stage,
+		slots: make(chan struct{}, concurrency),
+	}
+}
+
+func (l *stageLimiter) run(ctx context.Context, fn func(ctx context.Context) error) error {
+	select {
+	case l.slots <- struct{}{}:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	defer func() { <-l.slots }()
+
+	l.inFlight.Add(1)
+	defer l.inFlight.Add(-1)
+
+	if err := fn(ctx); err != nil {
+		l.failed.Add(1)
+		return err
+	}
+
+	l.processed.Add(1)
+	return nil
+}
+
+func (l *stageLimiter) stats() StageStats {
+	return StageStats{
+		Stage:       l.stage,
+		Concurrency: cap(l.slots),
+		InFlight:    int(l.inFlight.Load()),
+		Processed:   l.processed.Load(),
+		Failed:      l.failed.Load(),
+	}
+}
+
+// startIngestion persists activities produced by sources as pipeline jobs,
+// so that they survive restarts and slow pipeline stages don't block sources.
+// While the queue is at capacity, no activities are accepted, which throttles the sources.
+func (r *Registry) startIngestion() {
+	go func() {
+		for {
+			if !r.waitForCapacity() {
+				return
+			}
+
+			select {
+			case act := <-r.activityQueue:
+				changed, err := r.hasChanged(act)
+				if err != nil {
+					r.logger.Error().Err(err).Str("activity", act.UID()).Msg("Error checking activity revision")
+					continue
+				}
+				if !changed {
+					continue
+				}
+
+				if err := r.jobRepo.Enqueue(act); err != nil {
+					r.logger.Error().Err(err).Str("activity", act.UID()).Msg("Error enqueuing activity")
+					continue
+				}
+				r.notifyWorkers()
+
+			case <-r.done:
+				return
+			}
+		}
+	}()
+
+	go func() {
+		for {
+			select {
+			case err := <-r.errorQueue:
+				r.logger.Error().Err(err).Msg("Error streaming source")
+
+			case <-r.done:
+				return
+			}
+		}
+	}()
+}
+
+// hasChanged reports whether the activity is new or its content changed since it was stored,
+// so that unchanged activities are skipped before any summarizer or embedder calls.
+// New activities that are already older than their retention are skipped as well.
+func (r *Registry) hasChanged(act types.Activity) (bool, error) {
+	stored, found, err := r.activityRepo.ContentHash(act.SourceUID(), act.UID())
+	if err != nil {
+		return false, fmt.Errorf("get content hash: %w", err)
+	}
+
+	if !found {
+		// Pruned activities would be summarized and stored again, as long as sources keep returning them.
+		if r.expired(act) {
+			r.logger.Debug().Str("activity", act.UID()).Msg("Skipping activity older than its retention")
+			return false, nil
+		}
+		return true, nil
+	}
+
+	hash := types.ContentHash(act)
+
+	// Activities stored before content hashes were tracked are assumed to be unchanged.
+	if stored == "" {
+		if err := r.activityRepo.SetContentHash(act.SourceUID(), act.UID(), hash); err != nil {
+			return false, fmt.Errorf("set content hash: %w", err)
+		}
+		return false, nil
+	}
+
+	if stored == hash {
+		return false, nil
+	}
+
+	r.logger.Debug().Str("activity", act.UID()).Msg("Activity changed, storing new revision")
+	return true, nil
+}
+
+// waitForCapacity blocks until the queue has room for another job.
+// It returns false if the registry was shut down while waiting.
+func (r *Registry) waitForCapacity() bool {
+	throttled := false
+
+	for {
+		counts, err := r.jobRepo.Counts()
+		if err != nil {
+			r.logger.Error().Err(err).Msg("Error counting jobs")
+		} else if counts.Pending+counts.Running < r.config.QueueCapacity {
+			return true
+		}
+
+		if !throttled {
+			throttled = true
+			r.throttledEnqueues.Add(1)
+		}
+
+		select {
+		case <-time.After(jobPollInterval):
+		case <-r.done:
+			return false
+		}
+	}
+}
+
+func (r *Registry) notifyWorkers() {
+	select {
+	case r.jobsQueued <- struct{}{}:
+	default:
+	}
+}
+
+func (r *Registry) startWorkers(nWorkers int) {
+	for i := 0; i < nWorkers; i++ {
+		go func(workerID int) {
+			r.logger.Info().Msgf("Worker %d starting", workerID)
+
+			ticker := time.NewTicker(jobPollInterval)
+			defer ticker.Stop()
+
+			for {
+				r.processJobs(workerID)
+
+				select {
+				case <-r.jobsQueued:
+				case <-ticker.C:
+				case <-r.done:
+					r.logger.Info().Msgf("Worker %d shutting down", workerID)
+					return
+				}
+			}
+		}(i + 1)
+	}
+}
+
+// processJobs runs claimed jobs until there are none left.
+func (r *Registry) processJobs(workerID int) {
+	for {
+		select {
+		case <-r.done:
+			return
+		default:
+		}
+
+		jobs, err := r.jobRepo.Claim(1, jobLease)
+		if err != nil {
+			r.logger.Error().Err(err).Msgf("[Worker %d] Error claiming jobs", workerID)
+			return
+		}
+
+		if len(jobs) == 0 {
+			return
+		}
+
+		r.busyWorkers.Add(1)
+		for _, job := range jobs {
+			r.logger.Info().Msgf("[Worker %d] Processing activity %s (%s)", workerID, job.Activity.UID(), job.Stage)
+
+			if err := r.processJob(context.Background(), job); err != nil {
+				r.logger.Error().Err(err).Msgf("[Worker %d] Error processing activity %s", workerID, job.Activity.UID())
+				r.failJob(job, err)
+			}
+		}
+		r.busyWorkers.Add(-1)
+	}
+}
+
+// processJob runs the remaining stages of the job, saving progress after each stage
+// so that a retried job doesn't repeat completed LLM calls.
+func (r *Registry) processJob(ctx context.Context, job *types.Job) error {
+	for {
+		stage, ok := r.stages[job.Stage]
+		if !ok {
+			return fmt.Errorf("unknown job stage: %s", job.Stage)
+		}
+
+		err := stage.run(ctx, func(ctx context.Context) error {
+			return r.runStage(ctx, job)
+		})
+		if err != nil {
+			return fmt.Errorf("%s: %w", job.Stage, err)
+		}
+
+		if job.Stage == types.JobStageStore {
+			if err := r.jobRepo.Complete(job.ID); err != nil {
+				return fmt.Errorf("complete job: %w", err)
+			}
+			return nil
+		}
+
+		if err := r.jobRepo.Save(job); err != nil {
+			return fmt.Errorf("save job: %w", err)
+		}
+	}
+}
+
+// runStage runs the current stage of the job and advances it to the next stage.
+func (r *Registry) runStage(ctx context.Context, job *types.Job) error {
+	switch job.Stage {
+	case types.JobStageSummarize:
+		var summary *types.ActivitySummary
+		err := r.summarizerBreaker.Do(ctx, r.config.RetryPolicy(), func(ctx context.Context) (err error) {
+			summary, err = r.summarizer.Summarize(ctx, job.Activity)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+		job.Summary = summary
+		job.Stage = types.JobStageEmbed
+
+	case types.JobStageEmbed:
+		// Compute embedding for the full summary
+		var embedding []float32
+		err := r.embedderBreaker.Do(ctx, r.config.RetryPolicy(), func(ctx context.Context) (err error) {
+			embedding, err = r.embedder.Embed(ctx, job.Summary)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+		job.Embedding = embedding
+		job.Stage = types.JobStageStore
+
+	case types.JobStageStore:
+		return r.activityRepo.Add(&types.DecoratedActivity{
+			Activity:  job.Activity,
+			Summary:   job.Summary,
+			Embedding: job.Embedding,
+		})
+	}
+
+	return nil
+}
+
+func (r *Registry) failJob(job *types.Job, cause error) {
+	var err error
+	switch {
+	case errors.Is(cause, utils.ErrCircuitOpen):
+		// The job itself didn't fail, so postpone it until the dependency recovers, without using up an attempt.
+		runAfter := time.Now().Add(jobRetryDelay)
+		for _, openUntil := range []time.Time{r.summarizerBreaker.OpenUntil(), r.embedderBreaker.OpenUntil()} {
+			if openUntil.After(runAfter) {
+				runAfter = openUntil
+			}
+		}
+		err = r.jobRepo.Release(job.ID, runAfter)
+	case job.Attempts >= maxJobAttempts:
+		err = r.jobRepo.Fail(job.ID, cause)
+	default:
+		delay := jobRetryDelay << (job.Attempts - 1)
+		if wait, ok := utils.RetryAfter(cause); ok {
+			delay = max(delay, wait)
+		}
+		err = r.jobRepo.Retry(job.ID, cause, time.Now().Add(delay))
+	}
+
+	if err != nil {
+		r.logger.Error().Err(err).Str("job", job.ID).Msg("Error releasing failed job")
+	}
+}
+
+// PipelineStats returns the current load of the ingestion pipeline.
+func (r *Registry) PipelineStats() (*PipelineStats, error) {
+	counts, err := r.jobRepo.Counts()
+	if err != nil {
+		return nil, fmt.Errorf("count jobs: %w", err)
+	}
+
+	stats := &PipelineStats{
+		QueueDepth:        counts.Pending + counts.Running,
+		QueueCapacity:     r.config.QueueCapacity,
+		FailedJobs:        counts.Failed,
+		Workers:           r.config.Workers,
+		BusyWorkers:       int(r.busyWorkers.Load()),
+		ThrottledEnqueues: r.throttledEnqueues.Load(),
+		DroppedActivities: r.scheduler.Dropped(),
+	}
+
+	for _, stage := range []types.JobStage{types.JobStageSummarize, types.JobStageEmbed, types.JobStageStore} {
+		stats.Stages = append(stats.Stages, r.stages[stage].stats())
+	}
+
+	return stats, nil
+}
+
+// DeadLetters returns activities whose processing failed on every attempt.
+func (r *Registry) DeadLetters() ([]*types.DeadLetter, error) {
+	return r.jobRepo.DeadLetters()
+}
+
+// RetryDeadLetters queues the dead letters again, resuming from the failed stage.
+// All dead letters are retried if no IDs are given. It returns the number of queued jobs.
+func (r *Registry) RetryDeadLetters(ids ...string) (int, error) {
+	n, err := r.jobRepo.Requeue(ids...)
+	if err != nil {
+		return 0, fmt.Errorf("requeue dead letters: %w", err)
+	}
+
+	if n > 0 {
+		r.notifyWorkers()
+	}
+
+	return n, nil
+}
+
+// DiscardDeadLetters removes the dead letters. All dead letters are removed if no IDs are given.
+// It returns the number of removed dead letters.
+func (r *Registry) DiscardDeadLetters(ids ...string) (int, error) {
+	n, err := r.jobRepo.Discard(ids...)
+	if err != nil {
+		return 0, fmt.Errorf("discard dead letters: %w", err)
+	}
+
+	return n, nil
+}
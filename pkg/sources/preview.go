@@ -0,0 +1,132 @@
+package sources
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/glanceapp/glance/pkg/sources/activities/types"
+)
+
+const (
+	// previewTimeout bounds a preview, including the bodies of its activities, since the caller waits for it.
+	previewTimeout = 30 * time.Second
+	// previewFetchTimeout bounds the fetch of a previewed source, leaving the rest of previewTimeout for the bodies.
+	previewFetchTimeout = 25 * time.Second
+	// previewBodyConcurrency bounds the activity bodies built at once, since some fetch the linked article.
+	previewBodyConcurrency = 8
+	// maxPreviewActivities caps the activities returned by a preview. Further activities are discarded.
+	maxPreviewActivities = 50
+)
+
+// Preview is the outcome of a single fetch of a source that isn't persisted.
+type Preview struct {
+	Activities []types.Activity
+	// Bodies are the bodies of the activities, by index. Bodies that weren't built within previewTimeout are empty.
+	Bodies []string
+	// Errors are reported by the fetch. Activities fetched before an error are still included.
+	Errors []string
+}
+
+// PreviewSource runs a single fetch of an initialized source and returns its activities,
+// without summarizing, embedding or storing them.
+func PreviewSource(ctx context.Context, source Source) *Preview {
+	ctx, cancel := context.WithTimeout(ctx, previewTimeout)
+	defer cancel()
+
+	out := fetchPreview(ctx, source)
+	out.Bodies = previewBodies(ctx, out.Activities)
+
+	return out
+}
+
+func fetchPreview(ctx context.Context, source Source) *Preview {
+	ctx, cancel := context.WithTimeout(ctx, previewFetchTimeout)
+	defer cancel()
+
+	feed := make(chan types.Activity)
+	errs := make(chan error)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		source.Stream(ctx, feed, errs)
+	}()
+
+	out := &Preview{}
+	for {
+		select {
+		case act := <-feed:
+			if len(out.Activities) < maxPreviewActivities {
+				out.Activities = append(out.Activities, act)
+			}
+		case err := <-errs:
+			out.Errors = append(out.Errors, err.Error())
+		case <-done:
+			return out
+		case <-ctx.Done():
+			out.Errors = append(out.Errors, fmt.Sprintf("fetch didn't finish within %s", previewFetchTimeout))
+			// Not all sources stop streaming on cancellation, so keep draining until the stream returns.
+			go func() {
+				for {
+					select {
+					case <-feed:
+					case <-errs:
+					case <-done:
+						return
+					}
+				}
+			}()
+			return out
+		}
+	}
+}
+
+// previewBodies builds the bodies of the activities concurrently until ctx is done.
+// Bodies of some sources fetch the linked article and can't be canceled, so they are
+// left empty if they aren't ready in time, and finish in the background.
+func previewBodies(ctx context.Context, activities []types.Activity) []string {
+	var mu sync.Mutex
+	bodies := make([]string, len(activities))
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, previewBodyConcurrency)
+	for i, act := range activities {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+				return
+			}
+			if ctx.Err() != nil {
+				return
+			}
+
+			body := act.Body()
+
+			mu.Lock()
+			defer mu.Unlock()
+			bodies[i] = body
+		}()
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		wg.Wait()
+	}()
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	return slices.Clone(bodies)
+}
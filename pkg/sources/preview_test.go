@@ -0,0 +1,88 @@
+package sources
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/glanceapp/glance/pkg/sources/activities/types"
+)
+
+// slowActivity is an activity whose body takes a while to build, like one fetching the linked article.
+type slowActivity struct {
+	testActivity
+	body  string
+	delay time.Duration
+}
+
+func (a *slowActivity) Body() string {
+	time.Sleep(a.delay)
+	return a.body
+}
+
+func TestPreviewBodies(t *testing.T) {
+	const timeout = 100 * time.Millisecond
+
+	tests := []struct {
+		name       string
+		activities []*slowActivity
+		want       []string
+	}{
+		{name: "no activities", want: []string{}},
+		{
+			name:       "bodies",
+			activities: []*slowActivity{{body: "a"}, {body: "b"}},
+			want:       []string{"a", "b"},
+		},
+		{
+			name:       "slow body",
+			activities: []*slowActivity{{body: "a"}, {body: "b", delay: time.Second}, {body: "c"}},
+			want:       []string{"a", "", "c"},
+		},
+		{
+			name: "more bodies than built at once",
+			activities: func() []*slowActivity {
+				var out []*slowActivity
+				for range previewBodyConcurrency * 3 {
+					out = append(out, &slowActivity{body: "a", delay: 10 * time.Millisecond})
+				}
+				return out
+			}(),
+			want: slices.Repeat([]string{"a"}, previewBodyConcurrency*3),
+		},
+		{
+			name: "slow bodies",
+			activities: func() []*slowActivity {
+				var out []*slowActivity
+				for range maxPreviewActivities {
+					out = append(out, &slowActivity{body: "a", delay: time.Second})
+				}
+				return out
+			}(),
+			want: make([]string, maxPreviewActivities),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			activities := make([]types.Activity, len(tt.activities))
+			for i, act := range tt.activities {
+				activities[i] = act
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), timeout)
+			defer cancel()
+
+			start := time.Now()
+			got := previewBodies(ctx, activities)
+
+			if elapsed := time.Since(start); elapsed > timeout+50*time.Millisecond {
+				t.Errorf("took %s, want at most %s", elapsed, timeout)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("previewBodies() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
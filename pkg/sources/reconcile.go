@@ -0,0 +1,247 @@
+package sources
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+)
+
+// SourcesPlan lists the changes that make the persisted sources match the sources file.
+type SourcesPlan struct {
+	Create []string
+	Update []string
+	// Adopt lists sources created through the API that are now defined in the sources file.
+	Adopt []string
+	// Remove lists sources that were defined in the sources file, but no longer are.
+	// Sources created through the API are never removed.
+	Remove []string
+}
+
+func (p *SourcesPlan) Empty() bool {
+	return len(p.Create)+len(p.Update)+len(p.Adopt)+len(p.Remove) == 0
+}
+
+// LoadSourcesFile reads the sources defined in a YAML or JSON file of the form:
+//
+//	sources:
+//	  - type: rss-feed
+//	    config:
+//	      url: https://example.com/feed.xml
+func LoadSourcesFile(path string) ([]Source, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read %s: %w", path, err)
+	}
+
+	// JSON is valid YAML, so both formats are parsed the same way.
+	var raw struct {
+		Sources []struct {
+			Type   string         `yaml:"type"`
+			Config map[string]any `yaml:"config"`
+		} `yaml:"sources"`
+	}
+	if err := yaml.Unmarshal(contents, &raw); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", path, err)
+	}
+
+	out := make([]Source, 0, len(raw.Sources))
+	seen := make(map[string]bool)
+	for i, entry := range raw.Sources {
+		config, err := json.Marshal(entry.Config)
+		if err != nil {
+			return nil, fmt.Errorf("source %d: marshal config: %w", i+1, err)
+		}
+
+		source, err := DecodeSource(entry.Type, config)
+		if err != nil {
+			return nil, fmt.Errorf("source %d: %w", i+1, err)
+		}
+
+		if seen[source.UID()] {
+			return nil, fmt.Errorf("source %d: duplicate source '%s'", i+1, source.UID())
+		}
+		seen[source.UID()] = true
+
+		out = append(out, source)
+	}
+
+	return out, nil
+}
+
+// ReconcileSourcesFile adds, updates and removes sources to match the sources file.
+// Invalid sources files are rejected as a whole, so that a typo doesn't remove sources.
+// In dry-run mode, the plan is only logged. It returns nil if no sources file is configured.
+func (r *Registry) ReconcileSourcesFile() (*SourcesPlan, error) {
+	if r.config.SourcesFile == "" {
+		return nil, nil
+	}
+
+	r.reconcileMu.Lock()
+	defer r.reconcileMu.Unlock()
+
+	desired, err := LoadSourcesFile(r.config.SourcesFile)
+	if err != nil {
+		return nil, fmt.Errorf("load sources file: %w", err)
+	}
+
+	plan, err := r.planSources(desired)
+	if err != nil {
+		return nil, fmt.Errorf("plan sources: %w", err)
+	}
+
+	r.logger.Info().
+		Str("file", r.config.SourcesFile).
+		Bool("dry_run", r.config.SourcesFileDryRun).
+		Strs("create", plan.Create).
+		Strs("update", plan.Update).
+		Strs("adopt", plan.Adopt).
+		Strs("remove", plan.Remove).
+		Msg("Sources file plan")
+
+	if r.config.SourcesFileDryRun || plan.Empty() {
+		return plan, nil
+	}
+
+	if err := r.applySources(desired, plan); err != nil {
+		return plan, fmt.Errorf("apply sources: %w", err)
+	}
+
+	return plan, nil
+}
+
+func (r *Registry) planSources(desired []Source) (*SourcesPlan, error) {
+	persisted, err := r.sourceRepo.List()
+	if err != nil {
+		return nil, fmt.Errorf("list sources: %w", err)
+	}
+
+	managed, err := r.sourceRepo.Managed()
+	if err != nil {
+		return nil, fmt.Errorf("list managed sources: %w", err)
+	}
+
+	// Sources that can't be decoded are replaced by their definition in the file.
+	undecodable, err := r.sourceRepo.Undecodable()
+	if err != nil {
+		return nil, fmt.Errorf("list undecodable sources: %w", err)
+	}
+
+	existing := make(map[string]Source, len(persisted))
+	for _, source := range persisted {
+		existing[source.UID()] = source
+	}
+
+	plan := &SourcesPlan{}
+	defined := make(map[string]bool, len(desired))
+	for _, source := range desired {
+		uid := source.UID()
+		defined[uid] = true
+
+		if undecodable[uid] != nil {
+			if err := source.Initialize(); err != nil {
+				return nil, fmt.Errorf("source '%s': %w: %w", uid, ErrInvalidSource, err)
+			}
+			if !managed[uid] {
+				plan.Adopt = append(plan.Adopt, uid)
+			}
+			plan.Update = append(plan.Update, uid)
+			continue
+		}
+
+		current, ok := existing[uid]
+		if !ok {
+			if err := source.Initialize(); err != nil {
+				return nil, fmt.Errorf("source '%s': %w: %w", uid, ErrInvalidSource, err)
+			}
+			plan.Create = append(plan.Create, uid)
+			continue
+		}
+
+		if !managed[uid] {
+			plan.Adopt = append(plan.Adopt, uid)
+		}
+
+		// Sources in the file don't set the generated ingest token, which isn't a change of their config.
+		ensureIngestToken(source, current)
+
+		changed, err := configChanged(current, source)
+		if err != nil {
+			return nil, fmt.Errorf("source '%s': %w", uid, err)
+		}
+		if changed {
+			if err := source.Initialize(); err != nil {
+				return nil, fmt.Errorf("source '%s': %w: %w", uid, ErrInvalidSource, err)
+			}
+			plan.Update = append(plan.Update, uid)
+		}
+	}
+
+	// Managed sources include those that can't be decoded.
+	for uid := range managed {
+		if !defined[uid] {
+			plan.Remove = append(plan.Remove, uid)
+		}
+	}
+	sort.Strings(plan.Remove)
+
+	return plan, nil
+}
+
+// applySources applies as much of the plan as possible, returning all errors.
+func (r *Registry) applySources(desired []Source, plan *SourcesPlan) error {
+	byUID := make(map[string]Source, len(desired))
+	for _, source := range desired {
+		byUID[source.UID()] = source
+	}
+
+	var errs []error
+
+	for _, uid := range plan.Create {
+		if err := r.add(byUID[uid]); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if err := r.sourceRepo.SetManaged(uid, true); err != nil {
+			errs = append(errs, fmt.Errorf("source '%s': mark managed: %w", uid, err))
+		}
+	}
+
+	for _, uid := range plan.Adopt {
+		if err := r.sourceRepo.SetManaged(uid, true); err != nil {
+			errs = append(errs, fmt.Errorf("source '%s': mark managed: %w", uid, err))
+		}
+	}
+
+	for _, uid := range plan.Update {
+		if err := r.replace(byUID[uid]); err != nil {
+			errs = append(errs, fmt.Errorf("source '%s': %w", uid, err))
+		}
+	}
+
+	for _, uid := range plan.Remove {
+		if err := r.remove(uid); err != nil {
+			errs = append(errs, fmt.Errorf("source '%s': %w", uid, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func configChanged(current, desired Source) (bool, error) {
+	a, err := json.Marshal(current)
+	if err != nil {
+		return false, fmt.Errorf("marshal source: %w", err)
+	}
+
+	b, err := json.Marshal(desired)
+	if err != nil {
+		return false, fmt.Errorf("marshal source: %w", err)
+	}
+
+	return !bytes.Equal(a, b), nil
+}
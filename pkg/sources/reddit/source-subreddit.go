@@ -7,10 +7,13 @@ import (
 	"fmt"
 	"html"
 	"log/slog"
+	"net/url"
 	"strings"
 	"time"
 
+	"github.com/glanceapp/glance/pkg/sources"
 	"github.com/glanceapp/glance/pkg/sources/activities/types"
+	"github.com/glanceapp/glance/pkg/utils"
 
 	"github.com/go-shiori/go-readability"
 	"github.com/vartanbeno/go-reddit/v2/reddit"
@@ -18,17 +21,36 @@ import (
 
 const TypeRedditSubreddit = "reddit-subreddit"
 
+func init() {
+	sources.RegisterSourceType(sources.SourceType{
+		Type:         TypeRedditSubreddit,
+		Description:  "Posts in a subreddit, optionally filtered by a search query.",
+		NewSource:    func() sources.Source { return NewSourceSubreddit() },
+		NewActivity:  func() types.Activity { return NewPost() },
+		PollInterval: 15 * time.Minute,
+		Examples: []map[string]any{
+			{"subreddit": "golang", "sort-by": "hot", "top-period": "day"},
+			{"subreddit": "programming", "sort-by": "top", "top-period": "week", "search": "rust"},
+		},
+		FromFeedURL: sourceFromFeedURL,
+	})
+}
+
+// backfillPageSize is the maximum page size of the Reddit API.
+const backfillPageSize = 100
+
 type SourceSubreddit struct {
-	Subreddit          string `json:"subreddit"`
-	SortBy             string `json:"sort-by"`
-	TopPeriod          string `json:"top-period"`
-	Search             string `json:"search"`
-	RequestURLTemplate string `json:"request-url-template"`
+	types.SourceBase
+	Subreddit          string `json:"subreddit" jsonschema:"required" jsonschema_description:"Subreddit name without the r/ prefix."`
+	SortBy             string `json:"sort-by" jsonschema:"required,enum=hot,enum=new,enum=top,enum=rising"`
+	TopPeriod          string `json:"top-period" jsonschema:"required,enum=hour,enum=day,enum=week,enum=month,enum=year,enum=all"`
+	Search             string `json:"search" jsonschema_description:"Only include posts matching the search query."`
+	RequestURLTemplate string `json:"request-url-template" jsonschema_description:"Proxy URL with a {REQUEST-URL} placeholder."`
 	client             *reddit.Client
 	AppAuth            struct {
 		Name   string `json:"name"`
 		ID     string `json:"ID"`
-		Secret string `json:"secret"`
+		Secret string `json:"secret" jsonschema:"secret"`
 	} `json:"auth"`
 }
 
@@ -52,6 +74,55 @@ func (s *SourceSubreddit) Type() string {
 	return TypeRedditSubreddit
 }
 
+func (s *SourceSubreddit) SubscriptionURL() string {
+	out := fmt.Sprintf("https://www.reddit.com/r/%s/%s/.rss", s.Subreddit, s.SortBy)
+	if s.SortBy == "top" {
+		out += "?t=" + url.QueryEscape(s.TopPeriod)
+	}
+	return out
+}
+
+// sourceFromFeedURL maps https://www.reddit.com/r/{subreddit}/[{sort}/].rss feeds.
+func sourceFromFeedURL(u *url.URL, base types.SourceBase) sources.Source {
+	host := strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+	path := strings.Split(strings.Trim(u.Path, "/"), "/")
+	if host != "reddit.com" && host != "old.reddit.com" || len(path) < 2 || path[0] != "r" {
+		return nil
+	}
+
+	subreddit, sortBy, ok := parseFeedPath(path[1:])
+	if !ok {
+		return nil
+	}
+
+	out := NewSourceSubreddit()
+	out.SourceBase = base
+	out.Subreddit = subreddit
+	out.SortBy = sortBy
+	out.TopPeriod = "day"
+	if t := u.Query().Get("t"); t != "" {
+		out.TopPeriod = t
+	}
+	return out
+}
+
+// parseFeedPath parses the path of a subreddit feed after the "/r/" prefix.
+func parseFeedPath(path []string) (subreddit, sortBy string, ok bool) {
+	last := path[len(path)-1]
+	switch {
+	case len(path) == 1 && strings.HasSuffix(last, ".rss"):
+		return strings.TrimSuffix(last, ".rss"), "hot", true
+	case len(path) == 2 && last == ".rss":
+		return path[0], "hot", true
+	case len(path) == 2 && strings.HasSuffix(last, ".rss"):
+		return path[0], strings.TrimSuffix(last, ".rss"), true
+	case len(path) == 3 && last == ".rss":
+		return path[0], path[1], true
+	default:
+		return "", "", false
+	}
+}
+
 type Post struct {
 	Post      *reddit.Post `json:"post"`
 	SourceID  string       `json:"source_id"`
@@ -110,6 +181,11 @@ func (p *Post) Body() string {
 	return body
 }
 
+// ContentHash avoids fetching the referenced article just to detect changes.
+func (p *Post) ContentHash() string {
+	return types.HashContent(p.Title(), p.Post.Body, p.Post.URL)
+}
+
 func (p *Post) URL() string {
 	// TODO(pulse): Test format
 	return "https://www.reddit.com" + p.Post.Permalink
@@ -154,9 +230,9 @@ func (s *SourceSubreddit) Initialize() error {
 		client, err = reddit.NewClient(reddit.Credentials{
 			ID:     s.AppAuth.ID,
 			Secret: s.AppAuth.Secret,
-		})
+		}, reddit.WithHTTPClient(utils.NewHTTPClient(0)))
 	} else {
-		client, err = reddit.NewReadonlyClient()
+		client, err = reddit.NewReadonlyClient(reddit.WithHTTPClient(utils.NewHTTPClient(0)))
 	}
 
 	if err != nil {
@@ -172,7 +248,7 @@ func (s *SourceSubreddit) Stream(ctx context.Context, feed chan<- types.Activity
 	posts, err := s.fetchSubredditPosts(ctx)
 
 	if err != nil {
-		errs <- fmt.Errorf("fetch posts: %v", err)
+		errs <- fmt.Errorf("fetch posts: %w", wrapError(err))
 		return
 	}
 
@@ -181,51 +257,68 @@ func (s *SourceSubreddit) Stream(ctx context.Context, feed chan<- types.Activity
 	}
 }
 
-func (s *SourceSubreddit) fetchSubredditPosts(ctx context.Context) ([]*Post, error) {
-	var posts []*reddit.Post
-	var err error
+// BackfillPage lists posts in the configured order, following the "after" cursor of the Reddit API.
+// Only the "new" order is chronological, so time windows are approximate for the others.
+func (s *SourceSubreddit) BackfillPage(ctx context.Context, cursor string) ([]types.Activity, string, error) {
+	posts, next, err := s.listPosts(ctx, reddit.ListOptions{Limit: backfillPageSize, After: cursor})
+	if err != nil {
+		return nil, "", fmt.Errorf("fetch posts: %w", wrapError(err))
+	}
+
+	out := make([]types.Activity, len(posts))
+	for i, post := range posts {
+		out[i] = post
+	}
 
-	limit := 10
+	return out, next, nil
+}
 
-	opts := &reddit.ListOptions{
-		Limit: limit,
+func (s *SourceSubreddit) fetchSubredditPosts(ctx context.Context) ([]*Post, error) {
+	posts, _, err := s.listPosts(ctx, reddit.ListOptions{Limit: 10})
+	if err != nil {
+		return nil, err
 	}
 
+	if len(posts) == 0 {
+		return nil, fmt.Errorf("no posts found")
+	}
+
+	return posts, nil
+}
+
+// listPosts returns the page of posts and the cursor of the next page.
+func (s *SourceSubreddit) listPosts(ctx context.Context, opts reddit.ListOptions) ([]*Post, string, error) {
+	var posts []*reddit.Post
+	var res *reddit.Response
+	var err error
+
 	if s.Search != "" {
 		searchOpts := &reddit.ListPostSearchOptions{
 			ListPostOptions: reddit.ListPostOptions{
-				ListOptions: reddit.ListOptions{
-					Limit: limit,
-				},
+				ListOptions: opts,
 			},
 			Sort: s.SortBy,
 		}
-		posts, _, err = s.client.Subreddit.SearchPosts(ctx, s.Subreddit, s.Search, searchOpts)
+		posts, res, err = s.client.Subreddit.SearchPosts(ctx, s.Subreddit, s.Search, searchOpts)
 	} else {
 		switch s.SortBy {
 		case "hot":
-			posts, _, err = s.client.Subreddit.HotPosts(ctx, s.Subreddit, opts)
+			posts, res, err = s.client.Subreddit.HotPosts(ctx, s.Subreddit, &opts)
 		case "new":
-			posts, _, err = s.client.Subreddit.NewPosts(ctx, s.Subreddit, opts)
+			posts, res, err = s.client.Subreddit.NewPosts(ctx, s.Subreddit, &opts)
 		case "top":
 			topOpts := &reddit.ListPostOptions{
-				ListOptions: reddit.ListOptions{
-					Limit: limit,
-				},
-				Time: s.TopPeriod,
+				ListOptions: opts,
+				Time:        s.TopPeriod,
 			}
-			posts, _, err = s.client.Subreddit.TopPosts(ctx, s.Subreddit, topOpts)
+			posts, res, err = s.client.Subreddit.TopPosts(ctx, s.Subreddit, topOpts)
 		case "rising":
-			posts, _, err = s.client.Subreddit.RisingPosts(ctx, s.Subreddit, opts)
+			posts, res, err = s.client.Subreddit.RisingPosts(ctx, s.Subreddit, &opts)
 		}
 	}
 
 	if err != nil {
-		return nil, fmt.Errorf("fetching posts: %v", err)
-	}
-
-	if len(posts) == 0 {
-		return nil, fmt.Errorf("no posts found")
+		return nil, "", fmt.Errorf("fetching posts: %w", err)
 	}
 
 	redditPosts := make([]*Post, 0, len(posts))
@@ -237,7 +330,12 @@ func (s *SourceSubreddit) fetchSubredditPosts(ctx context.Context) ([]*Post, err
 		redditPosts = append(redditPosts, &Post{Post: post, SourceTyp: s.Type(), SourceID: s.UID()})
 	}
 
-	return redditPosts, nil
+	var next string
+	if res != nil {
+		next = res.After
+	}
+
+	return redditPosts, next, nil
 }
 
 func (s *SourceSubreddit) MarshalJSON() ([]byte, error) {
@@ -264,3 +362,21 @@ func (s *SourceSubreddit) UnmarshalJSON(data []byte) error {
 	}
 	return nil
 }
+
+// wrapError converts Reddit API errors to utils.HTTPError,
+// so that the retry policy can honor rate limit resets.
+func wrapError(err error) error {
+	var rateLimitErr *reddit.RateLimitError
+	if errors.As(err, &rateLimitErr) {
+		out := utils.NewHTTPError(rateLimitErr.Response, rateLimitErr.Message)
+		out.Wait = max(out.Wait, time.Until(rateLimitErr.Rate.Reset))
+		return out
+	}
+
+	var responseErr *reddit.ErrorResponse
+	if errors.As(err, &responseErr) {
+		return utils.NewHTTPError(responseErr.Response, responseErr.Message)
+	}
+
+	return err
+}
@@ -2,23 +2,54 @@ package sources
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math/rand/v2"
 	"sort"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/glanceapp/glance/pkg/sources/activities/types"
+	"github.com/glanceapp/glance/pkg/utils"
 
 	"github.com/rs/zerolog"
 )
 
+// restoreSpread is the time window over which the first fetches of restored sources are spread.
+const restoreSpread = 2 * time.Minute
+
 type Registry struct {
 	sourceRepo   sourceStore
 	activityRepo activityStore
-
-	cancelBySourceID sync.Map
-	activityQueue    chan types.Activity
-	errorQueue       chan error
-	done             chan struct{}
+	jobRepo      jobStore
+	webSubRepo   webSubStore
+
+	scheduler     *Scheduler
+	activityQueue chan types.Activity
+	errorQueue    chan error
+	jobsQueued    chan struct{}
+	done          chan struct{}
+
+	config            Config
+	reconcileMu       sync.Mutex
+	backfillsMu       sync.Mutex
+	backfills         map[string]bool
+	backfillSlots     chan struct{}
+	websubsMu         sync.Mutex
+	webSubsPushed     map[string]bool
+	listenersMu       sync.Mutex
+	listeners         map[string]context.CancelFunc
+	retentionMu       sync.Mutex
+	lastRetentionRun  *RetentionRun
+	retentionCache    map[string]cachedRetention
+	stages            map[types.JobStage]*stageLimiter
+	busyWorkers       atomic.Int64
+	throttledEnqueues atomic.Int64
+
+	// Breakers stop LLM calls while the provider is failing.
+	summarizerBreaker *utils.CircuitBreaker
+	embedderBreaker   *utils.CircuitBreaker
 
 	logger     *zerolog.Logger
 	summarizer summarizer
@@ -27,16 +58,46 @@ type Registry struct {
 
 type sourceStore interface {
 	Add(source Source) error
+	Update(source Source) error
 	Remove(uid string) error
 	List() ([]Source, error)
+	Undecodable() (map[string]error, error)
 	GetByID(uid string) (Source, error)
+	GetByIDFold(uid string) ([]Source, error)
+	GetByIngestToken(token string) (Source, error)
+	RecordRun(run *types.SourceRun) error
+	Runs(uid string, limit int) ([]*types.SourceRun, error)
+	Health(uid string) (*types.SourceHealth, error)
+	SetEnabled(uid string, enabled bool) error
+	Paused() (map[string]bool, error)
+	SetManaged(uid string, managed bool) error
+	Managed() (map[string]bool, error)
 }
 
 type activityStore interface {
 	Add(activity *types.DecoratedActivity) error
-	Remove(uid string) error
+	Remove(id string) error
 	List() ([]*types.DecoratedActivity, error)
 	Search(req types.SearchRequest) ([]*types.DecoratedActivity, error)
+	ContentHash(sourceUID, uid string) (string, bool, error)
+	SetContentHash(sourceUID, uid, hash string) error
+	SetStarred(id string, starred bool) (bool, error)
+	Expired(filter types.RetentionFilter, archive bool) (types.RetentionStats, error)
+	Prune(filter types.RetentionFilter, limit int, archive bool) (types.RetentionStats, error)
+}
+
+type jobStore interface {
+	Enqueue(activity types.Activity) error
+	Claim(limit int, lease time.Duration) ([]*types.Job, error)
+	Save(job *types.Job) error
+	Complete(id string) error
+	Retry(id string, cause error, runAfter time.Time) error
+	Release(id string, runAfter time.Time) error
+	Fail(id string, cause error) error
+	Counts() (types.JobCounts, error)
+	DeadLetters() ([]*types.DeadLetter, error)
+	Requeue(ids ...string) (int, error)
+	Discard(ids ...string) (int, error)
 }
 
 type summarizer interface {
@@ -49,70 +110,270 @@ type embedder interface {
 
 func NewRegistry(
 	logger *zerolog.Logger,
+	config Config,
 	summarizer summarizer,
 	embedder embedder,
 	activityRepo activityStore,
 	sourceRepo sourceStore,
+	jobRepo jobStore,
+	webSubRepo webSubStore,
 ) *Registry {
 	r := &Registry{
-		activityRepo:  activityRepo,
-		sourceRepo:    sourceRepo,
-		activityQueue: make(chan types.Activity),
-		errorQueue:    make(chan error),
-		done:          make(chan struct{}),
-		logger:        logger,
-		summarizer:    summarizer,
-		embedder:      embedder,
+		activityRepo:      activityRepo,
+		sourceRepo:        sourceRepo,
+		jobRepo:           jobRepo,
+		webSubRepo:        webSubRepo,
+		activityQueue:     make(chan types.Activity),
+		errorQueue:        make(chan error),
+		jobsQueued:        make(chan struct{}, 1),
+		done:              make(chan struct{}),
+		logger:            logger,
+		summarizer:        summarizer,
+		embedder:          embedder,
+		config:            config,
+		summarizerBreaker: config.NewCircuitBreaker(),
+		embedderBreaker:   config.NewCircuitBreaker(),
+		backfills:         make(map[string]bool),
+		backfillSlots:     make(chan struct{}, config.BackfillConcurrency),
+		webSubsPushed:     make(map[string]bool),
+		listeners:         make(map[string]context.CancelFunc),
+		retentionCache:    make(map[string]cachedRetention),
+		stages: map[types.JobStage]*stageLimiter{
+			types.JobStageSummarize: newStageLimiter(types.JobStageSummarize, config.SummarizeConcurrency),
+			types.JobStageEmbed:     newStageLimiter(types.JobStageEmbed, config.EmbedConcurrency),
+			types.JobStageStore:     newStageLimiter(types.JobStageStore, config.StoreConcurrency),
+		},
+	}
+
+	for host, limit := range config.RateLimits {
+		utils.RateLimits.SetLimit(host, limit)
 	}
 
-	r.startWorkers(1)
+	r.scheduler = NewScheduler(logger, config, r.activityQueue, r.errorQueue, r.recordRun)
+	r.startIngestion()
+	r.startWorkers(config.Workers)
+	r.startPruning()
+	r.startWebSubSync()
 
 	return r
 }
 
+// Add creates the source and starts polling it.
+// In strict mode, sources can only be added through the sources file.
 func (r *Registry) Add(source Source) error {
+	if r.config.SourcesFileStrict {
+		return fmt.Errorf("source '%s': %w", source.UID(), ErrSourceManaged)
+	}
+
+	return r.add(source)
+}
+
+func (r *Registry) add(source Source) error {
 	existing, _ := r.sourceRepo.GetByID(source.UID())
 
 	if existing != nil {
-		return fmt.Errorf("source '%s' already exists", source.UID())
+		return fmt.Errorf("source '%s': %w", source.UID(), ErrSourceExists)
 	}
 
+	ensureIngestToken(source, nil)
+
 	if err := source.Initialize(); err != nil {
-		return fmt.Errorf("initialize source: %w", err)
+		return fmt.Errorf("%w: initialize source: %w", ErrInvalidSource, err)
 	}
 
-	ctx, cancel := context.WithCancel(context.Background())
-
-	go source.Stream(ctx, r.activityQueue, r.errorQueue)
-
 	err := r.sourceRepo.Add(source)
 	if err != nil {
-		cancel()
 		return fmt.Errorf("add source: %w", err)
 	}
-	r.cancelBySourceID.Store(source.UID(), cancel)
+	r.invalidateRetention(source.UID())
+
+	r.scheduler.Schedule(source)
+	r.subscribe(source)
+
+	return nil
+}
+
+// Restore initializes and schedules all persisted sources, except paused ones.
+// Sources that can't be decoded or fail to initialize are reported in their health and skipped,
+// so that a single misconfigured source doesn't prevent the rest from being polled.
+func (r *Registry) Restore() error {
+	persisted, err := r.sourceRepo.List()
+	if err != nil {
+		return fmt.Errorf("list sources: %w", err)
+	}
+
+	undecodable, err := r.sourceRepo.Undecodable()
+	if err != nil {
+		return fmt.Errorf("list undecodable sources: %w", err)
+	}
+
+	for uid, err := range undecodable {
+		r.reportRestoreError(uid, err, "Failed to decode persisted source")
+	}
+
+	paused, err := r.sourceRepo.Paused()
+	if err != nil {
+		return fmt.Errorf("list paused sources: %w", err)
+	}
+
+	restored := 0
+	for _, source := range persisted {
+		// Sources created before ingest tokens get one, persisted so that it doesn't change on every start.
+		if ensureIngestToken(source, nil) {
+			if err := r.sourceRepo.Update(source); err != nil {
+				r.reportRestoreError(source.UID(), err, "Failed to persist ingest token of source")
+				continue
+			}
+		}
+
+		if paused[source.UID()] {
+			continue
+		}
+
+		if err := source.Initialize(); err != nil {
+			r.reportRestoreError(source.UID(), err, "Failed to initialize persisted source")
+			continue
+		}
+
+		// Spread out the first fetches, so that all sources aren't polled at once on startup.
+		// Subscriptions are delayed as well, so that hubs can reach the server once it's listening.
+		delay := rand.N(restoreSpread)
+		r.scheduler.ScheduleAfter(source, delay)
+		r.subscribeAfter(source, delay)
+		restored++
+	}
+
+	r.logger.Info().Msgf(
+		"Restored %d of %d persisted sources (%d paused, %d undecodable)",
+		restored, len(persisted)+len(undecodable), len(paused), len(undecodable),
+	)
 
 	return nil
 }
 
+// reportRestoreError logs a source that couldn't be restored, and records it as a failed run,
+// so that the source shows up as failing in its health and run history.
+func (r *Registry) reportRestoreError(uid string, err error, msg string) {
+	r.logger.Error().Err(err).Str("source", uid).Msg(msg)
+
+	now := time.Now()
+	r.recordRun(&types.SourceRun{
+		SourceUID:  uid,
+		StartedAt:  now,
+		FinishedAt: now,
+		Error:      err.Error(),
+	})
+}
+
+// Remove stops polling the source and deletes it.
+// Sources defined in the sources file must be removed from the file instead.
 func (r *Registry) Remove(uid string) error {
-	existing, _ := r.sourceRepo.GetByID(uid)
+	existing, err := r.sourceRepo.GetByID(uid)
+	// Sources that can't be decoded can still be removed.
+	if err != nil && !errors.Is(err, ErrUndecodableSource) {
+		return fmt.Errorf("get source: %w", err)
+	}
 
-	if existing != nil {
-		return fmt.Errorf("source '%s' not found", uid)
+	if existing == nil && err == nil {
+		return fmt.Errorf("source '%s': %w", uid, ErrSourceNotFound)
 	}
 
-	cancel, ok := r.cancelBySourceID.Load(uid)
-	if !ok {
-		return fmt.Errorf("source '%s' cancel func not found", uid)
+	if err := r.checkUnmanaged(uid); err != nil {
+		return err
 	}
-	cancel.(context.CancelFunc)()
-	r.cancelBySourceID.Delete(uid)
+
+	return r.remove(uid)
+}
+
+func (r *Registry) remove(uid string) error {
+	// Sources that failed to initialize on startup are not scheduled.
+	r.scheduler.Unschedule(uid)
+	r.unsubscribe(uid)
 
 	err := r.sourceRepo.Remove(uid)
 	if err != nil {
 		return fmt.Errorf("remove source: %w", err)
 	}
+	r.invalidateRetention(uid)
+
+	return nil
+}
+
+// Pause stops polling the source. Its config and activities are kept,
+// so that it stays listed and searchable until it's resumed.
+func (r *Registry) Pause(uid string) (Source, error) {
+	existing, err := r.sourceRepo.GetByID(uid)
+	if err != nil {
+		return nil, fmt.Errorf("get source: %w", err)
+	}
+
+	if existing == nil {
+		return nil, fmt.Errorf("source '%s': %w", uid, ErrSourceNotFound)
+	}
+
+	if err := r.sourceRepo.SetEnabled(uid, false); err != nil {
+		return nil, fmt.Errorf("disable source: %w", err)
+	}
+
+	r.scheduler.Unschedule(uid)
+	r.unsubscribe(uid)
+
+	return existing, nil
+}
+
+// Resume restarts polling of a paused source.
+func (r *Registry) Resume(uid string) (Source, error) {
+	existing, err := r.sourceRepo.GetByID(uid)
+	if err != nil {
+		return nil, fmt.Errorf("get source: %w", err)
+	}
+
+	if existing == nil {
+		return nil, fmt.Errorf("source '%s': %w", uid, ErrSourceNotFound)
+	}
+
+	paused, err := r.sourceRepo.Paused()
+	if err != nil {
+		return nil, fmt.Errorf("list paused sources: %w", err)
+	}
+
+	if !paused[uid] {
+		return existing, nil
+	}
+
+	if err := existing.Initialize(); err != nil {
+		return nil, fmt.Errorf("%w: initialize source: %w", ErrInvalidSource, err)
+	}
+
+	if err := r.sourceRepo.SetEnabled(uid, true); err != nil {
+		return nil, fmt.Errorf("enable source: %w", err)
+	}
+
+	r.scheduler.Schedule(existing)
+	r.subscribe(existing)
+
+	return existing, nil
+}
+
+// Paused returns the UIDs of paused sources.
+func (r *Registry) Paused() (map[string]bool, error) {
+	return r.sourceRepo.Paused()
+}
+
+// Managed returns the UIDs of sources defined in the sources file.
+func (r *Registry) Managed() (map[string]bool, error) {
+	return r.sourceRepo.Managed()
+}
+
+func (r *Registry) checkUnmanaged(uid string) error {
+	managed, err := r.sourceRepo.Managed()
+	if err != nil {
+		return fmt.Errorf("list managed sources: %w", err)
+	}
+
+	if managed[uid] {
+		return fmt.Errorf("source '%s': %w", uid, ErrSourceManaged)
+	}
 
 	return nil
 }
@@ -125,6 +386,27 @@ func (r *Registry) Source(uid string) (Source, error) {
 	return r.sourceRepo.GetByID(uid)
 }
 
+// Health returns the health of the source, or nil if the source doesn't exist.
+func (r *Registry) Health(uid string) (*types.SourceHealth, error) {
+	return r.sourceRepo.Health(uid)
+}
+
+// Runs returns the latest fetches of the source, newest first.
+func (r *Registry) Runs(uid string, limit int) ([]*types.SourceRun, error) {
+	return r.sourceRepo.Runs(uid, limit)
+}
+
+func (r *Registry) recordRun(run *types.SourceRun) {
+	if err := r.sourceRepo.RecordRun(run); err != nil {
+		r.logger.Error().Err(err).Str("source", run.SourceUID).Msg("Error recording source run")
+	}
+}
+
+// NextRun returns the time of the next scheduled fetch of the source.
+func (r *Registry) NextRun(uid string) (time.Time, bool) {
+	return r.scheduler.NextRun(uid)
+}
+
 func (r *Registry) Activities() ([]*types.DecoratedActivity, error) {
 	matches, err := r.activityRepo.List()
 	if err != nil {
@@ -154,62 +436,10 @@ func (r *Registry) ActivitiesBySource(sourceUID string) ([]*types.DecoratedActiv
 	return matches, nil
 }
 
-func (r *Registry) startWorkers(nWorkers int) {
-	var wg sync.WaitGroup
-
-	for i := 0; i < nWorkers; i++ {
-		wg.Add(1)
-		go func(workerID int) {
-			defer wg.Done()
-			r.logger.Info().Msgf("Worker %d starting\n", workerID)
-			for {
-				select {
-				case act := <-r.activityQueue:
-					r.logger.Info().Msgf("[Worker %d] Processing activity %s\n", workerID, act.UID())
-
-					summary, err := r.summarizer.Summarize(context.Background(), act)
-					if err != nil {
-						r.logger.Error().Err(err).Msgf("[Worker %d] Error summarizing activity %v\n", workerID, err)
-						continue
-					}
-
-					// Compute embedding for the full summary
-					embedding, err := r.embedder.Embed(context.Background(), summary)
-					if err != nil {
-						r.logger.Error().Err(err).Msgf("[Worker %d] Error computing embedding %v\n", workerID, err)
-						continue
-					}
-
-					err = r.activityRepo.Add(&types.DecoratedActivity{
-						Activity:  act,
-						Summary:   summary,
-						Embedding: embedding,
-					})
-					if err != nil {
-						r.logger.Error().Err(err).Msgf("[Worker %d] Error storing activity %v\n", workerID, err)
-					}
-
-				case err := <-r.errorQueue:
-					r.logger.Error().Err(err).Msgf("[Worker %d] Error processing activity %v\n", workerID, err)
-
-				case <-r.done:
-					r.logger.Info().Msgf("Worker %d shutting down\n", workerID)
-					return
-				}
-			}
-		}(i + 1)
-	}
-}
-
 func (r *Registry) Shutdown() {
 	close(r.done)
 
-	r.cancelBySourceID.Range(func(key, value interface{}) bool {
-		cancel := value.(context.CancelFunc)
-		cancel()
-		return true
-	})
-	r.cancelBySourceID.Clear()
+	r.scheduler.Stop()
 }
 
 func (r *Registry) Search(ctx context.Context, query string, sourceUIDs []string, minSimilarity float32, limit int, sortBy types.SortBy) ([]*types.DecoratedActivity, error) {
@@ -0,0 +1,328 @@
+package sources
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/glanceapp/glance/pkg/sources/activities/types"
+)
+
+const (
+	// RetentionModeDelete deletes expired activities.
+	RetentionModeDelete = "delete"
+	// RetentionModeArchive drops the embeddings of expired activities, which take up most of their storage,
+	// but keeps them listed. Archived activities are no longer found by semantic search.
+	RetentionModeArchive = "archive"
+)
+
+// retentionCacheTTL bounds how long the retention of a source is cached,
+// so that changes of the source through another replica are picked up.
+const retentionCacheTTL = 5 * time.Minute
+
+var ErrActivityNotFound = errors.New("activity not found")
+
+// RetentionRules maps source types to the retention of their activities.
+type RetentionRules map[string]types.Retention
+
+// Decode parses comma separated rules like "reddit-subreddit=30d,github-releases=forever".
+func (c *RetentionRules) Decode(in string) error {
+	out := make(RetentionRules)
+
+	for _, entry := range strings.Split(in, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		sourceType, value, ok := strings.Cut(entry, "=")
+		if !ok {
+			return fmt.Errorf("invalid retention rule %q: expected type=retention", entry)
+		}
+
+		retention, err := types.ParseRetention(value)
+		if err != nil {
+			return fmt.Errorf("invalid retention rule %q: %w", entry, err)
+		}
+
+		out[strings.TrimSpace(sourceType)] = retention
+	}
+
+	*c = out
+	return nil
+}
+
+// RetentionRule is a retention rule and the activities that expired under it.
+// Rules of sources take precedence over rules of source types, which take precedence over the default.
+type RetentionRule struct {
+	// SourceUID is set for rules of a source, and SourceType for rules of a source type.
+	SourceUID     string
+	SourceType    string
+	Retention     types.Retention
+	CreatedBefore time.Time
+	Expired       types.RetentionStats
+
+	filter types.RetentionFilter
+}
+
+// RetentionRun is the outcome of pruning expired activities.
+type RetentionRun struct {
+	StartedAt  time.Time
+	FinishedAt time.Time
+	Pruned     types.RetentionStats
+	// Error is the error the run stopped at, empty if it completed.
+	Error string
+}
+
+// RetentionPreview lists the expired activities the next run would prune.
+type RetentionPreview struct {
+	Mode           string
+	KeepStarred    bool
+	KeepReferenced bool
+	Rules          []RetentionRule
+	Total          types.RetentionStats
+	// LastRun is nil until the first run completes.
+	LastRun *RetentionRun
+}
+
+// RetentionPreview returns what pruning would reclaim now, and the outcome of the last run.
+func (r *Registry) RetentionPreview() (*RetentionPreview, error) {
+	rules, err := r.retentionRules(time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	out := &RetentionPreview{
+		Mode:           r.config.RetentionMode,
+		KeepStarred:    r.config.RetentionKeepStarred,
+		KeepReferenced: r.config.RetentionKeepReferenced,
+	}
+
+	for i := range rules {
+		rules[i].Expired, err = r.activityRepo.Expired(rules[i].filter, r.archiving())
+		if err != nil {
+			return nil, fmt.Errorf("count expired activities: %w", err)
+		}
+		out.Total.Add(rules[i].Expired)
+	}
+	out.Rules = rules
+
+	r.retentionMu.Lock()
+	out.LastRun = r.lastRetentionRun
+	r.retentionMu.Unlock()
+
+	return out, nil
+}
+
+// SetStarred stars or unstars the activity with the ID. Starred activities are kept by retention rules,
+// unless Config.RetentionKeepStarred is unset.
+func (r *Registry) SetStarred(id string, starred bool) error {
+	found, err := r.activityRepo.SetStarred(id, starred)
+	if err != nil {
+		return fmt.Errorf("set starred: %w", err)
+	}
+
+	if !found {
+		return fmt.Errorf("activity '%s': %w", id, ErrActivityNotFound)
+	}
+
+	return nil
+}
+
+// startPruning periodically prunes expired activities, starting once restored sources had time to start up.
+func (r *Registry) startPruning() {
+	go func() {
+		delay := restoreSpread
+		for {
+			select {
+			case <-time.After(delay):
+			case <-r.done:
+				return
+			}
+
+			r.prune()
+			delay = r.config.RetentionInterval
+		}
+	}()
+}
+
+// prune deletes or archives expired activities in batches, so that the activity table isn't locked for long.
+func (r *Registry) prune() {
+	run := &RetentionRun{StartedAt: time.Now()}
+	defer func() {
+		run.FinishedAt = time.Now()
+
+		r.retentionMu.Lock()
+		r.lastRetentionRun = run
+		r.retentionMu.Unlock()
+
+		if run.Error != "" {
+			r.logger.Error().Str("error", run.Error).Int("activities", run.Pruned.Activities).Msg("Failed to prune expired activities")
+		} else if run.Pruned.Activities > 0 {
+			r.logger.Info().
+				Str("mode", r.config.RetentionMode).
+				Int("activities", run.Pruned.Activities).
+				Int64("bytes", run.Pruned.Bytes).
+				Msg("Pruned expired activities")
+		}
+	}()
+
+	rules, err := r.retentionRules(run.StartedAt)
+	if err != nil {
+		run.Error = err.Error()
+		return
+	}
+
+	for _, rule := range rules {
+		for {
+			select {
+			case <-r.done:
+				run.Error = "registry is stopped"
+				return
+			default:
+			}
+
+			pruned, err := r.activityRepo.Prune(rule.filter, r.config.RetentionBatchSize, r.archiving())
+			if err != nil {
+				run.Error = fmt.Sprintf("prune activities: %s", err)
+				return
+			}
+
+			run.Pruned.Add(pruned)
+			if pruned.Activities < r.config.RetentionBatchSize {
+				break
+			}
+		}
+	}
+}
+
+// retentionRules returns the rules with a finite retention, most specific first.
+func (r *Registry) retentionRules(now time.Time) ([]RetentionRule, error) {
+	persisted, err := r.sourceRepo.List()
+	if err != nil {
+		return nil, fmt.Errorf("list sources: %w", err)
+	}
+
+	var out []RetentionRule
+	newRule := func(retention types.Retention, filter types.RetentionFilter) RetentionRule {
+		cutoff := now.Add(-time.Duration(retention))
+		filter.CreatedBefore = cutoff
+		filter.KeepStarred = r.config.RetentionKeepStarred
+		filter.KeepReferenced = r.config.RetentionKeepReferenced
+		// Activities archived before switching to delete mode are deleted as well.
+		filter.Archived = !r.archiving()
+
+		return RetentionRule{
+			SourceUID:     filter.SourceUID,
+			SourceType:    filter.SourceType,
+			Retention:     retention,
+			CreatedBefore: cutoff,
+			filter:        filter,
+		}
+	}
+
+	// Activities of sources with their own retention are excluded from the rules of their type and the default.
+	var overridden []string
+	for _, source := range persisted {
+		retention := Retention(source)
+		if retention == 0 {
+			continue
+		}
+
+		overridden = append(overridden, source.UID())
+		if retention.Finite() {
+			out = append(out, newRule(retention, types.RetentionFilter{SourceUID: source.UID()}))
+		}
+	}
+
+	typed := make([]string, 0, len(r.config.RetentionTypes))
+	for sourceType := range r.config.RetentionTypes {
+		typed = append(typed, sourceType)
+	}
+	sort.Strings(typed)
+
+	for _, sourceType := range typed {
+		if retention := r.config.RetentionTypes[sourceType]; retention.Finite() {
+			out = append(out, newRule(retention, types.RetentionFilter{
+				SourceType:        sourceType,
+				ExcludeSourceUIDs: overridden,
+			}))
+		}
+	}
+
+	if r.config.RetentionDefault.Finite() {
+		out = append(out, newRule(r.config.RetentionDefault, types.RetentionFilter{
+			ExcludeSourceUIDs:  overridden,
+			ExcludeSourceTypes: typed,
+		}))
+	}
+
+	return out, nil
+}
+
+// retentionOf returns the effective retention of activities of the source.
+func (r *Registry) retentionOf(sourceUID, sourceType string) types.Retention {
+	if retention := r.sourceRetention(sourceUID); retention != 0 {
+		return retention
+	}
+
+	if retention, ok := r.config.RetentionTypes[sourceType]; ok {
+		return retention
+	}
+
+	return r.config.RetentionDefault
+}
+
+type cachedRetention struct {
+	retention types.Retention
+	expiresAt time.Time
+}
+
+// sourceRetention returns the retention in the config of the source, zero if it has none.
+// It's cached, since it's checked for every fetched activity.
+func (r *Registry) sourceRetention(uid string) types.Retention {
+	r.retentionMu.Lock()
+	cached, ok := r.retentionCache[uid]
+	r.retentionMu.Unlock()
+
+	if ok && time.Now().Before(cached.expiresAt) {
+		return cached.retention
+	}
+
+	source, err := r.sourceRepo.GetByID(uid)
+	if err != nil {
+		return 0
+	}
+
+	var retention types.Retention
+	if source != nil {
+		retention = Retention(source)
+	}
+
+	r.retentionMu.Lock()
+	r.retentionCache[uid] = cachedRetention{retention: retention, expiresAt: time.Now().Add(retentionCacheTTL)}
+	r.retentionMu.Unlock()
+
+	return retention
+}
+
+// invalidateRetention drops the cached retention of the source, once it's added, updated or removed.
+func (r *Registry) invalidateRetention(uid string) {
+	r.retentionMu.Lock()
+	defer r.retentionMu.Unlock()
+
+	delete(r.retentionCache, uid)
+}
+
+// expired reports whether the activity is older than the retention of its source,
+// so that activities that were pruned aren't stored again when sources keep returning them.
+func (r *Registry) expired(act types.Activity) bool {
+	retention := r.retentionOf(act.SourceUID(), act.SourceType())
+	return retention.Finite() && act.CreatedAt().Before(time.Now().Add(-time.Duration(retention)))
+}
+
+func (r *Registry) archiving() bool {
+	return r.config.RetentionMode == RetentionModeArchive
+}
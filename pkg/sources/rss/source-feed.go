@@ -3,6 +3,7 @@ package rss
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"html"
 	"net/http"
@@ -11,6 +12,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/glanceapp/glance/pkg/sources"
 	"github.com/glanceapp/glance/pkg/sources/activities/types"
 	"github.com/glanceapp/glance/pkg/utils"
 
@@ -20,6 +22,21 @@ import (
 
 const TypeRSSFeed = "rss-feed"
 
+func init() {
+	sources.RegisterSourceType(sources.SourceType{
+		Type:         TypeRSSFeed,
+		Description:  "Items of an RSS or Atom feed.",
+		NewSource:    func() sources.Source { return NewSourceFeed() },
+		NewActivity:  func() types.Activity { return NewFeedItem() },
+		PollInterval: 30 * time.Minute,
+		Examples:     []map[string]any{{"url": "https://go.dev/blog/feed.atom"}},
+		// Feeds of services with a native source type are mapped to that type instead,
+		// since it provides richer activities.
+		FromFeedURL:    sourceFromFeedURL,
+		AcceptsAnyFeed: true,
+	})
+}
+
 type customTransport struct {
 	headers map[string]string
 	base    http.RoundTripper
@@ -33,14 +50,22 @@ func (t *customTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 }
 
 type SourceFeed struct {
-	FeedURL string            `json:"url"`
-	Headers map[string]string `json:"headers"`
+	types.SourceBase
+	FeedURL string            `json:"url" jsonschema:"required,format=uri" jsonschema_description:"URL of the RSS or Atom feed."`
+	Headers map[string]string `json:"headers" jsonschema:"secret" jsonschema_description:"HTTP headers sent with feed requests."`
 }
 
 func NewSourceFeed() *SourceFeed {
 	return &SourceFeed{}
 }
 
+func sourceFromFeedURL(u *url.URL, base types.SourceBase) sources.Source {
+	out := NewSourceFeed()
+	out.SourceBase = base
+	out.FeedURL = u.String()
+	return out
+}
+
 func (s *SourceFeed) UID() string {
 	return fmt.Sprintf("%s/%s", s.Type(), s.FeedURL)
 }
@@ -57,6 +82,10 @@ func (s *SourceFeed) Type() string {
 	return TypeRSSFeed
 }
 
+func (s *SourceFeed) SubscriptionURL() string {
+	return s.FeedURL
+}
+
 func (s *SourceFeed) Initialize() error {
 	if s.FeedURL == "" {
 		return fmt.Errorf("URL is required")
@@ -65,21 +94,30 @@ func (s *SourceFeed) Initialize() error {
 	return nil
 }
 
-func (s *SourceFeed) Stream(ctx context.Context, feed chan<- types.Activity, errs chan<- error) {
-	parser := gofeed.NewParser()
-	parser.UserAgent = utils.PulseUserAgentString
-
+// httpClient returns a client that sends the configured headers with feed requests.
+func (s *SourceFeed) httpClient(timeout time.Duration) *http.Client {
+	client := utils.NewHTTPClient(timeout)
 	if s.Headers != nil {
-		parser.Client = &http.Client{
-			Transport: &customTransport{
-				headers: s.Headers,
-				base:    http.DefaultTransport,
-			},
+		client.Transport = &customTransport{
+			headers: s.Headers,
+			base:    client.Transport,
 		}
 	}
+	return client
+}
+
+func (s *SourceFeed) Stream(ctx context.Context, feed chan<- types.Activity, errs chan<- error) {
+	parser := gofeed.NewParser()
+	parser.UserAgent = utils.PulseUserAgentString
+	parser.Client = s.httpClient(0)
 
-	rssFeed, err := parser.ParseURL(s.FeedURL)
+	rssFeed, err := parser.ParseURLWithContext(s.FeedURL, ctx)
 	if err != nil {
+		var httpErr gofeed.HTTPError
+		if errors.As(err, &httpErr) {
+			// Expose the status code to the retry policy.
+			err = &utils.HTTPError{StatusCode: httpErr.StatusCode, URL: s.FeedURL, Body: httpErr.Status}
+		}
 		errs <- fmt.Errorf("failed to parse RSS feed: %w", err)
 		return
 	}
@@ -94,9 +132,17 @@ func (s *SourceFeed) Stream(ctx context.Context, feed chan<- types.Activity, err
 		return
 	}
 
-	for _, item := range rssFeed.Items {
-		feed <- &FeedItem{Item: item, FeedURL: s.FeedURL, SourceTyp: s.Type(), SourceID: s.UID()}
+	for _, item := range s.feedItems(rssFeed) {
+		feed <- item
+	}
+}
+
+func (s *SourceFeed) feedItems(rssFeed *gofeed.Feed) []types.Activity {
+	out := make([]types.Activity, len(rssFeed.Items))
+	for i, item := range rssFeed.Items {
+		out[i] = &FeedItem{Item: item, FeedURL: s.FeedURL, SourceTyp: s.Type(), SourceID: s.UID()}
 	}
+	return out
 }
 
 type FeedItem struct {
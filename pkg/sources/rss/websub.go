@@ -0,0 +1,146 @@
+package rss
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/glanceapp/glance/pkg/sources"
+	"github.com/glanceapp/glance/pkg/sources/activities/types"
+	"github.com/glanceapp/glance/pkg/utils"
+
+	"github.com/mmcdole/gofeed"
+)
+
+var _ sources.WebSubSource = (*SourceFeed)(nil)
+
+// maxDiscoverySize limits how much of the feed is read to discover its hub.
+const maxDiscoverySize = 1 << 20
+
+// linkHeaderPattern matches a single link of a Link header, like <https://hub.example.com/>; rel="hub".
+var linkHeaderPattern = regexp.MustCompile(`<([^>]*)>\s*;\s*rel="?([^";,]+)"?`)
+
+// DiscoverHub returns the WebSub hub advertised by the feed in its Link headers or in its
+// <link rel="hub"> elements, and the topic URL it advertises as rel="self", defaulting to the feed URL.
+func (s *SourceFeed) DiscoverHub(ctx context.Context) (string, string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.FeedURL, nil)
+	if err != nil {
+		return "", "", err
+	}
+	req.Header.Set("User-Agent", utils.PulseUserAgentString)
+
+	res, err := s.httpClient(30 * time.Second).Do(req)
+	if err != nil {
+		return "", "", fmt.Errorf("fetch feed: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return "", "", utils.NewHTTPError(res, "")
+	}
+
+	links := make(map[string]string)
+	for _, header := range res.Header.Values("Link") {
+		for _, match := range linkHeaderPattern.FindAllStringSubmatch(header, -1) {
+			addLink(links, match[2], match[1])
+		}
+	}
+
+	if links["hub"] == "" {
+		body, err := io.ReadAll(io.LimitReader(res.Body, maxDiscoverySize))
+		if err != nil {
+			return "", "", fmt.Errorf("read feed: %w", err)
+		}
+		feedLinks(body, links)
+	}
+
+	if links["hub"] == "" {
+		return "", "", nil
+	}
+
+	topic := links["self"]
+	if topic == "" {
+		topic = s.FeedURL
+	}
+
+	return resolveURL(s.FeedURL, links["hub"]), resolveURL(s.FeedURL, topic), nil
+}
+
+// feedLinks collects the links of the feed or channel element, before the first item.
+func feedLinks(body []byte, links map[string]string) {
+	decoder := xml.NewDecoder(bytes.NewReader(body))
+	decoder.Strict = false
+
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return
+		}
+
+		start, ok := token.(xml.StartElement)
+		if !ok {
+			continue
+		}
+
+		switch start.Name.Local {
+		case "item", "entry":
+			return
+		case "link":
+			var rel, href string
+			for _, attr := range start.Attr {
+				switch attr.Name.Local {
+				case "rel":
+					rel = attr.Value
+				case "href":
+					href = attr.Value
+				}
+			}
+			addLink(links, rel, href)
+		}
+	}
+}
+
+// addLink keeps the first link of each relation.
+func addLink(links map[string]string, rel, href string) {
+	href = strings.TrimSpace(href)
+	for _, r := range strings.Fields(rel) {
+		if _, ok := links[r]; !ok && href != "" {
+			links[r] = href
+		}
+	}
+}
+
+func resolveURL(base, ref string) string {
+	b, err := url.Parse(base)
+	if err != nil {
+		return ref
+	}
+	r, err := url.Parse(ref)
+	if err != nil {
+		return ref
+	}
+	return b.ResolveReference(r).String()
+}
+
+// ReceiveContent parses the feed content distributed by a WebSub hub,
+// which contains the new and updated items of the feed.
+func (s *SourceFeed) ReceiveContent(body []byte) ([]types.Activity, error) {
+	rssFeed, err := gofeed.NewParser().Parse(bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("parse feed: %w", err)
+	}
+
+	if rssFeed == nil {
+		return nil, errors.New("feed is nil")
+	}
+
+	return s.feedItems(rssFeed), nil
+}
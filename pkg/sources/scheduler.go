@@ -0,0 +1,358 @@
+package sources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/glanceapp/glance/pkg/sources/activities/types"
+	"github.com/glanceapp/glance/pkg/utils"
+
+	"github.com/rs/zerolog"
+)
+
+const (
+	// minPollInterval protects upstream APIs from overly aggressive source configs.
+	minPollInterval = time.Minute
+	// maxJitterRatio is the maximum fraction of the interval added as random delay,
+	// so that sources created at the same time don't keep polling in lockstep.
+	maxJitterRatio = 0.1
+	// pushedPollInterval is the polling interval of sources whose activities are pushed,
+	// as a fallback for missed pushes.
+	pushedPollInterval = 24 * time.Hour
+)
+
+// PollInterval returns the effective polling interval of the source.
+func PollInterval(source Source) time.Duration {
+	interval := DefaultPollInterval(source.Type())
+
+	if s, ok := source.(interface{ PollInterval() time.Duration }); ok && s.PollInterval() > 0 {
+		interval = s.PollInterval()
+	}
+
+	return max(interval, minPollInterval)
+}
+
+// Scheduler periodically re-runs Source.Stream for every scheduled source,
+// since a single Stream call only fetches the latest activities once.
+type Scheduler struct {
+	logger *zerolog.Logger
+	config Config
+	feed   chan<- types.Activity
+	errs   chan<- error
+	// dropped counts activities dropped because the feed was full.
+	dropped atomic.Int64
+	// onRun is called with the outcome of every completed fetch.
+	onRun func(run *types.SourceRun)
+
+	mu      sync.Mutex
+	entries map[string]*scheduleEntry
+}
+
+type scheduleEntry struct {
+	source   Source
+	interval time.Duration
+	lastRun  time.Time
+	nextRun  time.Time
+	cancel   context.CancelFunc
+	// breaker pauses polling of a source that keeps failing.
+	breaker *utils.CircuitBreaker
+	// pushed is set while the activities of the source are pushed, so that it's only polled as a fallback.
+	pushed bool
+	// wake triggers a fetch before the next scheduled one.
+	wake chan struct{}
+}
+
+func NewScheduler(
+	logger *zerolog.Logger,
+	config Config,
+	feed chan<- types.Activity,
+	errs chan<- error,
+	onRun func(run *types.SourceRun),
+) *Scheduler {
+	return &Scheduler{
+		logger:  logger,
+		config:  config,
+		feed:    feed,
+		errs:    errs,
+		onRun:   onRun,
+		entries: make(map[string]*scheduleEntry),
+	}
+}
+
+// Schedule starts polling the source immediately and then on every interval.
+// Scheduling an already scheduled source replaces the previous schedule.
+func (s *Scheduler) Schedule(source Source) {
+	s.ScheduleAfter(source, 0)
+}
+
+// ScheduleAfter is like Schedule, but delays the first fetch.
+// Sources that aren't polled are only unscheduled.
+func (s *Scheduler) ScheduleAfter(source Source, delay time.Duration) {
+	uid := source.UID()
+
+	// The previous schedule is replaced under the same lock, so that concurrent calls for the source
+	// can't both store a schedule, leaving one of them polling without a way to stop it.
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.unschedule(uid)
+
+	if !Polled(source) {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	entry := &scheduleEntry{
+		source:   source,
+		interval: PollInterval(source),
+		nextRun:  time.Now().Add(delay),
+		cancel:   cancel,
+		breaker:  s.config.NewCircuitBreaker(),
+		wake:     make(chan struct{}, 1),
+	}
+	s.entries[uid] = entry
+
+	go s.run(ctx, entry, delay)
+}
+
+// Unschedule stops polling the source and reports whether it was scheduled.
+func (s *Scheduler) Unschedule(uid string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.unschedule(uid)
+}
+
+// unschedule is Unschedule for callers holding s.mu.
+func (s *Scheduler) unschedule(uid string) bool {
+	entry, ok := s.entries[uid]
+	if !ok {
+		return false
+	}
+
+	entry.cancel()
+	delete(s.entries, uid)
+
+	return true
+}
+
+// SetPushed slows down polling of the source while its activities are pushed.
+// It takes effect after the next fetch. Once pushes stop, the source is fetched
+// immediately, to catch up on the activities it missed.
+func (s *Scheduler) SetPushed(uid string, pushed bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, ok := s.entries[uid]
+	if !ok {
+		return
+	}
+
+	if entry.pushed && !pushed {
+		select {
+		case entry.wake <- struct{}{}:
+		default:
+		}
+	}
+	entry.pushed = pushed
+}
+
+// NextRun returns the time of the next scheduled fetch of the source.
+func (s *Scheduler) NextRun(uid string) (time.Time, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, ok := s.entries[uid]
+	if !ok {
+		return time.Time{}, false
+	}
+
+	return entry.nextRun, true
+}
+
+// LastRun returns the time the last fetch of the source started.
+func (s *Scheduler) LastRun(uid string) (time.Time, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, ok := s.entries[uid]
+	if !ok || entry.lastRun.IsZero() {
+		return time.Time{}, false
+	}
+
+	return entry.lastRun, true
+}
+
+// Dropped returns the number of activities dropped because the feed was full.
+func (s *Scheduler) Dropped() int64 {
+	return s.dropped.Load()
+}
+
+func (s *Scheduler) Stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for uid, entry := range s.entries {
+		entry.cancel()
+		delete(s.entries, uid)
+	}
+}
+
+func (s *Scheduler) run(ctx context.Context, entry *scheduleEntry, delay time.Duration) {
+	uid := entry.source.UID()
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		case <-entry.wake:
+		}
+
+		s.mu.Lock()
+		entry.lastRun = time.Now()
+		s.mu.Unlock()
+
+		s.logger.Debug().Str("source", uid).Msg("Polling source")
+		run, err := s.fetch(ctx, entry)
+
+		// Fetches interrupted by unscheduling the source don't reflect its health.
+		if ctx.Err() != nil {
+			return
+		}
+		if run != nil {
+			s.onRun(run)
+		}
+
+		s.mu.Lock()
+		interval := entry.interval
+		if entry.pushed {
+			interval = max(interval, pushedPollInterval)
+		}
+		s.mu.Unlock()
+
+		delay := interval + jitter(interval)
+		if wait, ok := utils.RetryAfter(err); ok {
+			delay = max(delay, wait)
+		}
+		if openUntil := entry.breaker.OpenUntil(); !openUntil.IsZero() {
+			delay = max(delay, time.Until(openUntil))
+			s.logger.Warn().Str("source", uid).Time("until", openUntil).Msg("Source keeps failing, pausing polling")
+		}
+
+		s.mu.Lock()
+		entry.nextRun = time.Now().Add(delay)
+		s.mu.Unlock()
+
+		timer.Reset(delay)
+	}
+}
+
+// fetch polls the source with the retry policy, unless its circuit breaker is open.
+// It returns a nil run if the fetch was skipped.
+func (s *Scheduler) fetch(ctx context.Context, entry *scheduleEntry) (*types.SourceRun, error) {
+	startedAt := time.Now()
+
+	var run *types.SourceRun
+	var pollErr error
+	err := entry.breaker.Do(ctx, s.config.RetryPolicy(), func(ctx context.Context) error {
+		run, pollErr = s.poll(ctx, entry.source)
+		// Retrying would forward the already fetched activities again.
+		if run.Items > 0 {
+			return nil
+		}
+		return pollErr
+	})
+	if errors.Is(err, utils.ErrCircuitOpen) {
+		return nil, err
+	}
+
+	run.StartedAt = startedAt
+	return run, pollErr
+}
+
+// poll runs a single fetch of the source. Activities are forwarded to the feed
+// until it stays full for longer than Config.EnqueueTimeout, at which point the fetch
+// is aborted. The remaining activities are dropped and fetched again on the next poll.
+func (s *Scheduler) poll(ctx context.Context, source Source) (*types.SourceRun, error) {
+	run := &types.SourceRun{
+		SourceUID: source.UID(),
+		StartedAt: time.Now(),
+	}
+
+	pollCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	items := make(chan types.Activity)
+	errs := make(chan error)
+	go func() {
+		defer close(items)
+		defer close(errs)
+		source.Stream(pollCtx, items, errs)
+	}()
+
+	// Record errors of this fetch, while still reporting them to the shared error queue.
+	var lastErr error
+	errsDone := make(chan struct{})
+	go func() {
+		defer close(errsDone)
+		for err := range errs {
+			lastErr = err
+			run.Error = err.Error()
+			select {
+			case s.errs <- fmt.Errorf("source '%s': %w", source.UID(), err):
+			case <-ctx.Done():
+			}
+		}
+	}()
+
+	aborted := false
+	dropped := 0
+	for act := range items {
+		run.Items++
+
+		// Keep draining, so that the stream can return.
+		if aborted {
+			dropped++
+			continue
+		}
+
+		select {
+		case s.feed <- act:
+		case <-pollCtx.Done():
+			aborted = true
+		case <-time.After(s.config.EnqueueTimeout):
+			aborted = true
+			dropped++
+			cancel()
+		}
+	}
+	<-errsDone
+
+	if dropped > 0 && ctx.Err() == nil {
+		s.dropped.Add(int64(dropped))
+		select {
+		case s.errs <- fmt.Errorf("source '%s': queue is full, dropped %d activities", source.UID(), dropped):
+		case <-ctx.Done():
+		}
+	}
+
+	run.FinishedAt = time.Now()
+	return run, lastErr
+}
+
+func jitter(interval time.Duration) time.Duration {
+	maxJitter := int64(float64(interval) * maxJitterRatio)
+	if maxJitter <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int64N(maxJitter))
+}
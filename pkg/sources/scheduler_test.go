@@ -0,0 +1,86 @@
+package sources
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/glanceapp/glance/pkg/sources/activities/types"
+)
+
+// blockingRepo is a source whose fetches last until they are canceled.
+type blockingRepo struct {
+	*testRepo
+	polling *atomic.Int64
+}
+
+func (s *blockingRepo) Stream(ctx context.Context, feed chan<- types.Activity, errs chan<- error) {
+	s.polling.Add(1)
+	defer s.polling.Add(-1)
+	<-ctx.Done()
+}
+
+// waitFor reports whether the condition is met within a second.
+func waitFor(condition func() bool) bool {
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		if condition() {
+			return true
+		}
+		time.Sleep(time.Millisecond)
+	}
+	return condition()
+}
+
+func TestSchedulerScheduleAfter(t *testing.T) {
+	tests := []struct {
+		name      string
+		schedules int
+	}{
+		{name: "single", schedules: 1},
+		{name: "replaced", schedules: 2},
+		{name: "replaced concurrently", schedules: 50},
+	}
+
+	config := Config{
+		RetryMaxAttempts: 1,
+		BreakerThreshold: 5,
+		BreakerCoolDown:  time.Minute,
+		EnqueueTimeout:   time.Second,
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger := zerolog.Nop()
+			s := NewScheduler(&logger, config, make(chan types.Activity), make(chan error), func(*types.SourceRun) {})
+			defer s.Stop()
+
+			var polling atomic.Int64
+			var wg sync.WaitGroup
+			for range tt.schedules {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					s.Schedule(&blockingRepo{testRepo: &testRepo{Owner: "a", Repo: "b"}, polling: &polling})
+				}()
+			}
+			wg.Wait()
+
+			// Replaced schedules stop polling, only the last one keeps going.
+			if !waitFor(func() bool { return polling.Load() == 1 }) {
+				t.Fatalf("%d fetches are running, want 1", polling.Load())
+			}
+
+			if !s.Unschedule("test-repo/a/b") {
+				t.Fatal("source isn't scheduled")
+			}
+			if !waitFor(func() bool { return polling.Load() == 0 }) {
+				t.Errorf("%d fetches keep running after the source was unscheduled", polling.Load())
+			}
+		})
+	}
+}
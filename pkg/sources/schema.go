@@ -0,0 +1,278 @@
+package sources
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3gen"
+
+	"github.com/glanceapp/glance/pkg/sources/activities/types"
+)
+
+// ValidationError describes an invalid part of a source config.
+type ValidationError struct {
+	// Field is the JSON path of the invalid field, empty if the error isn't about a single field.
+	Field   string
+	Message string
+}
+
+// ValidationErrors is returned for source configs that can't be decoded or initialized.
+type ValidationErrors []ValidationError
+
+func (e ValidationErrors) Error() string {
+	messages := make([]string, len(e))
+	for i, err := range e {
+		if err.Field != "" {
+			messages[i] = fmt.Sprintf("%s: %s", err.Field, err.Message)
+		} else {
+			messages[i] = err.Message
+		}
+	}
+	return fmt.Sprintf("%s: %s", ErrInvalidSource, strings.Join(messages, "; "))
+}
+
+func (e ValidationErrors) Unwrap() error {
+	return ErrInvalidSource
+}
+
+// DecodeSource creates a source of the given type from its JSON config,
+// after validating the config against the schema of the source type.
+// Invalid configs are reported as ValidationErrors.
+func DecodeSource(sourceType string, config []byte) (Source, error) {
+	source, err := NewSource(sourceType)
+	if err != nil {
+		return nil, ValidationErrors{{Field: "type", Message: err.Error()}}
+	}
+
+	if err := validateConfig(sourceType, config); err != nil {
+		return nil, err
+	}
+
+	if err := source.UnmarshalJSON(config); err != nil {
+		return nil, ValidationErrors{configError(err)}
+	}
+
+	return source, nil
+}
+
+func configError(err error) ValidationError {
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) && typeErr.Field != "" {
+		return ValidationError{
+			Field:   "config." + typeErr.Field,
+			Message: fmt.Sprintf("must be of type %s, got %s", typeErr.Type, typeErr.Value),
+		}
+	}
+
+	return ValidationError{Field: "config", Message: err.Error()}
+}
+
+// reflectSchema builds the schema of a source config from the struct of the source.
+// Fields can be annotated with `jsonschema:"required,enum=a,enum=b,default=c,pattern=d,format=e,secret"`
+// and `jsonschema_description:"..."`. Fields set by the constructor of the source are its defaults.
+// Secret fields hold credentials, and are marked as write-only.
+func reflectSchema(source Source) (*openapi3.Schema, error) {
+	ref, err := openapi3gen.NewSchemaRefForValue(source, nil, openapi3gen.SchemaCustomizer(customizeSchema))
+	if err != nil {
+		return nil, fmt.Errorf("reflect schema: %w", err)
+	}
+
+	schema := ref.Value
+	annotateSchema(schema, reflect.ValueOf(source))
+
+	return schema, nil
+}
+
+var (
+	durationType  = reflect.TypeOf(types.Duration(0))
+	retentionType = reflect.TypeOf(types.Retention(0))
+)
+
+func customizeSchema(_ string, t reflect.Type, _ reflect.StructTag, schema *openapi3.Schema) error {
+	// Durations are marshalled as strings, but plain numbers of seconds are accepted too.
+	if t == durationType {
+		*schema = openapi3.Schema{
+			AnyOf: openapi3.SchemaRefs{
+				openapi3.NewStringSchema().NewRef(),
+				openapi3.NewFloat64Schema().NewRef(),
+			},
+		}
+	}
+
+	if t == retentionType {
+		*schema = *openapi3.NewStringSchema()
+	}
+
+	return nil
+}
+
+// annotateSchema applies the struct tags and defaults of the fields of v to the properties of the object schema.
+func annotateSchema(schema *openapi3.Schema, v reflect.Value) {
+	for v.Kind() == reflect.Pointer {
+		v = v.Elem()
+	}
+
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		if field.Anonymous && field.Type.Kind() == reflect.Struct {
+			annotateSchema(schema, v.Field(i))
+			continue
+		}
+
+		name := jsonName(field)
+		property := schema.Properties[name]
+		if property == nil || property.Value == nil {
+			continue
+		}
+
+		if field.Type.Kind() == reflect.Struct {
+			annotateSchema(property.Value, v.Field(i))
+		} else if !v.Field(i).IsZero() {
+			property.Value.Default = v.Field(i).Interface()
+		}
+
+		if description := field.Tag.Get("jsonschema_description"); description != "" {
+			property.Value.Description = description
+		}
+
+		for _, option := range strings.Split(field.Tag.Get("jsonschema"), ",") {
+			key, value, _ := strings.Cut(option, "=")
+			switch key {
+			case "required":
+				schema.Required = append(schema.Required, name)
+			case "enum":
+				property.Value.Enum = append(property.Value.Enum, value)
+			case "default":
+				property.Value.Default = parseTagValue(value)
+			case "pattern":
+				property.Value.Pattern = value
+			case "format":
+				property.Value.Format = value
+			case "secret":
+				property.Value.WriteOnly = true
+			}
+		}
+	}
+}
+
+func jsonName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	if name == "" {
+		return field.Name
+	}
+	return name
+}
+
+func parseTagValue(in string) any {
+	if n, err := strconv.ParseFloat(in, 64); err == nil {
+		return n
+	}
+	if b, err := strconv.ParseBool(in); err == nil {
+		return b
+	}
+	return in
+}
+
+// validateConfig checks the config against the schema of the source type.
+// Field names are matched case-insensitively, like encoding/json does when decoding the config.
+func validateConfig(sourceType string, config []byte) error {
+	schema, err := ConfigSchema(sourceType)
+	if err != nil {
+		return ValidationErrors{{Field: "type", Message: err.Error()}}
+	}
+
+	var value any
+	if err := json.Unmarshal(config, &value); err != nil {
+		return ValidationErrors{{Field: "config", Message: err.Error()}}
+	}
+	dropNulls(value)
+	normalizeKeys(schema, value)
+
+	err = schema.VisitJSON(value, openapi3.MultiErrors())
+	if err == nil {
+		return nil
+	}
+
+	var out ValidationErrors
+	for _, e := range flattenSchemaErrors(err) {
+		var schemaErr *openapi3.SchemaError
+		if !errors.As(e, &schemaErr) {
+			out = append(out, ValidationError{Field: "config", Message: e.Error()})
+			continue
+		}
+
+		field := "config"
+		for _, part := range schemaErr.JSONPointer() {
+			field += "." + part
+		}
+
+		out = append(out, ValidationError{Field: field, Message: schemaErr.Reason})
+	}
+
+	return out
+}
+
+func flattenSchemaErrors(err error) []error {
+	var multi openapi3.MultiError
+	if !errors.As(err, &multi) {
+		return []error{err}
+	}
+
+	var out []error
+	for _, e := range multi {
+		out = append(out, flattenSchemaErrors(e)...)
+	}
+	return out
+}
+
+// dropNulls removes object keys with null values, which leave fields unset when decoding the config,
+// like the unset fields of marshalled sources.
+func dropNulls(value any) {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, item := range v {
+			if item == nil {
+				delete(v, key)
+				continue
+			}
+			dropNulls(item)
+		}
+	case []any:
+		for _, item := range v {
+			dropNulls(item)
+		}
+	}
+}
+
+// normalizeKeys renames object keys that only differ in case from a property of the schema.
+func normalizeKeys(schema *openapi3.Schema, value any) {
+	object, ok := value.(map[string]any)
+	if !ok || schema == nil {
+		return
+	}
+
+	for key, v := range object {
+		if _, ok := schema.Properties[key]; ok {
+			normalizeKeys(schema.Properties[key].Value, v)
+			continue
+		}
+
+		for name, property := range schema.Properties {
+			if strings.EqualFold(name, key) {
+				delete(object, key)
+				object[name] = v
+				normalizeKeys(property.Value, v)
+				break
+			}
+		}
+	}
+}
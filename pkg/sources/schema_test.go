@@ -0,0 +1,63 @@
+package sources
+
+import (
+	"errors"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestDecodeSourceValidation(t *testing.T) {
+	tests := []struct {
+		name       string
+		sourceType string
+		config     string
+		wantFields []string
+	}{
+		{name: "valid", sourceType: typeTestRepo, config: `{"owner":"a","repo":"b"}`},
+		{name: "null field", sourceType: typeTestRepo, config: `{"owner":"a","repo":"b","limit":null}`},
+		{name: "case-insensitive keys", sourceType: typeTestRepo, config: `{"Owner":"a","repo":"b"}`},
+		{name: "unknown type", sourceType: "unknown", config: `{}`, wantFields: []string{"type"}},
+		{name: "invalid JSON", sourceType: typeTestRepo, config: `{`, wantFields: []string{"config"}},
+		{name: "missing required field", sourceType: typeTestRepo, config: `{"owner":"a"}`, wantFields: []string{"config.repo"}},
+		{name: "wrong type", sourceType: typeTestRepo, config: `{"owner":1,"repo":"b"}`, wantFields: []string{"config.owner"}},
+		{name: "not an enum value", sourceType: typeTestRepo, config: `{"owner":"a","repo":"b","state":"merged"}`, wantFields: []string{"config.state"}},
+		{name: "not an integer", sourceType: typeTestRepo, config: `{"owner":"a","repo":"b","limit":"ten"}`, wantFields: []string{"config.limit"}},
+		{name: "invalid embedded field", sourceType: typeTestRepo, config: `{"owner":"a","repo":"b","tags":"go"}`, wantFields: []string{"config.tags"}},
+		{name: "invalid duration", sourceType: typeTestRepo, config: `{"owner":"a","repo":"b","interval":"soon"}`, wantFields: []string{"config"}},
+		{name: "several errors", sourceType: typeTestRepo, config: `{"owner":1,"state":"merged"}`, wantFields: []string{"config.owner", "config.repo", "config.state"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := DecodeSource(tt.sourceType, []byte(tt.config))
+			if tt.wantFields == nil {
+				if err != nil {
+					t.Fatalf("err = %v, want nil", err)
+				}
+				return
+			}
+
+			var validationErrs ValidationErrors
+			if !errors.As(err, &validationErrs) {
+				t.Fatalf("err = %v, want ValidationErrors", err)
+			}
+			if !errors.Is(err, ErrInvalidSource) {
+				t.Errorf("err = %v, want %v", err, ErrInvalidSource)
+			}
+
+			var fields []string
+			for _, e := range validationErrs {
+				if e.Message == "" {
+					t.Errorf("%s has no message", e.Field)
+				}
+				fields = append(fields, e.Field)
+			}
+			sort.Strings(fields)
+
+			if !reflect.DeepEqual(fields, tt.wantFields) {
+				t.Errorf("fields = %q, want %q (%v)", fields, tt.wantFields, err)
+			}
+		})
+	}
+}
@@ -3,49 +3,10 @@ package sources
 import (
 	"context"
 	"encoding/json"
-	"fmt"
-	"github.com/glanceapp/glance/pkg/sources/activities/types"
 
-	"github.com/glanceapp/glance/pkg/sources/changedetection"
-	"github.com/glanceapp/glance/pkg/sources/github"
-	"github.com/glanceapp/glance/pkg/sources/hackernews"
-	"github.com/glanceapp/glance/pkg/sources/lobsters"
-	"github.com/glanceapp/glance/pkg/sources/mastodon"
-	"github.com/glanceapp/glance/pkg/sources/reddit"
-	"github.com/glanceapp/glance/pkg/sources/rss"
+	"github.com/glanceapp/glance/pkg/sources/activities/types"
 )
 
-func NewSource(sourceType string) (Source, error) {
-	var s Source
-
-	switch sourceType {
-	case mastodon.TypeMastodonAccount:
-		s = mastodon.NewSourceAccount()
-	case mastodon.TypeMastodonTag:
-		s = mastodon.NewSourceTag()
-	case hackernews.TypeHackerNewsPosts:
-		s = hackernews.NewSourcePosts()
-	case reddit.TypeRedditSubreddit:
-		s = reddit.NewSourceSubreddit()
-	case lobsters.TypeLobstersTag:
-		s = lobsters.NewSourceTag()
-	case lobsters.TypeLobstersFeed:
-		s = lobsters.NewSourceFeed()
-	case rss.TypeRSSFeed:
-		s = rss.NewSourceFeed()
-	case github.TypeGithubReleases:
-		s = github.NewReleaseSource()
-	case github.TypeGithubIssues:
-		s = github.NewIssuesSource()
-	case changedetection.TypeChangedetectionWebsite:
-		s = changedetection.NewSourceWebsiteChange()
-	default:
-		return nil, fmt.Errorf("unknown source type: %s", sourceType)
-	}
-
-	return s, nil
-}
-
 type Source interface {
 	json.Marshaler
 	json.Unmarshaler
@@ -58,3 +19,19 @@ type Source interface {
 	Initialize() error
 	Stream(ctx context.Context, feed chan<- types.Activity, errs chan<- error)
 }
+
+// Tags returns the tags the source is grouped by.
+func Tags(source Source) []string {
+	if s, ok := source.(interface{ SourceTags() []string }); ok {
+		return s.SourceTags()
+	}
+	return nil
+}
+
+// Retention returns how long activities of the source are kept, or zero if it inherits the retention of its type.
+func Retention(source Source) types.Retention {
+	if s, ok := source.(interface{ SourceRetention() types.Retention }); ok {
+		return s.SourceRetention()
+	}
+	return 0
+}
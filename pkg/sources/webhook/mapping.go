@@ -0,0 +1,143 @@
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// Mapping maps pushed JSON payloads to activities.
+// Each field is either a JSONPath like "$.build.url", or a Go template like "Build {{.build.number}} {{.build.status}}".
+type Mapping struct {
+	// Items optionally selects an array of items in the payload, each of which becomes an activity.
+	Items     string `json:"items" jsonschema_description:"JSONPath of an array of items in the payload. By default, the payload is a single item."`
+	ID        string `json:"id" jsonschema_description:"Unique ID of an item. Defaults to a hash of the item."`
+	Title     string `json:"title" jsonschema:"required"`
+	Body      string `json:"body"`
+	URL       string `json:"url"`
+	ImageURL  string `json:"image_url"`
+	CreatedAt string `json:"created_at" jsonschema_description:"RFC 3339 time or Unix timestamp. Defaults to the time the payload is received."`
+}
+
+type expression struct {
+	path     []string
+	template *template.Template
+}
+
+func parseExpression(name, in string) (*expression, error) {
+	if in == "" {
+		return nil, nil
+	}
+
+	if strings.HasPrefix(in, "$") {
+		path, err := parsePath(in)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		return &expression{path: path}, nil
+	}
+
+	tmpl, err := template.New(name).Option("missingkey=zero").Parse(in)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	return &expression{template: tmpl}, nil
+}
+
+// parsePath parses the subset of JSONPath made of object keys and array indexes, like $.commits[0].message.
+func parsePath(in string) ([]string, error) {
+	rest := strings.TrimPrefix(in, "$")
+	var out []string
+
+	for rest != "" {
+		switch rest[0] {
+		case '.':
+			rest = rest[1:]
+			end := strings.IndexAny(rest, ".[")
+			if end < 0 {
+				end = len(rest)
+			}
+			if end == 0 {
+				return nil, fmt.Errorf("invalid JSONPath: %s", in)
+			}
+			out = append(out, rest[:end])
+			rest = rest[end:]
+		case '[':
+			end := strings.IndexByte(rest, ']')
+			if end < 0 {
+				return nil, fmt.Errorf("invalid JSONPath: %s", in)
+			}
+			key := strings.Trim(rest[1:end], `'"`)
+			if key == "" {
+				return nil, fmt.Errorf("invalid JSONPath: %s", in)
+			}
+			out = append(out, key)
+			rest = rest[end+1:]
+		default:
+			return nil, fmt.Errorf("invalid JSONPath: %s", in)
+		}
+	}
+
+	return out, nil
+}
+
+// lookup returns the value at the path, or nil if it doesn't exist.
+func lookup(value any, path []string) any {
+	for _, key := range path {
+		switch v := value.(type) {
+		case map[string]any:
+			value = v[key]
+		case []any:
+			i, err := strconv.Atoi(key)
+			if err != nil || i < 0 || i >= len(v) {
+				return nil
+			}
+			value = v[i]
+		default:
+			return nil
+		}
+	}
+	return value
+}
+
+func (e *expression) eval(item any) (string, error) {
+	if e == nil {
+		return "", nil
+	}
+
+	if e.template != nil {
+		var out strings.Builder
+		if err := e.template.Execute(&out, item); err != nil {
+			return "", err
+		}
+		return strings.TrimSpace(strings.ReplaceAll(out.String(), "<no value>", "")), nil
+	}
+
+	switch v := lookup(item, e.path).(type) {
+	case nil:
+		return "", nil
+	case string:
+		return v, nil
+	case json.Number:
+		return v.String(), nil
+	default:
+		out, err := json.Marshal(v)
+		return string(out), err
+	}
+}
+
+// parseTime parses RFC 3339 times and Unix timestamps in seconds or milliseconds.
+func parseTime(in string) (time.Time, error) {
+	if n, err := strconv.ParseInt(in, 10, 64); err == nil {
+		// Timestamps in seconds are below 1e11 until the year 5138.
+		if n > 1e11 {
+			return time.UnixMilli(n), nil
+		}
+		return time.Unix(n, 0), nil
+	}
+
+	return time.Parse(time.RFC3339, in)
+}
@@ -0,0 +1,224 @@
+package webhook
+
+import (
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/glanceapp/glance/pkg/sources"
+	"github.com/glanceapp/glance/pkg/utils"
+)
+
+func TestParsePath(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    []string
+		wantErr bool
+	}{
+		{in: "$", want: nil},
+		{in: "$.title", want: []string{"title"}},
+		{in: "$.build.url", want: []string{"build", "url"}},
+		{in: "$.commits[0].message", want: []string{"commits", "0", "message"}},
+		{in: "$['build']['url']", want: []string{"build", "url"}},
+		{in: `$["build.url"]`, want: []string{"build.url"}},
+		{in: "$..title", wantErr: true},
+		{in: "$.title.", wantErr: true},
+		{in: "$.commits[0", wantErr: true},
+		{in: "$.commits[]", wantErr: true},
+		{in: "$title", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := parsePath(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, want error %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parsePath(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExpressionEval(t *testing.T) {
+	item := map[string]any{
+		"service": "api",
+		"build": map[string]any{
+			"number": float64(42),
+			"url":    "https://ci.example.com/42",
+			"tags":   []any{"main", "release"},
+		},
+		"commits": []any{
+			map[string]any{"message": "Fix login"},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{name: "empty", in: "", want: ""},
+		{name: "string", in: "$.build.url", want: "https://ci.example.com/42"},
+		{name: "number", in: "$.build.number", want: "42"},
+		{name: "array", in: "$.build.tags", want: `["main","release"]`},
+		{name: "array index", in: "$.commits[0].message", want: "Fix login"},
+		{name: "index out of range", in: "$.commits[1].message", want: ""},
+		{name: "missing key", in: "$.deployment.url", want: ""},
+		{name: "template", in: "Build {{.build.number}} of {{.service}}", want: "Build 42 of api"},
+		{name: "template with missing key", in: "{{.service}} {{.version}}", want: "api"},
+		{name: "invalid template", in: "{{.service", wantErr: true},
+		{name: "invalid path", in: "$.", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expr, err := parseExpression(tt.name, tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, want error %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			got, err := expr.eval(item)
+			if err != nil {
+				t.Fatalf("eval: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("eval(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseTime(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    time.Time
+		wantErr bool
+	}{
+		{in: "2025-01-01T12:00:00Z", want: time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)},
+		{in: "1735732800", want: time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)},
+		{in: "1735732800000", want: time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)},
+		{in: "2025-01-01", wantErr: true},
+		{in: "yesterday", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := parseTime(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, want error %v", err, tt.wantErr)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("parseTime(%q) = %s, want %s", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSourceWebhookReceive(t *testing.T) {
+	const secret = "0123456789abcdef"
+
+	tests := []struct {
+		name       string
+		mapping    Mapping
+		body       string
+		signature  string
+		wantTitles []string
+		wantErr    error
+	}{
+		{
+			name:       "single item",
+			mapping:    Mapping{ID: "$.id", Title: "Deployed {{.service}}"},
+			body:       `{"id":"1","service":"api"}`,
+			wantTitles: []string{"Deployed api"},
+		},
+		{
+			name:       "items",
+			mapping:    Mapping{Items: "$.builds", Title: "$.name"},
+			body:       `{"builds":[{"name":"a"},{"name":"b"}]}`,
+			wantTitles: []string{"a", "b"},
+		},
+		{
+			name:      "invalid signature",
+			mapping:   Mapping{Title: "$.name"},
+			body:      `{"name":"a"}`,
+			signature: "sha256=00",
+			wantErr:   sources.ErrInvalidSignature,
+		},
+		{
+			name:    "invalid JSON",
+			mapping: Mapping{Title: "$.name"},
+			body:    `{"name":`,
+			wantErr: sources.ErrInvalidPayload,
+		},
+		{
+			name:    "items aren't an array",
+			mapping: Mapping{Items: "$.builds", Title: "$.name"},
+			body:    `{"builds":{"name":"a"}}`,
+			wantErr: sources.ErrInvalidPayload,
+		},
+		{
+			name:    "empty title",
+			mapping: Mapping{Title: "$.name"},
+			body:    `{"id":"1"}`,
+			wantErr: sources.ErrInvalidPayload,
+		},
+		{
+			name:    "invalid created_at",
+			mapping: Mapping{Title: "$.name", CreatedAt: "$.at"},
+			body:    `{"name":"a","at":"yesterday"}`,
+			wantErr: sources.ErrInvalidPayload,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewSourceWebhook()
+			s.WebhookName = "test"
+			s.Secret = secret
+			s.Mapping = tt.mapping
+			if err := s.Initialize(); err != nil {
+				t.Fatalf("initialize: %v", err)
+			}
+
+			signature := tt.signature
+			if signature == "" {
+				signature = "sha256=" + utils.SignHMAC([]byte(secret), []byte(tt.body))
+			}
+			header := http.Header{}
+			header.Set(defaultSignatureHeader, signature)
+
+			activities, err := s.Receive(header, []byte(tt.body))
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("err = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("err = %v", err)
+			}
+
+			var titles []string
+			for _, act := range activities {
+				titles = append(titles, act.Title())
+				if act.UID() == "" {
+					t.Errorf("activity %q has no UID", act.Title())
+				}
+				if act.SourceUID() != s.UID() {
+					t.Errorf("SourceUID() = %s, want %s", act.SourceUID(), s.UID())
+				}
+			}
+			if !reflect.DeepEqual(titles, tt.wantTitles) {
+				t.Errorf("titles = %q, want %q", titles, tt.wantTitles)
+			}
+		})
+	}
+}
@@ -0,0 +1,303 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/glanceapp/glance/pkg/sources"
+	"github.com/glanceapp/glance/pkg/sources/activities/types"
+	"github.com/glanceapp/glance/pkg/utils"
+)
+
+const TypeWebhook = "webhook"
+
+const defaultSignatureHeader = "X-Signature-256"
+
+func init() {
+	sources.RegisterSourceType(sources.SourceType{
+		Type:        TypeWebhook,
+		Description: "Activities pushed as JSON payloads to POST /ingest/{ingest_token}, signed with HMAC-SHA256.",
+		NewSource:   func() sources.Source { return NewSourceWebhook() },
+		NewActivity: func() types.Activity { return NewItem() },
+		Examples: []map[string]any{{
+			"name":   "deploys",
+			"secret": "<random string of at least 16 characters>",
+			"mapping": map[string]any{
+				"id":         "$.deployment.id",
+				"title":      "Deployed {{.service}} {{.version}}",
+				"body":       "$.changelog",
+				"url":        "$.deployment.url",
+				"created_at": "$.deployment.finished_at",
+			},
+		}},
+	})
+}
+
+// SourceWebhook receives activities from internal systems that can't be polled.
+// Payloads are pushed to the random ingest token of the source, and verified with the HMAC-SHA256 signature of the body, sent in the signature header.
+type SourceWebhook struct {
+	types.SourceBase
+	WebhookName     string  `json:"name" jsonschema:"required,pattern=^[a-z0-9-]+$" jsonschema_description:"Name of the webhook, part of the source UID."`
+	Secret          string  `json:"secret" jsonschema:"required,pattern=^.{16},secret" jsonschema_description:"Secret the payloads are signed with, at least 16 characters."`
+	SignatureHeader string  `json:"signature_header" jsonschema_description:"Header with the hex encoded HMAC-SHA256 of the body, optionally prefixed with sha256=."`
+	Token           string  `json:"ingest_token,omitempty" jsonschema:"pattern=^[A-Za-z0-9_-]{32},secret" jsonschema_description:"Token of the ingest URL, POST /ingest/{ingest_token}. Generated when the source is created."`
+	Mapping         Mapping `json:"mapping" jsonschema:"required"`
+
+	items                                     *expression
+	id, title, body, url, imageURL, createdAt *expression
+}
+
+func NewSourceWebhook() *SourceWebhook {
+	return &SourceWebhook{
+		SignatureHeader: defaultSignatureHeader,
+	}
+}
+
+func (s *SourceWebhook) UID() string {
+	return fmt.Sprintf("%s/%s", s.Type(), s.WebhookName)
+}
+
+func (s *SourceWebhook) Name() string {
+	return fmt.Sprintf("Webhook (%s)", s.WebhookName)
+}
+
+func (s *SourceWebhook) URL() string {
+	return ""
+}
+
+func (s *SourceWebhook) Type() string {
+	return TypeWebhook
+}
+
+// Polled is false, since activities are only pushed to webhooks.
+func (s *SourceWebhook) Polled() bool {
+	return false
+}
+
+func (s *SourceWebhook) Initialize() error {
+	if s.WebhookName == "" {
+		return fmt.Errorf("name is required")
+	}
+
+	if len(s.Secret) < 16 {
+		return fmt.Errorf("secret must be at least 16 characters")
+	}
+
+	if s.SignatureHeader == "" {
+		s.SignatureHeader = defaultSignatureHeader
+	}
+
+	if s.Mapping.Title == "" {
+		return fmt.Errorf("mapping.title is required")
+	}
+
+	for _, e := range []struct {
+		out  **expression
+		name string
+		in   string
+	}{
+		{&s.items, "items", s.Mapping.Items},
+		{&s.id, "id", s.Mapping.ID},
+		{&s.title, "title", s.Mapping.Title},
+		{&s.body, "body", s.Mapping.Body},
+		{&s.url, "url", s.Mapping.URL},
+		{&s.imageURL, "image_url", s.Mapping.ImageURL},
+		{&s.createdAt, "created_at", s.Mapping.CreatedAt},
+	} {
+		expr, err := parseExpression(e.name, e.in)
+		if err != nil {
+			return fmt.Errorf("mapping.%w", err)
+		}
+		*e.out = expr
+	}
+
+	if s.items != nil && s.items.path == nil {
+		return fmt.Errorf("mapping.items must be a JSONPath")
+	}
+
+	return nil
+}
+
+func (s *SourceWebhook) IngestToken() string {
+	return s.Token
+}
+
+func (s *SourceWebhook) SetIngestToken(token string) {
+	s.Token = token
+}
+
+// Stream doesn't fetch anything, activities are received from pushed payloads instead.
+func (s *SourceWebhook) Stream(ctx context.Context, feed chan<- types.Activity, errs chan<- error) {
+}
+
+// Receive verifies the signature of the payload and maps it to activities.
+func (s *SourceWebhook) Receive(header http.Header, body []byte) ([]types.Activity, error) {
+	if !utils.VerifyHMAC([]byte(s.Secret), body, header.Get(s.SignatureHeader)) {
+		return nil, fmt.Errorf("%w: %s header doesn't match the payload", sources.ErrInvalidSignature, s.SignatureHeader)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+
+	var payload any
+	if err := decoder.Decode(&payload); err != nil {
+		return nil, fmt.Errorf("%w: %w", sources.ErrInvalidPayload, err)
+	}
+
+	items := []any{payload}
+	if s.items != nil {
+		var ok bool
+		if items, ok = lookup(payload, s.items.path).([]any); !ok {
+			return nil, fmt.Errorf("%w: %s is not an array", sources.ErrInvalidPayload, s.Mapping.Items)
+		}
+	}
+
+	receivedAt := time.Now()
+	out := make([]types.Activity, 0, len(items))
+	for i, item := range items {
+		act, err := s.mapItem(item, receivedAt)
+		if err != nil {
+			return nil, fmt.Errorf("%w: item %d: %w", sources.ErrInvalidPayload, i, err)
+		}
+		out = append(out, act)
+	}
+
+	return out, nil
+}
+
+func (s *SourceWebhook) mapItem(item any, receivedAt time.Time) (*Item, error) {
+	out := &Item{SourceID: s.UID(), Created: receivedAt}
+
+	var createdAt string
+	for _, f := range []struct {
+		out  *string
+		expr *expression
+	}{
+		{&out.ItemID, s.id},
+		{&out.ItemTitle, s.title},
+		{&out.ItemBody, s.body},
+		{&out.ItemURL, s.url},
+		{&out.ItemImageURL, s.imageURL},
+		{&createdAt, s.createdAt},
+	} {
+		value, err := f.expr.eval(item)
+		if err != nil {
+			return nil, err
+		}
+		*f.out = value
+	}
+
+	if out.ItemTitle == "" {
+		return nil, fmt.Errorf("title is empty")
+	}
+
+	if createdAt != "" {
+		t, err := parseTime(createdAt)
+		if err != nil {
+			return nil, fmt.Errorf("created_at: %w", err)
+		}
+		out.Created = t
+	}
+
+	if out.ItemID == "" {
+		raw, err := json.Marshal(item)
+		if err != nil {
+			return nil, err
+		}
+		out.ItemID = types.HashContent(string(raw))
+	}
+
+	return out, nil
+}
+
+func (s *SourceWebhook) MarshalJSON() ([]byte, error) {
+	type Alias SourceWebhook
+	return json.Marshal(&struct {
+		*Alias
+		Type string `json:"type"`
+	}{
+		Alias: (*Alias)(s),
+		Type:  s.Type(),
+	})
+}
+
+func (s *SourceWebhook) UnmarshalJSON(data []byte) error {
+	type Alias SourceWebhook
+	aux := &struct {
+		*Alias
+		Type string `json:"type"`
+	}{
+		Alias: (*Alias)(s),
+	}
+	return json.Unmarshal(data, &aux)
+}
+
+// Item is an activity mapped from a pushed payload.
+type Item struct {
+	ItemID       string    `json:"id"`
+	ItemTitle    string    `json:"title"`
+	ItemBody     string    `json:"body"`
+	ItemURL      string    `json:"url"`
+	ItemImageURL string    `json:"image_url"`
+	Created      time.Time `json:"created_at"`
+	SourceID     string    `json:"source_id"`
+}
+
+func NewItem() *Item {
+	return &Item{}
+}
+
+func (i *Item) SourceType() string {
+	return TypeWebhook
+}
+
+func (i *Item) MarshalJSON() ([]byte, error) {
+	type Alias Item
+	return json.Marshal(&struct {
+		*Alias
+	}{
+		Alias: (*Alias)(i),
+	})
+}
+
+func (i *Item) UnmarshalJSON(data []byte) error {
+	type Alias Item
+	aux := &struct {
+		*Alias
+	}{
+		Alias: (*Alias)(i),
+	}
+	return json.Unmarshal(data, &aux)
+}
+
+func (i *Item) UID() string {
+	return i.ItemID
+}
+
+func (i *Item) SourceUID() string {
+	return i.SourceID
+}
+
+func (i *Item) Title() string {
+	return i.ItemTitle
+}
+
+func (i *Item) Body() string {
+	return i.ItemBody
+}
+
+func (i *Item) URL() string {
+	return i.ItemURL
+}
+
+func (i *Item) ImageURL() string {
+	return i.ItemImageURL
+}
+
+func (i *Item) CreatedAt() time.Time {
+	return i.Created
+}
@@ -0,0 +1,415 @@
+package sources
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/glanceapp/glance/pkg/sources/activities/types"
+	"github.com/glanceapp/glance/pkg/utils"
+)
+
+const (
+	// webSubLease is the lease requested from hubs. Hubs may grant a different one.
+	webSubLease = 10 * 24 * time.Hour
+	// webSubTimeout bounds hub discovery and subscription requests, including retries.
+	webSubTimeout = 2 * time.Minute
+	// webSubSyncInterval is how often replicas pick up subscriptions verified by other replicas, and renew due ones.
+	webSubSyncInterval = time.Minute
+)
+
+var ErrSubscriptionNotFound = errors.New("subscription not found")
+
+// WebSubSource is implemented by sources of feeds that may be pushed by a WebSub hub.
+type WebSubSource interface {
+	Source
+	// DiscoverHub returns the hub advertised by the feed and the topic URL to subscribe to,
+	// or an empty hub if the feed doesn't advertise one.
+	DiscoverHub(ctx context.Context) (hub, topic string, err error)
+	// ReceiveContent converts feed content distributed by the hub into activities.
+	ReceiveContent(body []byte) ([]types.Activity, error)
+}
+
+// WebSubVerification is a request of a hub to verify a subscription or report that it was denied.
+type WebSubVerification struct {
+	Mode      string
+	Topic     string
+	Challenge string
+	// LeaseSeconds is the lease granted by the hub, zero for unsubscriptions and denials.
+	LeaseSeconds int
+	// Reason is the reason a subscription was denied.
+	Reason string
+}
+
+type webSubStore interface {
+	Get(uid string) (*types.WebSubscription, error)
+	Save(sub *types.WebSubscription) error
+	Remove(uid string) error
+	List() ([]*types.WebSubscription, error)
+	ClaimRenewals(lease time.Duration) ([]*types.WebSubscription, error)
+}
+
+// subscribe subscribes to pushed updates of the source in the background, if a public URL is configured
+// and the source advertises a WebSub hub, or connects to sources that are configured to listen for updates.
+// Polling continues as a fallback.
+func (r *Registry) subscribe(source Source) {
+	r.subscribeAfter(source, 0)
+}
+
+// subscribeAfter is like subscribe, but delays hub discovery and connections.
+func (r *Registry) subscribeAfter(source Source, delay time.Duration) {
+	if l, ok := source.(Listener); ok {
+		r.listenAfter(l, delay)
+	}
+
+	s, ok := source.(WebSubSource)
+	if !ok || r.config.PublicURL == "" {
+		return
+	}
+
+	go func() {
+		select {
+		case <-time.After(delay):
+		case <-r.done:
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), webSubTimeout)
+		defer cancel()
+
+		hub, topic, err := s.DiscoverHub(ctx)
+		if err != nil {
+			r.logger.Warn().Err(err).Str("source", s.UID()).Msg("Failed to discover WebSub hub")
+			return
+		}
+		if hub == "" {
+			return
+		}
+
+		sub, err := r.webSubRepo.Get(s.UID())
+		if err != nil {
+			r.logger.Warn().Err(err).Str("source", s.UID()).Msg("Failed to get WebSub subscription")
+			return
+		}
+
+		// Subscriptions survive restarts and are shared by all replicas, which restore the same sources.
+		// An active subscription is renewed by syncWebSubs, and a pending one is requested again with the same secret.
+		if sub != nil && sub.Mode == "subscribe" && sub.Hub == hub && sub.Topic == topic {
+			if sub.Pushed(time.Now()) {
+				r.setPushed(s.UID(), true)
+				return
+			}
+		} else {
+			sub = &types.WebSubscription{
+				SourceUID: s.UID(),
+				Hub:       hub,
+				Topic:     topic,
+				Secret:    newWebSubSecret(),
+				Mode:      "subscribe",
+			}
+			if err := r.webSubRepo.Save(sub); err != nil {
+				r.logger.Warn().Err(err).Str("source", s.UID()).Msg("Failed to save WebSub subscription")
+				return
+			}
+		}
+
+		r.requestSubscription(ctx, sub, "subscribe")
+	}()
+}
+
+// unsubscribe cancels the subscription or connection of the source, if any.
+func (r *Registry) unsubscribe(uid string) {
+	r.stopListening(uid)
+
+	sub, err := r.webSubRepo.Get(uid)
+	if err != nil {
+		r.logger.Warn().Err(err).Str("source", uid).Msg("Failed to get WebSub subscription")
+		return
+	}
+	if sub == nil {
+		return
+	}
+
+	// The subscription is kept until the hub verifies the unsubscription.
+	sub.Mode = "unsubscribe"
+	sub.Active = false
+	if err := r.webSubRepo.Save(sub); err != nil {
+		r.logger.Warn().Err(err).Str("source", uid).Msg("Failed to save WebSub subscription")
+		return
+	}
+	r.setPushed(uid, false)
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), webSubTimeout)
+		defer cancel()
+
+		r.requestSubscription(ctx, sub, "unsubscribe")
+	}()
+}
+
+// requestSubscription sends a (un)subscription request to the hub, which then verifies it through the callback.
+func (r *Registry) requestSubscription(ctx context.Context, sub *types.WebSubscription, mode string) {
+	uid := sub.SourceUID
+
+	form := url.Values{
+		"hub.mode":     {mode},
+		"hub.topic":    {sub.Topic},
+		"hub.callback": {r.webSubCallback(uid)},
+	}
+	if mode == "subscribe" {
+		form.Set("hub.secret", sub.Secret)
+		form.Set("hub.lease_seconds", fmt.Sprint(int(webSubLease.Seconds())))
+	}
+
+	err := r.config.RetryPolicy().Do(ctx, func(ctx context.Context) error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, sub.Hub, strings.NewReader(form.Encode()))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.Header.Set("User-Agent", utils.PulseUserAgentString)
+
+		res, err := utils.NewHTTPClient(30 * time.Second).Do(req)
+		if err != nil {
+			return err
+		}
+		defer res.Body.Close()
+
+		if res.StatusCode < 200 || res.StatusCode >= 300 {
+			body, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+			return utils.NewHTTPError(res, string(body))
+		}
+		return nil
+	})
+	if err != nil {
+		r.logger.Warn().Err(err).Str("source", uid).Str("hub", sub.Hub).Msgf("Failed to %s to WebSub hub", mode)
+		return
+	}
+
+	r.logger.Debug().Str("source", uid).Str("hub", sub.Hub).Msgf("Requested WebSub %s", mode)
+}
+
+func (r *Registry) webSubCallback(uid string) string {
+	return fmt.Sprintf("%s/websub/%s", strings.TrimRight(r.config.PublicURL, "/"), url.PathEscape(uid))
+}
+
+// VerifyWebSub handles a verification request of a hub, and returns the challenge to echo back.
+// It returns ErrSubscriptionNotFound if the request doesn't match a pending (un)subscription.
+func (r *Registry) VerifyWebSub(uid string, req WebSubVerification) (string, error) {
+	sub, err := r.webSubRepo.Get(uid)
+	if err != nil {
+		return "", fmt.Errorf("get subscription: %w", err)
+	}
+
+	if sub == nil || sub.Topic != req.Topic {
+		return "", fmt.Errorf("source '%s': %w", uid, ErrSubscriptionNotFound)
+	}
+
+	switch req.Mode {
+	case "denied":
+		if err := r.webSubRepo.Remove(uid); err != nil {
+			return "", fmt.Errorf("remove subscription: %w", err)
+		}
+		r.setPushed(uid, false)
+		r.logger.Warn().Str("source", uid).Str("reason", req.Reason).Msg("WebSub hub denied subscription")
+		return "", nil
+
+	case "unsubscribe":
+		if sub.Mode != "unsubscribe" {
+			return "", fmt.Errorf("source '%s': %w", uid, ErrSubscriptionNotFound)
+		}
+		if err := r.webSubRepo.Remove(uid); err != nil {
+			return "", fmt.Errorf("remove subscription: %w", err)
+		}
+		return req.Challenge, nil
+
+	case "subscribe":
+		if sub.Mode != "subscribe" || req.LeaseSeconds <= 0 {
+			return "", fmt.Errorf("source '%s': %w", uid, ErrSubscriptionNotFound)
+		}
+
+		// Renew well before the lease expires, so that a failed renewal can be retried in time.
+		lease := time.Duration(req.LeaseSeconds) * time.Second
+		sub.Active = true
+		sub.ExpiresAt = time.Now().Add(lease)
+		sub.RenewAt = time.Now().Add(lease * 9 / 10)
+		if err := r.webSubRepo.Save(sub); err != nil {
+			return "", fmt.Errorf("save subscription: %w", err)
+		}
+		r.setPushed(uid, true)
+
+		r.logger.Info().Str("source", uid).Str("hub", sub.Hub).Dur("lease", lease).Msg("Subscribed to WebSub hub")
+		return req.Challenge, nil
+
+	default:
+		return "", fmt.Errorf("%w: invalid mode: %s", ErrInvalidPayload, req.Mode)
+	}
+}
+
+// startWebSubSync periodically runs syncWebSubs, if WebSub is enabled with a public URL.
+func (r *Registry) startWebSubSync() {
+	if r.config.PublicURL == "" {
+		return
+	}
+
+	go func() {
+		for {
+			select {
+			case <-time.After(webSubSyncInterval):
+			case <-r.done:
+				return
+			}
+
+			r.syncWebSubs()
+		}
+	}()
+}
+
+// syncWebSubs slows down polling of the sources with an active subscription, including those verified
+// through another replica, and resumes it once a lease expires without being renewed.
+// It then renews the subscriptions that are due, each claimed by a single replica.
+func (r *Registry) syncWebSubs() {
+	subs, err := r.webSubRepo.List()
+	if err != nil {
+		r.logger.Warn().Err(err).Msg("Failed to list WebSub subscriptions")
+		return
+	}
+
+	now := time.Now()
+	pushed := make(map[string]bool, len(subs))
+	for _, sub := range subs {
+		if sub.Pushed(now) {
+			pushed[sub.SourceUID] = true
+		}
+	}
+
+	r.websubsMu.Lock()
+	for uid := range r.webSubsPushed {
+		if !pushed[uid] {
+			r.scheduler.SetPushed(uid, false)
+			delete(r.webSubsPushed, uid)
+		}
+	}
+	for uid := range pushed {
+		if !r.webSubsPushed[uid] {
+			r.scheduler.SetPushed(uid, true)
+			r.webSubsPushed[uid] = true
+		}
+	}
+	r.websubsMu.Unlock()
+
+	due, err := r.webSubRepo.ClaimRenewals(webSubTimeout)
+	if err != nil {
+		r.logger.Warn().Err(err).Msg("Failed to claim WebSub renewals")
+		return
+	}
+
+	for _, sub := range due {
+		go func() {
+			ctx, cancel := context.WithTimeout(context.Background(), webSubTimeout)
+			defer cancel()
+
+			r.requestSubscription(ctx, sub, "subscribe")
+		}()
+	}
+}
+
+// setPushed slows down or resumes polling of the source on this replica, until the next syncWebSubs.
+func (r *Registry) setPushed(uid string, pushed bool) {
+	r.websubsMu.Lock()
+	defer r.websubsMu.Unlock()
+
+	if pushed {
+		r.webSubsPushed[uid] = true
+	} else {
+		delete(r.webSubsPushed, uid)
+	}
+	r.scheduler.SetPushed(uid, pushed)
+}
+
+// ReceiveWebSub forwards feed content distributed by the hub of a subscribed source.
+// Content that isn't signed with the secret of the subscription returns ErrInvalidSignature.
+func (r *Registry) ReceiveWebSub(uid string, header http.Header, body []byte) (int, error) {
+	sub, err := r.webSubRepo.Get(uid)
+	if err != nil {
+		return 0, fmt.Errorf("get subscription: %w", err)
+	}
+
+	if sub == nil || !sub.Active {
+		return 0, fmt.Errorf("source '%s': %w", uid, ErrSubscriptionNotFound)
+	}
+
+	if !verifyHubSignature(sub.Secret, body, header.Get("X-Hub-Signature")) {
+		return 0, fmt.Errorf("source '%s': %w", uid, ErrInvalidSignature)
+	}
+
+	source, err := r.sourceRepo.GetByID(uid)
+	if err != nil {
+		return 0, fmt.Errorf("get source: %w", err)
+	}
+
+	s, ok := source.(WebSubSource)
+	if !ok {
+		return 0, fmt.Errorf("source '%s': %w", uid, ErrSubscriptionNotFound)
+	}
+
+	if err := s.Initialize(); err != nil {
+		return 0, fmt.Errorf("%w: initialize source: %w", ErrInvalidSource, err)
+	}
+
+	activities, err := s.ReceiveContent(body)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %w", ErrInvalidPayload, err)
+	}
+
+	return r.push(uid, activities)
+}
+
+func newWebSubSecret() string {
+	b := make([]byte, 32)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// verifyHubSignature verifies a signature like "sha1=<hex>", with any of the methods hubs may use.
+func verifyHubSignature(secret string, body []byte, signature string) bool {
+	method, sum, ok := strings.Cut(signature, "=")
+	if !ok {
+		return false
+	}
+
+	var h func() hash.Hash
+	switch method {
+	case "sha1":
+		h = sha1.New
+	case "sha256":
+		h = sha256.New
+	case "sha384":
+		h = sha512.New384
+	case "sha512":
+		h = sha512.New
+	default:
+		return false
+	}
+
+	got, err := hex.DecodeString(sum)
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(h, []byte(secret))
+	mac.Write(body)
+	return hmac.Equal(got, mac.Sum(nil))
+}
@@ -3,14 +3,18 @@ package postgres
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
-	"github.com/glanceapp/glance/pkg/sources/activities"
+	"github.com/glanceapp/glance/pkg/sources"
 	"github.com/glanceapp/glance/pkg/sources/activities/types"
 	"github.com/pgvector/pgvector-go"
 
 	"github.com/glanceapp/glance/pkg/storage/postgres/ent"
 	"github.com/glanceapp/glance/pkg/storage/postgres/ent/activity"
+	"github.com/glanceapp/glance/pkg/storage/postgres/ent/deadletter"
+	"github.com/glanceapp/glance/pkg/storage/postgres/ent/job"
+	"github.com/glanceapp/glance/pkg/storage/postgres/ent/predicate"
 )
 
 type ActivityRepository struct {
@@ -21,36 +25,130 @@ func NewActivityRepository(db *DB) *ActivityRepository {
 	return &ActivityRepository{db: db}
 }
 
-func (r *ActivityRepository) Add(activity *types.DecoratedActivity) error {
+// Add stores the activity. If the activity is already stored,
+// it is replaced with the new content and its revision is incremented.
+// Stored activities with a URL the activity references are marked as referenced, see types.ReferencedURLs.
+func (r *ActivityRepository) Add(in *types.DecoratedActivity) error {
 	ctx := context.Background()
 
-	rawJson, err := activity.MarshalJSON()
+	rawJson, err := in.MarshalJSON()
 	if err != nil {
 		return fmt.Errorf("marshal activity: %w", err)
 	}
 
-	_, err = r.db.Client().Activity.Create().
-		SetID(activity.UID()).
-		SetUID(activity.UID()).
-		SetSourceUID(activity.SourceUID()).
-		SetTitle(activity.Title()).
-		SetBody(activity.Body()).
-		SetURL(activity.URL()).
-		SetImageURL(activity.ImageURL()).
-		SetCreatedAt(activity.CreatedAt()).
-		SetSourceType(activity.SourceType()).
+	// Bodies of some activities are expensive to compute, e.g. fetch the linked article.
+	body := in.Body()
+
+	tx, err := r.db.Client().Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+
+	err = tx.Activity.Create().
+		SetID(in.ID()).
+		SetUID(in.UID()).
+		SetSourceUID(in.SourceUID()).
+		SetTitle(in.Title()).
+		SetBody(body).
+		SetURL(in.URL()).
+		SetImageURL(in.ImageURL()).
+		SetCreatedAt(in.CreatedAt()).
+		SetSourceType(in.SourceType()).
 		SetRawJSON(string(rawJson)).
-		SetShortSummary(activity.Summary.ShortSummary).
-		SetFullSummary(activity.Summary.FullSummary).
-		SetEmbedding(pgvector.NewVector(activity.Embedding)).
-		Save(ctx)
+		SetShortSummary(in.Summary.ShortSummary).
+		SetFullSummary(in.Summary.FullSummary).
+		SetEmbedding(pgvector.NewVector(in.Embedding)).
+		SetContentHash(types.ContentHash(in.Activity)).
+		OnConflictColumns(activity.FieldID).
+		Update(func(u *ent.ActivityUpsert) {
+			u.UpdateTitle().
+				UpdateBody().
+				UpdateURL().
+				UpdateImageURL().
+				UpdateCreatedAt().
+				UpdateRawJSON().
+				UpdateShortSummary().
+				UpdateFullSummary().
+				UpdateEmbedding().
+				UpdateContentHash().
+				// The new revision has an embedding again.
+				ClearArchivedAt().
+				AddRevision(1).
+				SetUpdatedAt(time.Now())
+		}).
+		Exec(ctx)
+	if err != nil {
+		return rollback(tx, fmt.Errorf("upsert activity: %w", err))
+	}
+
+	if urls := types.ReferencedURLs(in.URL(), body); len(urls) > 0 {
+		err = tx.Activity.Update().
+			Where(
+				activity.URLIn(urls...),
+				activity.IDNEQ(in.ID()),
+				activity.ReferencedEQ(false),
+			).
+			SetReferenced(true).
+			Exec(ctx)
+		if err != nil {
+			return rollback(tx, fmt.Errorf("mark referenced activities: %w", err))
+		}
+	}
+
+	return tx.Commit()
+}
+
+// ContentHash returns the content hash of the stored activity.
+// The returned bool is false if the activity isn't stored.
+func (r *ActivityRepository) ContentHash(sourceUID, uid string) (string, bool, error) {
+	ctx := context.Background()
+
+	out, err := r.db.Client().Activity.Query().
+		Where(activity.SourceUIDEQ(sourceUID), activity.UIDEQ(uid)).
+		Select(activity.FieldContentHash).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, err
+	}
+
+	return out.ContentHash, true, nil
+}
 
-	return err
+// SetContentHash records the content hash of an activity stored before hashes were tracked.
+func (r *ActivityRepository) SetContentHash(sourceUID, uid, hash string) error {
+	ctx := context.Background()
+	return r.db.Client().Activity.Update().
+		Where(activity.SourceUIDEQ(sourceUID), activity.UIDEQ(uid)).
+		SetContentHash(hash).
+		Exec(ctx)
 }
 
-func (r *ActivityRepository) Remove(uid string) error {
+// Remove deletes the activity together with its queued job and dead letter, which share its ID,
+// so that the pipeline doesn't store the activity again after it was deleted at its source.
+func (r *ActivityRepository) Remove(id string) error {
 	ctx := context.Background()
-	return r.db.Client().Activity.DeleteOneID(uid).Exec(ctx)
+
+	tx, err := r.db.Client().Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+
+	if _, err := tx.Job.Delete().Where(job.IDEQ(id)).Exec(ctx); err != nil {
+		return rollback(tx, fmt.Errorf("delete job: %w", err))
+	}
+
+	if _, err := tx.DeadLetter.Delete().Where(deadletter.IDEQ(id)).Exec(ctx); err != nil {
+		return rollback(tx, fmt.Errorf("delete dead letter: %w", err))
+	}
+
+	if _, err := tx.Activity.Delete().Where(activity.IDEQ(id)).Exec(ctx); err != nil {
+		return rollback(tx, fmt.Errorf("delete activity: %w", err))
+	}
+
+	return tx.Commit()
 }
 
 func (r *ActivityRepository) List() ([]*types.DecoratedActivity, error) {
@@ -97,6 +195,8 @@ func (r *ActivityRepository) Search(req types.SearchRequest) ([]*types.Decorated
 			if req.MinSimilarity > 0 {
 				s.Where(sql.GT(simExpr, req.MinSimilarity))
 			}
+			// Archived activities have no embedding to compare.
+			s.Where(sql.NotNull(s.C(activity.FieldEmbedding)))
 		} else {
 			simExpr = "CAST(0 AS float8)"
 		}
@@ -132,6 +232,8 @@ func (r *ActivityRepository) Search(req types.SearchRequest) ([]*types.Decorated
 		activity.FieldFullSummary,
 		activity.FieldRawJSON,
 		activity.FieldEmbedding,
+		activity.FieldStarred,
+		activity.FieldReferenced,
 	}
 
 	var rows []activityWithSimilarity
@@ -142,7 +244,7 @@ func (r *ActivityRepository) Search(req types.SearchRequest) ([]*types.Decorated
 
 	result := make([]*types.DecoratedActivity, len(rows))
 	for i, a := range rows {
-		act, err := activities.NewActivity(a.SourceType)
+		act, err := sources.NewActivity(a.SourceType)
 		if err != nil {
 			return nil, fmt.Errorf("new activity: %w", err)
 		}
@@ -158,6 +260,8 @@ func (r *ActivityRepository) Search(req types.SearchRequest) ([]*types.Decorated
 			},
 			// Embedding:  a.Embedding.Slice(),
 			Similarity: float32(a.Similarity),
+			Starred:    a.Starred,
+			Referenced: a.Referenced,
 		}
 	}
 
@@ -165,7 +269,7 @@ func (r *ActivityRepository) Search(req types.SearchRequest) ([]*types.Decorated
 }
 
 func activityFromEnt(in *ent.Activity) (*types.DecoratedActivity, error) {
-	act, err := activities.NewActivity(in.SourceType)
+	act, err := sources.NewActivity(in.SourceType)
 	if err != nil {
 		return nil, fmt.Errorf("new activity: %w", err)
 	}
@@ -181,5 +285,130 @@ func activityFromEnt(in *ent.Activity) (*types.DecoratedActivity, error) {
 			ShortSummary: in.ShortSummary,
 			FullSummary:  in.FullSummary,
 		},
+		Starred:    in.Starred,
+		Referenced: in.Referenced,
 	}, nil
 }
+
+// SetStarred stars or unstars the activity with the ID.
+// The returned bool is false if the activity isn't stored.
+func (r *ActivityRepository) SetStarred(id string, starred bool) (bool, error) {
+	ctx := context.Background()
+
+	n, err := r.db.Client().Activity.Update().
+		Where(activity.IDEQ(id)).
+		SetStarred(starred).
+		Save(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return n > 0, nil
+}
+
+// Expired returns the number and size of activities that expired under the retention rule.
+// The size is that of whole rows, or only of embeddings if archive is set.
+func (r *ActivityRepository) Expired(filter types.RetentionFilter, archive bool) (types.RetentionStats, error) {
+	ctx := context.Background()
+
+	return r.expiredStats(ctx, r.db.Client().Activity.Query().Where(retentionPredicate(filter)), archive)
+}
+
+// Prune deletes up to limit activities that expired under the retention rule,
+// or drops their embeddings if archive is set. It returns what was pruned,
+// which is less than limit once no expired activities are left.
+func (r *ActivityRepository) Prune(filter types.RetentionFilter, limit int, archive bool) (types.RetentionStats, error) {
+	ctx := context.Background()
+
+	ids, err := r.db.Client().Activity.Query().
+		Where(retentionPredicate(filter)).
+		Order(ent.Asc(activity.FieldCreatedAt)).
+		Limit(limit).
+		IDs(ctx)
+	if err != nil {
+		return types.RetentionStats{}, fmt.Errorf("select expired activities: %w", err)
+	}
+
+	if len(ids) == 0 {
+		return types.RetentionStats{}, nil
+	}
+
+	stats, err := r.expiredStats(ctx, r.db.Client().Activity.Query().Where(activity.IDIn(ids...)), archive)
+	if err != nil {
+		return types.RetentionStats{}, err
+	}
+
+	if archive {
+		stats.Activities, err = r.db.Client().Activity.Update().
+			Where(activity.IDIn(ids...)).
+			ClearEmbedding().
+			SetArchivedAt(time.Now()).
+			Save(ctx)
+	} else {
+		stats.Activities, err = r.db.Client().Activity.Delete().
+			Where(activity.IDIn(ids...)).
+			Exec(ctx)
+	}
+	if err != nil {
+		return types.RetentionStats{}, fmt.Errorf("prune activities: %w", err)
+	}
+
+	return stats, nil
+}
+
+func (r *ActivityRepository) expiredStats(ctx context.Context, query *ent.ActivityQuery, archive bool) (types.RetentionStats, error) {
+	var rows []struct {
+		Count int   `json:"count"`
+		Bytes int64 `json:"bytes"`
+	}
+
+	err := query.Aggregate(
+		ent.Count(),
+		func(s *sql.Selector) string {
+			column := s.TableName() + ".*"
+			if archive {
+				column = s.C(activity.FieldEmbedding)
+			}
+			return sql.As(fmt.Sprintf("COALESCE(SUM(pg_column_size(%s)), 0)", column), "bytes")
+		},
+	).Scan(ctx, &rows)
+	if err != nil {
+		return types.RetentionStats{}, fmt.Errorf("count expired activities: %w", err)
+	}
+
+	if len(rows) == 0 {
+		return types.RetentionStats{}, nil
+	}
+
+	return types.RetentionStats{Activities: rows[0].Count, Bytes: rows[0].Bytes}, nil
+}
+
+func retentionPredicate(filter types.RetentionFilter) predicate.Activity {
+	predicates := []predicate.Activity{
+		activity.CreatedAtLT(filter.CreatedBefore),
+	}
+
+	if filter.SourceUID != "" {
+		predicates = append(predicates, activity.SourceUIDEQ(filter.SourceUID))
+	}
+	if filter.SourceType != "" {
+		predicates = append(predicates, activity.SourceTypeEQ(filter.SourceType))
+	}
+	if len(filter.ExcludeSourceUIDs) > 0 {
+		predicates = append(predicates, activity.SourceUIDNotIn(filter.ExcludeSourceUIDs...))
+	}
+	if len(filter.ExcludeSourceTypes) > 0 {
+		predicates = append(predicates, activity.SourceTypeNotIn(filter.ExcludeSourceTypes...))
+	}
+	if filter.KeepStarred {
+		predicates = append(predicates, activity.StarredEQ(false))
+	}
+	if filter.KeepReferenced {
+		predicates = append(predicates, activity.ReferencedEQ(false))
+	}
+	if !filter.Archived {
+		predicates = append(predicates, activity.ArchivedAtIsNil())
+	}
+
+	return activity.And(predicates...)
+}
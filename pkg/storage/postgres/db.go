@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"fmt"
 	"github.com/glanceapp/glance/pkg/storage/postgres/ent"
+	"github.com/glanceapp/glance/pkg/storage/postgres/ent/migrate"
 
 	entsql "entgo.io/ent/dialect/sql"
 
@@ -27,7 +28,7 @@ func (d *DB) Client() *ent.Client {
 	return d.client
 }
 
-// Connect connects to Postgres and optionally creates the schema.
+// Connect connects to Postgres, optionally creates the schema, and migrates data stored by earlier versions.
 func (d *DB) Connect(ctx context.Context) error {
 	db, err := sql.Open("pgx", d.cfg.DSN())
 	if err != nil {
@@ -39,12 +40,208 @@ func (d *DB) Connect(ctx context.Context) error {
 
 	// Optional schema creation for local/dev environments.
 	if d.cfg.AutoMigrate {
-		if err = client.Schema.Create(ctx); err != nil {
+		// Dropping indexes is required to relax the former unique constraint on activities.uid.
+		if err = client.Schema.Create(ctx, migrate.WithDropIndex(true)); err != nil {
 			return fmt.Errorf("create schema resources: %w", err)
 		}
 	}
 
+	// Data migrations run whether or not the schema is created here, since they rewrite rows
+	// stored by earlier versions. Each runs once, as soon as the schema has the columns it needs.
+	if err = migrateData(ctx, db); err != nil {
+		return fmt.Errorf("migrate data: %w", err)
+	}
+
 	d.client = client
 
 	return nil
 }
+
+// dataMigrationLock is the advisory lock that keeps replicas from migrating data concurrently.
+const dataMigrationLock = 0x70756c7365
+
+type dataMigration struct {
+	name string
+	// requires lists the columns the migration uses by table.
+	requires map[string][]string
+	run      func(ctx context.Context, tx *sql.Tx) error
+}
+
+// dataMigrations are applied in order, and recorded in the data_migrations table once applied.
+var dataMigrations = []dataMigration{
+	{
+		name: "namespace_activity_ids",
+		requires: map[string][]string{
+			"activities": {"id", "uid", "source_uid"},
+			"jobs":       {"id", "source_uid"},
+		},
+		run: migrateActivityIDs,
+	},
+	{
+		name: "dead_letter_failed_jobs",
+		requires: map[string][]string{
+			"jobs": {"id", "source_uid", "source_type", "raw_json", "stage", "state", "last_error", "attempts",
+				"short_summary", "full_summary", "embedding", "updated_at"},
+			"dead_letters": {"id", "source_uid", "source_type", "raw_json", "stage", "error", "attempts",
+				"short_summary", "full_summary", "embedding", "failed_at"},
+		},
+		run: migrateFailedJobs,
+	},
+}
+
+// migrateData applies the data migrations that weren't applied yet. Migrations whose tables or columns
+// don't exist yet, e.g. because the schema is managed without DB_AUTO_MIGRATE and wasn't updated yet,
+// are skipped, and applied on a later start.
+func migrateData(ctx context.Context, db *sql.DB) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err = tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock($1)`, dataMigrationLock); err != nil {
+		return fmt.Errorf("acquire lock: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		CREATE TABLE IF NOT EXISTS data_migrations (
+			name text PRIMARY KEY,
+			applied_at timestamptz NOT NULL DEFAULT now()
+		)`)
+	if err != nil {
+		return fmt.Errorf("create data migrations table: %w", err)
+	}
+
+	applied, err := appliedDataMigrations(ctx, tx)
+	if err != nil {
+		return fmt.Errorf("list applied data migrations: %w", err)
+	}
+
+	columns, err := schemaColumns(ctx, tx)
+	if err != nil {
+		return fmt.Errorf("list columns: %w", err)
+	}
+
+	for _, m := range dataMigrations {
+		if applied[m.name] || !hasColumns(columns, m.requires) {
+			continue
+		}
+
+		if err := m.run(ctx, tx); err != nil {
+			return fmt.Errorf("%s: %w", m.name, err)
+		}
+
+		if _, err := tx.ExecContext(ctx, `INSERT INTO data_migrations (name) VALUES ($1)`, m.name); err != nil {
+			return fmt.Errorf("record %s: %w", m.name, err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+func appliedDataMigrations(ctx context.Context, tx *sql.Tx) (map[string]bool, error) {
+	rows, err := tx.QueryContext(ctx, `SELECT name FROM data_migrations`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := make(map[string]bool)
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		out[name] = true
+	}
+
+	return out, rows.Err()
+}
+
+// schemaColumns returns the columns of the tables in the current schema, keyed by table and column name.
+func schemaColumns(ctx context.Context, tx *sql.Tx) (map[string]bool, error) {
+	rows, err := tx.QueryContext(ctx, `
+		SELECT table_name, column_name FROM information_schema.columns
+		WHERE table_schema = current_schema()`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := make(map[string]bool)
+	for rows.Next() {
+		var table, column string
+		if err := rows.Scan(&table, &column); err != nil {
+			return nil, err
+		}
+		out[table+"."+column] = true
+	}
+
+	return out, rows.Err()
+}
+
+func hasColumns(columns map[string]bool, required map[string][]string) bool {
+	for table, names := range required {
+		for _, name := range names {
+			if !columns[table+"."+name] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// migrateActivityIDs rewrites IDs of activities and jobs stored before IDs were namespaced by source.
+// Must produce the same IDs as types.ActivityID.
+func migrateActivityIDs(ctx context.Context, tx *sql.Tx) error {
+	// Legacy rows that were stored again under their namespaced ID are superseded.
+	_, err := tx.ExecContext(ctx, `
+		DELETE FROM activities legacy
+		WHERE legacy.id = legacy.uid
+		AND EXISTS (
+			SELECT 1 FROM activities a
+			WHERE a.id = encode(sha256(convert_to(legacy.source_uid || E'\n' || legacy.uid, 'UTF8')), 'hex')
+		)`)
+	if err != nil {
+		return fmt.Errorf("delete superseded activities: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE activities
+		SET id = encode(sha256(convert_to(source_uid || E'\n' || uid, 'UTF8')), 'hex')
+		WHERE id = uid`)
+	if err != nil {
+		return fmt.Errorf("update activities: %w", err)
+	}
+
+	// Jobs don't store the native UID separately, but their legacy IDs were the native UID.
+	// Since the migration is applied once, every job stored before it has a legacy ID.
+	_, err = tx.ExecContext(ctx, `
+		UPDATE jobs
+		SET id = encode(sha256(convert_to(source_uid || E'\n' || id, 'UTF8')), 'hex')`)
+	if err != nil {
+		return fmt.Errorf("update jobs: %w", err)
+	}
+
+	return nil
+}
+
+// migrateFailedJobs moves jobs that were marked as failed before dead letters existed to the dead letters.
+func migrateFailedJobs(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		INSERT INTO dead_letters (id, source_uid, source_type, raw_json, stage, error, attempts, short_summary, full_summary, embedding, failed_at)
+		SELECT id, source_uid, source_type, raw_json, stage, COALESCE(last_error, ''), attempts, short_summary, full_summary, embedding, updated_at
+		FROM jobs
+		WHERE state = 'failed'
+		ON CONFLICT (id) DO NOTHING`)
+	if err != nil {
+		return fmt.Errorf("insert dead letters: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, `DELETE FROM jobs WHERE state = 'failed'`)
+	if err != nil {
+		return fmt.Errorf("delete failed jobs: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,217 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	entsql "entgo.io/ent/dialect/sql"
+
+	"github.com/glanceapp/glance/pkg/storage/postgres/ent"
+)
+
+// fakeConnector is a database that answers SELECT queries of a table with scripted rows,
+// and records the statements executed against it.
+type fakeConnector struct {
+	mu    sync.Mutex
+	rows  map[string][]map[string]driver.Value
+	stmts []fakeStmt
+}
+
+type fakeStmt struct {
+	query string
+	args  []driver.Value
+}
+
+func newFakeDB(rows map[string][]map[string]driver.Value) (*DB, *fakeConnector) {
+	c := &fakeConnector{rows: rows}
+	return &DB{client: ent.NewClient(ent.Driver(entsql.OpenDB("postgres", sql.OpenDB(c))))}, c
+}
+
+// executed returns the recorded statements that start with the prefix.
+func (c *fakeConnector) executed(prefix string) []fakeStmt {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var out []fakeStmt
+	for _, s := range c.stmts {
+		if strings.HasPrefix(s.query, prefix) {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+func (c *fakeConnector) record(query string, args []driver.Value) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.stmts = append(c.stmts, fakeStmt{query: query, args: args})
+}
+
+func (c *fakeConnector) Connect(context.Context) (driver.Conn, error) { return &fakeConn{c}, nil }
+func (c *fakeConnector) Driver() driver.Driver                        { return nil }
+
+type fakeConn struct{ c *fakeConnector }
+
+func (conn *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return &fakeStatement{c: conn.c, query: strings.TrimSpace(query)}, nil
+}
+func (conn *fakeConn) Close() error { return nil }
+func (conn *fakeConn) Begin() (driver.Tx, error) {
+	conn.c.record("BEGIN", nil)
+	return &fakeTx{conn.c}, nil
+}
+
+type fakeTx struct{ c *fakeConnector }
+
+func (tx *fakeTx) Commit() error   { tx.c.record("COMMIT", nil); return nil }
+func (tx *fakeTx) Rollback() error { tx.c.record("ROLLBACK", nil); return nil }
+
+type fakeStatement struct {
+	c     *fakeConnector
+	query string
+}
+
+func (s *fakeStatement) Close() error  { return nil }
+func (s *fakeStatement) NumInput() int { return -1 }
+
+func (s *fakeStatement) Exec(args []driver.Value) (driver.Result, error) {
+	s.c.record(s.query, args)
+	return driver.RowsAffected(1), nil
+}
+
+func (s *fakeStatement) Query(args []driver.Value) (driver.Rows, error) {
+	s.c.record(s.query, args)
+
+	// Inserts return a row with the column they return, e.g. the ID of the inserted row.
+	if _, returning, ok := strings.Cut(s.query, " RETURNING "); ok {
+		column := strings.Trim(returning, `"`)
+		return &fakeRows{
+			columns: []string{column},
+			rows:    []map[string]driver.Value{{column: ""}},
+		}, nil
+	}
+
+	// Other SELECT queries return the scripted rows of their table, with the columns they select.
+	selected, from, ok := strings.Cut(strings.TrimPrefix(s.query, "SELECT "), " FROM ")
+	if !strings.HasPrefix(s.query, "SELECT ") || !ok {
+		return &fakeRows{}, nil
+	}
+
+	var columns []string
+	for _, column := range strings.Split(selected, ", ") {
+		if _, name, ok := strings.Cut(column, "."); ok {
+			column = name
+		}
+		columns = append(columns, strings.Trim(column, `"`))
+	}
+
+	table := strings.Trim(strings.Fields(from)[0], `"`)
+	return &fakeRows{columns: columns, rows: s.c.rows[table]}, nil
+}
+
+type fakeRows struct {
+	columns []string
+	rows    []map[string]driver.Value
+	next    int
+}
+
+func (r *fakeRows) Columns() []string { return r.columns }
+func (r *fakeRows) Close() error      { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.next == len(r.rows) {
+		return io.EOF
+	}
+	for i, column := range r.columns {
+		dest[i] = r.rows[r.next][column]
+	}
+	r.next++
+	return nil
+}
+
+// schemaRows returns the information_schema.columns rows of the tables required by the migrations.
+func schemaRows(tables ...string) []map[string]driver.Value {
+	var out []map[string]driver.Value
+	for _, m := range dataMigrations {
+		for table, columns := range m.requires {
+			if !slices.Contains(tables, table) {
+				continue
+			}
+			for _, column := range columns {
+				out = append(out, map[string]driver.Value{"table_name": table, "column_name": column})
+			}
+		}
+	}
+	return out
+}
+
+func TestMigrateData(t *testing.T) {
+	tests := []struct {
+		name    string
+		tables  []string
+		applied []string
+		want    []string
+	}{
+		{
+			name:   "new database",
+			tables: []string{"activities", "jobs", "dead_letters"},
+			want:   []string{"namespace_activity_ids", "dead_letter_failed_jobs"},
+		},
+		{
+			name:    "applied migrations",
+			tables:  []string{"activities", "jobs", "dead_letters"},
+			applied: []string{"namespace_activity_ids"},
+			want:    []string{"dead_letter_failed_jobs"},
+		},
+		{
+			name:    "all migrations applied",
+			tables:  []string{"activities", "jobs", "dead_letters"},
+			applied: []string{"namespace_activity_ids", "dead_letter_failed_jobs"},
+		},
+		{
+			name:   "schema without dead letters",
+			tables: []string{"activities", "jobs"},
+			want:   []string{"namespace_activity_ids"},
+		},
+		{name: "schema without tables"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var applied []map[string]driver.Value
+			for _, name := range tt.applied {
+				applied = append(applied, map[string]driver.Value{"name": name})
+			}
+
+			c := &fakeConnector{rows: map[string][]map[string]driver.Value{
+				"information_schema.columns": schemaRows(tt.tables...),
+				"data_migrations":            applied,
+			}}
+
+			if err := migrateData(context.Background(), sql.OpenDB(c)); err != nil {
+				t.Fatalf("migrateData() err = %v", err)
+			}
+
+			var got []string
+			for _, s := range c.executed("INSERT INTO data_migrations") {
+				got = append(got, s.args[0].(string))
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("applied %v, want %v", got, tt.want)
+			}
+
+			if !slices.Contains(tt.want, "namespace_activity_ids") && len(c.executed("UPDATE jobs")) > 0 {
+				t.Error("job IDs were migrated again")
+			}
+			if commits := c.executed("COMMIT"); len(commits) != 1 {
+				t.Errorf("committed %d times, want once", len(commits))
+			}
+		})
+	}
+}
@@ -41,7 +41,19 @@ type Activity struct {
 	// RawJSON holds the value of the "raw_json" field.
 	RawJSON string `json:"raw_json,omitempty"`
 	// Embedding holds the value of the "embedding" field.
-	Embedding    *pgvector.Vector `json:"embedding,omitempty"`
+	Embedding *pgvector.Vector `json:"embedding,omitempty"`
+	// ContentHash holds the value of the "content_hash" field.
+	ContentHash string `json:"content_hash,omitempty"`
+	// Revision holds the value of the "revision" field.
+	Revision int `json:"revision,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Starred holds the value of the "starred" field.
+	Starred bool `json:"starred,omitempty"`
+	// Referenced holds the value of the "referenced" field.
+	Referenced bool `json:"referenced,omitempty"`
+	// ArchivedAt holds the value of the "archived_at" field.
+	ArchivedAt   *time.Time `json:"archived_at,omitempty"`
 	selectValues sql.SelectValues
 }
 
@@ -52,9 +64,13 @@ func (*Activity) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case activity.FieldEmbedding:
 			values[i] = &sql.NullScanner{S: new(pgvector.Vector)}
-		case activity.FieldID, activity.FieldUID, activity.FieldSourceUID, activity.FieldSourceType, activity.FieldTitle, activity.FieldBody, activity.FieldURL, activity.FieldImageURL, activity.FieldShortSummary, activity.FieldFullSummary, activity.FieldRawJSON:
+		case activity.FieldStarred, activity.FieldReferenced:
+			values[i] = new(sql.NullBool)
+		case activity.FieldRevision:
+			values[i] = new(sql.NullInt64)
+		case activity.FieldID, activity.FieldUID, activity.FieldSourceUID, activity.FieldSourceType, activity.FieldTitle, activity.FieldBody, activity.FieldURL, activity.FieldImageURL, activity.FieldShortSummary, activity.FieldFullSummary, activity.FieldRawJSON, activity.FieldContentHash:
 			values[i] = new(sql.NullString)
-		case activity.FieldCreatedAt:
+		case activity.FieldCreatedAt, activity.FieldUpdatedAt, activity.FieldArchivedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -150,6 +166,43 @@ func (a *Activity) assignValues(columns []string, values []any) error {
 				a.Embedding = new(pgvector.Vector)
 				*a.Embedding = *value.S.(*pgvector.Vector)
 			}
+		case activity.FieldContentHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content_hash", values[i])
+			} else if value.Valid {
+				a.ContentHash = value.String
+			}
+		case activity.FieldRevision:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field revision", values[i])
+			} else if value.Valid {
+				a.Revision = int(value.Int64)
+			}
+		case activity.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				a.UpdatedAt = value.Time
+			}
+		case activity.FieldStarred:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field starred", values[i])
+			} else if value.Valid {
+				a.Starred = value.Bool
+			}
+		case activity.FieldReferenced:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field referenced", values[i])
+			} else if value.Valid {
+				a.Referenced = value.Bool
+			}
+		case activity.FieldArchivedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field archived_at", values[i])
+			} else if value.Valid {
+				a.ArchivedAt = new(time.Time)
+				*a.ArchivedAt = value.Time
+			}
 		default:
 			a.selectValues.Set(columns[i], values[i])
 		}
@@ -223,6 +276,26 @@ func (a *Activity) String() string {
 		builder.WriteString("embedding=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
+	builder.WriteString(", ")
+	builder.WriteString("content_hash=")
+	builder.WriteString(a.ContentHash)
+	builder.WriteString(", ")
+	builder.WriteString("revision=")
+	builder.WriteString(fmt.Sprintf("%v", a.Revision))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(a.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("starred=")
+	builder.WriteString(fmt.Sprintf("%v", a.Starred))
+	builder.WriteString(", ")
+	builder.WriteString("referenced=")
+	builder.WriteString(fmt.Sprintf("%v", a.Referenced))
+	builder.WriteString(", ")
+	if v := a.ArchivedAt; v != nil {
+		builder.WriteString("archived_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -3,6 +3,8 @@
 package activity
 
 import (
+	"time"
+
 	"entgo.io/ent/dialect/sql"
 )
 
@@ -35,6 +37,18 @@ const (
 	FieldRawJSON = "raw_json"
 	// FieldEmbedding holds the string denoting the embedding field in the database.
 	FieldEmbedding = "embedding"
+	// FieldContentHash holds the string denoting the content_hash field in the database.
+	FieldContentHash = "content_hash"
+	// FieldRevision holds the string denoting the revision field in the database.
+	FieldRevision = "revision"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldStarred holds the string denoting the starred field in the database.
+	FieldStarred = "starred"
+	// FieldReferenced holds the string denoting the referenced field in the database.
+	FieldReferenced = "referenced"
+	// FieldArchivedAt holds the string denoting the archived_at field in the database.
+	FieldArchivedAt = "archived_at"
 	// Table holds the table name of the activity in the database.
 	Table = "activities"
 )
@@ -54,6 +68,12 @@ var Columns = []string{
 	FieldFullSummary,
 	FieldRawJSON,
 	FieldEmbedding,
+	FieldContentHash,
+	FieldRevision,
+	FieldUpdatedAt,
+	FieldStarred,
+	FieldReferenced,
+	FieldArchivedAt,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -66,6 +86,21 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+var (
+	// DefaultContentHash holds the default value on creation for the "content_hash" field.
+	DefaultContentHash string
+	// DefaultRevision holds the default value on creation for the "revision" field.
+	DefaultRevision int
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultStarred holds the default value on creation for the "starred" field.
+	DefaultStarred bool
+	// DefaultReferenced holds the default value on creation for the "referenced" field.
+	DefaultReferenced bool
+)
+
 // OrderOption defines the ordering options for the Activity queries.
 type OrderOption func(*sql.Selector)
 
@@ -133,3 +168,33 @@ func ByRawJSON(opts ...sql.OrderTermOption) OrderOption {
 func ByEmbedding(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEmbedding, opts...).ToFunc()
 }
+
+// ByContentHash orders the results by the content_hash field.
+func ByContentHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContentHash, opts...).ToFunc()
+}
+
+// ByRevision orders the results by the revision field.
+func ByRevision(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRevision, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByStarred orders the results by the starred field.
+func ByStarred(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStarred, opts...).ToFunc()
+}
+
+// ByReferenced orders the results by the referenced field.
+func ByReferenced(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReferenced, opts...).ToFunc()
+}
+
+// ByArchivedAt orders the results by the archived_at field.
+func ByArchivedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldArchivedAt, opts...).ToFunc()
+}
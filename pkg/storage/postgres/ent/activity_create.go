@@ -8,6 +8,8 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/glanceapp/glance/pkg/storage/postgres/ent/activity"
@@ -19,6 +21,7 @@ type ActivityCreate struct {
 	config
 	mutation *ActivityMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetUID sets the "uid" field.
@@ -101,6 +104,90 @@ func (ac *ActivityCreate) SetNillableEmbedding(pg *pgvector.Vector) *ActivityCre
 	return ac
 }
 
+// SetContentHash sets the "content_hash" field.
+func (ac *ActivityCreate) SetContentHash(s string) *ActivityCreate {
+	ac.mutation.SetContentHash(s)
+	return ac
+}
+
+// SetNillableContentHash sets the "content_hash" field if the given value is not nil.
+func (ac *ActivityCreate) SetNillableContentHash(s *string) *ActivityCreate {
+	if s != nil {
+		ac.SetContentHash(*s)
+	}
+	return ac
+}
+
+// SetRevision sets the "revision" field.
+func (ac *ActivityCreate) SetRevision(i int) *ActivityCreate {
+	ac.mutation.SetRevision(i)
+	return ac
+}
+
+// SetNillableRevision sets the "revision" field if the given value is not nil.
+func (ac *ActivityCreate) SetNillableRevision(i *int) *ActivityCreate {
+	if i != nil {
+		ac.SetRevision(*i)
+	}
+	return ac
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ac *ActivityCreate) SetUpdatedAt(t time.Time) *ActivityCreate {
+	ac.mutation.SetUpdatedAt(t)
+	return ac
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (ac *ActivityCreate) SetNillableUpdatedAt(t *time.Time) *ActivityCreate {
+	if t != nil {
+		ac.SetUpdatedAt(*t)
+	}
+	return ac
+}
+
+// SetStarred sets the "starred" field.
+func (ac *ActivityCreate) SetStarred(b bool) *ActivityCreate {
+	ac.mutation.SetStarred(b)
+	return ac
+}
+
+// SetNillableStarred sets the "starred" field if the given value is not nil.
+func (ac *ActivityCreate) SetNillableStarred(b *bool) *ActivityCreate {
+	if b != nil {
+		ac.SetStarred(*b)
+	}
+	return ac
+}
+
+// SetReferenced sets the "referenced" field.
+func (ac *ActivityCreate) SetReferenced(b bool) *ActivityCreate {
+	ac.mutation.SetReferenced(b)
+	return ac
+}
+
+// SetNillableReferenced sets the "referenced" field if the given value is not nil.
+func (ac *ActivityCreate) SetNillableReferenced(b *bool) *ActivityCreate {
+	if b != nil {
+		ac.SetReferenced(*b)
+	}
+	return ac
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (ac *ActivityCreate) SetArchivedAt(t time.Time) *ActivityCreate {
+	ac.mutation.SetArchivedAt(t)
+	return ac
+}
+
+// SetNillableArchivedAt sets the "archived_at" field if the given value is not nil.
+func (ac *ActivityCreate) SetNillableArchivedAt(t *time.Time) *ActivityCreate {
+	if t != nil {
+		ac.SetArchivedAt(*t)
+	}
+	return ac
+}
+
 // SetID sets the "id" field.
 func (ac *ActivityCreate) SetID(s string) *ActivityCreate {
 	ac.mutation.SetID(s)
@@ -114,6 +201,7 @@ func (ac *ActivityCreate) Mutation() *ActivityMutation {
 
 // Save creates the Activity in the database.
 func (ac *ActivityCreate) Save(ctx context.Context) (*Activity, error) {
+	ac.defaults()
 	return withHooks(ctx, ac.sqlSave, ac.mutation, ac.hooks)
 }
 
@@ -139,6 +227,30 @@ func (ac *ActivityCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (ac *ActivityCreate) defaults() {
+	if _, ok := ac.mutation.ContentHash(); !ok {
+		v := activity.DefaultContentHash
+		ac.mutation.SetContentHash(v)
+	}
+	if _, ok := ac.mutation.Revision(); !ok {
+		v := activity.DefaultRevision
+		ac.mutation.SetRevision(v)
+	}
+	if _, ok := ac.mutation.UpdatedAt(); !ok {
+		v := activity.DefaultUpdatedAt()
+		ac.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := ac.mutation.Starred(); !ok {
+		v := activity.DefaultStarred
+		ac.mutation.SetStarred(v)
+	}
+	if _, ok := ac.mutation.Referenced(); !ok {
+		v := activity.DefaultReferenced
+		ac.mutation.SetReferenced(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (ac *ActivityCreate) check() error {
 	if _, ok := ac.mutation.UID(); !ok {
@@ -174,6 +286,21 @@ func (ac *ActivityCreate) check() error {
 	if _, ok := ac.mutation.RawJSON(); !ok {
 		return &ValidationError{Name: "raw_json", err: errors.New(`ent: missing required field "Activity.raw_json"`)}
 	}
+	if _, ok := ac.mutation.ContentHash(); !ok {
+		return &ValidationError{Name: "content_hash", err: errors.New(`ent: missing required field "Activity.content_hash"`)}
+	}
+	if _, ok := ac.mutation.Revision(); !ok {
+		return &ValidationError{Name: "revision", err: errors.New(`ent: missing required field "Activity.revision"`)}
+	}
+	if _, ok := ac.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Activity.updated_at"`)}
+	}
+	if _, ok := ac.mutation.Starred(); !ok {
+		return &ValidationError{Name: "starred", err: errors.New(`ent: missing required field "Activity.starred"`)}
+	}
+	if _, ok := ac.mutation.Referenced(); !ok {
+		return &ValidationError{Name: "referenced", err: errors.New(`ent: missing required field "Activity.referenced"`)}
+	}
 	return nil
 }
 
@@ -205,6 +332,7 @@ func (ac *ActivityCreate) createSpec() (*Activity, *sqlgraph.CreateSpec) {
 		_node = &Activity{config: ac.config}
 		_spec = sqlgraph.NewCreateSpec(activity.Table, sqlgraph.NewFieldSpec(activity.FieldID, field.TypeString))
 	)
+	_spec.OnConflict = ac.conflict
 	if id, ok := ac.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = id
@@ -257,14 +385,681 @@ func (ac *ActivityCreate) createSpec() (*Activity, *sqlgraph.CreateSpec) {
 		_spec.SetField(activity.FieldEmbedding, field.TypeOther, value)
 		_node.Embedding = &value
 	}
+	if value, ok := ac.mutation.ContentHash(); ok {
+		_spec.SetField(activity.FieldContentHash, field.TypeString, value)
+		_node.ContentHash = value
+	}
+	if value, ok := ac.mutation.Revision(); ok {
+		_spec.SetField(activity.FieldRevision, field.TypeInt, value)
+		_node.Revision = value
+	}
+	if value, ok := ac.mutation.UpdatedAt(); ok {
+		_spec.SetField(activity.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := ac.mutation.Starred(); ok {
+		_spec.SetField(activity.FieldStarred, field.TypeBool, value)
+		_node.Starred = value
+	}
+	if value, ok := ac.mutation.Referenced(); ok {
+		_spec.SetField(activity.FieldReferenced, field.TypeBool, value)
+		_node.Referenced = value
+	}
+	if value, ok := ac.mutation.ArchivedAt(); ok {
+		_spec.SetField(activity.FieldArchivedAt, field.TypeTime, value)
+		_node.ArchivedAt = &value
+	}
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Activity.Create().
+//		SetUID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ActivityUpsert) {
+//			SetUID(v+v).
+//		}).
+//		Exec(ctx)
+func (ac *ActivityCreate) OnConflict(opts ...sql.ConflictOption) *ActivityUpsertOne {
+	ac.conflict = opts
+	return &ActivityUpsertOne{
+		create: ac,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Activity.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ac *ActivityCreate) OnConflictColumns(columns ...string) *ActivityUpsertOne {
+	ac.conflict = append(ac.conflict, sql.ConflictColumns(columns...))
+	return &ActivityUpsertOne{
+		create: ac,
+	}
+}
+
+type (
+	// ActivityUpsertOne is the builder for "upsert"-ing
+	//  one Activity node.
+	ActivityUpsertOne struct {
+		create *ActivityCreate
+	}
+
+	// ActivityUpsert is the "OnConflict" setter.
+	ActivityUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUID sets the "uid" field.
+func (u *ActivityUpsert) SetUID(v string) *ActivityUpsert {
+	u.Set(activity.FieldUID, v)
+	return u
+}
+
+// UpdateUID sets the "uid" field to the value that was provided on create.
+func (u *ActivityUpsert) UpdateUID() *ActivityUpsert {
+	u.SetExcluded(activity.FieldUID)
+	return u
+}
+
+// SetSourceUID sets the "source_uid" field.
+func (u *ActivityUpsert) SetSourceUID(v string) *ActivityUpsert {
+	u.Set(activity.FieldSourceUID, v)
+	return u
+}
+
+// UpdateSourceUID sets the "source_uid" field to the value that was provided on create.
+func (u *ActivityUpsert) UpdateSourceUID() *ActivityUpsert {
+	u.SetExcluded(activity.FieldSourceUID)
+	return u
+}
+
+// SetSourceType sets the "source_type" field.
+func (u *ActivityUpsert) SetSourceType(v string) *ActivityUpsert {
+	u.Set(activity.FieldSourceType, v)
+	return u
+}
+
+// UpdateSourceType sets the "source_type" field to the value that was provided on create.
+func (u *ActivityUpsert) UpdateSourceType() *ActivityUpsert {
+	u.SetExcluded(activity.FieldSourceType)
+	return u
+}
+
+// SetTitle sets the "title" field.
+func (u *ActivityUpsert) SetTitle(v string) *ActivityUpsert {
+	u.Set(activity.FieldTitle, v)
+	return u
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *ActivityUpsert) UpdateTitle() *ActivityUpsert {
+	u.SetExcluded(activity.FieldTitle)
+	return u
+}
+
+// SetBody sets the "body" field.
+func (u *ActivityUpsert) SetBody(v string) *ActivityUpsert {
+	u.Set(activity.FieldBody, v)
+	return u
+}
+
+// UpdateBody sets the "body" field to the value that was provided on create.
+func (u *ActivityUpsert) UpdateBody() *ActivityUpsert {
+	u.SetExcluded(activity.FieldBody)
+	return u
+}
+
+// SetURL sets the "url" field.
+func (u *ActivityUpsert) SetURL(v string) *ActivityUpsert {
+	u.Set(activity.FieldURL, v)
+	return u
+}
+
+// UpdateURL sets the "url" field to the value that was provided on create.
+func (u *ActivityUpsert) UpdateURL() *ActivityUpsert {
+	u.SetExcluded(activity.FieldURL)
+	return u
+}
+
+// SetImageURL sets the "image_url" field.
+func (u *ActivityUpsert) SetImageURL(v string) *ActivityUpsert {
+	u.Set(activity.FieldImageURL, v)
+	return u
+}
+
+// UpdateImageURL sets the "image_url" field to the value that was provided on create.
+func (u *ActivityUpsert) UpdateImageURL() *ActivityUpsert {
+	u.SetExcluded(activity.FieldImageURL)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ActivityUpsert) SetCreatedAt(v time.Time) *ActivityUpsert {
+	u.Set(activity.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ActivityUpsert) UpdateCreatedAt() *ActivityUpsert {
+	u.SetExcluded(activity.FieldCreatedAt)
+	return u
+}
+
+// SetShortSummary sets the "short_summary" field.
+func (u *ActivityUpsert) SetShortSummary(v string) *ActivityUpsert {
+	u.Set(activity.FieldShortSummary, v)
+	return u
+}
+
+// UpdateShortSummary sets the "short_summary" field to the value that was provided on create.
+func (u *ActivityUpsert) UpdateShortSummary() *ActivityUpsert {
+	u.SetExcluded(activity.FieldShortSummary)
+	return u
+}
+
+// SetFullSummary sets the "full_summary" field.
+func (u *ActivityUpsert) SetFullSummary(v string) *ActivityUpsert {
+	u.Set(activity.FieldFullSummary, v)
+	return u
+}
+
+// UpdateFullSummary sets the "full_summary" field to the value that was provided on create.
+func (u *ActivityUpsert) UpdateFullSummary() *ActivityUpsert {
+	u.SetExcluded(activity.FieldFullSummary)
+	return u
+}
+
+// SetRawJSON sets the "raw_json" field.
+func (u *ActivityUpsert) SetRawJSON(v string) *ActivityUpsert {
+	u.Set(activity.FieldRawJSON, v)
+	return u
+}
+
+// UpdateRawJSON sets the "raw_json" field to the value that was provided on create.
+func (u *ActivityUpsert) UpdateRawJSON() *ActivityUpsert {
+	u.SetExcluded(activity.FieldRawJSON)
+	return u
+}
+
+// SetEmbedding sets the "embedding" field.
+func (u *ActivityUpsert) SetEmbedding(v pgvector.Vector) *ActivityUpsert {
+	u.Set(activity.FieldEmbedding, v)
+	return u
+}
+
+// UpdateEmbedding sets the "embedding" field to the value that was provided on create.
+func (u *ActivityUpsert) UpdateEmbedding() *ActivityUpsert {
+	u.SetExcluded(activity.FieldEmbedding)
+	return u
+}
+
+// ClearEmbedding clears the value of the "embedding" field.
+func (u *ActivityUpsert) ClearEmbedding() *ActivityUpsert {
+	u.SetNull(activity.FieldEmbedding)
+	return u
+}
+
+// SetContentHash sets the "content_hash" field.
+func (u *ActivityUpsert) SetContentHash(v string) *ActivityUpsert {
+	u.Set(activity.FieldContentHash, v)
+	return u
+}
+
+// UpdateContentHash sets the "content_hash" field to the value that was provided on create.
+func (u *ActivityUpsert) UpdateContentHash() *ActivityUpsert {
+	u.SetExcluded(activity.FieldContentHash)
+	return u
+}
+
+// SetRevision sets the "revision" field.
+func (u *ActivityUpsert) SetRevision(v int) *ActivityUpsert {
+	u.Set(activity.FieldRevision, v)
+	return u
+}
+
+// UpdateRevision sets the "revision" field to the value that was provided on create.
+func (u *ActivityUpsert) UpdateRevision() *ActivityUpsert {
+	u.SetExcluded(activity.FieldRevision)
+	return u
+}
+
+// AddRevision adds v to the "revision" field.
+func (u *ActivityUpsert) AddRevision(v int) *ActivityUpsert {
+	u.Add(activity.FieldRevision, v)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ActivityUpsert) SetUpdatedAt(v time.Time) *ActivityUpsert {
+	u.Set(activity.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ActivityUpsert) UpdateUpdatedAt() *ActivityUpsert {
+	u.SetExcluded(activity.FieldUpdatedAt)
+	return u
+}
+
+// SetStarred sets the "starred" field.
+func (u *ActivityUpsert) SetStarred(v bool) *ActivityUpsert {
+	u.Set(activity.FieldStarred, v)
+	return u
+}
+
+// UpdateStarred sets the "starred" field to the value that was provided on create.
+func (u *ActivityUpsert) UpdateStarred() *ActivityUpsert {
+	u.SetExcluded(activity.FieldStarred)
+	return u
+}
+
+// SetReferenced sets the "referenced" field.
+func (u *ActivityUpsert) SetReferenced(v bool) *ActivityUpsert {
+	u.Set(activity.FieldReferenced, v)
+	return u
+}
+
+// UpdateReferenced sets the "referenced" field to the value that was provided on create.
+func (u *ActivityUpsert) UpdateReferenced() *ActivityUpsert {
+	u.SetExcluded(activity.FieldReferenced)
+	return u
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (u *ActivityUpsert) SetArchivedAt(v time.Time) *ActivityUpsert {
+	u.Set(activity.FieldArchivedAt, v)
+	return u
+}
+
+// UpdateArchivedAt sets the "archived_at" field to the value that was provided on create.
+func (u *ActivityUpsert) UpdateArchivedAt() *ActivityUpsert {
+	u.SetExcluded(activity.FieldArchivedAt)
+	return u
+}
+
+// ClearArchivedAt clears the value of the "archived_at" field.
+func (u *ActivityUpsert) ClearArchivedAt() *ActivityUpsert {
+	u.SetNull(activity.FieldArchivedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Activity.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(activity.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ActivityUpsertOne) UpdateNewValues() *ActivityUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(activity.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Activity.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ActivityUpsertOne) Ignore() *ActivityUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ActivityUpsertOne) DoNothing() *ActivityUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ActivityCreate.OnConflict
+// documentation for more info.
+func (u *ActivityUpsertOne) Update(set func(*ActivityUpsert)) *ActivityUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ActivityUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUID sets the "uid" field.
+func (u *ActivityUpsertOne) SetUID(v string) *ActivityUpsertOne {
+	return u.Update(func(s *ActivityUpsert) {
+		s.SetUID(v)
+	})
+}
+
+// UpdateUID sets the "uid" field to the value that was provided on create.
+func (u *ActivityUpsertOne) UpdateUID() *ActivityUpsertOne {
+	return u.Update(func(s *ActivityUpsert) {
+		s.UpdateUID()
+	})
+}
+
+// SetSourceUID sets the "source_uid" field.
+func (u *ActivityUpsertOne) SetSourceUID(v string) *ActivityUpsertOne {
+	return u.Update(func(s *ActivityUpsert) {
+		s.SetSourceUID(v)
+	})
+}
+
+// UpdateSourceUID sets the "source_uid" field to the value that was provided on create.
+func (u *ActivityUpsertOne) UpdateSourceUID() *ActivityUpsertOne {
+	return u.Update(func(s *ActivityUpsert) {
+		s.UpdateSourceUID()
+	})
+}
+
+// SetSourceType sets the "source_type" field.
+func (u *ActivityUpsertOne) SetSourceType(v string) *ActivityUpsertOne {
+	return u.Update(func(s *ActivityUpsert) {
+		s.SetSourceType(v)
+	})
+}
+
+// UpdateSourceType sets the "source_type" field to the value that was provided on create.
+func (u *ActivityUpsertOne) UpdateSourceType() *ActivityUpsertOne {
+	return u.Update(func(s *ActivityUpsert) {
+		s.UpdateSourceType()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *ActivityUpsertOne) SetTitle(v string) *ActivityUpsertOne {
+	return u.Update(func(s *ActivityUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *ActivityUpsertOne) UpdateTitle() *ActivityUpsertOne {
+	return u.Update(func(s *ActivityUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetBody sets the "body" field.
+func (u *ActivityUpsertOne) SetBody(v string) *ActivityUpsertOne {
+	return u.Update(func(s *ActivityUpsert) {
+		s.SetBody(v)
+	})
+}
+
+// UpdateBody sets the "body" field to the value that was provided on create.
+func (u *ActivityUpsertOne) UpdateBody() *ActivityUpsertOne {
+	return u.Update(func(s *ActivityUpsert) {
+		s.UpdateBody()
+	})
+}
+
+// SetURL sets the "url" field.
+func (u *ActivityUpsertOne) SetURL(v string) *ActivityUpsertOne {
+	return u.Update(func(s *ActivityUpsert) {
+		s.SetURL(v)
+	})
+}
+
+// UpdateURL sets the "url" field to the value that was provided on create.
+func (u *ActivityUpsertOne) UpdateURL() *ActivityUpsertOne {
+	return u.Update(func(s *ActivityUpsert) {
+		s.UpdateURL()
+	})
+}
+
+// SetImageURL sets the "image_url" field.
+func (u *ActivityUpsertOne) SetImageURL(v string) *ActivityUpsertOne {
+	return u.Update(func(s *ActivityUpsert) {
+		s.SetImageURL(v)
+	})
+}
+
+// UpdateImageURL sets the "image_url" field to the value that was provided on create.
+func (u *ActivityUpsertOne) UpdateImageURL() *ActivityUpsertOne {
+	return u.Update(func(s *ActivityUpsert) {
+		s.UpdateImageURL()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ActivityUpsertOne) SetCreatedAt(v time.Time) *ActivityUpsertOne {
+	return u.Update(func(s *ActivityUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ActivityUpsertOne) UpdateCreatedAt() *ActivityUpsertOne {
+	return u.Update(func(s *ActivityUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetShortSummary sets the "short_summary" field.
+func (u *ActivityUpsertOne) SetShortSummary(v string) *ActivityUpsertOne {
+	return u.Update(func(s *ActivityUpsert) {
+		s.SetShortSummary(v)
+	})
+}
+
+// UpdateShortSummary sets the "short_summary" field to the value that was provided on create.
+func (u *ActivityUpsertOne) UpdateShortSummary() *ActivityUpsertOne {
+	return u.Update(func(s *ActivityUpsert) {
+		s.UpdateShortSummary()
+	})
+}
+
+// SetFullSummary sets the "full_summary" field.
+func (u *ActivityUpsertOne) SetFullSummary(v string) *ActivityUpsertOne {
+	return u.Update(func(s *ActivityUpsert) {
+		s.SetFullSummary(v)
+	})
+}
+
+// UpdateFullSummary sets the "full_summary" field to the value that was provided on create.
+func (u *ActivityUpsertOne) UpdateFullSummary() *ActivityUpsertOne {
+	return u.Update(func(s *ActivityUpsert) {
+		s.UpdateFullSummary()
+	})
+}
+
+// SetRawJSON sets the "raw_json" field.
+func (u *ActivityUpsertOne) SetRawJSON(v string) *ActivityUpsertOne {
+	return u.Update(func(s *ActivityUpsert) {
+		s.SetRawJSON(v)
+	})
+}
+
+// UpdateRawJSON sets the "raw_json" field to the value that was provided on create.
+func (u *ActivityUpsertOne) UpdateRawJSON() *ActivityUpsertOne {
+	return u.Update(func(s *ActivityUpsert) {
+		s.UpdateRawJSON()
+	})
+}
+
+// SetEmbedding sets the "embedding" field.
+func (u *ActivityUpsertOne) SetEmbedding(v pgvector.Vector) *ActivityUpsertOne {
+	return u.Update(func(s *ActivityUpsert) {
+		s.SetEmbedding(v)
+	})
+}
+
+// UpdateEmbedding sets the "embedding" field to the value that was provided on create.
+func (u *ActivityUpsertOne) UpdateEmbedding() *ActivityUpsertOne {
+	return u.Update(func(s *ActivityUpsert) {
+		s.UpdateEmbedding()
+	})
+}
+
+// ClearEmbedding clears the value of the "embedding" field.
+func (u *ActivityUpsertOne) ClearEmbedding() *ActivityUpsertOne {
+	return u.Update(func(s *ActivityUpsert) {
+		s.ClearEmbedding()
+	})
+}
+
+// SetContentHash sets the "content_hash" field.
+func (u *ActivityUpsertOne) SetContentHash(v string) *ActivityUpsertOne {
+	return u.Update(func(s *ActivityUpsert) {
+		s.SetContentHash(v)
+	})
+}
+
+// UpdateContentHash sets the "content_hash" field to the value that was provided on create.
+func (u *ActivityUpsertOne) UpdateContentHash() *ActivityUpsertOne {
+	return u.Update(func(s *ActivityUpsert) {
+		s.UpdateContentHash()
+	})
+}
+
+// SetRevision sets the "revision" field.
+func (u *ActivityUpsertOne) SetRevision(v int) *ActivityUpsertOne {
+	return u.Update(func(s *ActivityUpsert) {
+		s.SetRevision(v)
+	})
+}
+
+// AddRevision adds v to the "revision" field.
+func (u *ActivityUpsertOne) AddRevision(v int) *ActivityUpsertOne {
+	return u.Update(func(s *ActivityUpsert) {
+		s.AddRevision(v)
+	})
+}
+
+// UpdateRevision sets the "revision" field to the value that was provided on create.
+func (u *ActivityUpsertOne) UpdateRevision() *ActivityUpsertOne {
+	return u.Update(func(s *ActivityUpsert) {
+		s.UpdateRevision()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ActivityUpsertOne) SetUpdatedAt(v time.Time) *ActivityUpsertOne {
+	return u.Update(func(s *ActivityUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ActivityUpsertOne) UpdateUpdatedAt() *ActivityUpsertOne {
+	return u.Update(func(s *ActivityUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetStarred sets the "starred" field.
+func (u *ActivityUpsertOne) SetStarred(v bool) *ActivityUpsertOne {
+	return u.Update(func(s *ActivityUpsert) {
+		s.SetStarred(v)
+	})
+}
+
+// UpdateStarred sets the "starred" field to the value that was provided on create.
+func (u *ActivityUpsertOne) UpdateStarred() *ActivityUpsertOne {
+	return u.Update(func(s *ActivityUpsert) {
+		s.UpdateStarred()
+	})
+}
+
+// SetReferenced sets the "referenced" field.
+func (u *ActivityUpsertOne) SetReferenced(v bool) *ActivityUpsertOne {
+	return u.Update(func(s *ActivityUpsert) {
+		s.SetReferenced(v)
+	})
+}
+
+// UpdateReferenced sets the "referenced" field to the value that was provided on create.
+func (u *ActivityUpsertOne) UpdateReferenced() *ActivityUpsertOne {
+	return u.Update(func(s *ActivityUpsert) {
+		s.UpdateReferenced()
+	})
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (u *ActivityUpsertOne) SetArchivedAt(v time.Time) *ActivityUpsertOne {
+	return u.Update(func(s *ActivityUpsert) {
+		s.SetArchivedAt(v)
+	})
+}
+
+// UpdateArchivedAt sets the "archived_at" field to the value that was provided on create.
+func (u *ActivityUpsertOne) UpdateArchivedAt() *ActivityUpsertOne {
+	return u.Update(func(s *ActivityUpsert) {
+		s.UpdateArchivedAt()
+	})
+}
+
+// ClearArchivedAt clears the value of the "archived_at" field.
+func (u *ActivityUpsertOne) ClearArchivedAt() *ActivityUpsertOne {
+	return u.Update(func(s *ActivityUpsert) {
+		s.ClearArchivedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ActivityUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ActivityCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ActivityUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ActivityUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ActivityUpsertOne.ID is not supported by MySQL driver. Use ActivityUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ActivityUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // ActivityCreateBulk is the builder for creating many Activity entities in bulk.
 type ActivityCreateBulk struct {
 	config
 	err      error
 	builders []*ActivityCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the Activity entities in the database.
@@ -278,6 +1073,7 @@ func (acb *ActivityCreateBulk) Save(ctx context.Context) ([]*Activity, error) {
 	for i := range acb.builders {
 		func(i int, root context.Context) {
 			builder := acb.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*ActivityMutation)
 				if !ok {
@@ -293,6 +1089,7 @@ func (acb *ActivityCreateBulk) Save(ctx context.Context) ([]*Activity, error) {
 					_, err = mutators[i+1].Mutate(root, acb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = acb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, acb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -342,3 +1139,390 @@ func (acb *ActivityCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Activity.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ActivityUpsert) {
+//			SetUID(v+v).
+//		}).
+//		Exec(ctx)
+func (acb *ActivityCreateBulk) OnConflict(opts ...sql.ConflictOption) *ActivityUpsertBulk {
+	acb.conflict = opts
+	return &ActivityUpsertBulk{
+		create: acb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Activity.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (acb *ActivityCreateBulk) OnConflictColumns(columns ...string) *ActivityUpsertBulk {
+	acb.conflict = append(acb.conflict, sql.ConflictColumns(columns...))
+	return &ActivityUpsertBulk{
+		create: acb,
+	}
+}
+
+// ActivityUpsertBulk is the builder for "upsert"-ing
+// a bulk of Activity nodes.
+type ActivityUpsertBulk struct {
+	create *ActivityCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Activity.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(activity.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ActivityUpsertBulk) UpdateNewValues() *ActivityUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(activity.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Activity.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ActivityUpsertBulk) Ignore() *ActivityUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ActivityUpsertBulk) DoNothing() *ActivityUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ActivityCreateBulk.OnConflict
+// documentation for more info.
+func (u *ActivityUpsertBulk) Update(set func(*ActivityUpsert)) *ActivityUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ActivityUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUID sets the "uid" field.
+func (u *ActivityUpsertBulk) SetUID(v string) *ActivityUpsertBulk {
+	return u.Update(func(s *ActivityUpsert) {
+		s.SetUID(v)
+	})
+}
+
+// UpdateUID sets the "uid" field to the value that was provided on create.
+func (u *ActivityUpsertBulk) UpdateUID() *ActivityUpsertBulk {
+	return u.Update(func(s *ActivityUpsert) {
+		s.UpdateUID()
+	})
+}
+
+// SetSourceUID sets the "source_uid" field.
+func (u *ActivityUpsertBulk) SetSourceUID(v string) *ActivityUpsertBulk {
+	return u.Update(func(s *ActivityUpsert) {
+		s.SetSourceUID(v)
+	})
+}
+
+// UpdateSourceUID sets the "source_uid" field to the value that was provided on create.
+func (u *ActivityUpsertBulk) UpdateSourceUID() *ActivityUpsertBulk {
+	return u.Update(func(s *ActivityUpsert) {
+		s.UpdateSourceUID()
+	})
+}
+
+// SetSourceType sets the "source_type" field.
+func (u *ActivityUpsertBulk) SetSourceType(v string) *ActivityUpsertBulk {
+	return u.Update(func(s *ActivityUpsert) {
+		s.SetSourceType(v)
+	})
+}
+
+// UpdateSourceType sets the "source_type" field to the value that was provided on create.
+func (u *ActivityUpsertBulk) UpdateSourceType() *ActivityUpsertBulk {
+	return u.Update(func(s *ActivityUpsert) {
+		s.UpdateSourceType()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *ActivityUpsertBulk) SetTitle(v string) *ActivityUpsertBulk {
+	return u.Update(func(s *ActivityUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *ActivityUpsertBulk) UpdateTitle() *ActivityUpsertBulk {
+	return u.Update(func(s *ActivityUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetBody sets the "body" field.
+func (u *ActivityUpsertBulk) SetBody(v string) *ActivityUpsertBulk {
+	return u.Update(func(s *ActivityUpsert) {
+		s.SetBody(v)
+	})
+}
+
+// UpdateBody sets the "body" field to the value that was provided on create.
+func (u *ActivityUpsertBulk) UpdateBody() *ActivityUpsertBulk {
+	return u.Update(func(s *ActivityUpsert) {
+		s.UpdateBody()
+	})
+}
+
+// SetURL sets the "url" field.
+func (u *ActivityUpsertBulk) SetURL(v string) *ActivityUpsertBulk {
+	return u.Update(func(s *ActivityUpsert) {
+		s.SetURL(v)
+	})
+}
+
+// UpdateURL sets the "url" field to the value that was provided on create.
+func (u *ActivityUpsertBulk) UpdateURL() *ActivityUpsertBulk {
+	return u.Update(func(s *ActivityUpsert) {
+		s.UpdateURL()
+	})
+}
+
+// SetImageURL sets the "image_url" field.
+func (u *ActivityUpsertBulk) SetImageURL(v string) *ActivityUpsertBulk {
+	return u.Update(func(s *ActivityUpsert) {
+		s.SetImageURL(v)
+	})
+}
+
+// UpdateImageURL sets the "image_url" field to the value that was provided on create.
+func (u *ActivityUpsertBulk) UpdateImageURL() *ActivityUpsertBulk {
+	return u.Update(func(s *ActivityUpsert) {
+		s.UpdateImageURL()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ActivityUpsertBulk) SetCreatedAt(v time.Time) *ActivityUpsertBulk {
+	return u.Update(func(s *ActivityUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ActivityUpsertBulk) UpdateCreatedAt() *ActivityUpsertBulk {
+	return u.Update(func(s *ActivityUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetShortSummary sets the "short_summary" field.
+func (u *ActivityUpsertBulk) SetShortSummary(v string) *ActivityUpsertBulk {
+	return u.Update(func(s *ActivityUpsert) {
+		s.SetShortSummary(v)
+	})
+}
+
+// UpdateShortSummary sets the "short_summary" field to the value that was provided on create.
+func (u *ActivityUpsertBulk) UpdateShortSummary() *ActivityUpsertBulk {
+	return u.Update(func(s *ActivityUpsert) {
+		s.UpdateShortSummary()
+	})
+}
+
+// SetFullSummary sets the "full_summary" field.
+func (u *ActivityUpsertBulk) SetFullSummary(v string) *ActivityUpsertBulk {
+	return u.Update(func(s *ActivityUpsert) {
+		s.SetFullSummary(v)
+	})
+}
+
+// UpdateFullSummary sets the "full_summary" field to the value that was provided on create.
+func (u *ActivityUpsertBulk) UpdateFullSummary() *ActivityUpsertBulk {
+	return u.Update(func(s *ActivityUpsert) {
+		s.UpdateFullSummary()
+	})
+}
+
+// SetRawJSON sets the "raw_json" field.
+func (u *ActivityUpsertBulk) SetRawJSON(v string) *ActivityUpsertBulk {
+	return u.Update(func(s *ActivityUpsert) {
+		s.SetRawJSON(v)
+	})
+}
+
+// UpdateRawJSON sets the "raw_json" field to the value that was provided on create.
+func (u *ActivityUpsertBulk) UpdateRawJSON() *ActivityUpsertBulk {
+	return u.Update(func(s *ActivityUpsert) {
+		s.UpdateRawJSON()
+	})
+}
+
+// SetEmbedding sets the "embedding" field.
+func (u *ActivityUpsertBulk) SetEmbedding(v pgvector.Vector) *ActivityUpsertBulk {
+	return u.Update(func(s *ActivityUpsert) {
+		s.SetEmbedding(v)
+	})
+}
+
+// UpdateEmbedding sets the "embedding" field to the value that was provided on create.
+func (u *ActivityUpsertBulk) UpdateEmbedding() *ActivityUpsertBulk {
+	return u.Update(func(s *ActivityUpsert) {
+		s.UpdateEmbedding()
+	})
+}
+
+// ClearEmbedding clears the value of the "embedding" field.
+func (u *ActivityUpsertBulk) ClearEmbedding() *ActivityUpsertBulk {
+	return u.Update(func(s *ActivityUpsert) {
+		s.ClearEmbedding()
+	})
+}
+
+// SetContentHash sets the "content_hash" field.
+func (u *ActivityUpsertBulk) SetContentHash(v string) *ActivityUpsertBulk {
+	return u.Update(func(s *ActivityUpsert) {
+		s.SetContentHash(v)
+	})
+}
+
+// UpdateContentHash sets the "content_hash" field to the value that was provided on create.
+func (u *ActivityUpsertBulk) UpdateContentHash() *ActivityUpsertBulk {
+	return u.Update(func(s *ActivityUpsert) {
+		s.UpdateContentHash()
+	})
+}
+
+// SetRevision sets the "revision" field.
+func (u *ActivityUpsertBulk) SetRevision(v int) *ActivityUpsertBulk {
+	return u.Update(func(s *ActivityUpsert) {
+		s.SetRevision(v)
+	})
+}
+
+// AddRevision adds v to the "revision" field.
+func (u *ActivityUpsertBulk) AddRevision(v int) *ActivityUpsertBulk {
+	return u.Update(func(s *ActivityUpsert) {
+		s.AddRevision(v)
+	})
+}
+
+// UpdateRevision sets the "revision" field to the value that was provided on create.
+func (u *ActivityUpsertBulk) UpdateRevision() *ActivityUpsertBulk {
+	return u.Update(func(s *ActivityUpsert) {
+		s.UpdateRevision()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ActivityUpsertBulk) SetUpdatedAt(v time.Time) *ActivityUpsertBulk {
+	return u.Update(func(s *ActivityUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ActivityUpsertBulk) UpdateUpdatedAt() *ActivityUpsertBulk {
+	return u.Update(func(s *ActivityUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetStarred sets the "starred" field.
+func (u *ActivityUpsertBulk) SetStarred(v bool) *ActivityUpsertBulk {
+	return u.Update(func(s *ActivityUpsert) {
+		s.SetStarred(v)
+	})
+}
+
+// UpdateStarred sets the "starred" field to the value that was provided on create.
+func (u *ActivityUpsertBulk) UpdateStarred() *ActivityUpsertBulk {
+	return u.Update(func(s *ActivityUpsert) {
+		s.UpdateStarred()
+	})
+}
+
+// SetReferenced sets the "referenced" field.
+func (u *ActivityUpsertBulk) SetReferenced(v bool) *ActivityUpsertBulk {
+	return u.Update(func(s *ActivityUpsert) {
+		s.SetReferenced(v)
+	})
+}
+
+// UpdateReferenced sets the "referenced" field to the value that was provided on create.
+func (u *ActivityUpsertBulk) UpdateReferenced() *ActivityUpsertBulk {
+	return u.Update(func(s *ActivityUpsert) {
+		s.UpdateReferenced()
+	})
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (u *ActivityUpsertBulk) SetArchivedAt(v time.Time) *ActivityUpsertBulk {
+	return u.Update(func(s *ActivityUpsert) {
+		s.SetArchivedAt(v)
+	})
+}
+
+// UpdateArchivedAt sets the "archived_at" field to the value that was provided on create.
+func (u *ActivityUpsertBulk) UpdateArchivedAt() *ActivityUpsertBulk {
+	return u.Update(func(s *ActivityUpsert) {
+		s.UpdateArchivedAt()
+	})
+}
+
+// ClearArchivedAt clears the value of the "archived_at" field.
+func (u *ActivityUpsertBulk) ClearArchivedAt() *ActivityUpsertBulk {
+	return u.Update(func(s *ActivityUpsert) {
+		s.ClearArchivedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ActivityUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ActivityCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ActivityCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ActivityUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -203,6 +203,95 @@ func (au *ActivityUpdate) ClearEmbedding() *ActivityUpdate {
 	return au
 }
 
+// SetContentHash sets the "content_hash" field.
+func (au *ActivityUpdate) SetContentHash(s string) *ActivityUpdate {
+	au.mutation.SetContentHash(s)
+	return au
+}
+
+// SetNillableContentHash sets the "content_hash" field if the given value is not nil.
+func (au *ActivityUpdate) SetNillableContentHash(s *string) *ActivityUpdate {
+	if s != nil {
+		au.SetContentHash(*s)
+	}
+	return au
+}
+
+// SetRevision sets the "revision" field.
+func (au *ActivityUpdate) SetRevision(i int) *ActivityUpdate {
+	au.mutation.ResetRevision()
+	au.mutation.SetRevision(i)
+	return au
+}
+
+// SetNillableRevision sets the "revision" field if the given value is not nil.
+func (au *ActivityUpdate) SetNillableRevision(i *int) *ActivityUpdate {
+	if i != nil {
+		au.SetRevision(*i)
+	}
+	return au
+}
+
+// AddRevision adds i to the "revision" field.
+func (au *ActivityUpdate) AddRevision(i int) *ActivityUpdate {
+	au.mutation.AddRevision(i)
+	return au
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (au *ActivityUpdate) SetUpdatedAt(t time.Time) *ActivityUpdate {
+	au.mutation.SetUpdatedAt(t)
+	return au
+}
+
+// SetStarred sets the "starred" field.
+func (au *ActivityUpdate) SetStarred(b bool) *ActivityUpdate {
+	au.mutation.SetStarred(b)
+	return au
+}
+
+// SetNillableStarred sets the "starred" field if the given value is not nil.
+func (au *ActivityUpdate) SetNillableStarred(b *bool) *ActivityUpdate {
+	if b != nil {
+		au.SetStarred(*b)
+	}
+	return au
+}
+
+// SetReferenced sets the "referenced" field.
+func (au *ActivityUpdate) SetReferenced(b bool) *ActivityUpdate {
+	au.mutation.SetReferenced(b)
+	return au
+}
+
+// SetNillableReferenced sets the "referenced" field if the given value is not nil.
+func (au *ActivityUpdate) SetNillableReferenced(b *bool) *ActivityUpdate {
+	if b != nil {
+		au.SetReferenced(*b)
+	}
+	return au
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (au *ActivityUpdate) SetArchivedAt(t time.Time) *ActivityUpdate {
+	au.mutation.SetArchivedAt(t)
+	return au
+}
+
+// SetNillableArchivedAt sets the "archived_at" field if the given value is not nil.
+func (au *ActivityUpdate) SetNillableArchivedAt(t *time.Time) *ActivityUpdate {
+	if t != nil {
+		au.SetArchivedAt(*t)
+	}
+	return au
+}
+
+// ClearArchivedAt clears the value of the "archived_at" field.
+func (au *ActivityUpdate) ClearArchivedAt() *ActivityUpdate {
+	au.mutation.ClearArchivedAt()
+	return au
+}
+
 // Mutation returns the ActivityMutation object of the builder.
 func (au *ActivityUpdate) Mutation() *ActivityMutation {
 	return au.mutation
@@ -210,6 +299,7 @@ func (au *ActivityUpdate) Mutation() *ActivityMutation {
 
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (au *ActivityUpdate) Save(ctx context.Context) (int, error) {
+	au.defaults()
 	return withHooks(ctx, au.sqlSave, au.mutation, au.hooks)
 }
 
@@ -235,6 +325,14 @@ func (au *ActivityUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (au *ActivityUpdate) defaults() {
+	if _, ok := au.mutation.UpdatedAt(); !ok {
+		v := activity.UpdateDefaultUpdatedAt()
+		au.mutation.SetUpdatedAt(v)
+	}
+}
+
 func (au *ActivityUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	_spec := sqlgraph.NewUpdateSpec(activity.Table, activity.Columns, sqlgraph.NewFieldSpec(activity.FieldID, field.TypeString))
 	if ps := au.mutation.predicates; len(ps) > 0 {
@@ -283,6 +381,30 @@ func (au *ActivityUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if au.mutation.EmbeddingCleared() {
 		_spec.ClearField(activity.FieldEmbedding, field.TypeOther)
 	}
+	if value, ok := au.mutation.ContentHash(); ok {
+		_spec.SetField(activity.FieldContentHash, field.TypeString, value)
+	}
+	if value, ok := au.mutation.Revision(); ok {
+		_spec.SetField(activity.FieldRevision, field.TypeInt, value)
+	}
+	if value, ok := au.mutation.AddedRevision(); ok {
+		_spec.AddField(activity.FieldRevision, field.TypeInt, value)
+	}
+	if value, ok := au.mutation.UpdatedAt(); ok {
+		_spec.SetField(activity.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := au.mutation.Starred(); ok {
+		_spec.SetField(activity.FieldStarred, field.TypeBool, value)
+	}
+	if value, ok := au.mutation.Referenced(); ok {
+		_spec.SetField(activity.FieldReferenced, field.TypeBool, value)
+	}
+	if value, ok := au.mutation.ArchivedAt(); ok {
+		_spec.SetField(activity.FieldArchivedAt, field.TypeTime, value)
+	}
+	if au.mutation.ArchivedAtCleared() {
+		_spec.ClearField(activity.FieldArchivedAt, field.TypeTime)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, au.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{activity.Label}
@@ -477,6 +599,95 @@ func (auo *ActivityUpdateOne) ClearEmbedding() *ActivityUpdateOne {
 	return auo
 }
 
+// SetContentHash sets the "content_hash" field.
+func (auo *ActivityUpdateOne) SetContentHash(s string) *ActivityUpdateOne {
+	auo.mutation.SetContentHash(s)
+	return auo
+}
+
+// SetNillableContentHash sets the "content_hash" field if the given value is not nil.
+func (auo *ActivityUpdateOne) SetNillableContentHash(s *string) *ActivityUpdateOne {
+	if s != nil {
+		auo.SetContentHash(*s)
+	}
+	return auo
+}
+
+// SetRevision sets the "revision" field.
+func (auo *ActivityUpdateOne) SetRevision(i int) *ActivityUpdateOne {
+	auo.mutation.ResetRevision()
+	auo.mutation.SetRevision(i)
+	return auo
+}
+
+// SetNillableRevision sets the "revision" field if the given value is not nil.
+func (auo *ActivityUpdateOne) SetNillableRevision(i *int) *ActivityUpdateOne {
+	if i != nil {
+		auo.SetRevision(*i)
+	}
+	return auo
+}
+
+// AddRevision adds i to the "revision" field.
+func (auo *ActivityUpdateOne) AddRevision(i int) *ActivityUpdateOne {
+	auo.mutation.AddRevision(i)
+	return auo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (auo *ActivityUpdateOne) SetUpdatedAt(t time.Time) *ActivityUpdateOne {
+	auo.mutation.SetUpdatedAt(t)
+	return auo
+}
+
+// SetStarred sets the "starred" field.
+func (auo *ActivityUpdateOne) SetStarred(b bool) *ActivityUpdateOne {
+	auo.mutation.SetStarred(b)
+	return auo
+}
+
+// SetNillableStarred sets the "starred" field if the given value is not nil.
+func (auo *ActivityUpdateOne) SetNillableStarred(b *bool) *ActivityUpdateOne {
+	if b != nil {
+		auo.SetStarred(*b)
+	}
+	return auo
+}
+
+// SetReferenced sets the "referenced" field.
+func (auo *ActivityUpdateOne) SetReferenced(b bool) *ActivityUpdateOne {
+	auo.mutation.SetReferenced(b)
+	return auo
+}
+
+// SetNillableReferenced sets the "referenced" field if the given value is not nil.
+func (auo *ActivityUpdateOne) SetNillableReferenced(b *bool) *ActivityUpdateOne {
+	if b != nil {
+		auo.SetReferenced(*b)
+	}
+	return auo
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (auo *ActivityUpdateOne) SetArchivedAt(t time.Time) *ActivityUpdateOne {
+	auo.mutation.SetArchivedAt(t)
+	return auo
+}
+
+// SetNillableArchivedAt sets the "archived_at" field if the given value is not nil.
+func (auo *ActivityUpdateOne) SetNillableArchivedAt(t *time.Time) *ActivityUpdateOne {
+	if t != nil {
+		auo.SetArchivedAt(*t)
+	}
+	return auo
+}
+
+// ClearArchivedAt clears the value of the "archived_at" field.
+func (auo *ActivityUpdateOne) ClearArchivedAt() *ActivityUpdateOne {
+	auo.mutation.ClearArchivedAt()
+	return auo
+}
+
 // Mutation returns the ActivityMutation object of the builder.
 func (auo *ActivityUpdateOne) Mutation() *ActivityMutation {
 	return auo.mutation
@@ -497,6 +708,7 @@ func (auo *ActivityUpdateOne) Select(field string, fields ...string) *ActivityUp
 
 // Save executes the query and returns the updated Activity entity.
 func (auo *ActivityUpdateOne) Save(ctx context.Context) (*Activity, error) {
+	auo.defaults()
 	return withHooks(ctx, auo.sqlSave, auo.mutation, auo.hooks)
 }
 
@@ -522,6 +734,14 @@ func (auo *ActivityUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (auo *ActivityUpdateOne) defaults() {
+	if _, ok := auo.mutation.UpdatedAt(); !ok {
+		v := activity.UpdateDefaultUpdatedAt()
+		auo.mutation.SetUpdatedAt(v)
+	}
+}
+
 func (auo *ActivityUpdateOne) sqlSave(ctx context.Context) (_node *Activity, err error) {
 	_spec := sqlgraph.NewUpdateSpec(activity.Table, activity.Columns, sqlgraph.NewFieldSpec(activity.FieldID, field.TypeString))
 	id, ok := auo.mutation.ID()
@@ -587,6 +807,30 @@ func (auo *ActivityUpdateOne) sqlSave(ctx context.Context) (_node *Activity, err
 	if auo.mutation.EmbeddingCleared() {
 		_spec.ClearField(activity.FieldEmbedding, field.TypeOther)
 	}
+	if value, ok := auo.mutation.ContentHash(); ok {
+		_spec.SetField(activity.FieldContentHash, field.TypeString, value)
+	}
+	if value, ok := auo.mutation.Revision(); ok {
+		_spec.SetField(activity.FieldRevision, field.TypeInt, value)
+	}
+	if value, ok := auo.mutation.AddedRevision(); ok {
+		_spec.AddField(activity.FieldRevision, field.TypeInt, value)
+	}
+	if value, ok := auo.mutation.UpdatedAt(); ok {
+		_spec.SetField(activity.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := auo.mutation.Starred(); ok {
+		_spec.SetField(activity.FieldStarred, field.TypeBool, value)
+	}
+	if value, ok := auo.mutation.Referenced(); ok {
+		_spec.SetField(activity.FieldReferenced, field.TypeBool, value)
+	}
+	if value, ok := auo.mutation.ArchivedAt(); ok {
+		_spec.SetField(activity.FieldArchivedAt, field.TypeTime, value)
+	}
+	if auo.mutation.ArchivedAtCleared() {
+		_spec.ClearField(activity.FieldArchivedAt, field.TypeTime)
+	}
 	_node = &Activity{config: auo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
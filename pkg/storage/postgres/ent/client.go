@@ -15,7 +15,11 @@ import (
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"github.com/glanceapp/glance/pkg/storage/postgres/ent/activity"
+	"github.com/glanceapp/glance/pkg/storage/postgres/ent/deadletter"
+	"github.com/glanceapp/glance/pkg/storage/postgres/ent/job"
 	"github.com/glanceapp/glance/pkg/storage/postgres/ent/source"
+	"github.com/glanceapp/glance/pkg/storage/postgres/ent/sourcerun"
+	"github.com/glanceapp/glance/pkg/storage/postgres/ent/websubscription"
 )
 
 // Client is the client that holds all ent builders.
@@ -25,8 +29,16 @@ type Client struct {
 	Schema *migrate.Schema
 	// Activity is the client for interacting with the Activity builders.
 	Activity *ActivityClient
+	// DeadLetter is the client for interacting with the DeadLetter builders.
+	DeadLetter *DeadLetterClient
+	// Job is the client for interacting with the Job builders.
+	Job *JobClient
 	// Source is the client for interacting with the Source builders.
 	Source *SourceClient
+	// SourceRun is the client for interacting with the SourceRun builders.
+	SourceRun *SourceRunClient
+	// WebSubscription is the client for interacting with the WebSubscription builders.
+	WebSubscription *WebSubscriptionClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -39,7 +51,11 @@ func NewClient(opts ...Option) *Client {
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.Activity = NewActivityClient(c.config)
+	c.DeadLetter = NewDeadLetterClient(c.config)
+	c.Job = NewJobClient(c.config)
 	c.Source = NewSourceClient(c.config)
+	c.SourceRun = NewSourceRunClient(c.config)
+	c.WebSubscription = NewWebSubscriptionClient(c.config)
 }
 
 type (
@@ -130,10 +146,14 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:      ctx,
-		config:   cfg,
-		Activity: NewActivityClient(cfg),
-		Source:   NewSourceClient(cfg),
+		ctx:             ctx,
+		config:          cfg,
+		Activity:        NewActivityClient(cfg),
+		DeadLetter:      NewDeadLetterClient(cfg),
+		Job:             NewJobClient(cfg),
+		Source:          NewSourceClient(cfg),
+		SourceRun:       NewSourceRunClient(cfg),
+		WebSubscription: NewWebSubscriptionClient(cfg),
 	}, nil
 }
 
@@ -151,10 +171,14 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:      ctx,
-		config:   cfg,
-		Activity: NewActivityClient(cfg),
-		Source:   NewSourceClient(cfg),
+		ctx:             ctx,
+		config:          cfg,
+		Activity:        NewActivityClient(cfg),
+		DeadLetter:      NewDeadLetterClient(cfg),
+		Job:             NewJobClient(cfg),
+		Source:          NewSourceClient(cfg),
+		SourceRun:       NewSourceRunClient(cfg),
+		WebSubscription: NewWebSubscriptionClient(cfg),
 	}, nil
 }
 
@@ -183,15 +207,21 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.Activity.Use(hooks...)
-	c.Source.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.Activity, c.DeadLetter, c.Job, c.Source, c.SourceRun, c.WebSubscription,
+	} {
+		n.Use(hooks...)
+	}
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.Activity.Intercept(interceptors...)
-	c.Source.Intercept(interceptors...)
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.Activity, c.DeadLetter, c.Job, c.Source, c.SourceRun, c.WebSubscription,
+	} {
+		n.Intercept(interceptors...)
+	}
 }
 
 // Mutate implements the ent.Mutator interface.
@@ -199,8 +229,16 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
 	case *ActivityMutation:
 		return c.Activity.mutate(ctx, m)
+	case *DeadLetterMutation:
+		return c.DeadLetter.mutate(ctx, m)
+	case *JobMutation:
+		return c.Job.mutate(ctx, m)
 	case *SourceMutation:
 		return c.Source.mutate(ctx, m)
+	case *SourceRunMutation:
+		return c.SourceRun.mutate(ctx, m)
+	case *WebSubscriptionMutation:
+		return c.WebSubscription.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
@@ -339,6 +377,272 @@ func (c *ActivityClient) mutate(ctx context.Context, m *ActivityMutation) (Value
 	}
 }
 
+// DeadLetterClient is a client for the DeadLetter schema.
+type DeadLetterClient struct {
+	config
+}
+
+// NewDeadLetterClient returns a client for the DeadLetter from the given config.
+func NewDeadLetterClient(c config) *DeadLetterClient {
+	return &DeadLetterClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `deadletter.Hooks(f(g(h())))`.
+func (c *DeadLetterClient) Use(hooks ...Hook) {
+	c.hooks.DeadLetter = append(c.hooks.DeadLetter, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `deadletter.Intercept(f(g(h())))`.
+func (c *DeadLetterClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DeadLetter = append(c.inters.DeadLetter, interceptors...)
+}
+
+// Create returns a builder for creating a DeadLetter entity.
+func (c *DeadLetterClient) Create() *DeadLetterCreate {
+	mutation := newDeadLetterMutation(c.config, OpCreate)
+	return &DeadLetterCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DeadLetter entities.
+func (c *DeadLetterClient) CreateBulk(builders ...*DeadLetterCreate) *DeadLetterCreateBulk {
+	return &DeadLetterCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DeadLetterClient) MapCreateBulk(slice any, setFunc func(*DeadLetterCreate, int)) *DeadLetterCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DeadLetterCreateBulk{err: fmt.Errorf("calling to DeadLetterClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DeadLetterCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DeadLetterCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DeadLetter.
+func (c *DeadLetterClient) Update() *DeadLetterUpdate {
+	mutation := newDeadLetterMutation(c.config, OpUpdate)
+	return &DeadLetterUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DeadLetterClient) UpdateOne(dl *DeadLetter) *DeadLetterUpdateOne {
+	mutation := newDeadLetterMutation(c.config, OpUpdateOne, withDeadLetter(dl))
+	return &DeadLetterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DeadLetterClient) UpdateOneID(id string) *DeadLetterUpdateOne {
+	mutation := newDeadLetterMutation(c.config, OpUpdateOne, withDeadLetterID(id))
+	return &DeadLetterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DeadLetter.
+func (c *DeadLetterClient) Delete() *DeadLetterDelete {
+	mutation := newDeadLetterMutation(c.config, OpDelete)
+	return &DeadLetterDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DeadLetterClient) DeleteOne(dl *DeadLetter) *DeadLetterDeleteOne {
+	return c.DeleteOneID(dl.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DeadLetterClient) DeleteOneID(id string) *DeadLetterDeleteOne {
+	builder := c.Delete().Where(deadletter.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DeadLetterDeleteOne{builder}
+}
+
+// Query returns a query builder for DeadLetter.
+func (c *DeadLetterClient) Query() *DeadLetterQuery {
+	return &DeadLetterQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDeadLetter},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DeadLetter entity by its id.
+func (c *DeadLetterClient) Get(ctx context.Context, id string) (*DeadLetter, error) {
+	return c.Query().Where(deadletter.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DeadLetterClient) GetX(ctx context.Context, id string) *DeadLetter {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *DeadLetterClient) Hooks() []Hook {
+	return c.hooks.DeadLetter
+}
+
+// Interceptors returns the client interceptors.
+func (c *DeadLetterClient) Interceptors() []Interceptor {
+	return c.inters.DeadLetter
+}
+
+func (c *DeadLetterClient) mutate(ctx context.Context, m *DeadLetterMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DeadLetterCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DeadLetterUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DeadLetterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DeadLetterDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DeadLetter mutation op: %q", m.Op())
+	}
+}
+
+// JobClient is a client for the Job schema.
+type JobClient struct {
+	config
+}
+
+// NewJobClient returns a client for the Job from the given config.
+func NewJobClient(c config) *JobClient {
+	return &JobClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `job.Hooks(f(g(h())))`.
+func (c *JobClient) Use(hooks ...Hook) {
+	c.hooks.Job = append(c.hooks.Job, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `job.Intercept(f(g(h())))`.
+func (c *JobClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Job = append(c.inters.Job, interceptors...)
+}
+
+// Create returns a builder for creating a Job entity.
+func (c *JobClient) Create() *JobCreate {
+	mutation := newJobMutation(c.config, OpCreate)
+	return &JobCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Job entities.
+func (c *JobClient) CreateBulk(builders ...*JobCreate) *JobCreateBulk {
+	return &JobCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *JobClient) MapCreateBulk(slice any, setFunc func(*JobCreate, int)) *JobCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &JobCreateBulk{err: fmt.Errorf("calling to JobClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*JobCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &JobCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Job.
+func (c *JobClient) Update() *JobUpdate {
+	mutation := newJobMutation(c.config, OpUpdate)
+	return &JobUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *JobClient) UpdateOne(j *Job) *JobUpdateOne {
+	mutation := newJobMutation(c.config, OpUpdateOne, withJob(j))
+	return &JobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *JobClient) UpdateOneID(id string) *JobUpdateOne {
+	mutation := newJobMutation(c.config, OpUpdateOne, withJobID(id))
+	return &JobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Job.
+func (c *JobClient) Delete() *JobDelete {
+	mutation := newJobMutation(c.config, OpDelete)
+	return &JobDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *JobClient) DeleteOne(j *Job) *JobDeleteOne {
+	return c.DeleteOneID(j.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *JobClient) DeleteOneID(id string) *JobDeleteOne {
+	builder := c.Delete().Where(job.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &JobDeleteOne{builder}
+}
+
+// Query returns a query builder for Job.
+func (c *JobClient) Query() *JobQuery {
+	return &JobQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeJob},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Job entity by its id.
+func (c *JobClient) Get(ctx context.Context, id string) (*Job, error) {
+	return c.Query().Where(job.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *JobClient) GetX(ctx context.Context, id string) *Job {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *JobClient) Hooks() []Hook {
+	return c.hooks.Job
+}
+
+// Interceptors returns the client interceptors.
+func (c *JobClient) Interceptors() []Interceptor {
+	return c.inters.Job
+}
+
+func (c *JobClient) mutate(ctx context.Context, m *JobMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&JobCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&JobUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&JobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&JobDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Job mutation op: %q", m.Op())
+	}
+}
+
 // SourceClient is a client for the Source schema.
 type SourceClient struct {
 	config
@@ -472,12 +776,278 @@ func (c *SourceClient) mutate(ctx context.Context, m *SourceMutation) (Value, er
 	}
 }
 
+// SourceRunClient is a client for the SourceRun schema.
+type SourceRunClient struct {
+	config
+}
+
+// NewSourceRunClient returns a client for the SourceRun from the given config.
+func NewSourceRunClient(c config) *SourceRunClient {
+	return &SourceRunClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `sourcerun.Hooks(f(g(h())))`.
+func (c *SourceRunClient) Use(hooks ...Hook) {
+	c.hooks.SourceRun = append(c.hooks.SourceRun, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `sourcerun.Intercept(f(g(h())))`.
+func (c *SourceRunClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SourceRun = append(c.inters.SourceRun, interceptors...)
+}
+
+// Create returns a builder for creating a SourceRun entity.
+func (c *SourceRunClient) Create() *SourceRunCreate {
+	mutation := newSourceRunMutation(c.config, OpCreate)
+	return &SourceRunCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SourceRun entities.
+func (c *SourceRunClient) CreateBulk(builders ...*SourceRunCreate) *SourceRunCreateBulk {
+	return &SourceRunCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SourceRunClient) MapCreateBulk(slice any, setFunc func(*SourceRunCreate, int)) *SourceRunCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SourceRunCreateBulk{err: fmt.Errorf("calling to SourceRunClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SourceRunCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SourceRunCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SourceRun.
+func (c *SourceRunClient) Update() *SourceRunUpdate {
+	mutation := newSourceRunMutation(c.config, OpUpdate)
+	return &SourceRunUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SourceRunClient) UpdateOne(sr *SourceRun) *SourceRunUpdateOne {
+	mutation := newSourceRunMutation(c.config, OpUpdateOne, withSourceRun(sr))
+	return &SourceRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SourceRunClient) UpdateOneID(id int) *SourceRunUpdateOne {
+	mutation := newSourceRunMutation(c.config, OpUpdateOne, withSourceRunID(id))
+	return &SourceRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SourceRun.
+func (c *SourceRunClient) Delete() *SourceRunDelete {
+	mutation := newSourceRunMutation(c.config, OpDelete)
+	return &SourceRunDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SourceRunClient) DeleteOne(sr *SourceRun) *SourceRunDeleteOne {
+	return c.DeleteOneID(sr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SourceRunClient) DeleteOneID(id int) *SourceRunDeleteOne {
+	builder := c.Delete().Where(sourcerun.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SourceRunDeleteOne{builder}
+}
+
+// Query returns a query builder for SourceRun.
+func (c *SourceRunClient) Query() *SourceRunQuery {
+	return &SourceRunQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSourceRun},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SourceRun entity by its id.
+func (c *SourceRunClient) Get(ctx context.Context, id int) (*SourceRun, error) {
+	return c.Query().Where(sourcerun.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SourceRunClient) GetX(ctx context.Context, id int) *SourceRun {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SourceRunClient) Hooks() []Hook {
+	return c.hooks.SourceRun
+}
+
+// Interceptors returns the client interceptors.
+func (c *SourceRunClient) Interceptors() []Interceptor {
+	return c.inters.SourceRun
+}
+
+func (c *SourceRunClient) mutate(ctx context.Context, m *SourceRunMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SourceRunCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SourceRunUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SourceRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SourceRunDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SourceRun mutation op: %q", m.Op())
+	}
+}
+
+// WebSubscriptionClient is a client for the WebSubscription schema.
+type WebSubscriptionClient struct {
+	config
+}
+
+// NewWebSubscriptionClient returns a client for the WebSubscription from the given config.
+func NewWebSubscriptionClient(c config) *WebSubscriptionClient {
+	return &WebSubscriptionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `websubscription.Hooks(f(g(h())))`.
+func (c *WebSubscriptionClient) Use(hooks ...Hook) {
+	c.hooks.WebSubscription = append(c.hooks.WebSubscription, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `websubscription.Intercept(f(g(h())))`.
+func (c *WebSubscriptionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WebSubscription = append(c.inters.WebSubscription, interceptors...)
+}
+
+// Create returns a builder for creating a WebSubscription entity.
+func (c *WebSubscriptionClient) Create() *WebSubscriptionCreate {
+	mutation := newWebSubscriptionMutation(c.config, OpCreate)
+	return &WebSubscriptionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WebSubscription entities.
+func (c *WebSubscriptionClient) CreateBulk(builders ...*WebSubscriptionCreate) *WebSubscriptionCreateBulk {
+	return &WebSubscriptionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WebSubscriptionClient) MapCreateBulk(slice any, setFunc func(*WebSubscriptionCreate, int)) *WebSubscriptionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WebSubscriptionCreateBulk{err: fmt.Errorf("calling to WebSubscriptionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WebSubscriptionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WebSubscriptionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WebSubscription.
+func (c *WebSubscriptionClient) Update() *WebSubscriptionUpdate {
+	mutation := newWebSubscriptionMutation(c.config, OpUpdate)
+	return &WebSubscriptionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WebSubscriptionClient) UpdateOne(ws *WebSubscription) *WebSubscriptionUpdateOne {
+	mutation := newWebSubscriptionMutation(c.config, OpUpdateOne, withWebSubscription(ws))
+	return &WebSubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WebSubscriptionClient) UpdateOneID(id string) *WebSubscriptionUpdateOne {
+	mutation := newWebSubscriptionMutation(c.config, OpUpdateOne, withWebSubscriptionID(id))
+	return &WebSubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WebSubscription.
+func (c *WebSubscriptionClient) Delete() *WebSubscriptionDelete {
+	mutation := newWebSubscriptionMutation(c.config, OpDelete)
+	return &WebSubscriptionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WebSubscriptionClient) DeleteOne(ws *WebSubscription) *WebSubscriptionDeleteOne {
+	return c.DeleteOneID(ws.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WebSubscriptionClient) DeleteOneID(id string) *WebSubscriptionDeleteOne {
+	builder := c.Delete().Where(websubscription.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WebSubscriptionDeleteOne{builder}
+}
+
+// Query returns a query builder for WebSubscription.
+func (c *WebSubscriptionClient) Query() *WebSubscriptionQuery {
+	return &WebSubscriptionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWebSubscription},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WebSubscription entity by its id.
+func (c *WebSubscriptionClient) Get(ctx context.Context, id string) (*WebSubscription, error) {
+	return c.Query().Where(websubscription.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WebSubscriptionClient) GetX(ctx context.Context, id string) *WebSubscription {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *WebSubscriptionClient) Hooks() []Hook {
+	return c.hooks.WebSubscription
+}
+
+// Interceptors returns the client interceptors.
+func (c *WebSubscriptionClient) Interceptors() []Interceptor {
+	return c.inters.WebSubscription
+}
+
+func (c *WebSubscriptionClient) mutate(ctx context.Context, m *WebSubscriptionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WebSubscriptionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WebSubscriptionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WebSubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WebSubscriptionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown WebSubscription mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Activity, Source []ent.Hook
+		Activity, DeadLetter, Job, Source, SourceRun, WebSubscription []ent.Hook
 	}
 	inters struct {
-		Activity, Source []ent.Interceptor
+		Activity, DeadLetter, Job, Source, SourceRun, WebSubscription []ent.Interceptor
 	}
 )
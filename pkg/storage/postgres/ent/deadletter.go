@@ -0,0 +1,211 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/glanceapp/glance/pkg/storage/postgres/ent/deadletter"
+	pgvector "github.com/pgvector/pgvector-go"
+)
+
+// DeadLetter is the model entity for the DeadLetter schema.
+type DeadLetter struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// SourceUID holds the value of the "source_uid" field.
+	SourceUID string `json:"source_uid,omitempty"`
+	// SourceType holds the value of the "source_type" field.
+	SourceType string `json:"source_type,omitempty"`
+	// RawJSON holds the value of the "raw_json" field.
+	RawJSON string `json:"raw_json,omitempty"`
+	// Stage holds the value of the "stage" field.
+	Stage deadletter.Stage `json:"stage,omitempty"`
+	// Error holds the value of the "error" field.
+	Error string `json:"error,omitempty"`
+	// Attempts holds the value of the "attempts" field.
+	Attempts int `json:"attempts,omitempty"`
+	// ShortSummary holds the value of the "short_summary" field.
+	ShortSummary string `json:"short_summary,omitempty"`
+	// FullSummary holds the value of the "full_summary" field.
+	FullSummary string `json:"full_summary,omitempty"`
+	// Embedding holds the value of the "embedding" field.
+	Embedding *pgvector.Vector `json:"embedding,omitempty"`
+	// FailedAt holds the value of the "failed_at" field.
+	FailedAt     time.Time `json:"failed_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DeadLetter) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case deadletter.FieldEmbedding:
+			values[i] = &sql.NullScanner{S: new(pgvector.Vector)}
+		case deadletter.FieldAttempts:
+			values[i] = new(sql.NullInt64)
+		case deadletter.FieldID, deadletter.FieldSourceUID, deadletter.FieldSourceType, deadletter.FieldRawJSON, deadletter.FieldStage, deadletter.FieldError, deadletter.FieldShortSummary, deadletter.FieldFullSummary:
+			values[i] = new(sql.NullString)
+		case deadletter.FieldFailedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DeadLetter fields.
+func (dl *DeadLetter) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case deadletter.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				dl.ID = value.String
+			}
+		case deadletter.FieldSourceUID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source_uid", values[i])
+			} else if value.Valid {
+				dl.SourceUID = value.String
+			}
+		case deadletter.FieldSourceType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source_type", values[i])
+			} else if value.Valid {
+				dl.SourceType = value.String
+			}
+		case deadletter.FieldRawJSON:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field raw_json", values[i])
+			} else if value.Valid {
+				dl.RawJSON = value.String
+			}
+		case deadletter.FieldStage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field stage", values[i])
+			} else if value.Valid {
+				dl.Stage = deadletter.Stage(value.String)
+			}
+		case deadletter.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				dl.Error = value.String
+			}
+		case deadletter.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				dl.Attempts = int(value.Int64)
+			}
+		case deadletter.FieldShortSummary:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field short_summary", values[i])
+			} else if value.Valid {
+				dl.ShortSummary = value.String
+			}
+		case deadletter.FieldFullSummary:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field full_summary", values[i])
+			} else if value.Valid {
+				dl.FullSummary = value.String
+			}
+		case deadletter.FieldEmbedding:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field embedding", values[i])
+			} else if value.Valid {
+				dl.Embedding = new(pgvector.Vector)
+				*dl.Embedding = *value.S.(*pgvector.Vector)
+			}
+		case deadletter.FieldFailedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field failed_at", values[i])
+			} else if value.Valid {
+				dl.FailedAt = value.Time
+			}
+		default:
+			dl.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DeadLetter.
+// This includes values selected through modifiers, order, etc.
+func (dl *DeadLetter) Value(name string) (ent.Value, error) {
+	return dl.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this DeadLetter.
+// Note that you need to call DeadLetter.Unwrap() before calling this method if this DeadLetter
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (dl *DeadLetter) Update() *DeadLetterUpdateOne {
+	return NewDeadLetterClient(dl.config).UpdateOne(dl)
+}
+
+// Unwrap unwraps the DeadLetter entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (dl *DeadLetter) Unwrap() *DeadLetter {
+	_tx, ok := dl.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: DeadLetter is not a transactional entity")
+	}
+	dl.config.driver = _tx.drv
+	return dl
+}
+
+// String implements the fmt.Stringer.
+func (dl *DeadLetter) String() string {
+	var builder strings.Builder
+	builder.WriteString("DeadLetter(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", dl.ID))
+	builder.WriteString("source_uid=")
+	builder.WriteString(dl.SourceUID)
+	builder.WriteString(", ")
+	builder.WriteString("source_type=")
+	builder.WriteString(dl.SourceType)
+	builder.WriteString(", ")
+	builder.WriteString("raw_json=")
+	builder.WriteString(dl.RawJSON)
+	builder.WriteString(", ")
+	builder.WriteString("stage=")
+	builder.WriteString(fmt.Sprintf("%v", dl.Stage))
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(dl.Error)
+	builder.WriteString(", ")
+	builder.WriteString("attempts=")
+	builder.WriteString(fmt.Sprintf("%v", dl.Attempts))
+	builder.WriteString(", ")
+	builder.WriteString("short_summary=")
+	builder.WriteString(dl.ShortSummary)
+	builder.WriteString(", ")
+	builder.WriteString("full_summary=")
+	builder.WriteString(dl.FullSummary)
+	builder.WriteString(", ")
+	if v := dl.Embedding; v != nil {
+		builder.WriteString("embedding=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("failed_at=")
+	builder.WriteString(dl.FailedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DeadLetters is a parsable slice of DeadLetter.
+type DeadLetters []*DeadLetter
@@ -0,0 +1,151 @@
+// Code generated by ent, DO NOT EDIT.
+
+package deadletter
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the deadletter type in the database.
+	Label = "dead_letter"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldSourceUID holds the string denoting the source_uid field in the database.
+	FieldSourceUID = "source_uid"
+	// FieldSourceType holds the string denoting the source_type field in the database.
+	FieldSourceType = "source_type"
+	// FieldRawJSON holds the string denoting the raw_json field in the database.
+	FieldRawJSON = "raw_json"
+	// FieldStage holds the string denoting the stage field in the database.
+	FieldStage = "stage"
+	// FieldError holds the string denoting the error field in the database.
+	FieldError = "error"
+	// FieldAttempts holds the string denoting the attempts field in the database.
+	FieldAttempts = "attempts"
+	// FieldShortSummary holds the string denoting the short_summary field in the database.
+	FieldShortSummary = "short_summary"
+	// FieldFullSummary holds the string denoting the full_summary field in the database.
+	FieldFullSummary = "full_summary"
+	// FieldEmbedding holds the string denoting the embedding field in the database.
+	FieldEmbedding = "embedding"
+	// FieldFailedAt holds the string denoting the failed_at field in the database.
+	FieldFailedAt = "failed_at"
+	// Table holds the table name of the deadletter in the database.
+	Table = "dead_letters"
+)
+
+// Columns holds all SQL columns for deadletter fields.
+var Columns = []string{
+	FieldID,
+	FieldSourceUID,
+	FieldSourceType,
+	FieldRawJSON,
+	FieldStage,
+	FieldError,
+	FieldAttempts,
+	FieldShortSummary,
+	FieldFullSummary,
+	FieldEmbedding,
+	FieldFailedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultFailedAt holds the default value on creation for the "failed_at" field.
+	DefaultFailedAt func() time.Time
+)
+
+// Stage defines the type for the "stage" enum field.
+type Stage string
+
+// Stage values.
+const (
+	StageSummarize Stage = "summarize"
+	StageEmbed     Stage = "embed"
+	StageStore     Stage = "store"
+)
+
+func (s Stage) String() string {
+	return string(s)
+}
+
+// StageValidator is a validator for the "stage" field enum values. It is called by the builders before save.
+func StageValidator(s Stage) error {
+	switch s {
+	case StageSummarize, StageEmbed, StageStore:
+		return nil
+	default:
+		return fmt.Errorf("deadletter: invalid enum value for stage field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the DeadLetter queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// BySourceUID orders the results by the source_uid field.
+func BySourceUID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSourceUID, opts...).ToFunc()
+}
+
+// BySourceType orders the results by the source_type field.
+func BySourceType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSourceType, opts...).ToFunc()
+}
+
+// ByRawJSON orders the results by the raw_json field.
+func ByRawJSON(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRawJSON, opts...).ToFunc()
+}
+
+// ByStage orders the results by the stage field.
+func ByStage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStage, opts...).ToFunc()
+}
+
+// ByError orders the results by the error field.
+func ByError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldError, opts...).ToFunc()
+}
+
+// ByAttempts orders the results by the attempts field.
+func ByAttempts(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttempts, opts...).ToFunc()
+}
+
+// ByShortSummary orders the results by the short_summary field.
+func ByShortSummary(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldShortSummary, opts...).ToFunc()
+}
+
+// ByFullSummary orders the results by the full_summary field.
+func ByFullSummary(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFullSummary, opts...).ToFunc()
+}
+
+// ByEmbedding orders the results by the embedding field.
+func ByEmbedding(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEmbedding, opts...).ToFunc()
+}
+
+// ByFailedAt orders the results by the failed_at field.
+func ByFailedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFailedAt, opts...).ToFunc()
+}
@@ -0,0 +1,686 @@
+// Code generated by ent, DO NOT EDIT.
+
+package deadletter
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/glanceapp/glance/pkg/storage/postgres/ent/predicate"
+	pgvector "github.com/pgvector/pgvector-go"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldContainsFold(FieldID, id))
+}
+
+// SourceUID applies equality check predicate on the "source_uid" field. It's identical to SourceUIDEQ.
+func SourceUID(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldEQ(FieldSourceUID, v))
+}
+
+// SourceType applies equality check predicate on the "source_type" field. It's identical to SourceTypeEQ.
+func SourceType(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldEQ(FieldSourceType, v))
+}
+
+// RawJSON applies equality check predicate on the "raw_json" field. It's identical to RawJSONEQ.
+func RawJSON(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldEQ(FieldRawJSON, v))
+}
+
+// Error applies equality check predicate on the "error" field. It's identical to ErrorEQ.
+func Error(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldEQ(FieldError, v))
+}
+
+// Attempts applies equality check predicate on the "attempts" field. It's identical to AttemptsEQ.
+func Attempts(v int) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldEQ(FieldAttempts, v))
+}
+
+// ShortSummary applies equality check predicate on the "short_summary" field. It's identical to ShortSummaryEQ.
+func ShortSummary(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldEQ(FieldShortSummary, v))
+}
+
+// FullSummary applies equality check predicate on the "full_summary" field. It's identical to FullSummaryEQ.
+func FullSummary(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldEQ(FieldFullSummary, v))
+}
+
+// Embedding applies equality check predicate on the "embedding" field. It's identical to EmbeddingEQ.
+func Embedding(v pgvector.Vector) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldEQ(FieldEmbedding, v))
+}
+
+// FailedAt applies equality check predicate on the "failed_at" field. It's identical to FailedAtEQ.
+func FailedAt(v time.Time) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldEQ(FieldFailedAt, v))
+}
+
+// SourceUIDEQ applies the EQ predicate on the "source_uid" field.
+func SourceUIDEQ(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldEQ(FieldSourceUID, v))
+}
+
+// SourceUIDNEQ applies the NEQ predicate on the "source_uid" field.
+func SourceUIDNEQ(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldNEQ(FieldSourceUID, v))
+}
+
+// SourceUIDIn applies the In predicate on the "source_uid" field.
+func SourceUIDIn(vs ...string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldIn(FieldSourceUID, vs...))
+}
+
+// SourceUIDNotIn applies the NotIn predicate on the "source_uid" field.
+func SourceUIDNotIn(vs ...string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldNotIn(FieldSourceUID, vs...))
+}
+
+// SourceUIDGT applies the GT predicate on the "source_uid" field.
+func SourceUIDGT(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldGT(FieldSourceUID, v))
+}
+
+// SourceUIDGTE applies the GTE predicate on the "source_uid" field.
+func SourceUIDGTE(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldGTE(FieldSourceUID, v))
+}
+
+// SourceUIDLT applies the LT predicate on the "source_uid" field.
+func SourceUIDLT(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldLT(FieldSourceUID, v))
+}
+
+// SourceUIDLTE applies the LTE predicate on the "source_uid" field.
+func SourceUIDLTE(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldLTE(FieldSourceUID, v))
+}
+
+// SourceUIDContains applies the Contains predicate on the "source_uid" field.
+func SourceUIDContains(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldContains(FieldSourceUID, v))
+}
+
+// SourceUIDHasPrefix applies the HasPrefix predicate on the "source_uid" field.
+func SourceUIDHasPrefix(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldHasPrefix(FieldSourceUID, v))
+}
+
+// SourceUIDHasSuffix applies the HasSuffix predicate on the "source_uid" field.
+func SourceUIDHasSuffix(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldHasSuffix(FieldSourceUID, v))
+}
+
+// SourceUIDEqualFold applies the EqualFold predicate on the "source_uid" field.
+func SourceUIDEqualFold(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldEqualFold(FieldSourceUID, v))
+}
+
+// SourceUIDContainsFold applies the ContainsFold predicate on the "source_uid" field.
+func SourceUIDContainsFold(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldContainsFold(FieldSourceUID, v))
+}
+
+// SourceTypeEQ applies the EQ predicate on the "source_type" field.
+func SourceTypeEQ(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldEQ(FieldSourceType, v))
+}
+
+// SourceTypeNEQ applies the NEQ predicate on the "source_type" field.
+func SourceTypeNEQ(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldNEQ(FieldSourceType, v))
+}
+
+// SourceTypeIn applies the In predicate on the "source_type" field.
+func SourceTypeIn(vs ...string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldIn(FieldSourceType, vs...))
+}
+
+// SourceTypeNotIn applies the NotIn predicate on the "source_type" field.
+func SourceTypeNotIn(vs ...string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldNotIn(FieldSourceType, vs...))
+}
+
+// SourceTypeGT applies the GT predicate on the "source_type" field.
+func SourceTypeGT(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldGT(FieldSourceType, v))
+}
+
+// SourceTypeGTE applies the GTE predicate on the "source_type" field.
+func SourceTypeGTE(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldGTE(FieldSourceType, v))
+}
+
+// SourceTypeLT applies the LT predicate on the "source_type" field.
+func SourceTypeLT(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldLT(FieldSourceType, v))
+}
+
+// SourceTypeLTE applies the LTE predicate on the "source_type" field.
+func SourceTypeLTE(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldLTE(FieldSourceType, v))
+}
+
+// SourceTypeContains applies the Contains predicate on the "source_type" field.
+func SourceTypeContains(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldContains(FieldSourceType, v))
+}
+
+// SourceTypeHasPrefix applies the HasPrefix predicate on the "source_type" field.
+func SourceTypeHasPrefix(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldHasPrefix(FieldSourceType, v))
+}
+
+// SourceTypeHasSuffix applies the HasSuffix predicate on the "source_type" field.
+func SourceTypeHasSuffix(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldHasSuffix(FieldSourceType, v))
+}
+
+// SourceTypeEqualFold applies the EqualFold predicate on the "source_type" field.
+func SourceTypeEqualFold(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldEqualFold(FieldSourceType, v))
+}
+
+// SourceTypeContainsFold applies the ContainsFold predicate on the "source_type" field.
+func SourceTypeContainsFold(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldContainsFold(FieldSourceType, v))
+}
+
+// RawJSONEQ applies the EQ predicate on the "raw_json" field.
+func RawJSONEQ(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldEQ(FieldRawJSON, v))
+}
+
+// RawJSONNEQ applies the NEQ predicate on the "raw_json" field.
+func RawJSONNEQ(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldNEQ(FieldRawJSON, v))
+}
+
+// RawJSONIn applies the In predicate on the "raw_json" field.
+func RawJSONIn(vs ...string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldIn(FieldRawJSON, vs...))
+}
+
+// RawJSONNotIn applies the NotIn predicate on the "raw_json" field.
+func RawJSONNotIn(vs ...string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldNotIn(FieldRawJSON, vs...))
+}
+
+// RawJSONGT applies the GT predicate on the "raw_json" field.
+func RawJSONGT(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldGT(FieldRawJSON, v))
+}
+
+// RawJSONGTE applies the GTE predicate on the "raw_json" field.
+func RawJSONGTE(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldGTE(FieldRawJSON, v))
+}
+
+// RawJSONLT applies the LT predicate on the "raw_json" field.
+func RawJSONLT(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldLT(FieldRawJSON, v))
+}
+
+// RawJSONLTE applies the LTE predicate on the "raw_json" field.
+func RawJSONLTE(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldLTE(FieldRawJSON, v))
+}
+
+// RawJSONContains applies the Contains predicate on the "raw_json" field.
+func RawJSONContains(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldContains(FieldRawJSON, v))
+}
+
+// RawJSONHasPrefix applies the HasPrefix predicate on the "raw_json" field.
+func RawJSONHasPrefix(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldHasPrefix(FieldRawJSON, v))
+}
+
+// RawJSONHasSuffix applies the HasSuffix predicate on the "raw_json" field.
+func RawJSONHasSuffix(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldHasSuffix(FieldRawJSON, v))
+}
+
+// RawJSONEqualFold applies the EqualFold predicate on the "raw_json" field.
+func RawJSONEqualFold(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldEqualFold(FieldRawJSON, v))
+}
+
+// RawJSONContainsFold applies the ContainsFold predicate on the "raw_json" field.
+func RawJSONContainsFold(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldContainsFold(FieldRawJSON, v))
+}
+
+// StageEQ applies the EQ predicate on the "stage" field.
+func StageEQ(v Stage) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldEQ(FieldStage, v))
+}
+
+// StageNEQ applies the NEQ predicate on the "stage" field.
+func StageNEQ(v Stage) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldNEQ(FieldStage, v))
+}
+
+// StageIn applies the In predicate on the "stage" field.
+func StageIn(vs ...Stage) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldIn(FieldStage, vs...))
+}
+
+// StageNotIn applies the NotIn predicate on the "stage" field.
+func StageNotIn(vs ...Stage) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldNotIn(FieldStage, vs...))
+}
+
+// ErrorEQ applies the EQ predicate on the "error" field.
+func ErrorEQ(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldEQ(FieldError, v))
+}
+
+// ErrorNEQ applies the NEQ predicate on the "error" field.
+func ErrorNEQ(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldNEQ(FieldError, v))
+}
+
+// ErrorIn applies the In predicate on the "error" field.
+func ErrorIn(vs ...string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldIn(FieldError, vs...))
+}
+
+// ErrorNotIn applies the NotIn predicate on the "error" field.
+func ErrorNotIn(vs ...string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldNotIn(FieldError, vs...))
+}
+
+// ErrorGT applies the GT predicate on the "error" field.
+func ErrorGT(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldGT(FieldError, v))
+}
+
+// ErrorGTE applies the GTE predicate on the "error" field.
+func ErrorGTE(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldGTE(FieldError, v))
+}
+
+// ErrorLT applies the LT predicate on the "error" field.
+func ErrorLT(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldLT(FieldError, v))
+}
+
+// ErrorLTE applies the LTE predicate on the "error" field.
+func ErrorLTE(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldLTE(FieldError, v))
+}
+
+// ErrorContains applies the Contains predicate on the "error" field.
+func ErrorContains(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldContains(FieldError, v))
+}
+
+// ErrorHasPrefix applies the HasPrefix predicate on the "error" field.
+func ErrorHasPrefix(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldHasPrefix(FieldError, v))
+}
+
+// ErrorHasSuffix applies the HasSuffix predicate on the "error" field.
+func ErrorHasSuffix(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldHasSuffix(FieldError, v))
+}
+
+// ErrorEqualFold applies the EqualFold predicate on the "error" field.
+func ErrorEqualFold(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldEqualFold(FieldError, v))
+}
+
+// ErrorContainsFold applies the ContainsFold predicate on the "error" field.
+func ErrorContainsFold(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldContainsFold(FieldError, v))
+}
+
+// AttemptsEQ applies the EQ predicate on the "attempts" field.
+func AttemptsEQ(v int) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldEQ(FieldAttempts, v))
+}
+
+// AttemptsNEQ applies the NEQ predicate on the "attempts" field.
+func AttemptsNEQ(v int) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldNEQ(FieldAttempts, v))
+}
+
+// AttemptsIn applies the In predicate on the "attempts" field.
+func AttemptsIn(vs ...int) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldIn(FieldAttempts, vs...))
+}
+
+// AttemptsNotIn applies the NotIn predicate on the "attempts" field.
+func AttemptsNotIn(vs ...int) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldNotIn(FieldAttempts, vs...))
+}
+
+// AttemptsGT applies the GT predicate on the "attempts" field.
+func AttemptsGT(v int) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldGT(FieldAttempts, v))
+}
+
+// AttemptsGTE applies the GTE predicate on the "attempts" field.
+func AttemptsGTE(v int) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldGTE(FieldAttempts, v))
+}
+
+// AttemptsLT applies the LT predicate on the "attempts" field.
+func AttemptsLT(v int) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldLT(FieldAttempts, v))
+}
+
+// AttemptsLTE applies the LTE predicate on the "attempts" field.
+func AttemptsLTE(v int) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldLTE(FieldAttempts, v))
+}
+
+// ShortSummaryEQ applies the EQ predicate on the "short_summary" field.
+func ShortSummaryEQ(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldEQ(FieldShortSummary, v))
+}
+
+// ShortSummaryNEQ applies the NEQ predicate on the "short_summary" field.
+func ShortSummaryNEQ(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldNEQ(FieldShortSummary, v))
+}
+
+// ShortSummaryIn applies the In predicate on the "short_summary" field.
+func ShortSummaryIn(vs ...string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldIn(FieldShortSummary, vs...))
+}
+
+// ShortSummaryNotIn applies the NotIn predicate on the "short_summary" field.
+func ShortSummaryNotIn(vs ...string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldNotIn(FieldShortSummary, vs...))
+}
+
+// ShortSummaryGT applies the GT predicate on the "short_summary" field.
+func ShortSummaryGT(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldGT(FieldShortSummary, v))
+}
+
+// ShortSummaryGTE applies the GTE predicate on the "short_summary" field.
+func ShortSummaryGTE(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldGTE(FieldShortSummary, v))
+}
+
+// ShortSummaryLT applies the LT predicate on the "short_summary" field.
+func ShortSummaryLT(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldLT(FieldShortSummary, v))
+}
+
+// ShortSummaryLTE applies the LTE predicate on the "short_summary" field.
+func ShortSummaryLTE(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldLTE(FieldShortSummary, v))
+}
+
+// ShortSummaryContains applies the Contains predicate on the "short_summary" field.
+func ShortSummaryContains(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldContains(FieldShortSummary, v))
+}
+
+// ShortSummaryHasPrefix applies the HasPrefix predicate on the "short_summary" field.
+func ShortSummaryHasPrefix(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldHasPrefix(FieldShortSummary, v))
+}
+
+// ShortSummaryHasSuffix applies the HasSuffix predicate on the "short_summary" field.
+func ShortSummaryHasSuffix(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldHasSuffix(FieldShortSummary, v))
+}
+
+// ShortSummaryIsNil applies the IsNil predicate on the "short_summary" field.
+func ShortSummaryIsNil() predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldIsNull(FieldShortSummary))
+}
+
+// ShortSummaryNotNil applies the NotNil predicate on the "short_summary" field.
+func ShortSummaryNotNil() predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldNotNull(FieldShortSummary))
+}
+
+// ShortSummaryEqualFold applies the EqualFold predicate on the "short_summary" field.
+func ShortSummaryEqualFold(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldEqualFold(FieldShortSummary, v))
+}
+
+// ShortSummaryContainsFold applies the ContainsFold predicate on the "short_summary" field.
+func ShortSummaryContainsFold(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldContainsFold(FieldShortSummary, v))
+}
+
+// FullSummaryEQ applies the EQ predicate on the "full_summary" field.
+func FullSummaryEQ(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldEQ(FieldFullSummary, v))
+}
+
+// FullSummaryNEQ applies the NEQ predicate on the "full_summary" field.
+func FullSummaryNEQ(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldNEQ(FieldFullSummary, v))
+}
+
+// FullSummaryIn applies the In predicate on the "full_summary" field.
+func FullSummaryIn(vs ...string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldIn(FieldFullSummary, vs...))
+}
+
+// FullSummaryNotIn applies the NotIn predicate on the "full_summary" field.
+func FullSummaryNotIn(vs ...string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldNotIn(FieldFullSummary, vs...))
+}
+
+// FullSummaryGT applies the GT predicate on the "full_summary" field.
+func FullSummaryGT(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldGT(FieldFullSummary, v))
+}
+
+// FullSummaryGTE applies the GTE predicate on the "full_summary" field.
+func FullSummaryGTE(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldGTE(FieldFullSummary, v))
+}
+
+// FullSummaryLT applies the LT predicate on the "full_summary" field.
+func FullSummaryLT(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldLT(FieldFullSummary, v))
+}
+
+// FullSummaryLTE applies the LTE predicate on the "full_summary" field.
+func FullSummaryLTE(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldLTE(FieldFullSummary, v))
+}
+
+// FullSummaryContains applies the Contains predicate on the "full_summary" field.
+func FullSummaryContains(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldContains(FieldFullSummary, v))
+}
+
+// FullSummaryHasPrefix applies the HasPrefix predicate on the "full_summary" field.
+func FullSummaryHasPrefix(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldHasPrefix(FieldFullSummary, v))
+}
+
+// FullSummaryHasSuffix applies the HasSuffix predicate on the "full_summary" field.
+func FullSummaryHasSuffix(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldHasSuffix(FieldFullSummary, v))
+}
+
+// FullSummaryIsNil applies the IsNil predicate on the "full_summary" field.
+func FullSummaryIsNil() predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldIsNull(FieldFullSummary))
+}
+
+// FullSummaryNotNil applies the NotNil predicate on the "full_summary" field.
+func FullSummaryNotNil() predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldNotNull(FieldFullSummary))
+}
+
+// FullSummaryEqualFold applies the EqualFold predicate on the "full_summary" field.
+func FullSummaryEqualFold(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldEqualFold(FieldFullSummary, v))
+}
+
+// FullSummaryContainsFold applies the ContainsFold predicate on the "full_summary" field.
+func FullSummaryContainsFold(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldContainsFold(FieldFullSummary, v))
+}
+
+// EmbeddingEQ applies the EQ predicate on the "embedding" field.
+func EmbeddingEQ(v pgvector.Vector) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldEQ(FieldEmbedding, v))
+}
+
+// EmbeddingNEQ applies the NEQ predicate on the "embedding" field.
+func EmbeddingNEQ(v pgvector.Vector) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldNEQ(FieldEmbedding, v))
+}
+
+// EmbeddingIn applies the In predicate on the "embedding" field.
+func EmbeddingIn(vs ...pgvector.Vector) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldIn(FieldEmbedding, vs...))
+}
+
+// EmbeddingNotIn applies the NotIn predicate on the "embedding" field.
+func EmbeddingNotIn(vs ...pgvector.Vector) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldNotIn(FieldEmbedding, vs...))
+}
+
+// EmbeddingGT applies the GT predicate on the "embedding" field.
+func EmbeddingGT(v pgvector.Vector) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldGT(FieldEmbedding, v))
+}
+
+// EmbeddingGTE applies the GTE predicate on the "embedding" field.
+func EmbeddingGTE(v pgvector.Vector) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldGTE(FieldEmbedding, v))
+}
+
+// EmbeddingLT applies the LT predicate on the "embedding" field.
+func EmbeddingLT(v pgvector.Vector) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldLT(FieldEmbedding, v))
+}
+
+// EmbeddingLTE applies the LTE predicate on the "embedding" field.
+func EmbeddingLTE(v pgvector.Vector) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldLTE(FieldEmbedding, v))
+}
+
+// EmbeddingIsNil applies the IsNil predicate on the "embedding" field.
+func EmbeddingIsNil() predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldIsNull(FieldEmbedding))
+}
+
+// EmbeddingNotNil applies the NotNil predicate on the "embedding" field.
+func EmbeddingNotNil() predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldNotNull(FieldEmbedding))
+}
+
+// FailedAtEQ applies the EQ predicate on the "failed_at" field.
+func FailedAtEQ(v time.Time) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldEQ(FieldFailedAt, v))
+}
+
+// FailedAtNEQ applies the NEQ predicate on the "failed_at" field.
+func FailedAtNEQ(v time.Time) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldNEQ(FieldFailedAt, v))
+}
+
+// FailedAtIn applies the In predicate on the "failed_at" field.
+func FailedAtIn(vs ...time.Time) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldIn(FieldFailedAt, vs...))
+}
+
+// FailedAtNotIn applies the NotIn predicate on the "failed_at" field.
+func FailedAtNotIn(vs ...time.Time) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldNotIn(FieldFailedAt, vs...))
+}
+
+// FailedAtGT applies the GT predicate on the "failed_at" field.
+func FailedAtGT(v time.Time) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldGT(FieldFailedAt, v))
+}
+
+// FailedAtGTE applies the GTE predicate on the "failed_at" field.
+func FailedAtGTE(v time.Time) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldGTE(FieldFailedAt, v))
+}
+
+// FailedAtLT applies the LT predicate on the "failed_at" field.
+func FailedAtLT(v time.Time) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldLT(FieldFailedAt, v))
+}
+
+// FailedAtLTE applies the LTE predicate on the "failed_at" field.
+func FailedAtLTE(v time.Time) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldLTE(FieldFailedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.DeadLetter) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.DeadLetter) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.DeadLetter) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.NotPredicates(p))
+}
@@ -0,0 +1,1086 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/glanceapp/glance/pkg/storage/postgres/ent/deadletter"
+	pgvector "github.com/pgvector/pgvector-go"
+)
+
+// DeadLetterCreate is the builder for creating a DeadLetter entity.
+type DeadLetterCreate struct {
+	config
+	mutation *DeadLetterMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetSourceUID sets the "source_uid" field.
+func (dlc *DeadLetterCreate) SetSourceUID(s string) *DeadLetterCreate {
+	dlc.mutation.SetSourceUID(s)
+	return dlc
+}
+
+// SetSourceType sets the "source_type" field.
+func (dlc *DeadLetterCreate) SetSourceType(s string) *DeadLetterCreate {
+	dlc.mutation.SetSourceType(s)
+	return dlc
+}
+
+// SetRawJSON sets the "raw_json" field.
+func (dlc *DeadLetterCreate) SetRawJSON(s string) *DeadLetterCreate {
+	dlc.mutation.SetRawJSON(s)
+	return dlc
+}
+
+// SetStage sets the "stage" field.
+func (dlc *DeadLetterCreate) SetStage(d deadletter.Stage) *DeadLetterCreate {
+	dlc.mutation.SetStage(d)
+	return dlc
+}
+
+// SetError sets the "error" field.
+func (dlc *DeadLetterCreate) SetError(s string) *DeadLetterCreate {
+	dlc.mutation.SetError(s)
+	return dlc
+}
+
+// SetAttempts sets the "attempts" field.
+func (dlc *DeadLetterCreate) SetAttempts(i int) *DeadLetterCreate {
+	dlc.mutation.SetAttempts(i)
+	return dlc
+}
+
+// SetShortSummary sets the "short_summary" field.
+func (dlc *DeadLetterCreate) SetShortSummary(s string) *DeadLetterCreate {
+	dlc.mutation.SetShortSummary(s)
+	return dlc
+}
+
+// SetNillableShortSummary sets the "short_summary" field if the given value is not nil.
+func (dlc *DeadLetterCreate) SetNillableShortSummary(s *string) *DeadLetterCreate {
+	if s != nil {
+		dlc.SetShortSummary(*s)
+	}
+	return dlc
+}
+
+// SetFullSummary sets the "full_summary" field.
+func (dlc *DeadLetterCreate) SetFullSummary(s string) *DeadLetterCreate {
+	dlc.mutation.SetFullSummary(s)
+	return dlc
+}
+
+// SetNillableFullSummary sets the "full_summary" field if the given value is not nil.
+func (dlc *DeadLetterCreate) SetNillableFullSummary(s *string) *DeadLetterCreate {
+	if s != nil {
+		dlc.SetFullSummary(*s)
+	}
+	return dlc
+}
+
+// SetEmbedding sets the "embedding" field.
+func (dlc *DeadLetterCreate) SetEmbedding(pg pgvector.Vector) *DeadLetterCreate {
+	dlc.mutation.SetEmbedding(pg)
+	return dlc
+}
+
+// SetNillableEmbedding sets the "embedding" field if the given value is not nil.
+func (dlc *DeadLetterCreate) SetNillableEmbedding(pg *pgvector.Vector) *DeadLetterCreate {
+	if pg != nil {
+		dlc.SetEmbedding(*pg)
+	}
+	return dlc
+}
+
+// SetFailedAt sets the "failed_at" field.
+func (dlc *DeadLetterCreate) SetFailedAt(t time.Time) *DeadLetterCreate {
+	dlc.mutation.SetFailedAt(t)
+	return dlc
+}
+
+// SetNillableFailedAt sets the "failed_at" field if the given value is not nil.
+func (dlc *DeadLetterCreate) SetNillableFailedAt(t *time.Time) *DeadLetterCreate {
+	if t != nil {
+		dlc.SetFailedAt(*t)
+	}
+	return dlc
+}
+
+// SetID sets the "id" field.
+func (dlc *DeadLetterCreate) SetID(s string) *DeadLetterCreate {
+	dlc.mutation.SetID(s)
+	return dlc
+}
+
+// Mutation returns the DeadLetterMutation object of the builder.
+func (dlc *DeadLetterCreate) Mutation() *DeadLetterMutation {
+	return dlc.mutation
+}
+
+// Save creates the DeadLetter in the database.
+func (dlc *DeadLetterCreate) Save(ctx context.Context) (*DeadLetter, error) {
+	dlc.defaults()
+	return withHooks(ctx, dlc.sqlSave, dlc.mutation, dlc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (dlc *DeadLetterCreate) SaveX(ctx context.Context) *DeadLetter {
+	v, err := dlc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dlc *DeadLetterCreate) Exec(ctx context.Context) error {
+	_, err := dlc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dlc *DeadLetterCreate) ExecX(ctx context.Context) {
+	if err := dlc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dlc *DeadLetterCreate) defaults() {
+	if _, ok := dlc.mutation.FailedAt(); !ok {
+		v := deadletter.DefaultFailedAt()
+		dlc.mutation.SetFailedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dlc *DeadLetterCreate) check() error {
+	if _, ok := dlc.mutation.SourceUID(); !ok {
+		return &ValidationError{Name: "source_uid", err: errors.New(`ent: missing required field "DeadLetter.source_uid"`)}
+	}
+	if _, ok := dlc.mutation.SourceType(); !ok {
+		return &ValidationError{Name: "source_type", err: errors.New(`ent: missing required field "DeadLetter.source_type"`)}
+	}
+	if _, ok := dlc.mutation.RawJSON(); !ok {
+		return &ValidationError{Name: "raw_json", err: errors.New(`ent: missing required field "DeadLetter.raw_json"`)}
+	}
+	if _, ok := dlc.mutation.Stage(); !ok {
+		return &ValidationError{Name: "stage", err: errors.New(`ent: missing required field "DeadLetter.stage"`)}
+	}
+	if v, ok := dlc.mutation.Stage(); ok {
+		if err := deadletter.StageValidator(v); err != nil {
+			return &ValidationError{Name: "stage", err: fmt.Errorf(`ent: validator failed for field "DeadLetter.stage": %w`, err)}
+		}
+	}
+	if _, ok := dlc.mutation.Error(); !ok {
+		return &ValidationError{Name: "error", err: errors.New(`ent: missing required field "DeadLetter.error"`)}
+	}
+	if _, ok := dlc.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "DeadLetter.attempts"`)}
+	}
+	if _, ok := dlc.mutation.FailedAt(); !ok {
+		return &ValidationError{Name: "failed_at", err: errors.New(`ent: missing required field "DeadLetter.failed_at"`)}
+	}
+	return nil
+}
+
+func (dlc *DeadLetterCreate) sqlSave(ctx context.Context) (*DeadLetter, error) {
+	if err := dlc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := dlc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, dlc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected DeadLetter.ID type: %T", _spec.ID.Value)
+		}
+	}
+	dlc.mutation.id = &_node.ID
+	dlc.mutation.done = true
+	return _node, nil
+}
+
+func (dlc *DeadLetterCreate) createSpec() (*DeadLetter, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DeadLetter{config: dlc.config}
+		_spec = sqlgraph.NewCreateSpec(deadletter.Table, sqlgraph.NewFieldSpec(deadletter.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = dlc.conflict
+	if id, ok := dlc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := dlc.mutation.SourceUID(); ok {
+		_spec.SetField(deadletter.FieldSourceUID, field.TypeString, value)
+		_node.SourceUID = value
+	}
+	if value, ok := dlc.mutation.SourceType(); ok {
+		_spec.SetField(deadletter.FieldSourceType, field.TypeString, value)
+		_node.SourceType = value
+	}
+	if value, ok := dlc.mutation.RawJSON(); ok {
+		_spec.SetField(deadletter.FieldRawJSON, field.TypeString, value)
+		_node.RawJSON = value
+	}
+	if value, ok := dlc.mutation.Stage(); ok {
+		_spec.SetField(deadletter.FieldStage, field.TypeEnum, value)
+		_node.Stage = value
+	}
+	if value, ok := dlc.mutation.Error(); ok {
+		_spec.SetField(deadletter.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := dlc.mutation.Attempts(); ok {
+		_spec.SetField(deadletter.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := dlc.mutation.ShortSummary(); ok {
+		_spec.SetField(deadletter.FieldShortSummary, field.TypeString, value)
+		_node.ShortSummary = value
+	}
+	if value, ok := dlc.mutation.FullSummary(); ok {
+		_spec.SetField(deadletter.FieldFullSummary, field.TypeString, value)
+		_node.FullSummary = value
+	}
+	if value, ok := dlc.mutation.Embedding(); ok {
+		_spec.SetField(deadletter.FieldEmbedding, field.TypeOther, value)
+		_node.Embedding = &value
+	}
+	if value, ok := dlc.mutation.FailedAt(); ok {
+		_spec.SetField(deadletter.FieldFailedAt, field.TypeTime, value)
+		_node.FailedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DeadLetter.Create().
+//		SetSourceUID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DeadLetterUpsert) {
+//			SetSourceUID(v+v).
+//		}).
+//		Exec(ctx)
+func (dlc *DeadLetterCreate) OnConflict(opts ...sql.ConflictOption) *DeadLetterUpsertOne {
+	dlc.conflict = opts
+	return &DeadLetterUpsertOne{
+		create: dlc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DeadLetter.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (dlc *DeadLetterCreate) OnConflictColumns(columns ...string) *DeadLetterUpsertOne {
+	dlc.conflict = append(dlc.conflict, sql.ConflictColumns(columns...))
+	return &DeadLetterUpsertOne{
+		create: dlc,
+	}
+}
+
+type (
+	// DeadLetterUpsertOne is the builder for "upsert"-ing
+	//  one DeadLetter node.
+	DeadLetterUpsertOne struct {
+		create *DeadLetterCreate
+	}
+
+	// DeadLetterUpsert is the "OnConflict" setter.
+	DeadLetterUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetSourceUID sets the "source_uid" field.
+func (u *DeadLetterUpsert) SetSourceUID(v string) *DeadLetterUpsert {
+	u.Set(deadletter.FieldSourceUID, v)
+	return u
+}
+
+// UpdateSourceUID sets the "source_uid" field to the value that was provided on create.
+func (u *DeadLetterUpsert) UpdateSourceUID() *DeadLetterUpsert {
+	u.SetExcluded(deadletter.FieldSourceUID)
+	return u
+}
+
+// SetSourceType sets the "source_type" field.
+func (u *DeadLetterUpsert) SetSourceType(v string) *DeadLetterUpsert {
+	u.Set(deadletter.FieldSourceType, v)
+	return u
+}
+
+// UpdateSourceType sets the "source_type" field to the value that was provided on create.
+func (u *DeadLetterUpsert) UpdateSourceType() *DeadLetterUpsert {
+	u.SetExcluded(deadletter.FieldSourceType)
+	return u
+}
+
+// SetRawJSON sets the "raw_json" field.
+func (u *DeadLetterUpsert) SetRawJSON(v string) *DeadLetterUpsert {
+	u.Set(deadletter.FieldRawJSON, v)
+	return u
+}
+
+// UpdateRawJSON sets the "raw_json" field to the value that was provided on create.
+func (u *DeadLetterUpsert) UpdateRawJSON() *DeadLetterUpsert {
+	u.SetExcluded(deadletter.FieldRawJSON)
+	return u
+}
+
+// SetStage sets the "stage" field.
+func (u *DeadLetterUpsert) SetStage(v deadletter.Stage) *DeadLetterUpsert {
+	u.Set(deadletter.FieldStage, v)
+	return u
+}
+
+// UpdateStage sets the "stage" field to the value that was provided on create.
+func (u *DeadLetterUpsert) UpdateStage() *DeadLetterUpsert {
+	u.SetExcluded(deadletter.FieldStage)
+	return u
+}
+
+// SetError sets the "error" field.
+func (u *DeadLetterUpsert) SetError(v string) *DeadLetterUpsert {
+	u.Set(deadletter.FieldError, v)
+	return u
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *DeadLetterUpsert) UpdateError() *DeadLetterUpsert {
+	u.SetExcluded(deadletter.FieldError)
+	return u
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *DeadLetterUpsert) SetAttempts(v int) *DeadLetterUpsert {
+	u.Set(deadletter.FieldAttempts, v)
+	return u
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *DeadLetterUpsert) UpdateAttempts() *DeadLetterUpsert {
+	u.SetExcluded(deadletter.FieldAttempts)
+	return u
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *DeadLetterUpsert) AddAttempts(v int) *DeadLetterUpsert {
+	u.Add(deadletter.FieldAttempts, v)
+	return u
+}
+
+// SetShortSummary sets the "short_summary" field.
+func (u *DeadLetterUpsert) SetShortSummary(v string) *DeadLetterUpsert {
+	u.Set(deadletter.FieldShortSummary, v)
+	return u
+}
+
+// UpdateShortSummary sets the "short_summary" field to the value that was provided on create.
+func (u *DeadLetterUpsert) UpdateShortSummary() *DeadLetterUpsert {
+	u.SetExcluded(deadletter.FieldShortSummary)
+	return u
+}
+
+// ClearShortSummary clears the value of the "short_summary" field.
+func (u *DeadLetterUpsert) ClearShortSummary() *DeadLetterUpsert {
+	u.SetNull(deadletter.FieldShortSummary)
+	return u
+}
+
+// SetFullSummary sets the "full_summary" field.
+func (u *DeadLetterUpsert) SetFullSummary(v string) *DeadLetterUpsert {
+	u.Set(deadletter.FieldFullSummary, v)
+	return u
+}
+
+// UpdateFullSummary sets the "full_summary" field to the value that was provided on create.
+func (u *DeadLetterUpsert) UpdateFullSummary() *DeadLetterUpsert {
+	u.SetExcluded(deadletter.FieldFullSummary)
+	return u
+}
+
+// ClearFullSummary clears the value of the "full_summary" field.
+func (u *DeadLetterUpsert) ClearFullSummary() *DeadLetterUpsert {
+	u.SetNull(deadletter.FieldFullSummary)
+	return u
+}
+
+// SetEmbedding sets the "embedding" field.
+func (u *DeadLetterUpsert) SetEmbedding(v pgvector.Vector) *DeadLetterUpsert {
+	u.Set(deadletter.FieldEmbedding, v)
+	return u
+}
+
+// UpdateEmbedding sets the "embedding" field to the value that was provided on create.
+func (u *DeadLetterUpsert) UpdateEmbedding() *DeadLetterUpsert {
+	u.SetExcluded(deadletter.FieldEmbedding)
+	return u
+}
+
+// ClearEmbedding clears the value of the "embedding" field.
+func (u *DeadLetterUpsert) ClearEmbedding() *DeadLetterUpsert {
+	u.SetNull(deadletter.FieldEmbedding)
+	return u
+}
+
+// SetFailedAt sets the "failed_at" field.
+func (u *DeadLetterUpsert) SetFailedAt(v time.Time) *DeadLetterUpsert {
+	u.Set(deadletter.FieldFailedAt, v)
+	return u
+}
+
+// UpdateFailedAt sets the "failed_at" field to the value that was provided on create.
+func (u *DeadLetterUpsert) UpdateFailedAt() *DeadLetterUpsert {
+	u.SetExcluded(deadletter.FieldFailedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.DeadLetter.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(deadletter.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DeadLetterUpsertOne) UpdateNewValues() *DeadLetterUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(deadletter.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DeadLetter.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *DeadLetterUpsertOne) Ignore() *DeadLetterUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DeadLetterUpsertOne) DoNothing() *DeadLetterUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DeadLetterCreate.OnConflict
+// documentation for more info.
+func (u *DeadLetterUpsertOne) Update(set func(*DeadLetterUpsert)) *DeadLetterUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DeadLetterUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetSourceUID sets the "source_uid" field.
+func (u *DeadLetterUpsertOne) SetSourceUID(v string) *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.SetSourceUID(v)
+	})
+}
+
+// UpdateSourceUID sets the "source_uid" field to the value that was provided on create.
+func (u *DeadLetterUpsertOne) UpdateSourceUID() *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.UpdateSourceUID()
+	})
+}
+
+// SetSourceType sets the "source_type" field.
+func (u *DeadLetterUpsertOne) SetSourceType(v string) *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.SetSourceType(v)
+	})
+}
+
+// UpdateSourceType sets the "source_type" field to the value that was provided on create.
+func (u *DeadLetterUpsertOne) UpdateSourceType() *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.UpdateSourceType()
+	})
+}
+
+// SetRawJSON sets the "raw_json" field.
+func (u *DeadLetterUpsertOne) SetRawJSON(v string) *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.SetRawJSON(v)
+	})
+}
+
+// UpdateRawJSON sets the "raw_json" field to the value that was provided on create.
+func (u *DeadLetterUpsertOne) UpdateRawJSON() *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.UpdateRawJSON()
+	})
+}
+
+// SetStage sets the "stage" field.
+func (u *DeadLetterUpsertOne) SetStage(v deadletter.Stage) *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.SetStage(v)
+	})
+}
+
+// UpdateStage sets the "stage" field to the value that was provided on create.
+func (u *DeadLetterUpsertOne) UpdateStage() *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.UpdateStage()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *DeadLetterUpsertOne) SetError(v string) *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *DeadLetterUpsertOne) UpdateError() *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.UpdateError()
+	})
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *DeadLetterUpsertOne) SetAttempts(v int) *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.SetAttempts(v)
+	})
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *DeadLetterUpsertOne) AddAttempts(v int) *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.AddAttempts(v)
+	})
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *DeadLetterUpsertOne) UpdateAttempts() *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.UpdateAttempts()
+	})
+}
+
+// SetShortSummary sets the "short_summary" field.
+func (u *DeadLetterUpsertOne) SetShortSummary(v string) *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.SetShortSummary(v)
+	})
+}
+
+// UpdateShortSummary sets the "short_summary" field to the value that was provided on create.
+func (u *DeadLetterUpsertOne) UpdateShortSummary() *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.UpdateShortSummary()
+	})
+}
+
+// ClearShortSummary clears the value of the "short_summary" field.
+func (u *DeadLetterUpsertOne) ClearShortSummary() *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.ClearShortSummary()
+	})
+}
+
+// SetFullSummary sets the "full_summary" field.
+func (u *DeadLetterUpsertOne) SetFullSummary(v string) *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.SetFullSummary(v)
+	})
+}
+
+// UpdateFullSummary sets the "full_summary" field to the value that was provided on create.
+func (u *DeadLetterUpsertOne) UpdateFullSummary() *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.UpdateFullSummary()
+	})
+}
+
+// ClearFullSummary clears the value of the "full_summary" field.
+func (u *DeadLetterUpsertOne) ClearFullSummary() *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.ClearFullSummary()
+	})
+}
+
+// SetEmbedding sets the "embedding" field.
+func (u *DeadLetterUpsertOne) SetEmbedding(v pgvector.Vector) *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.SetEmbedding(v)
+	})
+}
+
+// UpdateEmbedding sets the "embedding" field to the value that was provided on create.
+func (u *DeadLetterUpsertOne) UpdateEmbedding() *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.UpdateEmbedding()
+	})
+}
+
+// ClearEmbedding clears the value of the "embedding" field.
+func (u *DeadLetterUpsertOne) ClearEmbedding() *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.ClearEmbedding()
+	})
+}
+
+// SetFailedAt sets the "failed_at" field.
+func (u *DeadLetterUpsertOne) SetFailedAt(v time.Time) *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.SetFailedAt(v)
+	})
+}
+
+// UpdateFailedAt sets the "failed_at" field to the value that was provided on create.
+func (u *DeadLetterUpsertOne) UpdateFailedAt() *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.UpdateFailedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *DeadLetterUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DeadLetterCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DeadLetterUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *DeadLetterUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: DeadLetterUpsertOne.ID is not supported by MySQL driver. Use DeadLetterUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *DeadLetterUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// DeadLetterCreateBulk is the builder for creating many DeadLetter entities in bulk.
+type DeadLetterCreateBulk struct {
+	config
+	err      error
+	builders []*DeadLetterCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the DeadLetter entities in the database.
+func (dlcb *DeadLetterCreateBulk) Save(ctx context.Context) ([]*DeadLetter, error) {
+	if dlcb.err != nil {
+		return nil, dlcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(dlcb.builders))
+	nodes := make([]*DeadLetter, len(dlcb.builders))
+	mutators := make([]Mutator, len(dlcb.builders))
+	for i := range dlcb.builders {
+		func(i int, root context.Context) {
+			builder := dlcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DeadLetterMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, dlcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = dlcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, dlcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, dlcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dlcb *DeadLetterCreateBulk) SaveX(ctx context.Context) []*DeadLetter {
+	v, err := dlcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dlcb *DeadLetterCreateBulk) Exec(ctx context.Context) error {
+	_, err := dlcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dlcb *DeadLetterCreateBulk) ExecX(ctx context.Context) {
+	if err := dlcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DeadLetter.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DeadLetterUpsert) {
+//			SetSourceUID(v+v).
+//		}).
+//		Exec(ctx)
+func (dlcb *DeadLetterCreateBulk) OnConflict(opts ...sql.ConflictOption) *DeadLetterUpsertBulk {
+	dlcb.conflict = opts
+	return &DeadLetterUpsertBulk{
+		create: dlcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DeadLetter.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (dlcb *DeadLetterCreateBulk) OnConflictColumns(columns ...string) *DeadLetterUpsertBulk {
+	dlcb.conflict = append(dlcb.conflict, sql.ConflictColumns(columns...))
+	return &DeadLetterUpsertBulk{
+		create: dlcb,
+	}
+}
+
+// DeadLetterUpsertBulk is the builder for "upsert"-ing
+// a bulk of DeadLetter nodes.
+type DeadLetterUpsertBulk struct {
+	create *DeadLetterCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.DeadLetter.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(deadletter.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DeadLetterUpsertBulk) UpdateNewValues() *DeadLetterUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(deadletter.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DeadLetter.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *DeadLetterUpsertBulk) Ignore() *DeadLetterUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DeadLetterUpsertBulk) DoNothing() *DeadLetterUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DeadLetterCreateBulk.OnConflict
+// documentation for more info.
+func (u *DeadLetterUpsertBulk) Update(set func(*DeadLetterUpsert)) *DeadLetterUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DeadLetterUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetSourceUID sets the "source_uid" field.
+func (u *DeadLetterUpsertBulk) SetSourceUID(v string) *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.SetSourceUID(v)
+	})
+}
+
+// UpdateSourceUID sets the "source_uid" field to the value that was provided on create.
+func (u *DeadLetterUpsertBulk) UpdateSourceUID() *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.UpdateSourceUID()
+	})
+}
+
+// SetSourceType sets the "source_type" field.
+func (u *DeadLetterUpsertBulk) SetSourceType(v string) *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.SetSourceType(v)
+	})
+}
+
+// UpdateSourceType sets the "source_type" field to the value that was provided on create.
+func (u *DeadLetterUpsertBulk) UpdateSourceType() *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.UpdateSourceType()
+	})
+}
+
+// SetRawJSON sets the "raw_json" field.
+func (u *DeadLetterUpsertBulk) SetRawJSON(v string) *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.SetRawJSON(v)
+	})
+}
+
+// UpdateRawJSON sets the "raw_json" field to the value that was provided on create.
+func (u *DeadLetterUpsertBulk) UpdateRawJSON() *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.UpdateRawJSON()
+	})
+}
+
+// SetStage sets the "stage" field.
+func (u *DeadLetterUpsertBulk) SetStage(v deadletter.Stage) *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.SetStage(v)
+	})
+}
+
+// UpdateStage sets the "stage" field to the value that was provided on create.
+func (u *DeadLetterUpsertBulk) UpdateStage() *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.UpdateStage()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *DeadLetterUpsertBulk) SetError(v string) *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *DeadLetterUpsertBulk) UpdateError() *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.UpdateError()
+	})
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *DeadLetterUpsertBulk) SetAttempts(v int) *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.SetAttempts(v)
+	})
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *DeadLetterUpsertBulk) AddAttempts(v int) *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.AddAttempts(v)
+	})
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *DeadLetterUpsertBulk) UpdateAttempts() *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.UpdateAttempts()
+	})
+}
+
+// SetShortSummary sets the "short_summary" field.
+func (u *DeadLetterUpsertBulk) SetShortSummary(v string) *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.SetShortSummary(v)
+	})
+}
+
+// UpdateShortSummary sets the "short_summary" field to the value that was provided on create.
+func (u *DeadLetterUpsertBulk) UpdateShortSummary() *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.UpdateShortSummary()
+	})
+}
+
+// ClearShortSummary clears the value of the "short_summary" field.
+func (u *DeadLetterUpsertBulk) ClearShortSummary() *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.ClearShortSummary()
+	})
+}
+
+// SetFullSummary sets the "full_summary" field.
+func (u *DeadLetterUpsertBulk) SetFullSummary(v string) *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.SetFullSummary(v)
+	})
+}
+
+// UpdateFullSummary sets the "full_summary" field to the value that was provided on create.
+func (u *DeadLetterUpsertBulk) UpdateFullSummary() *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.UpdateFullSummary()
+	})
+}
+
+// ClearFullSummary clears the value of the "full_summary" field.
+func (u *DeadLetterUpsertBulk) ClearFullSummary() *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.ClearFullSummary()
+	})
+}
+
+// SetEmbedding sets the "embedding" field.
+func (u *DeadLetterUpsertBulk) SetEmbedding(v pgvector.Vector) *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.SetEmbedding(v)
+	})
+}
+
+// UpdateEmbedding sets the "embedding" field to the value that was provided on create.
+func (u *DeadLetterUpsertBulk) UpdateEmbedding() *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.UpdateEmbedding()
+	})
+}
+
+// ClearEmbedding clears the value of the "embedding" field.
+func (u *DeadLetterUpsertBulk) ClearEmbedding() *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.ClearEmbedding()
+	})
+}
+
+// SetFailedAt sets the "failed_at" field.
+func (u *DeadLetterUpsertBulk) SetFailedAt(v time.Time) *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.SetFailedAt(v)
+	})
+}
+
+// UpdateFailedAt sets the "failed_at" field to the value that was provided on create.
+func (u *DeadLetterUpsertBulk) UpdateFailedAt() *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.UpdateFailedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *DeadLetterUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the DeadLetterCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DeadLetterCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DeadLetterUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,617 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/glanceapp/glance/pkg/storage/postgres/ent/deadletter"
+	"github.com/glanceapp/glance/pkg/storage/postgres/ent/predicate"
+	pgvector "github.com/pgvector/pgvector-go"
+)
+
+// DeadLetterUpdate is the builder for updating DeadLetter entities.
+type DeadLetterUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DeadLetterMutation
+}
+
+// Where appends a list predicates to the DeadLetterUpdate builder.
+func (dlu *DeadLetterUpdate) Where(ps ...predicate.DeadLetter) *DeadLetterUpdate {
+	dlu.mutation.Where(ps...)
+	return dlu
+}
+
+// SetSourceUID sets the "source_uid" field.
+func (dlu *DeadLetterUpdate) SetSourceUID(s string) *DeadLetterUpdate {
+	dlu.mutation.SetSourceUID(s)
+	return dlu
+}
+
+// SetNillableSourceUID sets the "source_uid" field if the given value is not nil.
+func (dlu *DeadLetterUpdate) SetNillableSourceUID(s *string) *DeadLetterUpdate {
+	if s != nil {
+		dlu.SetSourceUID(*s)
+	}
+	return dlu
+}
+
+// SetSourceType sets the "source_type" field.
+func (dlu *DeadLetterUpdate) SetSourceType(s string) *DeadLetterUpdate {
+	dlu.mutation.SetSourceType(s)
+	return dlu
+}
+
+// SetNillableSourceType sets the "source_type" field if the given value is not nil.
+func (dlu *DeadLetterUpdate) SetNillableSourceType(s *string) *DeadLetterUpdate {
+	if s != nil {
+		dlu.SetSourceType(*s)
+	}
+	return dlu
+}
+
+// SetRawJSON sets the "raw_json" field.
+func (dlu *DeadLetterUpdate) SetRawJSON(s string) *DeadLetterUpdate {
+	dlu.mutation.SetRawJSON(s)
+	return dlu
+}
+
+// SetNillableRawJSON sets the "raw_json" field if the given value is not nil.
+func (dlu *DeadLetterUpdate) SetNillableRawJSON(s *string) *DeadLetterUpdate {
+	if s != nil {
+		dlu.SetRawJSON(*s)
+	}
+	return dlu
+}
+
+// SetStage sets the "stage" field.
+func (dlu *DeadLetterUpdate) SetStage(d deadletter.Stage) *DeadLetterUpdate {
+	dlu.mutation.SetStage(d)
+	return dlu
+}
+
+// SetNillableStage sets the "stage" field if the given value is not nil.
+func (dlu *DeadLetterUpdate) SetNillableStage(d *deadletter.Stage) *DeadLetterUpdate {
+	if d != nil {
+		dlu.SetStage(*d)
+	}
+	return dlu
+}
+
+// SetError sets the "error" field.
+func (dlu *DeadLetterUpdate) SetError(s string) *DeadLetterUpdate {
+	dlu.mutation.SetError(s)
+	return dlu
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (dlu *DeadLetterUpdate) SetNillableError(s *string) *DeadLetterUpdate {
+	if s != nil {
+		dlu.SetError(*s)
+	}
+	return dlu
+}
+
+// SetAttempts sets the "attempts" field.
+func (dlu *DeadLetterUpdate) SetAttempts(i int) *DeadLetterUpdate {
+	dlu.mutation.ResetAttempts()
+	dlu.mutation.SetAttempts(i)
+	return dlu
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (dlu *DeadLetterUpdate) SetNillableAttempts(i *int) *DeadLetterUpdate {
+	if i != nil {
+		dlu.SetAttempts(*i)
+	}
+	return dlu
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (dlu *DeadLetterUpdate) AddAttempts(i int) *DeadLetterUpdate {
+	dlu.mutation.AddAttempts(i)
+	return dlu
+}
+
+// SetShortSummary sets the "short_summary" field.
+func (dlu *DeadLetterUpdate) SetShortSummary(s string) *DeadLetterUpdate {
+	dlu.mutation.SetShortSummary(s)
+	return dlu
+}
+
+// SetNillableShortSummary sets the "short_summary" field if the given value is not nil.
+func (dlu *DeadLetterUpdate) SetNillableShortSummary(s *string) *DeadLetterUpdate {
+	if s != nil {
+		dlu.SetShortSummary(*s)
+	}
+	return dlu
+}
+
+// ClearShortSummary clears the value of the "short_summary" field.
+func (dlu *DeadLetterUpdate) ClearShortSummary() *DeadLetterUpdate {
+	dlu.mutation.ClearShortSummary()
+	return dlu
+}
+
+// SetFullSummary sets the "full_summary" field.
+func (dlu *DeadLetterUpdate) SetFullSummary(s string) *DeadLetterUpdate {
+	dlu.mutation.SetFullSummary(s)
+	return dlu
+}
+
+// SetNillableFullSummary sets the "full_summary" field if the given value is not nil.
+func (dlu *DeadLetterUpdate) SetNillableFullSummary(s *string) *DeadLetterUpdate {
+	if s != nil {
+		dlu.SetFullSummary(*s)
+	}
+	return dlu
+}
+
+// ClearFullSummary clears the value of the "full_summary" field.
+func (dlu *DeadLetterUpdate) ClearFullSummary() *DeadLetterUpdate {
+	dlu.mutation.ClearFullSummary()
+	return dlu
+}
+
+// SetEmbedding sets the "embedding" field.
+func (dlu *DeadLetterUpdate) SetEmbedding(pg pgvector.Vector) *DeadLetterUpdate {
+	dlu.mutation.SetEmbedding(pg)
+	return dlu
+}
+
+// SetNillableEmbedding sets the "embedding" field if the given value is not nil.
+func (dlu *DeadLetterUpdate) SetNillableEmbedding(pg *pgvector.Vector) *DeadLetterUpdate {
+	if pg != nil {
+		dlu.SetEmbedding(*pg)
+	}
+	return dlu
+}
+
+// ClearEmbedding clears the value of the "embedding" field.
+func (dlu *DeadLetterUpdate) ClearEmbedding() *DeadLetterUpdate {
+	dlu.mutation.ClearEmbedding()
+	return dlu
+}
+
+// SetFailedAt sets the "failed_at" field.
+func (dlu *DeadLetterUpdate) SetFailedAt(t time.Time) *DeadLetterUpdate {
+	dlu.mutation.SetFailedAt(t)
+	return dlu
+}
+
+// SetNillableFailedAt sets the "failed_at" field if the given value is not nil.
+func (dlu *DeadLetterUpdate) SetNillableFailedAt(t *time.Time) *DeadLetterUpdate {
+	if t != nil {
+		dlu.SetFailedAt(*t)
+	}
+	return dlu
+}
+
+// Mutation returns the DeadLetterMutation object of the builder.
+func (dlu *DeadLetterUpdate) Mutation() *DeadLetterMutation {
+	return dlu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (dlu *DeadLetterUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, dlu.sqlSave, dlu.mutation, dlu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dlu *DeadLetterUpdate) SaveX(ctx context.Context) int {
+	affected, err := dlu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (dlu *DeadLetterUpdate) Exec(ctx context.Context) error {
+	_, err := dlu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dlu *DeadLetterUpdate) ExecX(ctx context.Context) {
+	if err := dlu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dlu *DeadLetterUpdate) check() error {
+	if v, ok := dlu.mutation.Stage(); ok {
+		if err := deadletter.StageValidator(v); err != nil {
+			return &ValidationError{Name: "stage", err: fmt.Errorf(`ent: validator failed for field "DeadLetter.stage": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (dlu *DeadLetterUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := dlu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(deadletter.Table, deadletter.Columns, sqlgraph.NewFieldSpec(deadletter.FieldID, field.TypeString))
+	if ps := dlu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := dlu.mutation.SourceUID(); ok {
+		_spec.SetField(deadletter.FieldSourceUID, field.TypeString, value)
+	}
+	if value, ok := dlu.mutation.SourceType(); ok {
+		_spec.SetField(deadletter.FieldSourceType, field.TypeString, value)
+	}
+	if value, ok := dlu.mutation.RawJSON(); ok {
+		_spec.SetField(deadletter.FieldRawJSON, field.TypeString, value)
+	}
+	if value, ok := dlu.mutation.Stage(); ok {
+		_spec.SetField(deadletter.FieldStage, field.TypeEnum, value)
+	}
+	if value, ok := dlu.mutation.Error(); ok {
+		_spec.SetField(deadletter.FieldError, field.TypeString, value)
+	}
+	if value, ok := dlu.mutation.Attempts(); ok {
+		_spec.SetField(deadletter.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := dlu.mutation.AddedAttempts(); ok {
+		_spec.AddField(deadletter.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := dlu.mutation.ShortSummary(); ok {
+		_spec.SetField(deadletter.FieldShortSummary, field.TypeString, value)
+	}
+	if dlu.mutation.ShortSummaryCleared() {
+		_spec.ClearField(deadletter.FieldShortSummary, field.TypeString)
+	}
+	if value, ok := dlu.mutation.FullSummary(); ok {
+		_spec.SetField(deadletter.FieldFullSummary, field.TypeString, value)
+	}
+	if dlu.mutation.FullSummaryCleared() {
+		_spec.ClearField(deadletter.FieldFullSummary, field.TypeString)
+	}
+	if value, ok := dlu.mutation.Embedding(); ok {
+		_spec.SetField(deadletter.FieldEmbedding, field.TypeOther, value)
+	}
+	if dlu.mutation.EmbeddingCleared() {
+		_spec.ClearField(deadletter.FieldEmbedding, field.TypeOther)
+	}
+	if value, ok := dlu.mutation.FailedAt(); ok {
+		_spec.SetField(deadletter.FieldFailedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, dlu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{deadletter.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	dlu.mutation.done = true
+	return n, nil
+}
+
+// DeadLetterUpdateOne is the builder for updating a single DeadLetter entity.
+type DeadLetterUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DeadLetterMutation
+}
+
+// SetSourceUID sets the "source_uid" field.
+func (dluo *DeadLetterUpdateOne) SetSourceUID(s string) *DeadLetterUpdateOne {
+	dluo.mutation.SetSourceUID(s)
+	return dluo
+}
+
+// SetNillableSourceUID sets the "source_uid" field if the given value is not nil.
+func (dluo *DeadLetterUpdateOne) SetNillableSourceUID(s *string) *DeadLetterUpdateOne {
+	if s != nil {
+		dluo.SetSourceUID(*s)
+	}
+	return dluo
+}
+
+// SetSourceType sets the "source_type" field.
+func (dluo *DeadLetterUpdateOne) SetSourceType(s string) *DeadLetterUpdateOne {
+	dluo.mutation.SetSourceType(s)
+	return dluo
+}
+
+// SetNillableSourceType sets the "source_type" field if the given value is not nil.
+func (dluo *DeadLetterUpdateOne) SetNillableSourceType(s *string) *DeadLetterUpdateOne {
+	if s != nil {
+		dluo.SetSourceType(*s)
+	}
+	return dluo
+}
+
+// SetRawJSON sets the "raw_json" field.
+func (dluo *DeadLetterUpdateOne) SetRawJSON(s string) *DeadLetterUpdateOne {
+	dluo.mutation.SetRawJSON(s)
+	return dluo
+}
+
+// SetNillableRawJSON sets the "raw_json" field if the given value is not nil.
+func (dluo *DeadLetterUpdateOne) SetNillableRawJSON(s *string) *DeadLetterUpdateOne {
+	if s != nil {
+		dluo.SetRawJSON(*s)
+	}
+	return dluo
+}
+
+// SetStage sets the "stage" field.
+func (dluo *DeadLetterUpdateOne) SetStage(d deadletter.Stage) *DeadLetterUpdateOne {
+	dluo.mutation.SetStage(d)
+	return dluo
+}
+
+// SetNillableStage sets the "stage" field if the given value is not nil.
+func (dluo *DeadLetterUpdateOne) SetNillableStage(d *deadletter.Stage) *DeadLetterUpdateOne {
+	if d != nil {
+		dluo.SetStage(*d)
+	}
+	return dluo
+}
+
+// SetError sets the "error" field.
+func (dluo *DeadLetterUpdateOne) SetError(s string) *DeadLetterUpdateOne {
+	dluo.mutation.SetError(s)
+	return dluo
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (dluo *DeadLetterUpdateOne) SetNillableError(s *string) *DeadLetterUpdateOne {
+	if s != nil {
+		dluo.SetError(*s)
+	}
+	return dluo
+}
+
+// SetAttempts sets the "attempts" field.
+func (dluo *DeadLetterUpdateOne) SetAttempts(i int) *DeadLetterUpdateOne {
+	dluo.mutation.ResetAttempts()
+	dluo.mutation.SetAttempts(i)
+	return dluo
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (dluo *DeadLetterUpdateOne) SetNillableAttempts(i *int) *DeadLetterUpdateOne {
+	if i != nil {
+		dluo.SetAttempts(*i)
+	}
+	return dluo
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (dluo *DeadLetterUpdateOne) AddAttempts(i int) *DeadLetterUpdateOne {
+	dluo.mutation.AddAttempts(i)
+	return dluo
+}
+
+// SetShortSummary sets the "short_summary" field.
+func (dluo *DeadLetterUpdateOne) SetShortSummary(s string) *DeadLetterUpdateOne {
+	dluo.mutation.SetShortSummary(s)
+	return dluo
+}
+
+// SetNillableShortSummary sets the "short_summary" field if the given value is not nil.
+func (dluo *DeadLetterUpdateOne) SetNillableShortSummary(s *string) *DeadLetterUpdateOne {
+	if s != nil {
+		dluo.SetShortSummary(*s)
+	}
+	return dluo
+}
+
+// ClearShortSummary clears the value of the "short_summary" field.
+func (dluo *DeadLetterUpdateOne) ClearShortSummary() *DeadLetterUpdateOne {
+	dluo.mutation.ClearShortSummary()
+	return dluo
+}
+
+// SetFullSummary sets the "full_summary" field.
+func (dluo *DeadLetterUpdateOne) SetFullSummary(s string) *DeadLetterUpdateOne {
+	dluo.mutation.SetFullSummary(s)
+	return dluo
+}
+
+// SetNillableFullSummary sets the "full_summary" field if the given value is not nil.
+func (dluo *DeadLetterUpdateOne) SetNillableFullSummary(s *string) *DeadLetterUpdateOne {
+	if s != nil {
+		dluo.SetFullSummary(*s)
+	}
+	return dluo
+}
+
+// ClearFullSummary clears the value of the "full_summary" field.
+func (dluo *DeadLetterUpdateOne) ClearFullSummary() *DeadLetterUpdateOne {
+	dluo.mutation.ClearFullSummary()
+	return dluo
+}
+
+// SetEmbedding sets the "embedding" field.
+func (dluo *DeadLetterUpdateOne) SetEmbedding(pg pgvector.Vector) *DeadLetterUpdateOne {
+	dluo.mutation.SetEmbedding(pg)
+	return dluo
+}
+
+// SetNillableEmbedding sets the "embedding" field if the given value is not nil.
+func (dluo *DeadLetterUpdateOne) SetNillableEmbedding(pg *pgvector.Vector) *DeadLetterUpdateOne {
+	if pg != nil {
+		dluo.SetEmbedding(*pg)
+	}
+	return dluo
+}
+
+// ClearEmbedding clears the value of the "embedding" field.
+func (dluo *DeadLetterUpdateOne) ClearEmbedding() *DeadLetterUpdateOne {
+	dluo.mutation.ClearEmbedding()
+	return dluo
+}
+
+// SetFailedAt sets the "failed_at" field.
+func (dluo *DeadLetterUpdateOne) SetFailedAt(t time.Time) *DeadLetterUpdateOne {
+	dluo.mutation.SetFailedAt(t)
+	return dluo
+}
+
+// SetNillableFailedAt sets the "failed_at" field if the given value is not nil.
+func (dluo *DeadLetterUpdateOne) SetNillableFailedAt(t *time.Time) *DeadLetterUpdateOne {
+	if t != nil {
+		dluo.SetFailedAt(*t)
+	}
+	return dluo
+}
+
+// Mutation returns the DeadLetterMutation object of the builder.
+func (dluo *DeadLetterUpdateOne) Mutation() *DeadLetterMutation {
+	return dluo.mutation
+}
+
+// Where appends a list predicates to the DeadLetterUpdate builder.
+func (dluo *DeadLetterUpdateOne) Where(ps ...predicate.DeadLetter) *DeadLetterUpdateOne {
+	dluo.mutation.Where(ps...)
+	return dluo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (dluo *DeadLetterUpdateOne) Select(field string, fields ...string) *DeadLetterUpdateOne {
+	dluo.fields = append([]string{field}, fields...)
+	return dluo
+}
+
+// Save executes the query and returns the updated DeadLetter entity.
+func (dluo *DeadLetterUpdateOne) Save(ctx context.Context) (*DeadLetter, error) {
+	return withHooks(ctx, dluo.sqlSave, dluo.mutation, dluo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dluo *DeadLetterUpdateOne) SaveX(ctx context.Context) *DeadLetter {
+	node, err := dluo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (dluo *DeadLetterUpdateOne) Exec(ctx context.Context) error {
+	_, err := dluo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dluo *DeadLetterUpdateOne) ExecX(ctx context.Context) {
+	if err := dluo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dluo *DeadLetterUpdateOne) check() error {
+	if v, ok := dluo.mutation.Stage(); ok {
+		if err := deadletter.StageValidator(v); err != nil {
+			return &ValidationError{Name: "stage", err: fmt.Errorf(`ent: validator failed for field "DeadLetter.stage": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (dluo *DeadLetterUpdateOne) sqlSave(ctx context.Context) (_node *DeadLetter, err error) {
+	if err := dluo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(deadletter.Table, deadletter.Columns, sqlgraph.NewFieldSpec(deadletter.FieldID, field.TypeString))
+	id, ok := dluo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DeadLetter.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := dluo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, deadletter.FieldID)
+		for _, f := range fields {
+			if !deadletter.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != deadletter.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := dluo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := dluo.mutation.SourceUID(); ok {
+		_spec.SetField(deadletter.FieldSourceUID, field.TypeString, value)
+	}
+	if value, ok := dluo.mutation.SourceType(); ok {
+		_spec.SetField(deadletter.FieldSourceType, field.TypeString, value)
+	}
+	if value, ok := dluo.mutation.RawJSON(); ok {
+		_spec.SetField(deadletter.FieldRawJSON, field.TypeString, value)
+	}
+	if value, ok := dluo.mutation.Stage(); ok {
+		_spec.SetField(deadletter.FieldStage, field.TypeEnum, value)
+	}
+	if value, ok := dluo.mutation.Error(); ok {
+		_spec.SetField(deadletter.FieldError, field.TypeString, value)
+	}
+	if value, ok := dluo.mutation.Attempts(); ok {
+		_spec.SetField(deadletter.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := dluo.mutation.AddedAttempts(); ok {
+		_spec.AddField(deadletter.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := dluo.mutation.ShortSummary(); ok {
+		_spec.SetField(deadletter.FieldShortSummary, field.TypeString, value)
+	}
+	if dluo.mutation.ShortSummaryCleared() {
+		_spec.ClearField(deadletter.FieldShortSummary, field.TypeString)
+	}
+	if value, ok := dluo.mutation.FullSummary(); ok {
+		_spec.SetField(deadletter.FieldFullSummary, field.TypeString, value)
+	}
+	if dluo.mutation.FullSummaryCleared() {
+		_spec.ClearField(deadletter.FieldFullSummary, field.TypeString)
+	}
+	if value, ok := dluo.mutation.Embedding(); ok {
+		_spec.SetField(deadletter.FieldEmbedding, field.TypeOther, value)
+	}
+	if dluo.mutation.EmbeddingCleared() {
+		_spec.ClearField(deadletter.FieldEmbedding, field.TypeOther)
+	}
+	if value, ok := dluo.mutation.FailedAt(); ok {
+		_spec.SetField(deadletter.FieldFailedAt, field.TypeTime, value)
+	}
+	_node = &DeadLetter{config: dluo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, dluo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{deadletter.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	dluo.mutation.done = true
+	return _node, nil
+}
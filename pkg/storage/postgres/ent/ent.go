@@ -13,7 +13,11 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/glanceapp/glance/pkg/storage/postgres/ent/activity"
+	"github.com/glanceapp/glance/pkg/storage/postgres/ent/deadletter"
+	"github.com/glanceapp/glance/pkg/storage/postgres/ent/job"
 	"github.com/glanceapp/glance/pkg/storage/postgres/ent/source"
+	"github.com/glanceapp/glance/pkg/storage/postgres/ent/sourcerun"
+	"github.com/glanceapp/glance/pkg/storage/postgres/ent/websubscription"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -74,8 +78,12 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			activity.Table: activity.ValidColumn,
-			source.Table:   source.ValidColumn,
+			activity.Table:        activity.ValidColumn,
+			deadletter.Table:      deadletter.ValidColumn,
+			job.Table:             job.ValidColumn,
+			source.Table:          source.ValidColumn,
+			sourcerun.Table:       sourcerun.ValidColumn,
+			websubscription.Table: websubscription.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
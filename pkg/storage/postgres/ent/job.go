@@ -0,0 +1,258 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/glanceapp/glance/pkg/storage/postgres/ent/job"
+	pgvector "github.com/pgvector/pgvector-go"
+)
+
+// Job is the model entity for the Job schema.
+type Job struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// SourceUID holds the value of the "source_uid" field.
+	SourceUID string `json:"source_uid,omitempty"`
+	// SourceType holds the value of the "source_type" field.
+	SourceType string `json:"source_type,omitempty"`
+	// RawJSON holds the value of the "raw_json" field.
+	RawJSON string `json:"raw_json,omitempty"`
+	// Stage holds the value of the "stage" field.
+	Stage job.Stage `json:"stage,omitempty"`
+	// State holds the value of the "state" field.
+	State job.State `json:"state,omitempty"`
+	// Attempts holds the value of the "attempts" field.
+	Attempts int `json:"attempts,omitempty"`
+	// LastError holds the value of the "last_error" field.
+	LastError string `json:"last_error,omitempty"`
+	// ShortSummary holds the value of the "short_summary" field.
+	ShortSummary string `json:"short_summary,omitempty"`
+	// FullSummary holds the value of the "full_summary" field.
+	FullSummary string `json:"full_summary,omitempty"`
+	// Embedding holds the value of the "embedding" field.
+	Embedding *pgvector.Vector `json:"embedding,omitempty"`
+	// RunAfter holds the value of the "run_after" field.
+	RunAfter time.Time `json:"run_after,omitempty"`
+	// ClaimedAt holds the value of the "claimed_at" field.
+	ClaimedAt *time.Time `json:"claimed_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Job) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case job.FieldEmbedding:
+			values[i] = &sql.NullScanner{S: new(pgvector.Vector)}
+		case job.FieldAttempts:
+			values[i] = new(sql.NullInt64)
+		case job.FieldID, job.FieldSourceUID, job.FieldSourceType, job.FieldRawJSON, job.FieldStage, job.FieldState, job.FieldLastError, job.FieldShortSummary, job.FieldFullSummary:
+			values[i] = new(sql.NullString)
+		case job.FieldRunAfter, job.FieldClaimedAt, job.FieldCreatedAt, job.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Job fields.
+func (j *Job) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case job.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				j.ID = value.String
+			}
+		case job.FieldSourceUID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source_uid", values[i])
+			} else if value.Valid {
+				j.SourceUID = value.String
+			}
+		case job.FieldSourceType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source_type", values[i])
+			} else if value.Valid {
+				j.SourceType = value.String
+			}
+		case job.FieldRawJSON:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field raw_json", values[i])
+			} else if value.Valid {
+				j.RawJSON = value.String
+			}
+		case job.FieldStage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field stage", values[i])
+			} else if value.Valid {
+				j.Stage = job.Stage(value.String)
+			}
+		case job.FieldState:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field state", values[i])
+			} else if value.Valid {
+				j.State = job.State(value.String)
+			}
+		case job.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				j.Attempts = int(value.Int64)
+			}
+		case job.FieldLastError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_error", values[i])
+			} else if value.Valid {
+				j.LastError = value.String
+			}
+		case job.FieldShortSummary:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field short_summary", values[i])
+			} else if value.Valid {
+				j.ShortSummary = value.String
+			}
+		case job.FieldFullSummary:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field full_summary", values[i])
+			} else if value.Valid {
+				j.FullSummary = value.String
+			}
+		case job.FieldEmbedding:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field embedding", values[i])
+			} else if value.Valid {
+				j.Embedding = new(pgvector.Vector)
+				*j.Embedding = *value.S.(*pgvector.Vector)
+			}
+		case job.FieldRunAfter:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field run_after", values[i])
+			} else if value.Valid {
+				j.RunAfter = value.Time
+			}
+		case job.FieldClaimedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field claimed_at", values[i])
+			} else if value.Valid {
+				j.ClaimedAt = new(time.Time)
+				*j.ClaimedAt = value.Time
+			}
+		case job.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				j.CreatedAt = value.Time
+			}
+		case job.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				j.UpdatedAt = value.Time
+			}
+		default:
+			j.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Job.
+// This includes values selected through modifiers, order, etc.
+func (j *Job) Value(name string) (ent.Value, error) {
+	return j.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Job.
+// Note that you need to call Job.Unwrap() before calling this method if this Job
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (j *Job) Update() *JobUpdateOne {
+	return NewJobClient(j.config).UpdateOne(j)
+}
+
+// Unwrap unwraps the Job entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (j *Job) Unwrap() *Job {
+	_tx, ok := j.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Job is not a transactional entity")
+	}
+	j.config.driver = _tx.drv
+	return j
+}
+
+// String implements the fmt.Stringer.
+func (j *Job) String() string {
+	var builder strings.Builder
+	builder.WriteString("Job(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", j.ID))
+	builder.WriteString("source_uid=")
+	builder.WriteString(j.SourceUID)
+	builder.WriteString(", ")
+	builder.WriteString("source_type=")
+	builder.WriteString(j.SourceType)
+	builder.WriteString(", ")
+	builder.WriteString("raw_json=")
+	builder.WriteString(j.RawJSON)
+	builder.WriteString(", ")
+	builder.WriteString("stage=")
+	builder.WriteString(fmt.Sprintf("%v", j.Stage))
+	builder.WriteString(", ")
+	builder.WriteString("state=")
+	builder.WriteString(fmt.Sprintf("%v", j.State))
+	builder.WriteString(", ")
+	builder.WriteString("attempts=")
+	builder.WriteString(fmt.Sprintf("%v", j.Attempts))
+	builder.WriteString(", ")
+	builder.WriteString("last_error=")
+	builder.WriteString(j.LastError)
+	builder.WriteString(", ")
+	builder.WriteString("short_summary=")
+	builder.WriteString(j.ShortSummary)
+	builder.WriteString(", ")
+	builder.WriteString("full_summary=")
+	builder.WriteString(j.FullSummary)
+	builder.WriteString(", ")
+	if v := j.Embedding; v != nil {
+		builder.WriteString("embedding=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("run_after=")
+	builder.WriteString(j.RunAfter.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := j.ClaimedAt; v != nil {
+		builder.WriteString("claimed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(j.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(j.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Jobs is a parsable slice of Job.
+type Jobs []*Job
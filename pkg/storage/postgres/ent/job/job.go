@@ -0,0 +1,220 @@
+// Code generated by ent, DO NOT EDIT.
+
+package job
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the job type in the database.
+	Label = "job"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldSourceUID holds the string denoting the source_uid field in the database.
+	FieldSourceUID = "source_uid"
+	// FieldSourceType holds the string denoting the source_type field in the database.
+	FieldSourceType = "source_type"
+	// FieldRawJSON holds the string denoting the raw_json field in the database.
+	FieldRawJSON = "raw_json"
+	// FieldStage holds the string denoting the stage field in the database.
+	FieldStage = "stage"
+	// FieldState holds the string denoting the state field in the database.
+	FieldState = "state"
+	// FieldAttempts holds the string denoting the attempts field in the database.
+	FieldAttempts = "attempts"
+	// FieldLastError holds the string denoting the last_error field in the database.
+	FieldLastError = "last_error"
+	// FieldShortSummary holds the string denoting the short_summary field in the database.
+	FieldShortSummary = "short_summary"
+	// FieldFullSummary holds the string denoting the full_summary field in the database.
+	FieldFullSummary = "full_summary"
+	// FieldEmbedding holds the string denoting the embedding field in the database.
+	FieldEmbedding = "embedding"
+	// FieldRunAfter holds the string denoting the run_after field in the database.
+	FieldRunAfter = "run_after"
+	// FieldClaimedAt holds the string denoting the claimed_at field in the database.
+	FieldClaimedAt = "claimed_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the job in the database.
+	Table = "jobs"
+)
+
+// Columns holds all SQL columns for job fields.
+var Columns = []string{
+	FieldID,
+	FieldSourceUID,
+	FieldSourceType,
+	FieldRawJSON,
+	FieldStage,
+	FieldState,
+	FieldAttempts,
+	FieldLastError,
+	FieldShortSummary,
+	FieldFullSummary,
+	FieldEmbedding,
+	FieldRunAfter,
+	FieldClaimedAt,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultAttempts holds the default value on creation for the "attempts" field.
+	DefaultAttempts int
+	// DefaultRunAfter holds the default value on creation for the "run_after" field.
+	DefaultRunAfter func() time.Time
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// Stage defines the type for the "stage" enum field.
+type Stage string
+
+// StageSummarize is the default value of the Stage enum.
+const DefaultStage = StageSummarize
+
+// Stage values.
+const (
+	StageSummarize Stage = "summarize"
+	StageEmbed     Stage = "embed"
+	StageStore     Stage = "store"
+)
+
+func (s Stage) String() string {
+	return string(s)
+}
+
+// StageValidator is a validator for the "stage" field enum values. It is called by the builders before save.
+func StageValidator(s Stage) error {
+	switch s {
+	case StageSummarize, StageEmbed, StageStore:
+		return nil
+	default:
+		return fmt.Errorf("job: invalid enum value for stage field: %q", s)
+	}
+}
+
+// State defines the type for the "state" enum field.
+type State string
+
+// StatePending is the default value of the State enum.
+const DefaultState = StatePending
+
+// State values.
+const (
+	StatePending State = "pending"
+	StateRunning State = "running"
+)
+
+func (s State) String() string {
+	return string(s)
+}
+
+// StateValidator is a validator for the "state" field enum values. It is called by the builders before save.
+func StateValidator(s State) error {
+	switch s {
+	case StatePending, StateRunning:
+		return nil
+	default:
+		return fmt.Errorf("job: invalid enum value for state field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the Job queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// BySourceUID orders the results by the source_uid field.
+func BySourceUID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSourceUID, opts...).ToFunc()
+}
+
+// BySourceType orders the results by the source_type field.
+func BySourceType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSourceType, opts...).ToFunc()
+}
+
+// ByRawJSON orders the results by the raw_json field.
+func ByRawJSON(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRawJSON, opts...).ToFunc()
+}
+
+// ByStage orders the results by the stage field.
+func ByStage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStage, opts...).ToFunc()
+}
+
+// ByState orders the results by the state field.
+func ByState(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldState, opts...).ToFunc()
+}
+
+// ByAttempts orders the results by the attempts field.
+func ByAttempts(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttempts, opts...).ToFunc()
+}
+
+// ByLastError orders the results by the last_error field.
+func ByLastError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastError, opts...).ToFunc()
+}
+
+// ByShortSummary orders the results by the short_summary field.
+func ByShortSummary(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldShortSummary, opts...).ToFunc()
+}
+
+// ByFullSummary orders the results by the full_summary field.
+func ByFullSummary(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFullSummary, opts...).ToFunc()
+}
+
+// ByEmbedding orders the results by the embedding field.
+func ByEmbedding(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEmbedding, opts...).ToFunc()
+}
+
+// ByRunAfter orders the results by the run_after field.
+func ByRunAfter(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRunAfter, opts...).ToFunc()
+}
+
+// ByClaimedAt orders the results by the claimed_at field.
+func ByClaimedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClaimedAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
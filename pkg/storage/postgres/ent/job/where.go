@@ -0,0 +1,861 @@
+// Code generated by ent, DO NOT EDIT.
+
+package job
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/glanceapp/glance/pkg/storage/postgres/ent/predicate"
+	pgvector "github.com/pgvector/pgvector-go"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.Job {
+	return predicate.Job(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.Job {
+	return predicate.Job(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.Job {
+	return predicate.Job(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.Job {
+	return predicate.Job(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.Job {
+	return predicate.Job(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.Job {
+	return predicate.Job(sql.FieldContainsFold(FieldID, id))
+}
+
+// SourceUID applies equality check predicate on the "source_uid" field. It's identical to SourceUIDEQ.
+func SourceUID(v string) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldSourceUID, v))
+}
+
+// SourceType applies equality check predicate on the "source_type" field. It's identical to SourceTypeEQ.
+func SourceType(v string) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldSourceType, v))
+}
+
+// RawJSON applies equality check predicate on the "raw_json" field. It's identical to RawJSONEQ.
+func RawJSON(v string) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldRawJSON, v))
+}
+
+// Attempts applies equality check predicate on the "attempts" field. It's identical to AttemptsEQ.
+func Attempts(v int) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldAttempts, v))
+}
+
+// LastError applies equality check predicate on the "last_error" field. It's identical to LastErrorEQ.
+func LastError(v string) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldLastError, v))
+}
+
+// ShortSummary applies equality check predicate on the "short_summary" field. It's identical to ShortSummaryEQ.
+func ShortSummary(v string) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldShortSummary, v))
+}
+
+// FullSummary applies equality check predicate on the "full_summary" field. It's identical to FullSummaryEQ.
+func FullSummary(v string) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldFullSummary, v))
+}
+
+// Embedding applies equality check predicate on the "embedding" field. It's identical to EmbeddingEQ.
+func Embedding(v pgvector.Vector) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldEmbedding, v))
+}
+
+// RunAfter applies equality check predicate on the "run_after" field. It's identical to RunAfterEQ.
+func RunAfter(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldRunAfter, v))
+}
+
+// ClaimedAt applies equality check predicate on the "claimed_at" field. It's identical to ClaimedAtEQ.
+func ClaimedAt(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldClaimedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// SourceUIDEQ applies the EQ predicate on the "source_uid" field.
+func SourceUIDEQ(v string) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldSourceUID, v))
+}
+
+// SourceUIDNEQ applies the NEQ predicate on the "source_uid" field.
+func SourceUIDNEQ(v string) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldSourceUID, v))
+}
+
+// SourceUIDIn applies the In predicate on the "source_uid" field.
+func SourceUIDIn(vs ...string) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldSourceUID, vs...))
+}
+
+// SourceUIDNotIn applies the NotIn predicate on the "source_uid" field.
+func SourceUIDNotIn(vs ...string) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldSourceUID, vs...))
+}
+
+// SourceUIDGT applies the GT predicate on the "source_uid" field.
+func SourceUIDGT(v string) predicate.Job {
+	return predicate.Job(sql.FieldGT(FieldSourceUID, v))
+}
+
+// SourceUIDGTE applies the GTE predicate on the "source_uid" field.
+func SourceUIDGTE(v string) predicate.Job {
+	return predicate.Job(sql.FieldGTE(FieldSourceUID, v))
+}
+
+// SourceUIDLT applies the LT predicate on the "source_uid" field.
+func SourceUIDLT(v string) predicate.Job {
+	return predicate.Job(sql.FieldLT(FieldSourceUID, v))
+}
+
+// SourceUIDLTE applies the LTE predicate on the "source_uid" field.
+func SourceUIDLTE(v string) predicate.Job {
+	return predicate.Job(sql.FieldLTE(FieldSourceUID, v))
+}
+
+// SourceUIDContains applies the Contains predicate on the "source_uid" field.
+func SourceUIDContains(v string) predicate.Job {
+	return predicate.Job(sql.FieldContains(FieldSourceUID, v))
+}
+
+// SourceUIDHasPrefix applies the HasPrefix predicate on the "source_uid" field.
+func SourceUIDHasPrefix(v string) predicate.Job {
+	return predicate.Job(sql.FieldHasPrefix(FieldSourceUID, v))
+}
+
+// SourceUIDHasSuffix applies the HasSuffix predicate on the "source_uid" field.
+func SourceUIDHasSuffix(v string) predicate.Job {
+	return predicate.Job(sql.FieldHasSuffix(FieldSourceUID, v))
+}
+
+// SourceUIDEqualFold applies the EqualFold predicate on the "source_uid" field.
+func SourceUIDEqualFold(v string) predicate.Job {
+	return predicate.Job(sql.FieldEqualFold(FieldSourceUID, v))
+}
+
+// SourceUIDContainsFold applies the ContainsFold predicate on the "source_uid" field.
+func SourceUIDContainsFold(v string) predicate.Job {
+	return predicate.Job(sql.FieldContainsFold(FieldSourceUID, v))
+}
+
+// SourceTypeEQ applies the EQ predicate on the "source_type" field.
+func SourceTypeEQ(v string) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldSourceType, v))
+}
+
+// SourceTypeNEQ applies the NEQ predicate on the "source_type" field.
+func SourceTypeNEQ(v string) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldSourceType, v))
+}
+
+// SourceTypeIn applies the In predicate on the "source_type" field.
+func SourceTypeIn(vs ...string) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldSourceType, vs...))
+}
+
+// SourceTypeNotIn applies the NotIn predicate on the "source_type" field.
+func SourceTypeNotIn(vs ...string) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldSourceType, vs...))
+}
+
+// SourceTypeGT applies the GT predicate on the "source_type" field.
+func SourceTypeGT(v string) predicate.Job {
+	return predicate.Job(sql.FieldGT(FieldSourceType, v))
+}
+
+// SourceTypeGTE applies the GTE predicate on the "source_type" field.
+func SourceTypeGTE(v string) predicate.Job {
+	return predicate.Job(sql.FieldGTE(FieldSourceType, v))
+}
+
+// SourceTypeLT applies the LT predicate on the "source_type" field.
+func SourceTypeLT(v string) predicate.Job {
+	return predicate.Job(sql.FieldLT(FieldSourceType, v))
+}
+
+// SourceTypeLTE applies the LTE predicate on the "source_type" field.
+func SourceTypeLTE(v string) predicate.Job {
+	return predicate.Job(sql.FieldLTE(FieldSourceType, v))
+}
+
+// SourceTypeContains applies the Contains predicate on the "source_type" field.
+func SourceTypeContains(v string) predicate.Job {
+	return predicate.Job(sql.FieldContains(FieldSourceType, v))
+}
+
+// SourceTypeHasPrefix applies the HasPrefix predicate on the "source_type" field.
+func SourceTypeHasPrefix(v string) predicate.Job {
+	return predicate.Job(sql.FieldHasPrefix(FieldSourceType, v))
+}
+
+// SourceTypeHasSuffix applies the HasSuffix predicate on the "source_type" field.
+func SourceTypeHasSuffix(v string) predicate.Job {
+	return predicate.Job(sql.FieldHasSuffix(FieldSourceType, v))
+}
+
+// SourceTypeEqualFold applies the EqualFold predicate on the "source_type" field.
+func SourceTypeEqualFold(v string) predicate.Job {
+	return predicate.Job(sql.FieldEqualFold(FieldSourceType, v))
+}
+
+// SourceTypeContainsFold applies the ContainsFold predicate on the "source_type" field.
+func SourceTypeContainsFold(v string) predicate.Job {
+	return predicate.Job(sql.FieldContainsFold(FieldSourceType, v))
+}
+
+// RawJSONEQ applies the EQ predicate on the "raw_json" field.
+func RawJSONEQ(v string) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldRawJSON, v))
+}
+
+// RawJSONNEQ applies the NEQ predicate on the "raw_json" field.
+func RawJSONNEQ(v string) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldRawJSON, v))
+}
+
+// RawJSONIn applies the In predicate on the "raw_json" field.
+func RawJSONIn(vs ...string) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldRawJSON, vs...))
+}
+
+// RawJSONNotIn applies the NotIn predicate on the "raw_json" field.
+func RawJSONNotIn(vs ...string) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldRawJSON, vs...))
+}
+
+// RawJSONGT applies the GT predicate on the "raw_json" field.
+func RawJSONGT(v string) predicate.Job {
+	return predicate.Job(sql.FieldGT(FieldRawJSON, v))
+}
+
+// RawJSONGTE applies the GTE predicate on the "raw_json" field.
+func RawJSONGTE(v string) predicate.Job {
+	return predicate.Job(sql.FieldGTE(FieldRawJSON, v))
+}
+
+// RawJSONLT applies the LT predicate on the "raw_json" field.
+func RawJSONLT(v string) predicate.Job {
+	return predicate.Job(sql.FieldLT(FieldRawJSON, v))
+}
+
+// RawJSONLTE applies the LTE predicate on the "raw_json" field.
+func RawJSONLTE(v string) predicate.Job {
+	return predicate.Job(sql.FieldLTE(FieldRawJSON, v))
+}
+
+// RawJSONContains applies the Contains predicate on the "raw_json" field.
+func RawJSONContains(v string) predicate.Job {
+	return predicate.Job(sql.FieldContains(FieldRawJSON, v))
+}
+
+// RawJSONHasPrefix applies the HasPrefix predicate on the "raw_json" field.
+func RawJSONHasPrefix(v string) predicate.Job {
+	return predicate.Job(sql.FieldHasPrefix(FieldRawJSON, v))
+}
+
+// RawJSONHasSuffix applies the HasSuffix predicate on the "raw_json" field.
+func RawJSONHasSuffix(v string) predicate.Job {
+	return predicate.Job(sql.FieldHasSuffix(FieldRawJSON, v))
+}
+
+// RawJSONEqualFold applies the EqualFold predicate on the "raw_json" field.
+func RawJSONEqualFold(v string) predicate.Job {
+	return predicate.Job(sql.FieldEqualFold(FieldRawJSON, v))
+}
+
+// RawJSONContainsFold applies the ContainsFold predicate on the "raw_json" field.
+func RawJSONContainsFold(v string) predicate.Job {
+	return predicate.Job(sql.FieldContainsFold(FieldRawJSON, v))
+}
+
+// StageEQ applies the EQ predicate on the "stage" field.
+func StageEQ(v Stage) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldStage, v))
+}
+
+// StageNEQ applies the NEQ predicate on the "stage" field.
+func StageNEQ(v Stage) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldStage, v))
+}
+
+// StageIn applies the In predicate on the "stage" field.
+func StageIn(vs ...Stage) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldStage, vs...))
+}
+
+// StageNotIn applies the NotIn predicate on the "stage" field.
+func StageNotIn(vs ...Stage) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldStage, vs...))
+}
+
+// StateEQ applies the EQ predicate on the "state" field.
+func StateEQ(v State) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldState, v))
+}
+
+// StateNEQ applies the NEQ predicate on the "state" field.
+func StateNEQ(v State) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldState, v))
+}
+
+// StateIn applies the In predicate on the "state" field.
+func StateIn(vs ...State) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldState, vs...))
+}
+
+// StateNotIn applies the NotIn predicate on the "state" field.
+func StateNotIn(vs ...State) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldState, vs...))
+}
+
+// AttemptsEQ applies the EQ predicate on the "attempts" field.
+func AttemptsEQ(v int) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldAttempts, v))
+}
+
+// AttemptsNEQ applies the NEQ predicate on the "attempts" field.
+func AttemptsNEQ(v int) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldAttempts, v))
+}
+
+// AttemptsIn applies the In predicate on the "attempts" field.
+func AttemptsIn(vs ...int) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldAttempts, vs...))
+}
+
+// AttemptsNotIn applies the NotIn predicate on the "attempts" field.
+func AttemptsNotIn(vs ...int) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldAttempts, vs...))
+}
+
+// AttemptsGT applies the GT predicate on the "attempts" field.
+func AttemptsGT(v int) predicate.Job {
+	return predicate.Job(sql.FieldGT(FieldAttempts, v))
+}
+
+// AttemptsGTE applies the GTE predicate on the "attempts" field.
+func AttemptsGTE(v int) predicate.Job {
+	return predicate.Job(sql.FieldGTE(FieldAttempts, v))
+}
+
+// AttemptsLT applies the LT predicate on the "attempts" field.
+func AttemptsLT(v int) predicate.Job {
+	return predicate.Job(sql.FieldLT(FieldAttempts, v))
+}
+
+// AttemptsLTE applies the LTE predicate on the "attempts" field.
+func AttemptsLTE(v int) predicate.Job {
+	return predicate.Job(sql.FieldLTE(FieldAttempts, v))
+}
+
+// LastErrorEQ applies the EQ predicate on the "last_error" field.
+func LastErrorEQ(v string) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldLastError, v))
+}
+
+// LastErrorNEQ applies the NEQ predicate on the "last_error" field.
+func LastErrorNEQ(v string) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldLastError, v))
+}
+
+// LastErrorIn applies the In predicate on the "last_error" field.
+func LastErrorIn(vs ...string) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldLastError, vs...))
+}
+
+// LastErrorNotIn applies the NotIn predicate on the "last_error" field.
+func LastErrorNotIn(vs ...string) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldLastError, vs...))
+}
+
+// LastErrorGT applies the GT predicate on the "last_error" field.
+func LastErrorGT(v string) predicate.Job {
+	return predicate.Job(sql.FieldGT(FieldLastError, v))
+}
+
+// LastErrorGTE applies the GTE predicate on the "last_error" field.
+func LastErrorGTE(v string) predicate.Job {
+	return predicate.Job(sql.FieldGTE(FieldLastError, v))
+}
+
+// LastErrorLT applies the LT predicate on the "last_error" field.
+func LastErrorLT(v string) predicate.Job {
+	return predicate.Job(sql.FieldLT(FieldLastError, v))
+}
+
+// LastErrorLTE applies the LTE predicate on the "last_error" field.
+func LastErrorLTE(v string) predicate.Job {
+	return predicate.Job(sql.FieldLTE(FieldLastError, v))
+}
+
+// LastErrorContains applies the Contains predicate on the "last_error" field.
+func LastErrorContains(v string) predicate.Job {
+	return predicate.Job(sql.FieldContains(FieldLastError, v))
+}
+
+// LastErrorHasPrefix applies the HasPrefix predicate on the "last_error" field.
+func LastErrorHasPrefix(v string) predicate.Job {
+	return predicate.Job(sql.FieldHasPrefix(FieldLastError, v))
+}
+
+// LastErrorHasSuffix applies the HasSuffix predicate on the "last_error" field.
+func LastErrorHasSuffix(v string) predicate.Job {
+	return predicate.Job(sql.FieldHasSuffix(FieldLastError, v))
+}
+
+// LastErrorIsNil applies the IsNil predicate on the "last_error" field.
+func LastErrorIsNil() predicate.Job {
+	return predicate.Job(sql.FieldIsNull(FieldLastError))
+}
+
+// LastErrorNotNil applies the NotNil predicate on the "last_error" field.
+func LastErrorNotNil() predicate.Job {
+	return predicate.Job(sql.FieldNotNull(FieldLastError))
+}
+
+// LastErrorEqualFold applies the EqualFold predicate on the "last_error" field.
+func LastErrorEqualFold(v string) predicate.Job {
+	return predicate.Job(sql.FieldEqualFold(FieldLastError, v))
+}
+
+// LastErrorContainsFold applies the ContainsFold predicate on the "last_error" field.
+func LastErrorContainsFold(v string) predicate.Job {
+	return predicate.Job(sql.FieldContainsFold(FieldLastError, v))
+}
+
+// ShortSummaryEQ applies the EQ predicate on the "short_summary" field.
+func ShortSummaryEQ(v string) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldShortSummary, v))
+}
+
+// ShortSummaryNEQ applies the NEQ predicate on the "short_summary" field.
+func ShortSummaryNEQ(v string) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldShortSummary, v))
+}
+
+// ShortSummaryIn applies the In predicate on the "short_summary" field.
+func ShortSummaryIn(vs ...string) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldShortSummary, vs...))
+}
+
+// ShortSummaryNotIn applies the NotIn predicate on the "short_summary" field.
+func ShortSummaryNotIn(vs ...string) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldShortSummary, vs...))
+}
+
+// ShortSummaryGT applies the GT predicate on the "short_summary" field.
+func ShortSummaryGT(v string) predicate.Job {
+	return predicate.Job(sql.FieldGT(FieldShortSummary, v))
+}
+
+// ShortSummaryGTE applies the GTE predicate on the "short_summary" field.
+func ShortSummaryGTE(v string) predicate.Job {
+	return predicate.Job(sql.FieldGTE(FieldShortSummary, v))
+}
+
+// ShortSummaryLT applies the LT predicate on the "short_summary" field.
+func ShortSummaryLT(v string) predicate.Job {
+	return predicate.Job(sql.FieldLT(FieldShortSummary, v))
+}
+
+// ShortSummaryLTE applies the LTE predicate on the "short_summary" field.
+func ShortSummaryLTE(v string) predicate.Job {
+	return predicate.Job(sql.FieldLTE(FieldShortSummary, v))
+}
+
+// ShortSummaryContains applies the Contains predicate on the "short_summary" field.
+func ShortSummaryContains(v string) predicate.Job {
+	return predicate.Job(sql.FieldContains(FieldShortSummary, v))
+}
+
+// ShortSummaryHasPrefix applies the HasPrefix predicate on the "short_summary" field.
+func ShortSummaryHasPrefix(v string) predicate.Job {
+	return predicate.Job(sql.FieldHasPrefix(FieldShortSummary, v))
+}
+
+// ShortSummaryHasSuffix applies the HasSuffix predicate on the "short_summary" field.
+func ShortSummaryHasSuffix(v string) predicate.Job {
+	return predicate.Job(sql.FieldHasSuffix(FieldShortSummary, v))
+}
+
+// ShortSummaryIsNil applies the IsNil predicate on the "short_summary" field.
+func ShortSummaryIsNil() predicate.Job {
+	return predicate.Job(sql.FieldIsNull(FieldShortSummary))
+}
+
+// ShortSummaryNotNil applies the NotNil predicate on the "short_summary" field.
+func ShortSummaryNotNil() predicate.Job {
+	return predicate.Job(sql.FieldNotNull(FieldShortSummary))
+}
+
+// ShortSummaryEqualFold applies the EqualFold predicate on the "short_summary" field.
+func ShortSummaryEqualFold(v string) predicate.Job {
+	return predicate.Job(sql.FieldEqualFold(FieldShortSummary, v))
+}
+
+// ShortSummaryContainsFold applies the ContainsFold predicate on the "short_summary" field.
+func ShortSummaryContainsFold(v string) predicate.Job {
+	return predicate.Job(sql.FieldContainsFold(FieldShortSummary, v))
+}
+
+// FullSummaryEQ applies the EQ predicate on the "full_summary" field.
+func FullSummaryEQ(v string) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldFullSummary, v))
+}
+
+// FullSummaryNEQ applies the NEQ predicate on the "full_summary" field.
+func FullSummaryNEQ(v string) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldFullSummary, v))
+}
+
+// FullSummaryIn applies the In predicate on the "full_summary" field.
+func FullSummaryIn(vs ...string) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldFullSummary, vs...))
+}
+
+// FullSummaryNotIn applies the NotIn predicate on the "full_summary" field.
+func FullSummaryNotIn(vs ...string) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldFullSummary, vs...))
+}
+
+// FullSummaryGT applies the GT predicate on the "full_summary" field.
+func FullSummaryGT(v string) predicate.Job {
+	return predicate.Job(sql.FieldGT(FieldFullSummary, v))
+}
+
+// FullSummaryGTE applies the GTE predicate on the "full_summary" field.
+func FullSummaryGTE(v string) predicate.Job {
+	return predicate.Job(sql.FieldGTE(FieldFullSummary, v))
+}
+
+// FullSummaryLT applies the LT predicate on the "full_summary" field.
+func FullSummaryLT(v string) predicate.Job {
+	return predicate.Job(sql.FieldLT(FieldFullSummary, v))
+}
+
+// FullSummaryLTE applies the LTE predicate on the "full_summary" field.
+func FullSummaryLTE(v string) predicate.Job {
+	return predicate.Job(sql.FieldLTE(FieldFullSummary, v))
+}
+
+// FullSummaryContains applies the Contains predicate on the "full_summary" field.
+func FullSummaryContains(v string) predicate.Job {
+	return predicate.Job(sql.FieldContains(FieldFullSummary, v))
+}
+
+// FullSummaryHasPrefix applies the HasPrefix predicate on the "full_summary" field.
+func FullSummaryHasPrefix(v string) predicate.Job {
+	return predicate.Job(sql.FieldHasPrefix(FieldFullSummary, v))
+}
+
+// FullSummaryHasSuffix applies the HasSuffix predicate on the "full_summary" field.
+func FullSummaryHasSuffix(v string) predicate.Job {
+	return predicate.Job(sql.FieldHasSuffix(FieldFullSummary, v))
+}
+
+// FullSummaryIsNil applies the IsNil predicate on the "full_summary" field.
+func FullSummaryIsNil() predicate.Job {
+	return predicate.Job(sql.FieldIsNull(FieldFullSummary))
+}
+
+// FullSummaryNotNil applies the NotNil predicate on the "full_summary" field.
+func FullSummaryNotNil() predicate.Job {
+	return predicate.Job(sql.FieldNotNull(FieldFullSummary))
+}
+
+// FullSummaryEqualFold applies the EqualFold predicate on the "full_summary" field.
+func FullSummaryEqualFold(v string) predicate.Job {
+	return predicate.Job(sql.FieldEqualFold(FieldFullSummary, v))
+}
+
+// FullSummaryContainsFold applies the ContainsFold predicate on the "full_summary" field.
+func FullSummaryContainsFold(v string) predicate.Job {
+	return predicate.Job(sql.FieldContainsFold(FieldFullSummary, v))
+}
+
+// EmbeddingEQ applies the EQ predicate on the "embedding" field.
+func EmbeddingEQ(v pgvector.Vector) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldEmbedding, v))
+}
+
+// EmbeddingNEQ applies the NEQ predicate on the "embedding" field.
+func EmbeddingNEQ(v pgvector.Vector) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldEmbedding, v))
+}
+
+// EmbeddingIn applies the In predicate on the "embedding" field.
+func EmbeddingIn(vs ...pgvector.Vector) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldEmbedding, vs...))
+}
+
+// EmbeddingNotIn applies the NotIn predicate on the "embedding" field.
+func EmbeddingNotIn(vs ...pgvector.Vector) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldEmbedding, vs...))
+}
+
+// EmbeddingGT applies the GT predicate on the "embedding" field.
+func EmbeddingGT(v pgvector.Vector) predicate.Job {
+	return predicate.Job(sql.FieldGT(FieldEmbedding, v))
+}
+
+// EmbeddingGTE applies the GTE predicate on the "embedding" field.
+func EmbeddingGTE(v pgvector.Vector) predicate.Job {
+	return predicate.Job(sql.FieldGTE(FieldEmbedding, v))
+}
+
+// EmbeddingLT applies the LT predicate on the "embedding" field.
+func EmbeddingLT(v pgvector.Vector) predicate.Job {
+	return predicate.Job(sql.FieldLT(FieldEmbedding, v))
+}
+
+// EmbeddingLTE applies the LTE predicate on the "embedding" field.
+func EmbeddingLTE(v pgvector.Vector) predicate.Job {
+	return predicate.Job(sql.FieldLTE(FieldEmbedding, v))
+}
+
+// EmbeddingIsNil applies the IsNil predicate on the "embedding" field.
+func EmbeddingIsNil() predicate.Job {
+	return predicate.Job(sql.FieldIsNull(FieldEmbedding))
+}
+
+// EmbeddingNotNil applies the NotNil predicate on the "embedding" field.
+func EmbeddingNotNil() predicate.Job {
+	return predicate.Job(sql.FieldNotNull(FieldEmbedding))
+}
+
+// RunAfterEQ applies the EQ predicate on the "run_after" field.
+func RunAfterEQ(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldRunAfter, v))
+}
+
+// RunAfterNEQ applies the NEQ predicate on the "run_after" field.
+func RunAfterNEQ(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldRunAfter, v))
+}
+
+// RunAfterIn applies the In predicate on the "run_after" field.
+func RunAfterIn(vs ...time.Time) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldRunAfter, vs...))
+}
+
+// RunAfterNotIn applies the NotIn predicate on the "run_after" field.
+func RunAfterNotIn(vs ...time.Time) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldRunAfter, vs...))
+}
+
+// RunAfterGT applies the GT predicate on the "run_after" field.
+func RunAfterGT(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldGT(FieldRunAfter, v))
+}
+
+// RunAfterGTE applies the GTE predicate on the "run_after" field.
+func RunAfterGTE(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldGTE(FieldRunAfter, v))
+}
+
+// RunAfterLT applies the LT predicate on the "run_after" field.
+func RunAfterLT(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldLT(FieldRunAfter, v))
+}
+
+// RunAfterLTE applies the LTE predicate on the "run_after" field.
+func RunAfterLTE(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldLTE(FieldRunAfter, v))
+}
+
+// ClaimedAtEQ applies the EQ predicate on the "claimed_at" field.
+func ClaimedAtEQ(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldClaimedAt, v))
+}
+
+// ClaimedAtNEQ applies the NEQ predicate on the "claimed_at" field.
+func ClaimedAtNEQ(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldClaimedAt, v))
+}
+
+// ClaimedAtIn applies the In predicate on the "claimed_at" field.
+func ClaimedAtIn(vs ...time.Time) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldClaimedAt, vs...))
+}
+
+// ClaimedAtNotIn applies the NotIn predicate on the "claimed_at" field.
+func ClaimedAtNotIn(vs ...time.Time) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldClaimedAt, vs...))
+}
+
+// ClaimedAtGT applies the GT predicate on the "claimed_at" field.
+func ClaimedAtGT(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldGT(FieldClaimedAt, v))
+}
+
+// ClaimedAtGTE applies the GTE predicate on the "claimed_at" field.
+func ClaimedAtGTE(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldGTE(FieldClaimedAt, v))
+}
+
+// ClaimedAtLT applies the LT predicate on the "claimed_at" field.
+func ClaimedAtLT(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldLT(FieldClaimedAt, v))
+}
+
+// ClaimedAtLTE applies the LTE predicate on the "claimed_at" field.
+func ClaimedAtLTE(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldLTE(FieldClaimedAt, v))
+}
+
+// ClaimedAtIsNil applies the IsNil predicate on the "claimed_at" field.
+func ClaimedAtIsNil() predicate.Job {
+	return predicate.Job(sql.FieldIsNull(FieldClaimedAt))
+}
+
+// ClaimedAtNotNil applies the NotNil predicate on the "claimed_at" field.
+func ClaimedAtNotNil() predicate.Job {
+	return predicate.Job(sql.FieldNotNull(FieldClaimedAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Job) predicate.Job {
+	return predicate.Job(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Job) predicate.Job {
+	return predicate.Job(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Job) predicate.Job {
+	return predicate.Job(sql.NotPredicates(p))
+}
@@ -0,0 +1,1379 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/glanceapp/glance/pkg/storage/postgres/ent/job"
+	pgvector "github.com/pgvector/pgvector-go"
+)
+
+// JobCreate is the builder for creating a Job entity.
+type JobCreate struct {
+	config
+	mutation *JobMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetSourceUID sets the "source_uid" field.
+func (jc *JobCreate) SetSourceUID(s string) *JobCreate {
+	jc.mutation.SetSourceUID(s)
+	return jc
+}
+
+// SetSourceType sets the "source_type" field.
+func (jc *JobCreate) SetSourceType(s string) *JobCreate {
+	jc.mutation.SetSourceType(s)
+	return jc
+}
+
+// SetRawJSON sets the "raw_json" field.
+func (jc *JobCreate) SetRawJSON(s string) *JobCreate {
+	jc.mutation.SetRawJSON(s)
+	return jc
+}
+
+// SetStage sets the "stage" field.
+func (jc *JobCreate) SetStage(j job.Stage) *JobCreate {
+	jc.mutation.SetStage(j)
+	return jc
+}
+
+// SetNillableStage sets the "stage" field if the given value is not nil.
+func (jc *JobCreate) SetNillableStage(j *job.Stage) *JobCreate {
+	if j != nil {
+		jc.SetStage(*j)
+	}
+	return jc
+}
+
+// SetState sets the "state" field.
+func (jc *JobCreate) SetState(j job.State) *JobCreate {
+	jc.mutation.SetState(j)
+	return jc
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (jc *JobCreate) SetNillableState(j *job.State) *JobCreate {
+	if j != nil {
+		jc.SetState(*j)
+	}
+	return jc
+}
+
+// SetAttempts sets the "attempts" field.
+func (jc *JobCreate) SetAttempts(i int) *JobCreate {
+	jc.mutation.SetAttempts(i)
+	return jc
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (jc *JobCreate) SetNillableAttempts(i *int) *JobCreate {
+	if i != nil {
+		jc.SetAttempts(*i)
+	}
+	return jc
+}
+
+// SetLastError sets the "last_error" field.
+func (jc *JobCreate) SetLastError(s string) *JobCreate {
+	jc.mutation.SetLastError(s)
+	return jc
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (jc *JobCreate) SetNillableLastError(s *string) *JobCreate {
+	if s != nil {
+		jc.SetLastError(*s)
+	}
+	return jc
+}
+
+// SetShortSummary sets the "short_summary" field.
+func (jc *JobCreate) SetShortSummary(s string) *JobCreate {
+	jc.mutation.SetShortSummary(s)
+	return jc
+}
+
+// SetNillableShortSummary sets the "short_summary" field if the given value is not nil.
+func (jc *JobCreate) SetNillableShortSummary(s *string) *JobCreate {
+	if s != nil {
+		jc.SetShortSummary(*s)
+	}
+	return jc
+}
+
+// SetFullSummary sets the "full_summary" field.
+func (jc *JobCreate) SetFullSummary(s string) *JobCreate {
+	jc.mutation.SetFullSummary(s)
+	return jc
+}
+
+// SetNillableFullSummary sets the "full_summary" field if the given value is not nil.
+func (jc *JobCreate) SetNillableFullSummary(s *string) *JobCreate {
+	if s != nil {
+		jc.SetFullSummary(*s)
+	}
+	return jc
+}
+
+// SetEmbedding sets the "embedding" field.
+func (jc *JobCreate) SetEmbedding(pg pgvector.Vector) *JobCreate {
+	jc.mutation.SetEmbedding(pg)
+	return jc
+}
+
+// SetNillableEmbedding sets the "embedding" field if the given value is not nil.
+func (jc *JobCreate) SetNillableEmbedding(pg *pgvector.Vector) *JobCreate {
+	if pg != nil {
+		jc.SetEmbedding(*pg)
+	}
+	return jc
+}
+
+// SetRunAfter sets the "run_after" field.
+func (jc *JobCreate) SetRunAfter(t time.Time) *JobCreate {
+	jc.mutation.SetRunAfter(t)
+	return jc
+}
+
+// SetNillableRunAfter sets the "run_after" field if the given value is not nil.
+func (jc *JobCreate) SetNillableRunAfter(t *time.Time) *JobCreate {
+	if t != nil {
+		jc.SetRunAfter(*t)
+	}
+	return jc
+}
+
+// SetClaimedAt sets the "claimed_at" field.
+func (jc *JobCreate) SetClaimedAt(t time.Time) *JobCreate {
+	jc.mutation.SetClaimedAt(t)
+	return jc
+}
+
+// SetNillableClaimedAt sets the "claimed_at" field if the given value is not nil.
+func (jc *JobCreate) SetNillableClaimedAt(t *time.Time) *JobCreate {
+	if t != nil {
+		jc.SetClaimedAt(*t)
+	}
+	return jc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (jc *JobCreate) SetCreatedAt(t time.Time) *JobCreate {
+	jc.mutation.SetCreatedAt(t)
+	return jc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (jc *JobCreate) SetNillableCreatedAt(t *time.Time) *JobCreate {
+	if t != nil {
+		jc.SetCreatedAt(*t)
+	}
+	return jc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (jc *JobCreate) SetUpdatedAt(t time.Time) *JobCreate {
+	jc.mutation.SetUpdatedAt(t)
+	return jc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (jc *JobCreate) SetNillableUpdatedAt(t *time.Time) *JobCreate {
+	if t != nil {
+		jc.SetUpdatedAt(*t)
+	}
+	return jc
+}
+
+// SetID sets the "id" field.
+func (jc *JobCreate) SetID(s string) *JobCreate {
+	jc.mutation.SetID(s)
+	return jc
+}
+
+// Mutation returns the JobMutation object of the builder.
+func (jc *JobCreate) Mutation() *JobMutation {
+	return jc.mutation
+}
+
+// Save creates the Job in the database.
+func (jc *JobCreate) Save(ctx context.Context) (*Job, error) {
+	jc.defaults()
+	return withHooks(ctx, jc.sqlSave, jc.mutation, jc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (jc *JobCreate) SaveX(ctx context.Context) *Job {
+	v, err := jc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (jc *JobCreate) Exec(ctx context.Context) error {
+	_, err := jc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jc *JobCreate) ExecX(ctx context.Context) {
+	if err := jc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (jc *JobCreate) defaults() {
+	if _, ok := jc.mutation.Stage(); !ok {
+		v := job.DefaultStage
+		jc.mutation.SetStage(v)
+	}
+	if _, ok := jc.mutation.State(); !ok {
+		v := job.DefaultState
+		jc.mutation.SetState(v)
+	}
+	if _, ok := jc.mutation.Attempts(); !ok {
+		v := job.DefaultAttempts
+		jc.mutation.SetAttempts(v)
+	}
+	if _, ok := jc.mutation.RunAfter(); !ok {
+		v := job.DefaultRunAfter()
+		jc.mutation.SetRunAfter(v)
+	}
+	if _, ok := jc.mutation.CreatedAt(); !ok {
+		v := job.DefaultCreatedAt()
+		jc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := jc.mutation.UpdatedAt(); !ok {
+		v := job.DefaultUpdatedAt()
+		jc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (jc *JobCreate) check() error {
+	if _, ok := jc.mutation.SourceUID(); !ok {
+		return &ValidationError{Name: "source_uid", err: errors.New(`ent: missing required field "Job.source_uid"`)}
+	}
+	if _, ok := jc.mutation.SourceType(); !ok {
+		return &ValidationError{Name: "source_type", err: errors.New(`ent: missing required field "Job.source_type"`)}
+	}
+	if _, ok := jc.mutation.RawJSON(); !ok {
+		return &ValidationError{Name: "raw_json", err: errors.New(`ent: missing required field "Job.raw_json"`)}
+	}
+	if _, ok := jc.mutation.Stage(); !ok {
+		return &ValidationError{Name: "stage", err: errors.New(`ent: missing required field "Job.stage"`)}
+	}
+	if v, ok := jc.mutation.Stage(); ok {
+		if err := job.StageValidator(v); err != nil {
+			return &ValidationError{Name: "stage", err: fmt.Errorf(`ent: validator failed for field "Job.stage": %w`, err)}
+		}
+	}
+	if _, ok := jc.mutation.State(); !ok {
+		return &ValidationError{Name: "state", err: errors.New(`ent: missing required field "Job.state"`)}
+	}
+	if v, ok := jc.mutation.State(); ok {
+		if err := job.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "Job.state": %w`, err)}
+		}
+	}
+	if _, ok := jc.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "Job.attempts"`)}
+	}
+	if _, ok := jc.mutation.RunAfter(); !ok {
+		return &ValidationError{Name: "run_after", err: errors.New(`ent: missing required field "Job.run_after"`)}
+	}
+	if _, ok := jc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Job.created_at"`)}
+	}
+	if _, ok := jc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Job.updated_at"`)}
+	}
+	return nil
+}
+
+func (jc *JobCreate) sqlSave(ctx context.Context) (*Job, error) {
+	if err := jc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := jc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, jc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Job.ID type: %T", _spec.ID.Value)
+		}
+	}
+	jc.mutation.id = &_node.ID
+	jc.mutation.done = true
+	return _node, nil
+}
+
+func (jc *JobCreate) createSpec() (*Job, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Job{config: jc.config}
+		_spec = sqlgraph.NewCreateSpec(job.Table, sqlgraph.NewFieldSpec(job.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = jc.conflict
+	if id, ok := jc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := jc.mutation.SourceUID(); ok {
+		_spec.SetField(job.FieldSourceUID, field.TypeString, value)
+		_node.SourceUID = value
+	}
+	if value, ok := jc.mutation.SourceType(); ok {
+		_spec.SetField(job.FieldSourceType, field.TypeString, value)
+		_node.SourceType = value
+	}
+	if value, ok := jc.mutation.RawJSON(); ok {
+		_spec.SetField(job.FieldRawJSON, field.TypeString, value)
+		_node.RawJSON = value
+	}
+	if value, ok := jc.mutation.Stage(); ok {
+		_spec.SetField(job.FieldStage, field.TypeEnum, value)
+		_node.Stage = value
+	}
+	if value, ok := jc.mutation.State(); ok {
+		_spec.SetField(job.FieldState, field.TypeEnum, value)
+		_node.State = value
+	}
+	if value, ok := jc.mutation.Attempts(); ok {
+		_spec.SetField(job.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := jc.mutation.LastError(); ok {
+		_spec.SetField(job.FieldLastError, field.TypeString, value)
+		_node.LastError = value
+	}
+	if value, ok := jc.mutation.ShortSummary(); ok {
+		_spec.SetField(job.FieldShortSummary, field.TypeString, value)
+		_node.ShortSummary = value
+	}
+	if value, ok := jc.mutation.FullSummary(); ok {
+		_spec.SetField(job.FieldFullSummary, field.TypeString, value)
+		_node.FullSummary = value
+	}
+	if value, ok := jc.mutation.Embedding(); ok {
+		_spec.SetField(job.FieldEmbedding, field.TypeOther, value)
+		_node.Embedding = &value
+	}
+	if value, ok := jc.mutation.RunAfter(); ok {
+		_spec.SetField(job.FieldRunAfter, field.TypeTime, value)
+		_node.RunAfter = value
+	}
+	if value, ok := jc.mutation.ClaimedAt(); ok {
+		_spec.SetField(job.FieldClaimedAt, field.TypeTime, value)
+		_node.ClaimedAt = &value
+	}
+	if value, ok := jc.mutation.CreatedAt(); ok {
+		_spec.SetField(job.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := jc.mutation.UpdatedAt(); ok {
+		_spec.SetField(job.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Job.Create().
+//		SetSourceUID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.JobUpsert) {
+//			SetSourceUID(v+v).
+//		}).
+//		Exec(ctx)
+func (jc *JobCreate) OnConflict(opts ...sql.ConflictOption) *JobUpsertOne {
+	jc.conflict = opts
+	return &JobUpsertOne{
+		create: jc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Job.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (jc *JobCreate) OnConflictColumns(columns ...string) *JobUpsertOne {
+	jc.conflict = append(jc.conflict, sql.ConflictColumns(columns...))
+	return &JobUpsertOne{
+		create: jc,
+	}
+}
+
+type (
+	// JobUpsertOne is the builder for "upsert"-ing
+	//  one Job node.
+	JobUpsertOne struct {
+		create *JobCreate
+	}
+
+	// JobUpsert is the "OnConflict" setter.
+	JobUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetSourceUID sets the "source_uid" field.
+func (u *JobUpsert) SetSourceUID(v string) *JobUpsert {
+	u.Set(job.FieldSourceUID, v)
+	return u
+}
+
+// UpdateSourceUID sets the "source_uid" field to the value that was provided on create.
+func (u *JobUpsert) UpdateSourceUID() *JobUpsert {
+	u.SetExcluded(job.FieldSourceUID)
+	return u
+}
+
+// SetSourceType sets the "source_type" field.
+func (u *JobUpsert) SetSourceType(v string) *JobUpsert {
+	u.Set(job.FieldSourceType, v)
+	return u
+}
+
+// UpdateSourceType sets the "source_type" field to the value that was provided on create.
+func (u *JobUpsert) UpdateSourceType() *JobUpsert {
+	u.SetExcluded(job.FieldSourceType)
+	return u
+}
+
+// SetRawJSON sets the "raw_json" field.
+func (u *JobUpsert) SetRawJSON(v string) *JobUpsert {
+	u.Set(job.FieldRawJSON, v)
+	return u
+}
+
+// UpdateRawJSON sets the "raw_json" field to the value that was provided on create.
+func (u *JobUpsert) UpdateRawJSON() *JobUpsert {
+	u.SetExcluded(job.FieldRawJSON)
+	return u
+}
+
+// SetStage sets the "stage" field.
+func (u *JobUpsert) SetStage(v job.Stage) *JobUpsert {
+	u.Set(job.FieldStage, v)
+	return u
+}
+
+// UpdateStage sets the "stage" field to the value that was provided on create.
+func (u *JobUpsert) UpdateStage() *JobUpsert {
+	u.SetExcluded(job.FieldStage)
+	return u
+}
+
+// SetState sets the "state" field.
+func (u *JobUpsert) SetState(v job.State) *JobUpsert {
+	u.Set(job.FieldState, v)
+	return u
+}
+
+// UpdateState sets the "state" field to the value that was provided on create.
+func (u *JobUpsert) UpdateState() *JobUpsert {
+	u.SetExcluded(job.FieldState)
+	return u
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *JobUpsert) SetAttempts(v int) *JobUpsert {
+	u.Set(job.FieldAttempts, v)
+	return u
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *JobUpsert) UpdateAttempts() *JobUpsert {
+	u.SetExcluded(job.FieldAttempts)
+	return u
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *JobUpsert) AddAttempts(v int) *JobUpsert {
+	u.Add(job.FieldAttempts, v)
+	return u
+}
+
+// SetLastError sets the "last_error" field.
+func (u *JobUpsert) SetLastError(v string) *JobUpsert {
+	u.Set(job.FieldLastError, v)
+	return u
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *JobUpsert) UpdateLastError() *JobUpsert {
+	u.SetExcluded(job.FieldLastError)
+	return u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (u *JobUpsert) ClearLastError() *JobUpsert {
+	u.SetNull(job.FieldLastError)
+	return u
+}
+
+// SetShortSummary sets the "short_summary" field.
+func (u *JobUpsert) SetShortSummary(v string) *JobUpsert {
+	u.Set(job.FieldShortSummary, v)
+	return u
+}
+
+// UpdateShortSummary sets the "short_summary" field to the value that was provided on create.
+func (u *JobUpsert) UpdateShortSummary() *JobUpsert {
+	u.SetExcluded(job.FieldShortSummary)
+	return u
+}
+
+// ClearShortSummary clears the value of the "short_summary" field.
+func (u *JobUpsert) ClearShortSummary() *JobUpsert {
+	u.SetNull(job.FieldShortSummary)
+	return u
+}
+
+// SetFullSummary sets the "full_summary" field.
+func (u *JobUpsert) SetFullSummary(v string) *JobUpsert {
+	u.Set(job.FieldFullSummary, v)
+	return u
+}
+
+// UpdateFullSummary sets the "full_summary" field to the value that was provided on create.
+func (u *JobUpsert) UpdateFullSummary() *JobUpsert {
+	u.SetExcluded(job.FieldFullSummary)
+	return u
+}
+
+// ClearFullSummary clears the value of the "full_summary" field.
+func (u *JobUpsert) ClearFullSummary() *JobUpsert {
+	u.SetNull(job.FieldFullSummary)
+	return u
+}
+
+// SetEmbedding sets the "embedding" field.
+func (u *JobUpsert) SetEmbedding(v pgvector.Vector) *JobUpsert {
+	u.Set(job.FieldEmbedding, v)
+	return u
+}
+
+// UpdateEmbedding sets the "embedding" field to the value that was provided on create.
+func (u *JobUpsert) UpdateEmbedding() *JobUpsert {
+	u.SetExcluded(job.FieldEmbedding)
+	return u
+}
+
+// ClearEmbedding clears the value of the "embedding" field.
+func (u *JobUpsert) ClearEmbedding() *JobUpsert {
+	u.SetNull(job.FieldEmbedding)
+	return u
+}
+
+// SetRunAfter sets the "run_after" field.
+func (u *JobUpsert) SetRunAfter(v time.Time) *JobUpsert {
+	u.Set(job.FieldRunAfter, v)
+	return u
+}
+
+// UpdateRunAfter sets the "run_after" field to the value that was provided on create.
+func (u *JobUpsert) UpdateRunAfter() *JobUpsert {
+	u.SetExcluded(job.FieldRunAfter)
+	return u
+}
+
+// SetClaimedAt sets the "claimed_at" field.
+func (u *JobUpsert) SetClaimedAt(v time.Time) *JobUpsert {
+	u.Set(job.FieldClaimedAt, v)
+	return u
+}
+
+// UpdateClaimedAt sets the "claimed_at" field to the value that was provided on create.
+func (u *JobUpsert) UpdateClaimedAt() *JobUpsert {
+	u.SetExcluded(job.FieldClaimedAt)
+	return u
+}
+
+// ClearClaimedAt clears the value of the "claimed_at" field.
+func (u *JobUpsert) ClearClaimedAt() *JobUpsert {
+	u.SetNull(job.FieldClaimedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *JobUpsert) SetUpdatedAt(v time.Time) *JobUpsert {
+	u.Set(job.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *JobUpsert) UpdateUpdatedAt() *JobUpsert {
+	u.SetExcluded(job.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Job.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(job.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *JobUpsertOne) UpdateNewValues() *JobUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(job.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(job.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Job.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *JobUpsertOne) Ignore() *JobUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *JobUpsertOne) DoNothing() *JobUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the JobCreate.OnConflict
+// documentation for more info.
+func (u *JobUpsertOne) Update(set func(*JobUpsert)) *JobUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&JobUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetSourceUID sets the "source_uid" field.
+func (u *JobUpsertOne) SetSourceUID(v string) *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.SetSourceUID(v)
+	})
+}
+
+// UpdateSourceUID sets the "source_uid" field to the value that was provided on create.
+func (u *JobUpsertOne) UpdateSourceUID() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateSourceUID()
+	})
+}
+
+// SetSourceType sets the "source_type" field.
+func (u *JobUpsertOne) SetSourceType(v string) *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.SetSourceType(v)
+	})
+}
+
+// UpdateSourceType sets the "source_type" field to the value that was provided on create.
+func (u *JobUpsertOne) UpdateSourceType() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateSourceType()
+	})
+}
+
+// SetRawJSON sets the "raw_json" field.
+func (u *JobUpsertOne) SetRawJSON(v string) *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.SetRawJSON(v)
+	})
+}
+
+// UpdateRawJSON sets the "raw_json" field to the value that was provided on create.
+func (u *JobUpsertOne) UpdateRawJSON() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateRawJSON()
+	})
+}
+
+// SetStage sets the "stage" field.
+func (u *JobUpsertOne) SetStage(v job.Stage) *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.SetStage(v)
+	})
+}
+
+// UpdateStage sets the "stage" field to the value that was provided on create.
+func (u *JobUpsertOne) UpdateStage() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateStage()
+	})
+}
+
+// SetState sets the "state" field.
+func (u *JobUpsertOne) SetState(v job.State) *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.SetState(v)
+	})
+}
+
+// UpdateState sets the "state" field to the value that was provided on create.
+func (u *JobUpsertOne) UpdateState() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateState()
+	})
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *JobUpsertOne) SetAttempts(v int) *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.SetAttempts(v)
+	})
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *JobUpsertOne) AddAttempts(v int) *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.AddAttempts(v)
+	})
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *JobUpsertOne) UpdateAttempts() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateAttempts()
+	})
+}
+
+// SetLastError sets the "last_error" field.
+func (u *JobUpsertOne) SetLastError(v string) *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.SetLastError(v)
+	})
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *JobUpsertOne) UpdateLastError() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateLastError()
+	})
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (u *JobUpsertOne) ClearLastError() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.ClearLastError()
+	})
+}
+
+// SetShortSummary sets the "short_summary" field.
+func (u *JobUpsertOne) SetShortSummary(v string) *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.SetShortSummary(v)
+	})
+}
+
+// UpdateShortSummary sets the "short_summary" field to the value that was provided on create.
+func (u *JobUpsertOne) UpdateShortSummary() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateShortSummary()
+	})
+}
+
+// ClearShortSummary clears the value of the "short_summary" field.
+func (u *JobUpsertOne) ClearShortSummary() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.ClearShortSummary()
+	})
+}
+
+// SetFullSummary sets the "full_summary" field.
+func (u *JobUpsertOne) SetFullSummary(v string) *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.SetFullSummary(v)
+	})
+}
+
+// UpdateFullSummary sets the "full_summary" field to the value that was provided on create.
+func (u *JobUpsertOne) UpdateFullSummary() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateFullSummary()
+	})
+}
+
+// ClearFullSummary clears the value of the "full_summary" field.
+func (u *JobUpsertOne) ClearFullSummary() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.ClearFullSummary()
+	})
+}
+
+// SetEmbedding sets the "embedding" field.
+func (u *JobUpsertOne) SetEmbedding(v pgvector.Vector) *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.SetEmbedding(v)
+	})
+}
+
+// UpdateEmbedding sets the "embedding" field to the value that was provided on create.
+func (u *JobUpsertOne) UpdateEmbedding() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateEmbedding()
+	})
+}
+
+// ClearEmbedding clears the value of the "embedding" field.
+func (u *JobUpsertOne) ClearEmbedding() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.ClearEmbedding()
+	})
+}
+
+// SetRunAfter sets the "run_after" field.
+func (u *JobUpsertOne) SetRunAfter(v time.Time) *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.SetRunAfter(v)
+	})
+}
+
+// UpdateRunAfter sets the "run_after" field to the value that was provided on create.
+func (u *JobUpsertOne) UpdateRunAfter() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateRunAfter()
+	})
+}
+
+// SetClaimedAt sets the "claimed_at" field.
+func (u *JobUpsertOne) SetClaimedAt(v time.Time) *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.SetClaimedAt(v)
+	})
+}
+
+// UpdateClaimedAt sets the "claimed_at" field to the value that was provided on create.
+func (u *JobUpsertOne) UpdateClaimedAt() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateClaimedAt()
+	})
+}
+
+// ClearClaimedAt clears the value of the "claimed_at" field.
+func (u *JobUpsertOne) ClearClaimedAt() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.ClearClaimedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *JobUpsertOne) SetUpdatedAt(v time.Time) *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *JobUpsertOne) UpdateUpdatedAt() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *JobUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for JobCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *JobUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *JobUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: JobUpsertOne.ID is not supported by MySQL driver. Use JobUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *JobUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// JobCreateBulk is the builder for creating many Job entities in bulk.
+type JobCreateBulk struct {
+	config
+	err      error
+	builders []*JobCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Job entities in the database.
+func (jcb *JobCreateBulk) Save(ctx context.Context) ([]*Job, error) {
+	if jcb.err != nil {
+		return nil, jcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(jcb.builders))
+	nodes := make([]*Job, len(jcb.builders))
+	mutators := make([]Mutator, len(jcb.builders))
+	for i := range jcb.builders {
+		func(i int, root context.Context) {
+			builder := jcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*JobMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, jcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = jcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, jcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, jcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (jcb *JobCreateBulk) SaveX(ctx context.Context) []*Job {
+	v, err := jcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (jcb *JobCreateBulk) Exec(ctx context.Context) error {
+	_, err := jcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jcb *JobCreateBulk) ExecX(ctx context.Context) {
+	if err := jcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Job.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.JobUpsert) {
+//			SetSourceUID(v+v).
+//		}).
+//		Exec(ctx)
+func (jcb *JobCreateBulk) OnConflict(opts ...sql.ConflictOption) *JobUpsertBulk {
+	jcb.conflict = opts
+	return &JobUpsertBulk{
+		create: jcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Job.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (jcb *JobCreateBulk) OnConflictColumns(columns ...string) *JobUpsertBulk {
+	jcb.conflict = append(jcb.conflict, sql.ConflictColumns(columns...))
+	return &JobUpsertBulk{
+		create: jcb,
+	}
+}
+
+// JobUpsertBulk is the builder for "upsert"-ing
+// a bulk of Job nodes.
+type JobUpsertBulk struct {
+	create *JobCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Job.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(job.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *JobUpsertBulk) UpdateNewValues() *JobUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(job.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(job.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Job.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *JobUpsertBulk) Ignore() *JobUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *JobUpsertBulk) DoNothing() *JobUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the JobCreateBulk.OnConflict
+// documentation for more info.
+func (u *JobUpsertBulk) Update(set func(*JobUpsert)) *JobUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&JobUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetSourceUID sets the "source_uid" field.
+func (u *JobUpsertBulk) SetSourceUID(v string) *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.SetSourceUID(v)
+	})
+}
+
+// UpdateSourceUID sets the "source_uid" field to the value that was provided on create.
+func (u *JobUpsertBulk) UpdateSourceUID() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateSourceUID()
+	})
+}
+
+// SetSourceType sets the "source_type" field.
+func (u *JobUpsertBulk) SetSourceType(v string) *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.SetSourceType(v)
+	})
+}
+
+// UpdateSourceType sets the "source_type" field to the value that was provided on create.
+func (u *JobUpsertBulk) UpdateSourceType() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateSourceType()
+	})
+}
+
+// SetRawJSON sets the "raw_json" field.
+func (u *JobUpsertBulk) SetRawJSON(v string) *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.SetRawJSON(v)
+	})
+}
+
+// UpdateRawJSON sets the "raw_json" field to the value that was provided on create.
+func (u *JobUpsertBulk) UpdateRawJSON() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateRawJSON()
+	})
+}
+
+// SetStage sets the "stage" field.
+func (u *JobUpsertBulk) SetStage(v job.Stage) *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.SetStage(v)
+	})
+}
+
+// UpdateStage sets the "stage" field to the value that was provided on create.
+func (u *JobUpsertBulk) UpdateStage() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateStage()
+	})
+}
+
+// SetState sets the "state" field.
+func (u *JobUpsertBulk) SetState(v job.State) *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.SetState(v)
+	})
+}
+
+// UpdateState sets the "state" field to the value that was provided on create.
+func (u *JobUpsertBulk) UpdateState() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateState()
+	})
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *JobUpsertBulk) SetAttempts(v int) *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.SetAttempts(v)
+	})
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *JobUpsertBulk) AddAttempts(v int) *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.AddAttempts(v)
+	})
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *JobUpsertBulk) UpdateAttempts() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateAttempts()
+	})
+}
+
+// SetLastError sets the "last_error" field.
+func (u *JobUpsertBulk) SetLastError(v string) *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.SetLastError(v)
+	})
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *JobUpsertBulk) UpdateLastError() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateLastError()
+	})
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (u *JobUpsertBulk) ClearLastError() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.ClearLastError()
+	})
+}
+
+// SetShortSummary sets the "short_summary" field.
+func (u *JobUpsertBulk) SetShortSummary(v string) *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.SetShortSummary(v)
+	})
+}
+
+// UpdateShortSummary sets the "short_summary" field to the value that was provided on create.
+func (u *JobUpsertBulk) UpdateShortSummary() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateShortSummary()
+	})
+}
+
+// ClearShortSummary clears the value of the "short_summary" field.
+func (u *JobUpsertBulk) ClearShortSummary() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.ClearShortSummary()
+	})
+}
+
+// SetFullSummary sets the "full_summary" field.
+func (u *JobUpsertBulk) SetFullSummary(v string) *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.SetFullSummary(v)
+	})
+}
+
+// UpdateFullSummary sets the "full_summary" field to the value that was provided on create.
+func (u *JobUpsertBulk) UpdateFullSummary() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateFullSummary()
+	})
+}
+
+// ClearFullSummary clears the value of the "full_summary" field.
+func (u *JobUpsertBulk) ClearFullSummary() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.ClearFullSummary()
+	})
+}
+
+// SetEmbedding sets the "embedding" field.
+func (u *JobUpsertBulk) SetEmbedding(v pgvector.Vector) *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.SetEmbedding(v)
+	})
+}
+
+// UpdateEmbedding sets the "embedding" field to the value that was provided on create.
+func (u *JobUpsertBulk) UpdateEmbedding() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateEmbedding()
+	})
+}
+
+// ClearEmbedding clears the value of the "embedding" field.
+func (u *JobUpsertBulk) ClearEmbedding() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.ClearEmbedding()
+	})
+}
+
+// SetRunAfter sets the "run_after" field.
+func (u *JobUpsertBulk) SetRunAfter(v time.Time) *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.SetRunAfter(v)
+	})
+}
+
+// UpdateRunAfter sets the "run_after" field to the value that was provided on create.
+func (u *JobUpsertBulk) UpdateRunAfter() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateRunAfter()
+	})
+}
+
+// SetClaimedAt sets the "claimed_at" field.
+func (u *JobUpsertBulk) SetClaimedAt(v time.Time) *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.SetClaimedAt(v)
+	})
+}
+
+// UpdateClaimedAt sets the "claimed_at" field to the value that was provided on create.
+func (u *JobUpsertBulk) UpdateClaimedAt() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateClaimedAt()
+	})
+}
+
+// ClearClaimedAt clears the value of the "claimed_at" field.
+func (u *JobUpsertBulk) ClearClaimedAt() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.ClearClaimedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *JobUpsertBulk) SetUpdatedAt(v time.Time) *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *JobUpsertBulk) UpdateUpdatedAt() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *JobUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the JobCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for JobCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *JobUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,767 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/glanceapp/glance/pkg/storage/postgres/ent/job"
+	"github.com/glanceapp/glance/pkg/storage/postgres/ent/predicate"
+	pgvector "github.com/pgvector/pgvector-go"
+)
+
+// JobUpdate is the builder for updating Job entities.
+type JobUpdate struct {
+	config
+	hooks    []Hook
+	mutation *JobMutation
+}
+
+// Where appends a list predicates to the JobUpdate builder.
+func (ju *JobUpdate) Where(ps ...predicate.Job) *JobUpdate {
+	ju.mutation.Where(ps...)
+	return ju
+}
+
+// SetSourceUID sets the "source_uid" field.
+func (ju *JobUpdate) SetSourceUID(s string) *JobUpdate {
+	ju.mutation.SetSourceUID(s)
+	return ju
+}
+
+// SetNillableSourceUID sets the "source_uid" field if the given value is not nil.
+func (ju *JobUpdate) SetNillableSourceUID(s *string) *JobUpdate {
+	if s != nil {
+		ju.SetSourceUID(*s)
+	}
+	return ju
+}
+
+// SetSourceType sets the "source_type" field.
+func (ju *JobUpdate) SetSourceType(s string) *JobUpdate {
+	ju.mutation.SetSourceType(s)
+	return ju
+}
+
+// SetNillableSourceType sets the "source_type" field if the given value is not nil.
+func (ju *JobUpdate) SetNillableSourceType(s *string) *JobUpdate {
+	if s != nil {
+		ju.SetSourceType(*s)
+	}
+	return ju
+}
+
+// SetRawJSON sets the "raw_json" field.
+func (ju *JobUpdate) SetRawJSON(s string) *JobUpdate {
+	ju.mutation.SetRawJSON(s)
+	return ju
+}
+
+// SetNillableRawJSON sets the "raw_json" field if the given value is not nil.
+func (ju *JobUpdate) SetNillableRawJSON(s *string) *JobUpdate {
+	if s != nil {
+		ju.SetRawJSON(*s)
+	}
+	return ju
+}
+
+// SetStage sets the "stage" field.
+func (ju *JobUpdate) SetStage(j job.Stage) *JobUpdate {
+	ju.mutation.SetStage(j)
+	return ju
+}
+
+// SetNillableStage sets the "stage" field if the given value is not nil.
+func (ju *JobUpdate) SetNillableStage(j *job.Stage) *JobUpdate {
+	if j != nil {
+		ju.SetStage(*j)
+	}
+	return ju
+}
+
+// SetState sets the "state" field.
+func (ju *JobUpdate) SetState(j job.State) *JobUpdate {
+	ju.mutation.SetState(j)
+	return ju
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (ju *JobUpdate) SetNillableState(j *job.State) *JobUpdate {
+	if j != nil {
+		ju.SetState(*j)
+	}
+	return ju
+}
+
+// SetAttempts sets the "attempts" field.
+func (ju *JobUpdate) SetAttempts(i int) *JobUpdate {
+	ju.mutation.ResetAttempts()
+	ju.mutation.SetAttempts(i)
+	return ju
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (ju *JobUpdate) SetNillableAttempts(i *int) *JobUpdate {
+	if i != nil {
+		ju.SetAttempts(*i)
+	}
+	return ju
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (ju *JobUpdate) AddAttempts(i int) *JobUpdate {
+	ju.mutation.AddAttempts(i)
+	return ju
+}
+
+// SetLastError sets the "last_error" field.
+func (ju *JobUpdate) SetLastError(s string) *JobUpdate {
+	ju.mutation.SetLastError(s)
+	return ju
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (ju *JobUpdate) SetNillableLastError(s *string) *JobUpdate {
+	if s != nil {
+		ju.SetLastError(*s)
+	}
+	return ju
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (ju *JobUpdate) ClearLastError() *JobUpdate {
+	ju.mutation.ClearLastError()
+	return ju
+}
+
+// SetShortSummary sets the "short_summary" field.
+func (ju *JobUpdate) SetShortSummary(s string) *JobUpdate {
+	ju.mutation.SetShortSummary(s)
+	return ju
+}
+
+// SetNillableShortSummary sets the "short_summary" field if the given value is not nil.
+func (ju *JobUpdate) SetNillableShortSummary(s *string) *JobUpdate {
+	if s != nil {
+		ju.SetShortSummary(*s)
+	}
+	return ju
+}
+
+// ClearShortSummary clears the value of the "short_summary" field.
+func (ju *JobUpdate) ClearShortSummary() *JobUpdate {
+	ju.mutation.ClearShortSummary()
+	return ju
+}
+
+// SetFullSummary sets the "full_summary" field.
+func (ju *JobUpdate) SetFullSummary(s string) *JobUpdate {
+	ju.mutation.SetFullSummary(s)
+	return ju
+}
+
+// SetNillableFullSummary sets the "full_summary" field if the given value is not nil.
+func (ju *JobUpdate) SetNillableFullSummary(s *string) *JobUpdate {
+	if s != nil {
+		ju.SetFullSummary(*s)
+	}
+	return ju
+}
+
+// ClearFullSummary clears the value of the "full_summary" field.
+func (ju *JobUpdate) ClearFullSummary() *JobUpdate {
+	ju.mutation.ClearFullSummary()
+	return ju
+}
+
+// SetEmbedding sets the "embedding" field.
+func (ju *JobUpdate) SetEmbedding(pg pgvector.Vector) *JobUpdate {
+	ju.mutation.SetEmbedding(pg)
+	return ju
+}
+
+// SetNillableEmbedding sets the "embedding" field if the given value is not nil.
+func (ju *JobUpdate) SetNillableEmbedding(pg *pgvector.Vector) *JobUpdate {
+	if pg != nil {
+		ju.SetEmbedding(*pg)
+	}
+	return ju
+}
+
+// ClearEmbedding clears the value of the "embedding" field.
+func (ju *JobUpdate) ClearEmbedding() *JobUpdate {
+	ju.mutation.ClearEmbedding()
+	return ju
+}
+
+// SetRunAfter sets the "run_after" field.
+func (ju *JobUpdate) SetRunAfter(t time.Time) *JobUpdate {
+	ju.mutation.SetRunAfter(t)
+	return ju
+}
+
+// SetNillableRunAfter sets the "run_after" field if the given value is not nil.
+func (ju *JobUpdate) SetNillableRunAfter(t *time.Time) *JobUpdate {
+	if t != nil {
+		ju.SetRunAfter(*t)
+	}
+	return ju
+}
+
+// SetClaimedAt sets the "claimed_at" field.
+func (ju *JobUpdate) SetClaimedAt(t time.Time) *JobUpdate {
+	ju.mutation.SetClaimedAt(t)
+	return ju
+}
+
+// SetNillableClaimedAt sets the "claimed_at" field if the given value is not nil.
+func (ju *JobUpdate) SetNillableClaimedAt(t *time.Time) *JobUpdate {
+	if t != nil {
+		ju.SetClaimedAt(*t)
+	}
+	return ju
+}
+
+// ClearClaimedAt clears the value of the "claimed_at" field.
+func (ju *JobUpdate) ClearClaimedAt() *JobUpdate {
+	ju.mutation.ClearClaimedAt()
+	return ju
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ju *JobUpdate) SetUpdatedAt(t time.Time) *JobUpdate {
+	ju.mutation.SetUpdatedAt(t)
+	return ju
+}
+
+// Mutation returns the JobMutation object of the builder.
+func (ju *JobUpdate) Mutation() *JobMutation {
+	return ju.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ju *JobUpdate) Save(ctx context.Context) (int, error) {
+	ju.defaults()
+	return withHooks(ctx, ju.sqlSave, ju.mutation, ju.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ju *JobUpdate) SaveX(ctx context.Context) int {
+	affected, err := ju.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ju *JobUpdate) Exec(ctx context.Context) error {
+	_, err := ju.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ju *JobUpdate) ExecX(ctx context.Context) {
+	if err := ju.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ju *JobUpdate) defaults() {
+	if _, ok := ju.mutation.UpdatedAt(); !ok {
+		v := job.UpdateDefaultUpdatedAt()
+		ju.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ju *JobUpdate) check() error {
+	if v, ok := ju.mutation.Stage(); ok {
+		if err := job.StageValidator(v); err != nil {
+			return &ValidationError{Name: "stage", err: fmt.Errorf(`ent: validator failed for field "Job.stage": %w`, err)}
+		}
+	}
+	if v, ok := ju.mutation.State(); ok {
+		if err := job.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "Job.state": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ju *JobUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ju.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(job.Table, job.Columns, sqlgraph.NewFieldSpec(job.FieldID, field.TypeString))
+	if ps := ju.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ju.mutation.SourceUID(); ok {
+		_spec.SetField(job.FieldSourceUID, field.TypeString, value)
+	}
+	if value, ok := ju.mutation.SourceType(); ok {
+		_spec.SetField(job.FieldSourceType, field.TypeString, value)
+	}
+	if value, ok := ju.mutation.RawJSON(); ok {
+		_spec.SetField(job.FieldRawJSON, field.TypeString, value)
+	}
+	if value, ok := ju.mutation.Stage(); ok {
+		_spec.SetField(job.FieldStage, field.TypeEnum, value)
+	}
+	if value, ok := ju.mutation.State(); ok {
+		_spec.SetField(job.FieldState, field.TypeEnum, value)
+	}
+	if value, ok := ju.mutation.Attempts(); ok {
+		_spec.SetField(job.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := ju.mutation.AddedAttempts(); ok {
+		_spec.AddField(job.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := ju.mutation.LastError(); ok {
+		_spec.SetField(job.FieldLastError, field.TypeString, value)
+	}
+	if ju.mutation.LastErrorCleared() {
+		_spec.ClearField(job.FieldLastError, field.TypeString)
+	}
+	if value, ok := ju.mutation.ShortSummary(); ok {
+		_spec.SetField(job.FieldShortSummary, field.TypeString, value)
+	}
+	if ju.mutation.ShortSummaryCleared() {
+		_spec.ClearField(job.FieldShortSummary, field.TypeString)
+	}
+	if value, ok := ju.mutation.FullSummary(); ok {
+		_spec.SetField(job.FieldFullSummary, field.TypeString, value)
+	}
+	if ju.mutation.FullSummaryCleared() {
+		_spec.ClearField(job.FieldFullSummary, field.TypeString)
+	}
+	if value, ok := ju.mutation.Embedding(); ok {
+		_spec.SetField(job.FieldEmbedding, field.TypeOther, value)
+	}
+	if ju.mutation.EmbeddingCleared() {
+		_spec.ClearField(job.FieldEmbedding, field.TypeOther)
+	}
+	if value, ok := ju.mutation.RunAfter(); ok {
+		_spec.SetField(job.FieldRunAfter, field.TypeTime, value)
+	}
+	if value, ok := ju.mutation.ClaimedAt(); ok {
+		_spec.SetField(job.FieldClaimedAt, field.TypeTime, value)
+	}
+	if ju.mutation.ClaimedAtCleared() {
+		_spec.ClearField(job.FieldClaimedAt, field.TypeTime)
+	}
+	if value, ok := ju.mutation.UpdatedAt(); ok {
+		_spec.SetField(job.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ju.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{job.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ju.mutation.done = true
+	return n, nil
+}
+
+// JobUpdateOne is the builder for updating a single Job entity.
+type JobUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *JobMutation
+}
+
+// SetSourceUID sets the "source_uid" field.
+func (juo *JobUpdateOne) SetSourceUID(s string) *JobUpdateOne {
+	juo.mutation.SetSourceUID(s)
+	return juo
+}
+
+// SetNillableSourceUID sets the "source_uid" field if the given value is not nil.
+func (juo *JobUpdateOne) SetNillableSourceUID(s *string) *JobUpdateOne {
+	if s != nil {
+		juo.SetSourceUID(*s)
+	}
+	return juo
+}
+
+// SetSourceType sets the "source_type" field.
+func (juo *JobUpdateOne) SetSourceType(s string) *JobUpdateOne {
+	juo.mutation.SetSourceType(s)
+	return juo
+}
+
+// SetNillableSourceType sets the "source_type" field if the given value is not nil.
+func (juo *JobUpdateOne) SetNillableSourceType(s *string) *JobUpdateOne {
+	if s != nil {
+		juo.SetSourceType(*s)
+	}
+	return juo
+}
+
+// SetRawJSON sets the "raw_json" field.
+func (juo *JobUpdateOne) SetRawJSON(s string) *JobUpdateOne {
+	juo.mutation.SetRawJSON(s)
+	return juo
+}
+
+// SetNillableRawJSON sets the "raw_json" field if the given value is not nil.
+func (juo *JobUpdateOne) SetNillableRawJSON(s *string) *JobUpdateOne {
+	if s != nil {
+		juo.SetRawJSON(*s)
+	}
+	return juo
+}
+
+// SetStage sets the "stage" field.
+func (juo *JobUpdateOne) SetStage(j job.Stage) *JobUpdateOne {
+	juo.mutation.SetStage(j)
+	return juo
+}
+
+// SetNillableStage sets the "stage" field if the given value is not nil.
+func (juo *JobUpdateOne) SetNillableStage(j *job.Stage) *JobUpdateOne {
+	if j != nil {
+		juo.SetStage(*j)
+	}
+	return juo
+}
+
+// SetState sets the "state" field.
+func (juo *JobUpdateOne) SetState(j job.State) *JobUpdateOne {
+	juo.mutation.SetState(j)
+	return juo
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (juo *JobUpdateOne) SetNillableState(j *job.State) *JobUpdateOne {
+	if j != nil {
+		juo.SetState(*j)
+	}
+	return juo
+}
+
+// SetAttempts sets the "attempts" field.
+func (juo *JobUpdateOne) SetAttempts(i int) *JobUpdateOne {
+	juo.mutation.ResetAttempts()
+	juo.mutation.SetAttempts(i)
+	return juo
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (juo *JobUpdateOne) SetNillableAttempts(i *int) *JobUpdateOne {
+	if i != nil {
+		juo.SetAttempts(*i)
+	}
+	return juo
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (juo *JobUpdateOne) AddAttempts(i int) *JobUpdateOne {
+	juo.mutation.AddAttempts(i)
+	return juo
+}
+
+// SetLastError sets the "last_error" field.
+func (juo *JobUpdateOne) SetLastError(s string) *JobUpdateOne {
+	juo.mutation.SetLastError(s)
+	return juo
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (juo *JobUpdateOne) SetNillableLastError(s *string) *JobUpdateOne {
+	if s != nil {
+		juo.SetLastError(*s)
+	}
+	return juo
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (juo *JobUpdateOne) ClearLastError() *JobUpdateOne {
+	juo.mutation.ClearLastError()
+	return juo
+}
+
+// SetShortSummary sets the "short_summary" field.
+func (juo *JobUpdateOne) SetShortSummary(s string) *JobUpdateOne {
+	juo.mutation.SetShortSummary(s)
+	return juo
+}
+
+// SetNillableShortSummary sets the "short_summary" field if the given value is not nil.
+func (juo *JobUpdateOne) SetNillableShortSummary(s *string) *JobUpdateOne {
+	if s != nil {
+		juo.SetShortSummary(*s)
+	}
+	return juo
+}
+
+// ClearShortSummary clears the value of the "short_summary" field.
+func (juo *JobUpdateOne) ClearShortSummary() *JobUpdateOne {
+	juo.mutation.ClearShortSummary()
+	return juo
+}
+
+// SetFullSummary sets the "full_summary" field.
+func (juo *JobUpdateOne) SetFullSummary(s string) *JobUpdateOne {
+	juo.mutation.SetFullSummary(s)
+	return juo
+}
+
+// SetNillableFullSummary sets the "full_summary" field if the given value is not nil.
+func (juo *JobUpdateOne) SetNillableFullSummary(s *string) *JobUpdateOne {
+	if s != nil {
+		juo.SetFullSummary(*s)
+	}
+	return juo
+}
+
+// ClearFullSummary clears the value of the "full_summary" field.
+func (juo *JobUpdateOne) ClearFullSummary() *JobUpdateOne {
+	juo.mutation.ClearFullSummary()
+	return juo
+}
+
+// SetEmbedding sets the "embedding" field.
+func (juo *JobUpdateOne) SetEmbedding(pg pgvector.Vector) *JobUpdateOne {
+	juo.mutation.SetEmbedding(pg)
+	return juo
+}
+
+// SetNillableEmbedding sets the "embedding" field if the given value is not nil.
+func (juo *JobUpdateOne) SetNillableEmbedding(pg *pgvector.Vector) *JobUpdateOne {
+	if pg != nil {
+		juo.SetEmbedding(*pg)
+	}
+	return juo
+}
+
+// ClearEmbedding clears the value of the "embedding" field.
+func (juo *JobUpdateOne) ClearEmbedding() *JobUpdateOne {
+	juo.mutation.ClearEmbedding()
+	return juo
+}
+
+// SetRunAfter sets the "run_after" field.
+func (juo *JobUpdateOne) SetRunAfter(t time.Time) *JobUpdateOne {
+	juo.mutation.SetRunAfter(t)
+	return juo
+}
+
+// SetNillableRunAfter sets the "run_after" field if the given value is not nil.
+func (juo *JobUpdateOne) SetNillableRunAfter(t *time.Time) *JobUpdateOne {
+	if t != nil {
+		juo.SetRunAfter(*t)
+	}
+	return juo
+}
+
+// SetClaimedAt sets the "claimed_at" field.
+func (juo *JobUpdateOne) SetClaimedAt(t time.Time) *JobUpdateOne {
+	juo.mutation.SetClaimedAt(t)
+	return juo
+}
+
+// SetNillableClaimedAt sets the "claimed_at" field if the given value is not nil.
+func (juo *JobUpdateOne) SetNillableClaimedAt(t *time.Time) *JobUpdateOne {
+	if t != nil {
+		juo.SetClaimedAt(*t)
+	}
+	return juo
+}
+
+// ClearClaimedAt clears the value of the "claimed_at" field.
+func (juo *JobUpdateOne) ClearClaimedAt() *JobUpdateOne {
+	juo.mutation.ClearClaimedAt()
+	return juo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (juo *JobUpdateOne) SetUpdatedAt(t time.Time) *JobUpdateOne {
+	juo.mutation.SetUpdatedAt(t)
+	return juo
+}
+
+// Mutation returns the JobMutation object of the builder.
+func (juo *JobUpdateOne) Mutation() *JobMutation {
+	return juo.mutation
+}
+
+// Where appends a list predicates to the JobUpdate builder.
+func (juo *JobUpdateOne) Where(ps ...predicate.Job) *JobUpdateOne {
+	juo.mutation.Where(ps...)
+	return juo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (juo *JobUpdateOne) Select(field string, fields ...string) *JobUpdateOne {
+	juo.fields = append([]string{field}, fields...)
+	return juo
+}
+
+// Save executes the query and returns the updated Job entity.
+func (juo *JobUpdateOne) Save(ctx context.Context) (*Job, error) {
+	juo.defaults()
+	return withHooks(ctx, juo.sqlSave, juo.mutation, juo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (juo *JobUpdateOne) SaveX(ctx context.Context) *Job {
+	node, err := juo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (juo *JobUpdateOne) Exec(ctx context.Context) error {
+	_, err := juo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (juo *JobUpdateOne) ExecX(ctx context.Context) {
+	if err := juo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (juo *JobUpdateOne) defaults() {
+	if _, ok := juo.mutation.UpdatedAt(); !ok {
+		v := job.UpdateDefaultUpdatedAt()
+		juo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (juo *JobUpdateOne) check() error {
+	if v, ok := juo.mutation.Stage(); ok {
+		if err := job.StageValidator(v); err != nil {
+			return &ValidationError{Name: "stage", err: fmt.Errorf(`ent: validator failed for field "Job.stage": %w`, err)}
+		}
+	}
+	if v, ok := juo.mutation.State(); ok {
+		if err := job.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "Job.state": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (juo *JobUpdateOne) sqlSave(ctx context.Context) (_node *Job, err error) {
+	if err := juo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(job.Table, job.Columns, sqlgraph.NewFieldSpec(job.FieldID, field.TypeString))
+	id, ok := juo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Job.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := juo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, job.FieldID)
+		for _, f := range fields {
+			if !job.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != job.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := juo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := juo.mutation.SourceUID(); ok {
+		_spec.SetField(job.FieldSourceUID, field.TypeString, value)
+	}
+	if value, ok := juo.mutation.SourceType(); ok {
+		_spec.SetField(job.FieldSourceType, field.TypeString, value)
+	}
+	if value, ok := juo.mutation.RawJSON(); ok {
+		_spec.SetField(job.FieldRawJSON, field.TypeString, value)
+	}
+	if value, ok := juo.mutation.Stage(); ok {
+		_spec.SetField(job.FieldStage, field.TypeEnum, value)
+	}
+	if value, ok := juo.mutation.State(); ok {
+		_spec.SetField(job.FieldState, field.TypeEnum, value)
+	}
+	if value, ok := juo.mutation.Attempts(); ok {
+		_spec.SetField(job.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := juo.mutation.AddedAttempts(); ok {
+		_spec.AddField(job.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := juo.mutation.LastError(); ok {
+		_spec.SetField(job.FieldLastError, field.TypeString, value)
+	}
+	if juo.mutation.LastErrorCleared() {
+		_spec.ClearField(job.FieldLastError, field.TypeString)
+	}
+	if value, ok := juo.mutation.ShortSummary(); ok {
+		_spec.SetField(job.FieldShortSummary, field.TypeString, value)
+	}
+	if juo.mutation.ShortSummaryCleared() {
+		_spec.ClearField(job.FieldShortSummary, field.TypeString)
+	}
+	if value, ok := juo.mutation.FullSummary(); ok {
+		_spec.SetField(job.FieldFullSummary, field.TypeString, value)
+	}
+	if juo.mutation.FullSummaryCleared() {
+		_spec.ClearField(job.FieldFullSummary, field.TypeString)
+	}
+	if value, ok := juo.mutation.Embedding(); ok {
+		_spec.SetField(job.FieldEmbedding, field.TypeOther, value)
+	}
+	if juo.mutation.EmbeddingCleared() {
+		_spec.ClearField(job.FieldEmbedding, field.TypeOther)
+	}
+	if value, ok := juo.mutation.RunAfter(); ok {
+		_spec.SetField(job.FieldRunAfter, field.TypeTime, value)
+	}
+	if value, ok := juo.mutation.ClaimedAt(); ok {
+		_spec.SetField(job.FieldClaimedAt, field.TypeTime, value)
+	}
+	if juo.mutation.ClaimedAtCleared() {
+		_spec.ClearField(job.FieldClaimedAt, field.TypeTime)
+	}
+	if value, ok := juo.mutation.UpdatedAt(); ok {
+		_spec.SetField(job.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &Job{config: juo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, juo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{job.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	juo.mutation.done = true
+	return _node, nil
+}
@@ -11,7 +11,7 @@ var (
 	// ActivitiesColumns holds the columns for the "activities" table.
 	ActivitiesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true},
-		{Name: "uid", Type: field.TypeString, Unique: true},
+		{Name: "uid", Type: field.TypeString},
 		{Name: "source_uid", Type: field.TypeString},
 		{Name: "source_type", Type: field.TypeString},
 		{Name: "title", Type: field.TypeString},
@@ -23,12 +23,86 @@ var (
 		{Name: "full_summary", Type: field.TypeString},
 		{Name: "raw_json", Type: field.TypeString},
 		{Name: "embedding", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"postgres": "vector(3072)"}},
+		{Name: "content_hash", Type: field.TypeString, Default: ""},
+		{Name: "revision", Type: field.TypeInt, Default: 1},
+		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "starred", Type: field.TypeBool, Default: false},
+		{Name: "referenced", Type: field.TypeBool, Default: false},
+		{Name: "archived_at", Type: field.TypeTime, Nullable: true},
 	}
 	// ActivitiesTable holds the schema information for the "activities" table.
 	ActivitiesTable = &schema.Table{
 		Name:       "activities",
 		Columns:    ActivitiesColumns,
 		PrimaryKey: []*schema.Column{ActivitiesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "activity_source_uid_uid",
+				Unique:  true,
+				Columns: []*schema.Column{ActivitiesColumns[2], ActivitiesColumns[1]},
+			},
+			{
+				Name:    "activity_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{ActivitiesColumns[8]},
+			},
+			{
+				Name:    "activity_url",
+				Unique:  false,
+				Columns: []*schema.Column{ActivitiesColumns[6]},
+			},
+		},
+	}
+	// DeadLettersColumns holds the columns for the "dead_letters" table.
+	DeadLettersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "source_uid", Type: field.TypeString},
+		{Name: "source_type", Type: field.TypeString},
+		{Name: "raw_json", Type: field.TypeString},
+		{Name: "stage", Type: field.TypeEnum, Enums: []string{"summarize", "embed", "store"}},
+		{Name: "error", Type: field.TypeString},
+		{Name: "attempts", Type: field.TypeInt},
+		{Name: "short_summary", Type: field.TypeString, Nullable: true},
+		{Name: "full_summary", Type: field.TypeString, Nullable: true},
+		{Name: "embedding", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"postgres": "vector(3072)"}},
+		{Name: "failed_at", Type: field.TypeTime},
+	}
+	// DeadLettersTable holds the schema information for the "dead_letters" table.
+	DeadLettersTable = &schema.Table{
+		Name:       "dead_letters",
+		Columns:    DeadLettersColumns,
+		PrimaryKey: []*schema.Column{DeadLettersColumns[0]},
+	}
+	// JobsColumns holds the columns for the "jobs" table.
+	JobsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "source_uid", Type: field.TypeString},
+		{Name: "source_type", Type: field.TypeString},
+		{Name: "raw_json", Type: field.TypeString},
+		{Name: "stage", Type: field.TypeEnum, Enums: []string{"summarize", "embed", "store"}, Default: "summarize"},
+		{Name: "state", Type: field.TypeEnum, Enums: []string{"pending", "running"}, Default: "pending"},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "last_error", Type: field.TypeString, Nullable: true},
+		{Name: "short_summary", Type: field.TypeString, Nullable: true},
+		{Name: "full_summary", Type: field.TypeString, Nullable: true},
+		{Name: "embedding", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"postgres": "vector(3072)"}},
+		{Name: "run_after", Type: field.TypeTime},
+		{Name: "claimed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// JobsTable holds the schema information for the "jobs" table.
+	JobsTable = &schema.Table{
+		Name:       "jobs",
+		Columns:    JobsColumns,
+		PrimaryKey: []*schema.Column{JobsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "job_state_run_after",
+				Unique:  false,
+				Columns: []*schema.Column{JobsColumns[5], JobsColumns[11]},
+			},
+		},
 	}
 	// SourcesColumns holds the columns for the "sources" table.
 	SourcesColumns = []*schema.Column{
@@ -37,6 +111,14 @@ var (
 		{Name: "url", Type: field.TypeString},
 		{Name: "type", Type: field.TypeString},
 		{Name: "raw_json", Type: field.TypeString},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "managed", Type: field.TypeBool, Default: false},
+		{Name: "ingest_token", Type: field.TypeString, Unique: true, Nullable: true},
+		{Name: "last_success_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_error", Type: field.TypeString, Nullable: true},
+		{Name: "last_error_at", Type: field.TypeTime, Nullable: true},
+		{Name: "consecutive_failures", Type: field.TypeInt, Default: 0},
+		{Name: "last_items", Type: field.TypeInt, Default: 0},
 	}
 	// SourcesTable holds the schema information for the "sources" table.
 	SourcesTable = &schema.Table{
@@ -44,10 +126,63 @@ var (
 		Columns:    SourcesColumns,
 		PrimaryKey: []*schema.Column{SourcesColumns[0]},
 	}
+	// SourceRunsColumns holds the columns for the "source_runs" table.
+	SourceRunsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "source_uid", Type: field.TypeString},
+		{Name: "started_at", Type: field.TypeTime},
+		{Name: "finished_at", Type: field.TypeTime},
+		{Name: "items", Type: field.TypeInt, Default: 0},
+		{Name: "error", Type: field.TypeString, Nullable: true},
+	}
+	// SourceRunsTable holds the schema information for the "source_runs" table.
+	SourceRunsTable = &schema.Table{
+		Name:       "source_runs",
+		Columns:    SourceRunsColumns,
+		PrimaryKey: []*schema.Column{SourceRunsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "sourcerun_source_uid_started_at",
+				Unique:  false,
+				Columns: []*schema.Column{SourceRunsColumns[1], SourceRunsColumns[2]},
+			},
+		},
+	}
+	// WebSubscriptionsColumns holds the columns for the "web_subscriptions" table.
+	WebSubscriptionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "hub", Type: field.TypeString},
+		{Name: "topic", Type: field.TypeString},
+		{Name: "secret", Type: field.TypeString},
+		{Name: "mode", Type: field.TypeEnum, Enums: []string{"subscribe", "unsubscribe"}, Default: "subscribe"},
+		{Name: "active", Type: field.TypeBool, Default: false},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "renew_at", Type: field.TypeTime, Nullable: true},
+		{Name: "renewal_claimed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// WebSubscriptionsTable holds the schema information for the "web_subscriptions" table.
+	WebSubscriptionsTable = &schema.Table{
+		Name:       "web_subscriptions",
+		Columns:    WebSubscriptionsColumns,
+		PrimaryKey: []*schema.Column{WebSubscriptionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "websubscription_mode_renew_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebSubscriptionsColumns[4], WebSubscriptionsColumns[7]},
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		ActivitiesTable,
+		DeadLettersTable,
+		JobsTable,
 		SourcesTable,
+		SourceRunsTable,
+		WebSubscriptionsTable,
 	}
 )
 
@@ -12,8 +12,12 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/glanceapp/glance/pkg/storage/postgres/ent/activity"
+	"github.com/glanceapp/glance/pkg/storage/postgres/ent/deadletter"
+	"github.com/glanceapp/glance/pkg/storage/postgres/ent/job"
 	"github.com/glanceapp/glance/pkg/storage/postgres/ent/predicate"
 	"github.com/glanceapp/glance/pkg/storage/postgres/ent/source"
+	"github.com/glanceapp/glance/pkg/storage/postgres/ent/sourcerun"
+	"github.com/glanceapp/glance/pkg/storage/postgres/ent/websubscription"
 	pgvector "github.com/pgvector/pgvector-go"
 )
 
@@ -26,8 +30,12 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeActivity = "Activity"
-	TypeSource   = "Source"
+	TypeActivity        = "Activity"
+	TypeDeadLetter      = "DeadLetter"
+	TypeJob             = "Job"
+	TypeSource          = "Source"
+	TypeSourceRun       = "SourceRun"
+	TypeWebSubscription = "WebSubscription"
 )
 
 // ActivityMutation represents an operation that mutates the Activity nodes in the graph.
@@ -48,6 +56,13 @@ type ActivityMutation struct {
 	full_summary  *string
 	raw_json      *string
 	embedding     *pgvector.Vector
+	content_hash  *string
+	revision      *int
+	addrevision   *int
+	updated_at    *time.Time
+	starred       *bool
+	referenced    *bool
+	archived_at   *time.Time
 	clearedFields map[string]struct{}
 	done          bool
 	oldValue      func(context.Context) (*Activity, error)
@@ -603,6 +618,255 @@ func (m *ActivityMutation) ResetEmbedding() {
 	delete(m.clearedFields, activity.FieldEmbedding)
 }
 
+// SetContentHash sets the "content_hash" field.
+func (m *ActivityMutation) SetContentHash(s string) {
+	m.content_hash = &s
+}
+
+// ContentHash returns the value of the "content_hash" field in the mutation.
+func (m *ActivityMutation) ContentHash() (r string, exists bool) {
+	v := m.content_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContentHash returns the old "content_hash" field's value of the Activity entity.
+// If the Activity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ActivityMutation) OldContentHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContentHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContentHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContentHash: %w", err)
+	}
+	return oldValue.ContentHash, nil
+}
+
+// ResetContentHash resets all changes to the "content_hash" field.
+func (m *ActivityMutation) ResetContentHash() {
+	m.content_hash = nil
+}
+
+// SetRevision sets the "revision" field.
+func (m *ActivityMutation) SetRevision(i int) {
+	m.revision = &i
+	m.addrevision = nil
+}
+
+// Revision returns the value of the "revision" field in the mutation.
+func (m *ActivityMutation) Revision() (r int, exists bool) {
+	v := m.revision
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRevision returns the old "revision" field's value of the Activity entity.
+// If the Activity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ActivityMutation) OldRevision(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRevision is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRevision requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRevision: %w", err)
+	}
+	return oldValue.Revision, nil
+}
+
+// AddRevision adds i to the "revision" field.
+func (m *ActivityMutation) AddRevision(i int) {
+	if m.addrevision != nil {
+		*m.addrevision += i
+	} else {
+		m.addrevision = &i
+	}
+}
+
+// AddedRevision returns the value that was added to the "revision" field in this mutation.
+func (m *ActivityMutation) AddedRevision() (r int, exists bool) {
+	v := m.addrevision
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRevision resets all changes to the "revision" field.
+func (m *ActivityMutation) ResetRevision() {
+	m.revision = nil
+	m.addrevision = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ActivityMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ActivityMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Activity entity.
+// If the Activity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ActivityMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ActivityMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetStarred sets the "starred" field.
+func (m *ActivityMutation) SetStarred(b bool) {
+	m.starred = &b
+}
+
+// Starred returns the value of the "starred" field in the mutation.
+func (m *ActivityMutation) Starred() (r bool, exists bool) {
+	v := m.starred
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStarred returns the old "starred" field's value of the Activity entity.
+// If the Activity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ActivityMutation) OldStarred(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStarred is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStarred requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStarred: %w", err)
+	}
+	return oldValue.Starred, nil
+}
+
+// ResetStarred resets all changes to the "starred" field.
+func (m *ActivityMutation) ResetStarred() {
+	m.starred = nil
+}
+
+// SetReferenced sets the "referenced" field.
+func (m *ActivityMutation) SetReferenced(b bool) {
+	m.referenced = &b
+}
+
+// Referenced returns the value of the "referenced" field in the mutation.
+func (m *ActivityMutation) Referenced() (r bool, exists bool) {
+	v := m.referenced
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReferenced returns the old "referenced" field's value of the Activity entity.
+// If the Activity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ActivityMutation) OldReferenced(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReferenced is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReferenced requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReferenced: %w", err)
+	}
+	return oldValue.Referenced, nil
+}
+
+// ResetReferenced resets all changes to the "referenced" field.
+func (m *ActivityMutation) ResetReferenced() {
+	m.referenced = nil
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (m *ActivityMutation) SetArchivedAt(t time.Time) {
+	m.archived_at = &t
+}
+
+// ArchivedAt returns the value of the "archived_at" field in the mutation.
+func (m *ActivityMutation) ArchivedAt() (r time.Time, exists bool) {
+	v := m.archived_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldArchivedAt returns the old "archived_at" field's value of the Activity entity.
+// If the Activity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ActivityMutation) OldArchivedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldArchivedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldArchivedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldArchivedAt: %w", err)
+	}
+	return oldValue.ArchivedAt, nil
+}
+
+// ClearArchivedAt clears the value of the "archived_at" field.
+func (m *ActivityMutation) ClearArchivedAt() {
+	m.archived_at = nil
+	m.clearedFields[activity.FieldArchivedAt] = struct{}{}
+}
+
+// ArchivedAtCleared returns if the "archived_at" field was cleared in this mutation.
+func (m *ActivityMutation) ArchivedAtCleared() bool {
+	_, ok := m.clearedFields[activity.FieldArchivedAt]
+	return ok
+}
+
+// ResetArchivedAt resets all changes to the "archived_at" field.
+func (m *ActivityMutation) ResetArchivedAt() {
+	m.archived_at = nil
+	delete(m.clearedFields, activity.FieldArchivedAt)
+}
+
 // Where appends a list predicates to the ActivityMutation builder.
 func (m *ActivityMutation) Where(ps ...predicate.Activity) {
 	m.predicates = append(m.predicates, ps...)
@@ -637,7 +901,7 @@ func (m *ActivityMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *ActivityMutation) Fields() []string {
-	fields := make([]string, 0, 12)
+	fields := make([]string, 0, 18)
 	if m.uid != nil {
 		fields = append(fields, activity.FieldUID)
 	}
@@ -674,6 +938,24 @@ func (m *ActivityMutation) Fields() []string {
 	if m.embedding != nil {
 		fields = append(fields, activity.FieldEmbedding)
 	}
+	if m.content_hash != nil {
+		fields = append(fields, activity.FieldContentHash)
+	}
+	if m.revision != nil {
+		fields = append(fields, activity.FieldRevision)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, activity.FieldUpdatedAt)
+	}
+	if m.starred != nil {
+		fields = append(fields, activity.FieldStarred)
+	}
+	if m.referenced != nil {
+		fields = append(fields, activity.FieldReferenced)
+	}
+	if m.archived_at != nil {
+		fields = append(fields, activity.FieldArchivedAt)
+	}
 	return fields
 }
 
@@ -706,6 +988,18 @@ func (m *ActivityMutation) Field(name string) (ent.Value, bool) {
 		return m.RawJSON()
 	case activity.FieldEmbedding:
 		return m.Embedding()
+	case activity.FieldContentHash:
+		return m.ContentHash()
+	case activity.FieldRevision:
+		return m.Revision()
+	case activity.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case activity.FieldStarred:
+		return m.Starred()
+	case activity.FieldReferenced:
+		return m.Referenced()
+	case activity.FieldArchivedAt:
+		return m.ArchivedAt()
 	}
 	return nil, false
 }
@@ -739,6 +1033,18 @@ func (m *ActivityMutation) OldField(ctx context.Context, name string) (ent.Value
 		return m.OldRawJSON(ctx)
 	case activity.FieldEmbedding:
 		return m.OldEmbedding(ctx)
+	case activity.FieldContentHash:
+		return m.OldContentHash(ctx)
+	case activity.FieldRevision:
+		return m.OldRevision(ctx)
+	case activity.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case activity.FieldStarred:
+		return m.OldStarred(ctx)
+	case activity.FieldReferenced:
+		return m.OldReferenced(ctx)
+	case activity.FieldArchivedAt:
+		return m.OldArchivedAt(ctx)
 	}
 	return nil, fmt.Errorf("unknown Activity field %s", name)
 }
@@ -832,6 +1138,48 @@ func (m *ActivityMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetEmbedding(v)
 		return nil
+	case activity.FieldContentHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContentHash(v)
+		return nil
+	case activity.FieldRevision:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevision(v)
+		return nil
+	case activity.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case activity.FieldStarred:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStarred(v)
+		return nil
+	case activity.FieldReferenced:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReferenced(v)
+		return nil
+	case activity.FieldArchivedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetArchivedAt(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Activity field %s", name)
 }
@@ -839,13 +1187,21 @@ func (m *ActivityMutation) SetField(name string, value ent.Value) error {
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
 func (m *ActivityMutation) AddedFields() []string {
-	return nil
+	var fields []string
+	if m.addrevision != nil {
+		fields = append(fields, activity.FieldRevision)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
 func (m *ActivityMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case activity.FieldRevision:
+		return m.AddedRevision()
+	}
 	return nil, false
 }
 
@@ -854,6 +1210,13 @@ func (m *ActivityMutation) AddedField(name string) (ent.Value, bool) {
 // type.
 func (m *ActivityMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case activity.FieldRevision:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRevision(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Activity numeric field %s", name)
 }
@@ -865,6 +1228,9 @@ func (m *ActivityMutation) ClearedFields() []string {
 	if m.FieldCleared(activity.FieldEmbedding) {
 		fields = append(fields, activity.FieldEmbedding)
 	}
+	if m.FieldCleared(activity.FieldArchivedAt) {
+		fields = append(fields, activity.FieldArchivedAt)
+	}
 	return fields
 }
 
@@ -882,6 +1248,9 @@ func (m *ActivityMutation) ClearField(name string) error {
 	case activity.FieldEmbedding:
 		m.ClearEmbedding()
 		return nil
+	case activity.FieldArchivedAt:
+		m.ClearArchivedAt()
+		return nil
 	}
 	return fmt.Errorf("unknown Activity nullable field %s", name)
 }
@@ -926,6 +1295,24 @@ func (m *ActivityMutation) ResetField(name string) error {
 	case activity.FieldEmbedding:
 		m.ResetEmbedding()
 		return nil
+	case activity.FieldContentHash:
+		m.ResetContentHash()
+		return nil
+	case activity.FieldRevision:
+		m.ResetRevision()
+		return nil
+	case activity.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case activity.FieldStarred:
+		m.ResetStarred()
+		return nil
+	case activity.FieldReferenced:
+		m.ResetReferenced()
+		return nil
+	case activity.FieldArchivedAt:
+		m.ResetArchivedAt()
+		return nil
 	}
 	return fmt.Errorf("unknown Activity field %s", name)
 }
@@ -978,33 +1365,40 @@ func (m *ActivityMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown Activity edge %s", name)
 }
 
-// SourceMutation represents an operation that mutates the Source nodes in the graph.
-type SourceMutation struct {
+// DeadLetterMutation represents an operation that mutates the DeadLetter nodes in the graph.
+type DeadLetterMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *string
-	name          *string
-	url           *string
-	_type         *string
+	source_uid    *string
+	source_type   *string
 	raw_json      *string
+	stage         *deadletter.Stage
+	error         *string
+	attempts      *int
+	addattempts   *int
+	short_summary *string
+	full_summary  *string
+	embedding     *pgvector.Vector
+	failed_at     *time.Time
 	clearedFields map[string]struct{}
 	done          bool
-	oldValue      func(context.Context) (*Source, error)
-	predicates    []predicate.Source
+	oldValue      func(context.Context) (*DeadLetter, error)
+	predicates    []predicate.DeadLetter
 }
 
-var _ ent.Mutation = (*SourceMutation)(nil)
+var _ ent.Mutation = (*DeadLetterMutation)(nil)
 
-// sourceOption allows management of the mutation configuration using functional options.
-type sourceOption func(*SourceMutation)
+// deadletterOption allows management of the mutation configuration using functional options.
+type deadletterOption func(*DeadLetterMutation)
 
-// newSourceMutation creates new mutation for the Source entity.
-func newSourceMutation(c config, op Op, opts ...sourceOption) *SourceMutation {
-	m := &SourceMutation{
+// newDeadLetterMutation creates new mutation for the DeadLetter entity.
+func newDeadLetterMutation(c config, op Op, opts ...deadletterOption) *DeadLetterMutation {
+	m := &DeadLetterMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeSource,
+		typ:           TypeDeadLetter,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1013,20 +1407,20 @@ func newSourceMutation(c config, op Op, opts ...sourceOption) *SourceMutation {
 	return m
 }
 
-// withSourceID sets the ID field of the mutation.
-func withSourceID(id string) sourceOption {
-	return func(m *SourceMutation) {
+// withDeadLetterID sets the ID field of the mutation.
+func withDeadLetterID(id string) deadletterOption {
+	return func(m *DeadLetterMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Source
+			value *DeadLetter
 		)
-		m.oldValue = func(ctx context.Context) (*Source, error) {
+		m.oldValue = func(ctx context.Context) (*DeadLetter, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Source.Get(ctx, id)
+					value, err = m.Client().DeadLetter.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1035,10 +1429,10 @@ func withSourceID(id string) sourceOption {
 	}
 }
 
-// withSource sets the old Source of the mutation.
-func withSource(node *Source) sourceOption {
-	return func(m *SourceMutation) {
-		m.oldValue = func(context.Context) (*Source, error) {
+// withDeadLetter sets the old DeadLetter of the mutation.
+func withDeadLetter(node *DeadLetter) deadletterOption {
+	return func(m *DeadLetterMutation) {
+		m.oldValue = func(context.Context) (*DeadLetter, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1047,7 +1441,7 @@ func withSource(node *Source) sourceOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m SourceMutation) Client() *Client {
+func (m DeadLetterMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1055,7 +1449,7 @@ func (m SourceMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m SourceMutation) Tx() (*Tx, error) {
+func (m DeadLetterMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1065,14 +1459,14 @@ func (m SourceMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Source entities.
-func (m *SourceMutation) SetID(id string) {
+// operation is only accepted on creation of DeadLetter entities.
+func (m *DeadLetterMutation) SetID(id string) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *SourceMutation) ID() (id string, exists bool) {
+func (m *DeadLetterMutation) ID() (id string, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1083,7 +1477,7 @@ func (m *SourceMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *SourceMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *DeadLetterMutation) IDs(ctx context.Context) ([]string, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1092,54 +1486,2139 @@ func (m *SourceMutation) IDs(ctx context.Context) ([]string, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Source.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().DeadLetter.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *SourceMutation) SetName(s string) {
-	m.name = &s
+// SetSourceUID sets the "source_uid" field.
+func (m *DeadLetterMutation) SetSourceUID(s string) {
+	m.source_uid = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *SourceMutation) Name() (r string, exists bool) {
-	v := m.name
+// SourceUID returns the value of the "source_uid" field in the mutation.
+func (m *DeadLetterMutation) SourceUID() (r string, exists bool) {
+	v := m.source_uid
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Source entity.
-// If the Source object wasn't provided to the builder, the object is fetched from the database.
+// OldSourceUID returns the old "source_uid" field's value of the DeadLetter entity.
+// If the DeadLetter object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SourceMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *DeadLetterMutation) OldSourceUID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldSourceUID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldSourceUID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldSourceUID: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.SourceUID, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *SourceMutation) ResetName() {
-	m.name = nil
+// ResetSourceUID resets all changes to the "source_uid" field.
+func (m *DeadLetterMutation) ResetSourceUID() {
+	m.source_uid = nil
 }
 
-// SetURL sets the "url" field.
-func (m *SourceMutation) SetURL(s string) {
-	m.url = &s
+// SetSourceType sets the "source_type" field.
+func (m *DeadLetterMutation) SetSourceType(s string) {
+	m.source_type = &s
 }
 
-// URL returns the value of the "url" field in the mutation.
+// SourceType returns the value of the "source_type" field in the mutation.
+func (m *DeadLetterMutation) SourceType() (r string, exists bool) {
+	v := m.source_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSourceType returns the old "source_type" field's value of the DeadLetter entity.
+// If the DeadLetter object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeadLetterMutation) OldSourceType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSourceType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSourceType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSourceType: %w", err)
+	}
+	return oldValue.SourceType, nil
+}
+
+// ResetSourceType resets all changes to the "source_type" field.
+func (m *DeadLetterMutation) ResetSourceType() {
+	m.source_type = nil
+}
+
+// SetRawJSON sets the "raw_json" field.
+func (m *DeadLetterMutation) SetRawJSON(s string) {
+	m.raw_json = &s
+}
+
+// RawJSON returns the value of the "raw_json" field in the mutation.
+func (m *DeadLetterMutation) RawJSON() (r string, exists bool) {
+	v := m.raw_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRawJSON returns the old "raw_json" field's value of the DeadLetter entity.
+// If the DeadLetter object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeadLetterMutation) OldRawJSON(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRawJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRawJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRawJSON: %w", err)
+	}
+	return oldValue.RawJSON, nil
+}
+
+// ResetRawJSON resets all changes to the "raw_json" field.
+func (m *DeadLetterMutation) ResetRawJSON() {
+	m.raw_json = nil
+}
+
+// SetStage sets the "stage" field.
+func (m *DeadLetterMutation) SetStage(d deadletter.Stage) {
+	m.stage = &d
+}
+
+// Stage returns the value of the "stage" field in the mutation.
+func (m *DeadLetterMutation) Stage() (r deadletter.Stage, exists bool) {
+	v := m.stage
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStage returns the old "stage" field's value of the DeadLetter entity.
+// If the DeadLetter object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeadLetterMutation) OldStage(ctx context.Context) (v deadletter.Stage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStage: %w", err)
+	}
+	return oldValue.Stage, nil
+}
+
+// ResetStage resets all changes to the "stage" field.
+func (m *DeadLetterMutation) ResetStage() {
+	m.stage = nil
+}
+
+// SetError sets the "error" field.
+func (m *DeadLetterMutation) SetError(s string) {
+	m.error = &s
+}
+
+// Error returns the value of the "error" field in the mutation.
+func (m *DeadLetterMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldError returns the old "error" field's value of the DeadLetter entity.
+// If the DeadLetter object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeadLetterMutation) OldError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
+	}
+	return oldValue.Error, nil
+}
+
+// ResetError resets all changes to the "error" field.
+func (m *DeadLetterMutation) ResetError() {
+	m.error = nil
+}
+
+// SetAttempts sets the "attempts" field.
+func (m *DeadLetterMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
+}
+
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *DeadLetterMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttempts returns the old "attempts" field's value of the DeadLetter entity.
+// If the DeadLetter object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeadLetterMutation) OldAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
+	}
+	return oldValue.Attempts, nil
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (m *DeadLetterMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
+}
+
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *DeadLetterMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *DeadLetterMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
+}
+
+// SetShortSummary sets the "short_summary" field.
+func (m *DeadLetterMutation) SetShortSummary(s string) {
+	m.short_summary = &s
+}
+
+// ShortSummary returns the value of the "short_summary" field in the mutation.
+func (m *DeadLetterMutation) ShortSummary() (r string, exists bool) {
+	v := m.short_summary
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldShortSummary returns the old "short_summary" field's value of the DeadLetter entity.
+// If the DeadLetter object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeadLetterMutation) OldShortSummary(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldShortSummary is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldShortSummary requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldShortSummary: %w", err)
+	}
+	return oldValue.ShortSummary, nil
+}
+
+// ClearShortSummary clears the value of the "short_summary" field.
+func (m *DeadLetterMutation) ClearShortSummary() {
+	m.short_summary = nil
+	m.clearedFields[deadletter.FieldShortSummary] = struct{}{}
+}
+
+// ShortSummaryCleared returns if the "short_summary" field was cleared in this mutation.
+func (m *DeadLetterMutation) ShortSummaryCleared() bool {
+	_, ok := m.clearedFields[deadletter.FieldShortSummary]
+	return ok
+}
+
+// ResetShortSummary resets all changes to the "short_summary" field.
+func (m *DeadLetterMutation) ResetShortSummary() {
+	m.short_summary = nil
+	delete(m.clearedFields, deadletter.FieldShortSummary)
+}
+
+// SetFullSummary sets the "full_summary" field.
+func (m *DeadLetterMutation) SetFullSummary(s string) {
+	m.full_summary = &s
+}
+
+// FullSummary returns the value of the "full_summary" field in the mutation.
+func (m *DeadLetterMutation) FullSummary() (r string, exists bool) {
+	v := m.full_summary
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFullSummary returns the old "full_summary" field's value of the DeadLetter entity.
+// If the DeadLetter object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeadLetterMutation) OldFullSummary(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFullSummary is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFullSummary requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFullSummary: %w", err)
+	}
+	return oldValue.FullSummary, nil
+}
+
+// ClearFullSummary clears the value of the "full_summary" field.
+func (m *DeadLetterMutation) ClearFullSummary() {
+	m.full_summary = nil
+	m.clearedFields[deadletter.FieldFullSummary] = struct{}{}
+}
+
+// FullSummaryCleared returns if the "full_summary" field was cleared in this mutation.
+func (m *DeadLetterMutation) FullSummaryCleared() bool {
+	_, ok := m.clearedFields[deadletter.FieldFullSummary]
+	return ok
+}
+
+// ResetFullSummary resets all changes to the "full_summary" field.
+func (m *DeadLetterMutation) ResetFullSummary() {
+	m.full_summary = nil
+	delete(m.clearedFields, deadletter.FieldFullSummary)
+}
+
+// SetEmbedding sets the "embedding" field.
+func (m *DeadLetterMutation) SetEmbedding(pg pgvector.Vector) {
+	m.embedding = &pg
+}
+
+// Embedding returns the value of the "embedding" field in the mutation.
+func (m *DeadLetterMutation) Embedding() (r pgvector.Vector, exists bool) {
+	v := m.embedding
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmbedding returns the old "embedding" field's value of the DeadLetter entity.
+// If the DeadLetter object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeadLetterMutation) OldEmbedding(ctx context.Context) (v *pgvector.Vector, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmbedding is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmbedding requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmbedding: %w", err)
+	}
+	return oldValue.Embedding, nil
+}
+
+// ClearEmbedding clears the value of the "embedding" field.
+func (m *DeadLetterMutation) ClearEmbedding() {
+	m.embedding = nil
+	m.clearedFields[deadletter.FieldEmbedding] = struct{}{}
+}
+
+// EmbeddingCleared returns if the "embedding" field was cleared in this mutation.
+func (m *DeadLetterMutation) EmbeddingCleared() bool {
+	_, ok := m.clearedFields[deadletter.FieldEmbedding]
+	return ok
+}
+
+// ResetEmbedding resets all changes to the "embedding" field.
+func (m *DeadLetterMutation) ResetEmbedding() {
+	m.embedding = nil
+	delete(m.clearedFields, deadletter.FieldEmbedding)
+}
+
+// SetFailedAt sets the "failed_at" field.
+func (m *DeadLetterMutation) SetFailedAt(t time.Time) {
+	m.failed_at = &t
+}
+
+// FailedAt returns the value of the "failed_at" field in the mutation.
+func (m *DeadLetterMutation) FailedAt() (r time.Time, exists bool) {
+	v := m.failed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFailedAt returns the old "failed_at" field's value of the DeadLetter entity.
+// If the DeadLetter object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeadLetterMutation) OldFailedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFailedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFailedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFailedAt: %w", err)
+	}
+	return oldValue.FailedAt, nil
+}
+
+// ResetFailedAt resets all changes to the "failed_at" field.
+func (m *DeadLetterMutation) ResetFailedAt() {
+	m.failed_at = nil
+}
+
+// Where appends a list predicates to the DeadLetterMutation builder.
+func (m *DeadLetterMutation) Where(ps ...predicate.DeadLetter) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DeadLetterMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DeadLetterMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DeadLetter, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DeadLetterMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DeadLetterMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (DeadLetter).
+func (m *DeadLetterMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DeadLetterMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.source_uid != nil {
+		fields = append(fields, deadletter.FieldSourceUID)
+	}
+	if m.source_type != nil {
+		fields = append(fields, deadletter.FieldSourceType)
+	}
+	if m.raw_json != nil {
+		fields = append(fields, deadletter.FieldRawJSON)
+	}
+	if m.stage != nil {
+		fields = append(fields, deadletter.FieldStage)
+	}
+	if m.error != nil {
+		fields = append(fields, deadletter.FieldError)
+	}
+	if m.attempts != nil {
+		fields = append(fields, deadletter.FieldAttempts)
+	}
+	if m.short_summary != nil {
+		fields = append(fields, deadletter.FieldShortSummary)
+	}
+	if m.full_summary != nil {
+		fields = append(fields, deadletter.FieldFullSummary)
+	}
+	if m.embedding != nil {
+		fields = append(fields, deadletter.FieldEmbedding)
+	}
+	if m.failed_at != nil {
+		fields = append(fields, deadletter.FieldFailedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DeadLetterMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case deadletter.FieldSourceUID:
+		return m.SourceUID()
+	case deadletter.FieldSourceType:
+		return m.SourceType()
+	case deadletter.FieldRawJSON:
+		return m.RawJSON()
+	case deadletter.FieldStage:
+		return m.Stage()
+	case deadletter.FieldError:
+		return m.Error()
+	case deadletter.FieldAttempts:
+		return m.Attempts()
+	case deadletter.FieldShortSummary:
+		return m.ShortSummary()
+	case deadletter.FieldFullSummary:
+		return m.FullSummary()
+	case deadletter.FieldEmbedding:
+		return m.Embedding()
+	case deadletter.FieldFailedAt:
+		return m.FailedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DeadLetterMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case deadletter.FieldSourceUID:
+		return m.OldSourceUID(ctx)
+	case deadletter.FieldSourceType:
+		return m.OldSourceType(ctx)
+	case deadletter.FieldRawJSON:
+		return m.OldRawJSON(ctx)
+	case deadletter.FieldStage:
+		return m.OldStage(ctx)
+	case deadletter.FieldError:
+		return m.OldError(ctx)
+	case deadletter.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case deadletter.FieldShortSummary:
+		return m.OldShortSummary(ctx)
+	case deadletter.FieldFullSummary:
+		return m.OldFullSummary(ctx)
+	case deadletter.FieldEmbedding:
+		return m.OldEmbedding(ctx)
+	case deadletter.FieldFailedAt:
+		return m.OldFailedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown DeadLetter field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DeadLetterMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case deadletter.FieldSourceUID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSourceUID(v)
+		return nil
+	case deadletter.FieldSourceType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSourceType(v)
+		return nil
+	case deadletter.FieldRawJSON:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRawJSON(v)
+		return nil
+	case deadletter.FieldStage:
+		v, ok := value.(deadletter.Stage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStage(v)
+		return nil
+	case deadletter.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
+	case deadletter.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case deadletter.FieldShortSummary:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetShortSummary(v)
+		return nil
+	case deadletter.FieldFullSummary:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFullSummary(v)
+		return nil
+	case deadletter.FieldEmbedding:
+		v, ok := value.(pgvector.Vector)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmbedding(v)
+		return nil
+	case deadletter.FieldFailedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFailedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DeadLetter field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DeadLetterMutation) AddedFields() []string {
+	var fields []string
+	if m.addattempts != nil {
+		fields = append(fields, deadletter.FieldAttempts)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DeadLetterMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case deadletter.FieldAttempts:
+		return m.AddedAttempts()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DeadLetterMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case deadletter.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DeadLetter numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DeadLetterMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(deadletter.FieldShortSummary) {
+		fields = append(fields, deadletter.FieldShortSummary)
+	}
+	if m.FieldCleared(deadletter.FieldFullSummary) {
+		fields = append(fields, deadletter.FieldFullSummary)
+	}
+	if m.FieldCleared(deadletter.FieldEmbedding) {
+		fields = append(fields, deadletter.FieldEmbedding)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DeadLetterMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DeadLetterMutation) ClearField(name string) error {
+	switch name {
+	case deadletter.FieldShortSummary:
+		m.ClearShortSummary()
+		return nil
+	case deadletter.FieldFullSummary:
+		m.ClearFullSummary()
+		return nil
+	case deadletter.FieldEmbedding:
+		m.ClearEmbedding()
+		return nil
+	}
+	return fmt.Errorf("unknown DeadLetter nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DeadLetterMutation) ResetField(name string) error {
+	switch name {
+	case deadletter.FieldSourceUID:
+		m.ResetSourceUID()
+		return nil
+	case deadletter.FieldSourceType:
+		m.ResetSourceType()
+		return nil
+	case deadletter.FieldRawJSON:
+		m.ResetRawJSON()
+		return nil
+	case deadletter.FieldStage:
+		m.ResetStage()
+		return nil
+	case deadletter.FieldError:
+		m.ResetError()
+		return nil
+	case deadletter.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case deadletter.FieldShortSummary:
+		m.ResetShortSummary()
+		return nil
+	case deadletter.FieldFullSummary:
+		m.ResetFullSummary()
+		return nil
+	case deadletter.FieldEmbedding:
+		m.ResetEmbedding()
+		return nil
+	case deadletter.FieldFailedAt:
+		m.ResetFailedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown DeadLetter field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DeadLetterMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DeadLetterMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DeadLetterMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DeadLetterMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DeadLetterMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DeadLetterMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DeadLetterMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown DeadLetter unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DeadLetterMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown DeadLetter edge %s", name)
+}
+
+// JobMutation represents an operation that mutates the Job nodes in the graph.
+type JobMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	source_uid    *string
+	source_type   *string
+	raw_json      *string
+	stage         *job.Stage
+	state         *job.State
+	attempts      *int
+	addattempts   *int
+	last_error    *string
+	short_summary *string
+	full_summary  *string
+	embedding     *pgvector.Vector
+	run_after     *time.Time
+	claimed_at    *time.Time
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Job, error)
+	predicates    []predicate.Job
+}
+
+var _ ent.Mutation = (*JobMutation)(nil)
+
+// jobOption allows management of the mutation configuration using functional options.
+type jobOption func(*JobMutation)
+
+// newJobMutation creates new mutation for the Job entity.
+func newJobMutation(c config, op Op, opts ...jobOption) *JobMutation {
+	m := &JobMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeJob,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withJobID sets the ID field of the mutation.
+func withJobID(id string) jobOption {
+	return func(m *JobMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Job
+		)
+		m.oldValue = func(ctx context.Context) (*Job, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Job.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withJob sets the old Job of the mutation.
+func withJob(node *Job) jobOption {
+	return func(m *JobMutation) {
+		m.oldValue = func(context.Context) (*Job, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m JobMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m JobMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Job entities.
+func (m *JobMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *JobMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *JobMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Job.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetSourceUID sets the "source_uid" field.
+func (m *JobMutation) SetSourceUID(s string) {
+	m.source_uid = &s
+}
+
+// SourceUID returns the value of the "source_uid" field in the mutation.
+func (m *JobMutation) SourceUID() (r string, exists bool) {
+	v := m.source_uid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSourceUID returns the old "source_uid" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldSourceUID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSourceUID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSourceUID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSourceUID: %w", err)
+	}
+	return oldValue.SourceUID, nil
+}
+
+// ResetSourceUID resets all changes to the "source_uid" field.
+func (m *JobMutation) ResetSourceUID() {
+	m.source_uid = nil
+}
+
+// SetSourceType sets the "source_type" field.
+func (m *JobMutation) SetSourceType(s string) {
+	m.source_type = &s
+}
+
+// SourceType returns the value of the "source_type" field in the mutation.
+func (m *JobMutation) SourceType() (r string, exists bool) {
+	v := m.source_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSourceType returns the old "source_type" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldSourceType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSourceType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSourceType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSourceType: %w", err)
+	}
+	return oldValue.SourceType, nil
+}
+
+// ResetSourceType resets all changes to the "source_type" field.
+func (m *JobMutation) ResetSourceType() {
+	m.source_type = nil
+}
+
+// SetRawJSON sets the "raw_json" field.
+func (m *JobMutation) SetRawJSON(s string) {
+	m.raw_json = &s
+}
+
+// RawJSON returns the value of the "raw_json" field in the mutation.
+func (m *JobMutation) RawJSON() (r string, exists bool) {
+	v := m.raw_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRawJSON returns the old "raw_json" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldRawJSON(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRawJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRawJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRawJSON: %w", err)
+	}
+	return oldValue.RawJSON, nil
+}
+
+// ResetRawJSON resets all changes to the "raw_json" field.
+func (m *JobMutation) ResetRawJSON() {
+	m.raw_json = nil
+}
+
+// SetStage sets the "stage" field.
+func (m *JobMutation) SetStage(j job.Stage) {
+	m.stage = &j
+}
+
+// Stage returns the value of the "stage" field in the mutation.
+func (m *JobMutation) Stage() (r job.Stage, exists bool) {
+	v := m.stage
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStage returns the old "stage" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldStage(ctx context.Context) (v job.Stage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStage: %w", err)
+	}
+	return oldValue.Stage, nil
+}
+
+// ResetStage resets all changes to the "stage" field.
+func (m *JobMutation) ResetStage() {
+	m.stage = nil
+}
+
+// SetState sets the "state" field.
+func (m *JobMutation) SetState(j job.State) {
+	m.state = &j
+}
+
+// State returns the value of the "state" field in the mutation.
+func (m *JobMutation) State() (r job.State, exists bool) {
+	v := m.state
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldState returns the old "state" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldState(ctx context.Context) (v job.State, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldState is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldState requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldState: %w", err)
+	}
+	return oldValue.State, nil
+}
+
+// ResetState resets all changes to the "state" field.
+func (m *JobMutation) ResetState() {
+	m.state = nil
+}
+
+// SetAttempts sets the "attempts" field.
+func (m *JobMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
+}
+
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *JobMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttempts returns the old "attempts" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
+	}
+	return oldValue.Attempts, nil
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (m *JobMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
+}
+
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *JobMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *JobMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
+}
+
+// SetLastError sets the "last_error" field.
+func (m *JobMutation) SetLastError(s string) {
+	m.last_error = &s
+}
+
+// LastError returns the value of the "last_error" field in the mutation.
+func (m *JobMutation) LastError() (r string, exists bool) {
+	v := m.last_error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastError returns the old "last_error" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldLastError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastError: %w", err)
+	}
+	return oldValue.LastError, nil
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (m *JobMutation) ClearLastError() {
+	m.last_error = nil
+	m.clearedFields[job.FieldLastError] = struct{}{}
+}
+
+// LastErrorCleared returns if the "last_error" field was cleared in this mutation.
+func (m *JobMutation) LastErrorCleared() bool {
+	_, ok := m.clearedFields[job.FieldLastError]
+	return ok
+}
+
+// ResetLastError resets all changes to the "last_error" field.
+func (m *JobMutation) ResetLastError() {
+	m.last_error = nil
+	delete(m.clearedFields, job.FieldLastError)
+}
+
+// SetShortSummary sets the "short_summary" field.
+func (m *JobMutation) SetShortSummary(s string) {
+	m.short_summary = &s
+}
+
+// ShortSummary returns the value of the "short_summary" field in the mutation.
+func (m *JobMutation) ShortSummary() (r string, exists bool) {
+	v := m.short_summary
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldShortSummary returns the old "short_summary" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldShortSummary(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldShortSummary is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldShortSummary requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldShortSummary: %w", err)
+	}
+	return oldValue.ShortSummary, nil
+}
+
+// ClearShortSummary clears the value of the "short_summary" field.
+func (m *JobMutation) ClearShortSummary() {
+	m.short_summary = nil
+	m.clearedFields[job.FieldShortSummary] = struct{}{}
+}
+
+// ShortSummaryCleared returns if the "short_summary" field was cleared in this mutation.
+func (m *JobMutation) ShortSummaryCleared() bool {
+	_, ok := m.clearedFields[job.FieldShortSummary]
+	return ok
+}
+
+// ResetShortSummary resets all changes to the "short_summary" field.
+func (m *JobMutation) ResetShortSummary() {
+	m.short_summary = nil
+	delete(m.clearedFields, job.FieldShortSummary)
+}
+
+// SetFullSummary sets the "full_summary" field.
+func (m *JobMutation) SetFullSummary(s string) {
+	m.full_summary = &s
+}
+
+// FullSummary returns the value of the "full_summary" field in the mutation.
+func (m *JobMutation) FullSummary() (r string, exists bool) {
+	v := m.full_summary
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFullSummary returns the old "full_summary" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldFullSummary(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFullSummary is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFullSummary requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFullSummary: %w", err)
+	}
+	return oldValue.FullSummary, nil
+}
+
+// ClearFullSummary clears the value of the "full_summary" field.
+func (m *JobMutation) ClearFullSummary() {
+	m.full_summary = nil
+	m.clearedFields[job.FieldFullSummary] = struct{}{}
+}
+
+// FullSummaryCleared returns if the "full_summary" field was cleared in this mutation.
+func (m *JobMutation) FullSummaryCleared() bool {
+	_, ok := m.clearedFields[job.FieldFullSummary]
+	return ok
+}
+
+// ResetFullSummary resets all changes to the "full_summary" field.
+func (m *JobMutation) ResetFullSummary() {
+	m.full_summary = nil
+	delete(m.clearedFields, job.FieldFullSummary)
+}
+
+// SetEmbedding sets the "embedding" field.
+func (m *JobMutation) SetEmbedding(pg pgvector.Vector) {
+	m.embedding = &pg
+}
+
+// Embedding returns the value of the "embedding" field in the mutation.
+func (m *JobMutation) Embedding() (r pgvector.Vector, exists bool) {
+	v := m.embedding
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmbedding returns the old "embedding" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldEmbedding(ctx context.Context) (v *pgvector.Vector, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmbedding is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmbedding requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmbedding: %w", err)
+	}
+	return oldValue.Embedding, nil
+}
+
+// ClearEmbedding clears the value of the "embedding" field.
+func (m *JobMutation) ClearEmbedding() {
+	m.embedding = nil
+	m.clearedFields[job.FieldEmbedding] = struct{}{}
+}
+
+// EmbeddingCleared returns if the "embedding" field was cleared in this mutation.
+func (m *JobMutation) EmbeddingCleared() bool {
+	_, ok := m.clearedFields[job.FieldEmbedding]
+	return ok
+}
+
+// ResetEmbedding resets all changes to the "embedding" field.
+func (m *JobMutation) ResetEmbedding() {
+	m.embedding = nil
+	delete(m.clearedFields, job.FieldEmbedding)
+}
+
+// SetRunAfter sets the "run_after" field.
+func (m *JobMutation) SetRunAfter(t time.Time) {
+	m.run_after = &t
+}
+
+// RunAfter returns the value of the "run_after" field in the mutation.
+func (m *JobMutation) RunAfter() (r time.Time, exists bool) {
+	v := m.run_after
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRunAfter returns the old "run_after" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldRunAfter(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRunAfter is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRunAfter requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRunAfter: %w", err)
+	}
+	return oldValue.RunAfter, nil
+}
+
+// ResetRunAfter resets all changes to the "run_after" field.
+func (m *JobMutation) ResetRunAfter() {
+	m.run_after = nil
+}
+
+// SetClaimedAt sets the "claimed_at" field.
+func (m *JobMutation) SetClaimedAt(t time.Time) {
+	m.claimed_at = &t
+}
+
+// ClaimedAt returns the value of the "claimed_at" field in the mutation.
+func (m *JobMutation) ClaimedAt() (r time.Time, exists bool) {
+	v := m.claimed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClaimedAt returns the old "claimed_at" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldClaimedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClaimedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClaimedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClaimedAt: %w", err)
+	}
+	return oldValue.ClaimedAt, nil
+}
+
+// ClearClaimedAt clears the value of the "claimed_at" field.
+func (m *JobMutation) ClearClaimedAt() {
+	m.claimed_at = nil
+	m.clearedFields[job.FieldClaimedAt] = struct{}{}
+}
+
+// ClaimedAtCleared returns if the "claimed_at" field was cleared in this mutation.
+func (m *JobMutation) ClaimedAtCleared() bool {
+	_, ok := m.clearedFields[job.FieldClaimedAt]
+	return ok
+}
+
+// ResetClaimedAt resets all changes to the "claimed_at" field.
+func (m *JobMutation) ResetClaimedAt() {
+	m.claimed_at = nil
+	delete(m.clearedFields, job.FieldClaimedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *JobMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *JobMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *JobMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *JobMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *JobMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *JobMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the JobMutation builder.
+func (m *JobMutation) Where(ps ...predicate.Job) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the JobMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *JobMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Job, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *JobMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *JobMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Job).
+func (m *JobMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *JobMutation) Fields() []string {
+	fields := make([]string, 0, 14)
+	if m.source_uid != nil {
+		fields = append(fields, job.FieldSourceUID)
+	}
+	if m.source_type != nil {
+		fields = append(fields, job.FieldSourceType)
+	}
+	if m.raw_json != nil {
+		fields = append(fields, job.FieldRawJSON)
+	}
+	if m.stage != nil {
+		fields = append(fields, job.FieldStage)
+	}
+	if m.state != nil {
+		fields = append(fields, job.FieldState)
+	}
+	if m.attempts != nil {
+		fields = append(fields, job.FieldAttempts)
+	}
+	if m.last_error != nil {
+		fields = append(fields, job.FieldLastError)
+	}
+	if m.short_summary != nil {
+		fields = append(fields, job.FieldShortSummary)
+	}
+	if m.full_summary != nil {
+		fields = append(fields, job.FieldFullSummary)
+	}
+	if m.embedding != nil {
+		fields = append(fields, job.FieldEmbedding)
+	}
+	if m.run_after != nil {
+		fields = append(fields, job.FieldRunAfter)
+	}
+	if m.claimed_at != nil {
+		fields = append(fields, job.FieldClaimedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, job.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, job.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *JobMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case job.FieldSourceUID:
+		return m.SourceUID()
+	case job.FieldSourceType:
+		return m.SourceType()
+	case job.FieldRawJSON:
+		return m.RawJSON()
+	case job.FieldStage:
+		return m.Stage()
+	case job.FieldState:
+		return m.State()
+	case job.FieldAttempts:
+		return m.Attempts()
+	case job.FieldLastError:
+		return m.LastError()
+	case job.FieldShortSummary:
+		return m.ShortSummary()
+	case job.FieldFullSummary:
+		return m.FullSummary()
+	case job.FieldEmbedding:
+		return m.Embedding()
+	case job.FieldRunAfter:
+		return m.RunAfter()
+	case job.FieldClaimedAt:
+		return m.ClaimedAt()
+	case job.FieldCreatedAt:
+		return m.CreatedAt()
+	case job.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *JobMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case job.FieldSourceUID:
+		return m.OldSourceUID(ctx)
+	case job.FieldSourceType:
+		return m.OldSourceType(ctx)
+	case job.FieldRawJSON:
+		return m.OldRawJSON(ctx)
+	case job.FieldStage:
+		return m.OldStage(ctx)
+	case job.FieldState:
+		return m.OldState(ctx)
+	case job.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case job.FieldLastError:
+		return m.OldLastError(ctx)
+	case job.FieldShortSummary:
+		return m.OldShortSummary(ctx)
+	case job.FieldFullSummary:
+		return m.OldFullSummary(ctx)
+	case job.FieldEmbedding:
+		return m.OldEmbedding(ctx)
+	case job.FieldRunAfter:
+		return m.OldRunAfter(ctx)
+	case job.FieldClaimedAt:
+		return m.OldClaimedAt(ctx)
+	case job.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case job.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Job field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *JobMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case job.FieldSourceUID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSourceUID(v)
+		return nil
+	case job.FieldSourceType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSourceType(v)
+		return nil
+	case job.FieldRawJSON:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRawJSON(v)
+		return nil
+	case job.FieldStage:
+		v, ok := value.(job.Stage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStage(v)
+		return nil
+	case job.FieldState:
+		v, ok := value.(job.State)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetState(v)
+		return nil
+	case job.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case job.FieldLastError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastError(v)
+		return nil
+	case job.FieldShortSummary:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetShortSummary(v)
+		return nil
+	case job.FieldFullSummary:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFullSummary(v)
+		return nil
+	case job.FieldEmbedding:
+		v, ok := value.(pgvector.Vector)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmbedding(v)
+		return nil
+	case job.FieldRunAfter:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRunAfter(v)
+		return nil
+	case job.FieldClaimedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClaimedAt(v)
+		return nil
+	case job.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case job.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Job field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *JobMutation) AddedFields() []string {
+	var fields []string
+	if m.addattempts != nil {
+		fields = append(fields, job.FieldAttempts)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *JobMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case job.FieldAttempts:
+		return m.AddedAttempts()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *JobMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case job.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Job numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *JobMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(job.FieldLastError) {
+		fields = append(fields, job.FieldLastError)
+	}
+	if m.FieldCleared(job.FieldShortSummary) {
+		fields = append(fields, job.FieldShortSummary)
+	}
+	if m.FieldCleared(job.FieldFullSummary) {
+		fields = append(fields, job.FieldFullSummary)
+	}
+	if m.FieldCleared(job.FieldEmbedding) {
+		fields = append(fields, job.FieldEmbedding)
+	}
+	if m.FieldCleared(job.FieldClaimedAt) {
+		fields = append(fields, job.FieldClaimedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *JobMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *JobMutation) ClearField(name string) error {
+	switch name {
+	case job.FieldLastError:
+		m.ClearLastError()
+		return nil
+	case job.FieldShortSummary:
+		m.ClearShortSummary()
+		return nil
+	case job.FieldFullSummary:
+		m.ClearFullSummary()
+		return nil
+	case job.FieldEmbedding:
+		m.ClearEmbedding()
+		return nil
+	case job.FieldClaimedAt:
+		m.ClearClaimedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Job nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *JobMutation) ResetField(name string) error {
+	switch name {
+	case job.FieldSourceUID:
+		m.ResetSourceUID()
+		return nil
+	case job.FieldSourceType:
+		m.ResetSourceType()
+		return nil
+	case job.FieldRawJSON:
+		m.ResetRawJSON()
+		return nil
+	case job.FieldStage:
+		m.ResetStage()
+		return nil
+	case job.FieldState:
+		m.ResetState()
+		return nil
+	case job.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case job.FieldLastError:
+		m.ResetLastError()
+		return nil
+	case job.FieldShortSummary:
+		m.ResetShortSummary()
+		return nil
+	case job.FieldFullSummary:
+		m.ResetFullSummary()
+		return nil
+	case job.FieldEmbedding:
+		m.ResetEmbedding()
+		return nil
+	case job.FieldRunAfter:
+		m.ResetRunAfter()
+		return nil
+	case job.FieldClaimedAt:
+		m.ResetClaimedAt()
+		return nil
+	case job.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case job.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Job field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *JobMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *JobMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *JobMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *JobMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *JobMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *JobMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *JobMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Job unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *JobMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Job edge %s", name)
+}
+
+// SourceMutation represents an operation that mutates the Source nodes in the graph.
+type SourceMutation struct {
+	config
+	op                      Op
+	typ                     string
+	id                      *string
+	name                    *string
+	url                     *string
+	_type                   *string
+	raw_json                *string
+	enabled                 *bool
+	managed                 *bool
+	ingest_token            *string
+	last_success_at         *time.Time
+	last_error              *string
+	last_error_at           *time.Time
+	consecutive_failures    *int
+	addconsecutive_failures *int
+	last_items              *int
+	addlast_items           *int
+	clearedFields           map[string]struct{}
+	done                    bool
+	oldValue                func(context.Context) (*Source, error)
+	predicates              []predicate.Source
+}
+
+var _ ent.Mutation = (*SourceMutation)(nil)
+
+// sourceOption allows management of the mutation configuration using functional options.
+type sourceOption func(*SourceMutation)
+
+// newSourceMutation creates new mutation for the Source entity.
+func newSourceMutation(c config, op Op, opts ...sourceOption) *SourceMutation {
+	m := &SourceMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSource,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSourceID sets the ID field of the mutation.
+func withSourceID(id string) sourceOption {
+	return func(m *SourceMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Source
+		)
+		m.oldValue = func(ctx context.Context) (*Source, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Source.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSource sets the old Source of the mutation.
+func withSource(node *Source) sourceOption {
+	return func(m *SourceMutation) {
+		m.oldValue = func(context.Context) (*Source, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SourceMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SourceMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Source entities.
+func (m *SourceMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SourceMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SourceMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Source.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *SourceMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *SourceMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Source entity.
+// If the Source object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SourceMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *SourceMutation) ResetName() {
+	m.name = nil
+}
+
+// SetURL sets the "url" field.
+func (m *SourceMutation) SetURL(s string) {
+	m.url = &s
+}
+
+// URL returns the value of the "url" field in the mutation.
 func (m *SourceMutation) URL() (r string, exists bool) {
 	v := m.url
 	if v == nil {
@@ -1148,109 +3627,2034 @@ func (m *SourceMutation) URL() (r string, exists bool) {
 	return *v, true
 }
 
-// OldURL returns the old "url" field's value of the Source entity.
-// If the Source object wasn't provided to the builder, the object is fetched from the database.
+// OldURL returns the old "url" field's value of the Source entity.
+// If the Source object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SourceMutation) OldURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
+	}
+	return oldValue.URL, nil
+}
+
+// ResetURL resets all changes to the "url" field.
+func (m *SourceMutation) ResetURL() {
+	m.url = nil
+}
+
+// SetType sets the "type" field.
+func (m *SourceMutation) SetType(s string) {
+	m._type = &s
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *SourceMutation) GetType() (r string, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the Source entity.
+// If the Source object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SourceMutation) OldType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *SourceMutation) ResetType() {
+	m._type = nil
+}
+
+// SetRawJSON sets the "raw_json" field.
+func (m *SourceMutation) SetRawJSON(s string) {
+	m.raw_json = &s
+}
+
+// RawJSON returns the value of the "raw_json" field in the mutation.
+func (m *SourceMutation) RawJSON() (r string, exists bool) {
+	v := m.raw_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRawJSON returns the old "raw_json" field's value of the Source entity.
+// If the Source object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SourceMutation) OldRawJSON(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRawJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRawJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRawJSON: %w", err)
+	}
+	return oldValue.RawJSON, nil
+}
+
+// ResetRawJSON resets all changes to the "raw_json" field.
+func (m *SourceMutation) ResetRawJSON() {
+	m.raw_json = nil
+}
+
+// SetEnabled sets the "enabled" field.
+func (m *SourceMutation) SetEnabled(b bool) {
+	m.enabled = &b
+}
+
+// Enabled returns the value of the "enabled" field in the mutation.
+func (m *SourceMutation) Enabled() (r bool, exists bool) {
+	v := m.enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnabled returns the old "enabled" field's value of the Source entity.
+// If the Source object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SourceMutation) OldEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
+	}
+	return oldValue.Enabled, nil
+}
+
+// ResetEnabled resets all changes to the "enabled" field.
+func (m *SourceMutation) ResetEnabled() {
+	m.enabled = nil
+}
+
+// SetManaged sets the "managed" field.
+func (m *SourceMutation) SetManaged(b bool) {
+	m.managed = &b
+}
+
+// Managed returns the value of the "managed" field in the mutation.
+func (m *SourceMutation) Managed() (r bool, exists bool) {
+	v := m.managed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldManaged returns the old "managed" field's value of the Source entity.
+// If the Source object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SourceMutation) OldManaged(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldManaged is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldManaged requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldManaged: %w", err)
+	}
+	return oldValue.Managed, nil
+}
+
+// ResetManaged resets all changes to the "managed" field.
+func (m *SourceMutation) ResetManaged() {
+	m.managed = nil
+}
+
+// SetIngestToken sets the "ingest_token" field.
+func (m *SourceMutation) SetIngestToken(s string) {
+	m.ingest_token = &s
+}
+
+// IngestToken returns the value of the "ingest_token" field in the mutation.
+func (m *SourceMutation) IngestToken() (r string, exists bool) {
+	v := m.ingest_token
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIngestToken returns the old "ingest_token" field's value of the Source entity.
+// If the Source object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SourceMutation) OldIngestToken(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIngestToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIngestToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIngestToken: %w", err)
+	}
+	return oldValue.IngestToken, nil
+}
+
+// ClearIngestToken clears the value of the "ingest_token" field.
+func (m *SourceMutation) ClearIngestToken() {
+	m.ingest_token = nil
+	m.clearedFields[source.FieldIngestToken] = struct{}{}
+}
+
+// IngestTokenCleared returns if the "ingest_token" field was cleared in this mutation.
+func (m *SourceMutation) IngestTokenCleared() bool {
+	_, ok := m.clearedFields[source.FieldIngestToken]
+	return ok
+}
+
+// ResetIngestToken resets all changes to the "ingest_token" field.
+func (m *SourceMutation) ResetIngestToken() {
+	m.ingest_token = nil
+	delete(m.clearedFields, source.FieldIngestToken)
+}
+
+// SetLastSuccessAt sets the "last_success_at" field.
+func (m *SourceMutation) SetLastSuccessAt(t time.Time) {
+	m.last_success_at = &t
+}
+
+// LastSuccessAt returns the value of the "last_success_at" field in the mutation.
+func (m *SourceMutation) LastSuccessAt() (r time.Time, exists bool) {
+	v := m.last_success_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastSuccessAt returns the old "last_success_at" field's value of the Source entity.
+// If the Source object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SourceMutation) OldLastSuccessAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastSuccessAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastSuccessAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastSuccessAt: %w", err)
+	}
+	return oldValue.LastSuccessAt, nil
+}
+
+// ClearLastSuccessAt clears the value of the "last_success_at" field.
+func (m *SourceMutation) ClearLastSuccessAt() {
+	m.last_success_at = nil
+	m.clearedFields[source.FieldLastSuccessAt] = struct{}{}
+}
+
+// LastSuccessAtCleared returns if the "last_success_at" field was cleared in this mutation.
+func (m *SourceMutation) LastSuccessAtCleared() bool {
+	_, ok := m.clearedFields[source.FieldLastSuccessAt]
+	return ok
+}
+
+// ResetLastSuccessAt resets all changes to the "last_success_at" field.
+func (m *SourceMutation) ResetLastSuccessAt() {
+	m.last_success_at = nil
+	delete(m.clearedFields, source.FieldLastSuccessAt)
+}
+
+// SetLastError sets the "last_error" field.
+func (m *SourceMutation) SetLastError(s string) {
+	m.last_error = &s
+}
+
+// LastError returns the value of the "last_error" field in the mutation.
+func (m *SourceMutation) LastError() (r string, exists bool) {
+	v := m.last_error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastError returns the old "last_error" field's value of the Source entity.
+// If the Source object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SourceMutation) OldLastError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastError: %w", err)
+	}
+	return oldValue.LastError, nil
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (m *SourceMutation) ClearLastError() {
+	m.last_error = nil
+	m.clearedFields[source.FieldLastError] = struct{}{}
+}
+
+// LastErrorCleared returns if the "last_error" field was cleared in this mutation.
+func (m *SourceMutation) LastErrorCleared() bool {
+	_, ok := m.clearedFields[source.FieldLastError]
+	return ok
+}
+
+// ResetLastError resets all changes to the "last_error" field.
+func (m *SourceMutation) ResetLastError() {
+	m.last_error = nil
+	delete(m.clearedFields, source.FieldLastError)
+}
+
+// SetLastErrorAt sets the "last_error_at" field.
+func (m *SourceMutation) SetLastErrorAt(t time.Time) {
+	m.last_error_at = &t
+}
+
+// LastErrorAt returns the value of the "last_error_at" field in the mutation.
+func (m *SourceMutation) LastErrorAt() (r time.Time, exists bool) {
+	v := m.last_error_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastErrorAt returns the old "last_error_at" field's value of the Source entity.
+// If the Source object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SourceMutation) OldLastErrorAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastErrorAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastErrorAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastErrorAt: %w", err)
+	}
+	return oldValue.LastErrorAt, nil
+}
+
+// ClearLastErrorAt clears the value of the "last_error_at" field.
+func (m *SourceMutation) ClearLastErrorAt() {
+	m.last_error_at = nil
+	m.clearedFields[source.FieldLastErrorAt] = struct{}{}
+}
+
+// LastErrorAtCleared returns if the "last_error_at" field was cleared in this mutation.
+func (m *SourceMutation) LastErrorAtCleared() bool {
+	_, ok := m.clearedFields[source.FieldLastErrorAt]
+	return ok
+}
+
+// ResetLastErrorAt resets all changes to the "last_error_at" field.
+func (m *SourceMutation) ResetLastErrorAt() {
+	m.last_error_at = nil
+	delete(m.clearedFields, source.FieldLastErrorAt)
+}
+
+// SetConsecutiveFailures sets the "consecutive_failures" field.
+func (m *SourceMutation) SetConsecutiveFailures(i int) {
+	m.consecutive_failures = &i
+	m.addconsecutive_failures = nil
+}
+
+// ConsecutiveFailures returns the value of the "consecutive_failures" field in the mutation.
+func (m *SourceMutation) ConsecutiveFailures() (r int, exists bool) {
+	v := m.consecutive_failures
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldConsecutiveFailures returns the old "consecutive_failures" field's value of the Source entity.
+// If the Source object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SourceMutation) OldConsecutiveFailures(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConsecutiveFailures is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConsecutiveFailures requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConsecutiveFailures: %w", err)
+	}
+	return oldValue.ConsecutiveFailures, nil
+}
+
+// AddConsecutiveFailures adds i to the "consecutive_failures" field.
+func (m *SourceMutation) AddConsecutiveFailures(i int) {
+	if m.addconsecutive_failures != nil {
+		*m.addconsecutive_failures += i
+	} else {
+		m.addconsecutive_failures = &i
+	}
+}
+
+// AddedConsecutiveFailures returns the value that was added to the "consecutive_failures" field in this mutation.
+func (m *SourceMutation) AddedConsecutiveFailures() (r int, exists bool) {
+	v := m.addconsecutive_failures
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetConsecutiveFailures resets all changes to the "consecutive_failures" field.
+func (m *SourceMutation) ResetConsecutiveFailures() {
+	m.consecutive_failures = nil
+	m.addconsecutive_failures = nil
+}
+
+// SetLastItems sets the "last_items" field.
+func (m *SourceMutation) SetLastItems(i int) {
+	m.last_items = &i
+	m.addlast_items = nil
+}
+
+// LastItems returns the value of the "last_items" field in the mutation.
+func (m *SourceMutation) LastItems() (r int, exists bool) {
+	v := m.last_items
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastItems returns the old "last_items" field's value of the Source entity.
+// If the Source object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SourceMutation) OldLastItems(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastItems is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastItems requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastItems: %w", err)
+	}
+	return oldValue.LastItems, nil
+}
+
+// AddLastItems adds i to the "last_items" field.
+func (m *SourceMutation) AddLastItems(i int) {
+	if m.addlast_items != nil {
+		*m.addlast_items += i
+	} else {
+		m.addlast_items = &i
+	}
+}
+
+// AddedLastItems returns the value that was added to the "last_items" field in this mutation.
+func (m *SourceMutation) AddedLastItems() (r int, exists bool) {
+	v := m.addlast_items
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLastItems resets all changes to the "last_items" field.
+func (m *SourceMutation) ResetLastItems() {
+	m.last_items = nil
+	m.addlast_items = nil
+}
+
+// Where appends a list predicates to the SourceMutation builder.
+func (m *SourceMutation) Where(ps ...predicate.Source) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SourceMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SourceMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Source, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SourceMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SourceMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Source).
+func (m *SourceMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SourceMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.name != nil {
+		fields = append(fields, source.FieldName)
+	}
+	if m.url != nil {
+		fields = append(fields, source.FieldURL)
+	}
+	if m._type != nil {
+		fields = append(fields, source.FieldType)
+	}
+	if m.raw_json != nil {
+		fields = append(fields, source.FieldRawJSON)
+	}
+	if m.enabled != nil {
+		fields = append(fields, source.FieldEnabled)
+	}
+	if m.managed != nil {
+		fields = append(fields, source.FieldManaged)
+	}
+	if m.ingest_token != nil {
+		fields = append(fields, source.FieldIngestToken)
+	}
+	if m.last_success_at != nil {
+		fields = append(fields, source.FieldLastSuccessAt)
+	}
+	if m.last_error != nil {
+		fields = append(fields, source.FieldLastError)
+	}
+	if m.last_error_at != nil {
+		fields = append(fields, source.FieldLastErrorAt)
+	}
+	if m.consecutive_failures != nil {
+		fields = append(fields, source.FieldConsecutiveFailures)
+	}
+	if m.last_items != nil {
+		fields = append(fields, source.FieldLastItems)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SourceMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case source.FieldName:
+		return m.Name()
+	case source.FieldURL:
+		return m.URL()
+	case source.FieldType:
+		return m.GetType()
+	case source.FieldRawJSON:
+		return m.RawJSON()
+	case source.FieldEnabled:
+		return m.Enabled()
+	case source.FieldManaged:
+		return m.Managed()
+	case source.FieldIngestToken:
+		return m.IngestToken()
+	case source.FieldLastSuccessAt:
+		return m.LastSuccessAt()
+	case source.FieldLastError:
+		return m.LastError()
+	case source.FieldLastErrorAt:
+		return m.LastErrorAt()
+	case source.FieldConsecutiveFailures:
+		return m.ConsecutiveFailures()
+	case source.FieldLastItems:
+		return m.LastItems()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SourceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case source.FieldName:
+		return m.OldName(ctx)
+	case source.FieldURL:
+		return m.OldURL(ctx)
+	case source.FieldType:
+		return m.OldType(ctx)
+	case source.FieldRawJSON:
+		return m.OldRawJSON(ctx)
+	case source.FieldEnabled:
+		return m.OldEnabled(ctx)
+	case source.FieldManaged:
+		return m.OldManaged(ctx)
+	case source.FieldIngestToken:
+		return m.OldIngestToken(ctx)
+	case source.FieldLastSuccessAt:
+		return m.OldLastSuccessAt(ctx)
+	case source.FieldLastError:
+		return m.OldLastError(ctx)
+	case source.FieldLastErrorAt:
+		return m.OldLastErrorAt(ctx)
+	case source.FieldConsecutiveFailures:
+		return m.OldConsecutiveFailures(ctx)
+	case source.FieldLastItems:
+		return m.OldLastItems(ctx)
+	}
+	return nil, fmt.Errorf("unknown Source field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SourceMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case source.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case source.FieldURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetURL(v)
+		return nil
+	case source.FieldType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case source.FieldRawJSON:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRawJSON(v)
+		return nil
+	case source.FieldEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnabled(v)
+		return nil
+	case source.FieldManaged:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetManaged(v)
+		return nil
+	case source.FieldIngestToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIngestToken(v)
+		return nil
+	case source.FieldLastSuccessAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastSuccessAt(v)
+		return nil
+	case source.FieldLastError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastError(v)
+		return nil
+	case source.FieldLastErrorAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastErrorAt(v)
+		return nil
+	case source.FieldConsecutiveFailures:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConsecutiveFailures(v)
+		return nil
+	case source.FieldLastItems:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastItems(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Source field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SourceMutation) AddedFields() []string {
+	var fields []string
+	if m.addconsecutive_failures != nil {
+		fields = append(fields, source.FieldConsecutiveFailures)
+	}
+	if m.addlast_items != nil {
+		fields = append(fields, source.FieldLastItems)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SourceMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case source.FieldConsecutiveFailures:
+		return m.AddedConsecutiveFailures()
+	case source.FieldLastItems:
+		return m.AddedLastItems()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SourceMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case source.FieldConsecutiveFailures:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddConsecutiveFailures(v)
+		return nil
+	case source.FieldLastItems:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLastItems(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Source numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SourceMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(source.FieldIngestToken) {
+		fields = append(fields, source.FieldIngestToken)
+	}
+	if m.FieldCleared(source.FieldLastSuccessAt) {
+		fields = append(fields, source.FieldLastSuccessAt)
+	}
+	if m.FieldCleared(source.FieldLastError) {
+		fields = append(fields, source.FieldLastError)
+	}
+	if m.FieldCleared(source.FieldLastErrorAt) {
+		fields = append(fields, source.FieldLastErrorAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SourceMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SourceMutation) ClearField(name string) error {
+	switch name {
+	case source.FieldIngestToken:
+		m.ClearIngestToken()
+		return nil
+	case source.FieldLastSuccessAt:
+		m.ClearLastSuccessAt()
+		return nil
+	case source.FieldLastError:
+		m.ClearLastError()
+		return nil
+	case source.FieldLastErrorAt:
+		m.ClearLastErrorAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Source nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SourceMutation) ResetField(name string) error {
+	switch name {
+	case source.FieldName:
+		m.ResetName()
+		return nil
+	case source.FieldURL:
+		m.ResetURL()
+		return nil
+	case source.FieldType:
+		m.ResetType()
+		return nil
+	case source.FieldRawJSON:
+		m.ResetRawJSON()
+		return nil
+	case source.FieldEnabled:
+		m.ResetEnabled()
+		return nil
+	case source.FieldManaged:
+		m.ResetManaged()
+		return nil
+	case source.FieldIngestToken:
+		m.ResetIngestToken()
+		return nil
+	case source.FieldLastSuccessAt:
+		m.ResetLastSuccessAt()
+		return nil
+	case source.FieldLastError:
+		m.ResetLastError()
+		return nil
+	case source.FieldLastErrorAt:
+		m.ResetLastErrorAt()
+		return nil
+	case source.FieldConsecutiveFailures:
+		m.ResetConsecutiveFailures()
+		return nil
+	case source.FieldLastItems:
+		m.ResetLastItems()
+		return nil
+	}
+	return fmt.Errorf("unknown Source field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SourceMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SourceMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SourceMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SourceMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SourceMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SourceMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SourceMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Source unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SourceMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Source edge %s", name)
+}
+
+// SourceRunMutation represents an operation that mutates the SourceRun nodes in the graph.
+type SourceRunMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	source_uid    *string
+	started_at    *time.Time
+	finished_at   *time.Time
+	items         *int
+	additems      *int
+	error         *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*SourceRun, error)
+	predicates    []predicate.SourceRun
+}
+
+var _ ent.Mutation = (*SourceRunMutation)(nil)
+
+// sourcerunOption allows management of the mutation configuration using functional options.
+type sourcerunOption func(*SourceRunMutation)
+
+// newSourceRunMutation creates new mutation for the SourceRun entity.
+func newSourceRunMutation(c config, op Op, opts ...sourcerunOption) *SourceRunMutation {
+	m := &SourceRunMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSourceRun,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSourceRunID sets the ID field of the mutation.
+func withSourceRunID(id int) sourcerunOption {
+	return func(m *SourceRunMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SourceRun
+		)
+		m.oldValue = func(ctx context.Context) (*SourceRun, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SourceRun.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSourceRun sets the old SourceRun of the mutation.
+func withSourceRun(node *SourceRun) sourcerunOption {
+	return func(m *SourceRunMutation) {
+		m.oldValue = func(context.Context) (*SourceRun, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SourceRunMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SourceRunMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SourceRunMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SourceRunMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SourceRun.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetSourceUID sets the "source_uid" field.
+func (m *SourceRunMutation) SetSourceUID(s string) {
+	m.source_uid = &s
+}
+
+// SourceUID returns the value of the "source_uid" field in the mutation.
+func (m *SourceRunMutation) SourceUID() (r string, exists bool) {
+	v := m.source_uid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSourceUID returns the old "source_uid" field's value of the SourceRun entity.
+// If the SourceRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SourceRunMutation) OldSourceUID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSourceUID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSourceUID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSourceUID: %w", err)
+	}
+	return oldValue.SourceUID, nil
+}
+
+// ResetSourceUID resets all changes to the "source_uid" field.
+func (m *SourceRunMutation) ResetSourceUID() {
+	m.source_uid = nil
+}
+
+// SetStartedAt sets the "started_at" field.
+func (m *SourceRunMutation) SetStartedAt(t time.Time) {
+	m.started_at = &t
+}
+
+// StartedAt returns the value of the "started_at" field in the mutation.
+func (m *SourceRunMutation) StartedAt() (r time.Time, exists bool) {
+	v := m.started_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartedAt returns the old "started_at" field's value of the SourceRun entity.
+// If the SourceRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SourceRunMutation) OldStartedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
+	}
+	return oldValue.StartedAt, nil
+}
+
+// ResetStartedAt resets all changes to the "started_at" field.
+func (m *SourceRunMutation) ResetStartedAt() {
+	m.started_at = nil
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (m *SourceRunMutation) SetFinishedAt(t time.Time) {
+	m.finished_at = &t
+}
+
+// FinishedAt returns the value of the "finished_at" field in the mutation.
+func (m *SourceRunMutation) FinishedAt() (r time.Time, exists bool) {
+	v := m.finished_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFinishedAt returns the old "finished_at" field's value of the SourceRun entity.
+// If the SourceRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SourceRunMutation) OldFinishedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFinishedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFinishedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFinishedAt: %w", err)
+	}
+	return oldValue.FinishedAt, nil
+}
+
+// ResetFinishedAt resets all changes to the "finished_at" field.
+func (m *SourceRunMutation) ResetFinishedAt() {
+	m.finished_at = nil
+}
+
+// SetItems sets the "items" field.
+func (m *SourceRunMutation) SetItems(i int) {
+	m.items = &i
+	m.additems = nil
+}
+
+// Items returns the value of the "items" field in the mutation.
+func (m *SourceRunMutation) Items() (r int, exists bool) {
+	v := m.items
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldItems returns the old "items" field's value of the SourceRun entity.
+// If the SourceRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SourceRunMutation) OldItems(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldItems is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldItems requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldItems: %w", err)
+	}
+	return oldValue.Items, nil
+}
+
+// AddItems adds i to the "items" field.
+func (m *SourceRunMutation) AddItems(i int) {
+	if m.additems != nil {
+		*m.additems += i
+	} else {
+		m.additems = &i
+	}
+}
+
+// AddedItems returns the value that was added to the "items" field in this mutation.
+func (m *SourceRunMutation) AddedItems() (r int, exists bool) {
+	v := m.additems
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetItems resets all changes to the "items" field.
+func (m *SourceRunMutation) ResetItems() {
+	m.items = nil
+	m.additems = nil
+}
+
+// SetError sets the "error" field.
+func (m *SourceRunMutation) SetError(s string) {
+	m.error = &s
+}
+
+// Error returns the value of the "error" field in the mutation.
+func (m *SourceRunMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldError returns the old "error" field's value of the SourceRun entity.
+// If the SourceRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SourceRunMutation) OldError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
+	}
+	return oldValue.Error, nil
+}
+
+// ClearError clears the value of the "error" field.
+func (m *SourceRunMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[sourcerun.FieldError] = struct{}{}
+}
+
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *SourceRunMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[sourcerun.FieldError]
+	return ok
+}
+
+// ResetError resets all changes to the "error" field.
+func (m *SourceRunMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, sourcerun.FieldError)
+}
+
+// Where appends a list predicates to the SourceRunMutation builder.
+func (m *SourceRunMutation) Where(ps ...predicate.SourceRun) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SourceRunMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SourceRunMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SourceRun, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SourceRunMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SourceRunMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SourceRun).
+func (m *SourceRunMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SourceRunMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.source_uid != nil {
+		fields = append(fields, sourcerun.FieldSourceUID)
+	}
+	if m.started_at != nil {
+		fields = append(fields, sourcerun.FieldStartedAt)
+	}
+	if m.finished_at != nil {
+		fields = append(fields, sourcerun.FieldFinishedAt)
+	}
+	if m.items != nil {
+		fields = append(fields, sourcerun.FieldItems)
+	}
+	if m.error != nil {
+		fields = append(fields, sourcerun.FieldError)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SourceRunMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case sourcerun.FieldSourceUID:
+		return m.SourceUID()
+	case sourcerun.FieldStartedAt:
+		return m.StartedAt()
+	case sourcerun.FieldFinishedAt:
+		return m.FinishedAt()
+	case sourcerun.FieldItems:
+		return m.Items()
+	case sourcerun.FieldError:
+		return m.Error()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SourceRunMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case sourcerun.FieldSourceUID:
+		return m.OldSourceUID(ctx)
+	case sourcerun.FieldStartedAt:
+		return m.OldStartedAt(ctx)
+	case sourcerun.FieldFinishedAt:
+		return m.OldFinishedAt(ctx)
+	case sourcerun.FieldItems:
+		return m.OldItems(ctx)
+	case sourcerun.FieldError:
+		return m.OldError(ctx)
+	}
+	return nil, fmt.Errorf("unknown SourceRun field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SourceRunMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case sourcerun.FieldSourceUID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSourceUID(v)
+		return nil
+	case sourcerun.FieldStartedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartedAt(v)
+		return nil
+	case sourcerun.FieldFinishedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFinishedAt(v)
+		return nil
+	case sourcerun.FieldItems:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetItems(v)
+		return nil
+	case sourcerun.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SourceRun field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SourceRunMutation) AddedFields() []string {
+	var fields []string
+	if m.additems != nil {
+		fields = append(fields, sourcerun.FieldItems)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SourceRunMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case sourcerun.FieldItems:
+		return m.AddedItems()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SourceRunMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case sourcerun.FieldItems:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddItems(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SourceRun numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SourceRunMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(sourcerun.FieldError) {
+		fields = append(fields, sourcerun.FieldError)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SourceRunMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SourceRunMutation) ClearField(name string) error {
+	switch name {
+	case sourcerun.FieldError:
+		m.ClearError()
+		return nil
+	}
+	return fmt.Errorf("unknown SourceRun nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SourceRunMutation) ResetField(name string) error {
+	switch name {
+	case sourcerun.FieldSourceUID:
+		m.ResetSourceUID()
+		return nil
+	case sourcerun.FieldStartedAt:
+		m.ResetStartedAt()
+		return nil
+	case sourcerun.FieldFinishedAt:
+		m.ResetFinishedAt()
+		return nil
+	case sourcerun.FieldItems:
+		m.ResetItems()
+		return nil
+	case sourcerun.FieldError:
+		m.ResetError()
+		return nil
+	}
+	return fmt.Errorf("unknown SourceRun field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SourceRunMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SourceRunMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SourceRunMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SourceRunMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SourceRunMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SourceRunMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SourceRunMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown SourceRun unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SourceRunMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown SourceRun edge %s", name)
+}
+
+// WebSubscriptionMutation represents an operation that mutates the WebSubscription nodes in the graph.
+type WebSubscriptionMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *string
+	hub                *string
+	topic              *string
+	secret             *string
+	mode               *websubscription.Mode
+	active             *bool
+	expires_at         *time.Time
+	renew_at           *time.Time
+	renewal_claimed_at *time.Time
+	created_at         *time.Time
+	updated_at         *time.Time
+	clearedFields      map[string]struct{}
+	done               bool
+	oldValue           func(context.Context) (*WebSubscription, error)
+	predicates         []predicate.WebSubscription
+}
+
+var _ ent.Mutation = (*WebSubscriptionMutation)(nil)
+
+// websubscriptionOption allows management of the mutation configuration using functional options.
+type websubscriptionOption func(*WebSubscriptionMutation)
+
+// newWebSubscriptionMutation creates new mutation for the WebSubscription entity.
+func newWebSubscriptionMutation(c config, op Op, opts ...websubscriptionOption) *WebSubscriptionMutation {
+	m := &WebSubscriptionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeWebSubscription,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withWebSubscriptionID sets the ID field of the mutation.
+func withWebSubscriptionID(id string) websubscriptionOption {
+	return func(m *WebSubscriptionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *WebSubscription
+		)
+		m.oldValue = func(ctx context.Context) (*WebSubscription, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().WebSubscription.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withWebSubscription sets the old WebSubscription of the mutation.
+func withWebSubscription(node *WebSubscription) websubscriptionOption {
+	return func(m *WebSubscriptionMutation) {
+		m.oldValue = func(context.Context) (*WebSubscription, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m WebSubscriptionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m WebSubscriptionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of WebSubscription entities.
+func (m *WebSubscriptionMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *WebSubscriptionMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *WebSubscriptionMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().WebSubscription.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetHub sets the "hub" field.
+func (m *WebSubscriptionMutation) SetHub(s string) {
+	m.hub = &s
+}
+
+// Hub returns the value of the "hub" field in the mutation.
+func (m *WebSubscriptionMutation) Hub() (r string, exists bool) {
+	v := m.hub
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHub returns the old "hub" field's value of the WebSubscription entity.
+// If the WebSubscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebSubscriptionMutation) OldHub(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHub is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHub requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHub: %w", err)
+	}
+	return oldValue.Hub, nil
+}
+
+// ResetHub resets all changes to the "hub" field.
+func (m *WebSubscriptionMutation) ResetHub() {
+	m.hub = nil
+}
+
+// SetTopic sets the "topic" field.
+func (m *WebSubscriptionMutation) SetTopic(s string) {
+	m.topic = &s
+}
+
+// Topic returns the value of the "topic" field in the mutation.
+func (m *WebSubscriptionMutation) Topic() (r string, exists bool) {
+	v := m.topic
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTopic returns the old "topic" field's value of the WebSubscription entity.
+// If the WebSubscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebSubscriptionMutation) OldTopic(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTopic is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTopic requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTopic: %w", err)
+	}
+	return oldValue.Topic, nil
+}
+
+// ResetTopic resets all changes to the "topic" field.
+func (m *WebSubscriptionMutation) ResetTopic() {
+	m.topic = nil
+}
+
+// SetSecret sets the "secret" field.
+func (m *WebSubscriptionMutation) SetSecret(s string) {
+	m.secret = &s
+}
+
+// Secret returns the value of the "secret" field in the mutation.
+func (m *WebSubscriptionMutation) Secret() (r string, exists bool) {
+	v := m.secret
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSecret returns the old "secret" field's value of the WebSubscription entity.
+// If the WebSubscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebSubscriptionMutation) OldSecret(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSecret is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSecret requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSecret: %w", err)
+	}
+	return oldValue.Secret, nil
+}
+
+// ResetSecret resets all changes to the "secret" field.
+func (m *WebSubscriptionMutation) ResetSecret() {
+	m.secret = nil
+}
+
+// SetMode sets the "mode" field.
+func (m *WebSubscriptionMutation) SetMode(w websubscription.Mode) {
+	m.mode = &w
+}
+
+// Mode returns the value of the "mode" field in the mutation.
+func (m *WebSubscriptionMutation) Mode() (r websubscription.Mode, exists bool) {
+	v := m.mode
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMode returns the old "mode" field's value of the WebSubscription entity.
+// If the WebSubscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebSubscriptionMutation) OldMode(ctx context.Context) (v websubscription.Mode, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMode: %w", err)
+	}
+	return oldValue.Mode, nil
+}
+
+// ResetMode resets all changes to the "mode" field.
+func (m *WebSubscriptionMutation) ResetMode() {
+	m.mode = nil
+}
+
+// SetActive sets the "active" field.
+func (m *WebSubscriptionMutation) SetActive(b bool) {
+	m.active = &b
+}
+
+// Active returns the value of the "active" field in the mutation.
+func (m *WebSubscriptionMutation) Active() (r bool, exists bool) {
+	v := m.active
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActive returns the old "active" field's value of the WebSubscription entity.
+// If the WebSubscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebSubscriptionMutation) OldActive(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActive is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActive requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActive: %w", err)
+	}
+	return oldValue.Active, nil
+}
+
+// ResetActive resets all changes to the "active" field.
+func (m *WebSubscriptionMutation) ResetActive() {
+	m.active = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *WebSubscriptionMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *WebSubscriptionMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the WebSubscription entity.
+// If the WebSubscription object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SourceMutation) OldURL(ctx context.Context) (v string, err error) {
+func (m *WebSubscriptionMutation) OldExpiresAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldURL is only allowed on UpdateOne operations")
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldURL requires an ID field in the mutation")
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldURL: %w", err)
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
 	}
-	return oldValue.URL, nil
+	return oldValue.ExpiresAt, nil
 }
 
-// ResetURL resets all changes to the "url" field.
-func (m *SourceMutation) ResetURL() {
-	m.url = nil
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (m *WebSubscriptionMutation) ClearExpiresAt() {
+	m.expires_at = nil
+	m.clearedFields[websubscription.FieldExpiresAt] = struct{}{}
 }
 
-// SetType sets the "type" field.
-func (m *SourceMutation) SetType(s string) {
-	m._type = &s
+// ExpiresAtCleared returns if the "expires_at" field was cleared in this mutation.
+func (m *WebSubscriptionMutation) ExpiresAtCleared() bool {
+	_, ok := m.clearedFields[websubscription.FieldExpiresAt]
+	return ok
 }
 
-// GetType returns the value of the "type" field in the mutation.
-func (m *SourceMutation) GetType() (r string, exists bool) {
-	v := m._type
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *WebSubscriptionMutation) ResetExpiresAt() {
+	m.expires_at = nil
+	delete(m.clearedFields, websubscription.FieldExpiresAt)
+}
+
+// SetRenewAt sets the "renew_at" field.
+func (m *WebSubscriptionMutation) SetRenewAt(t time.Time) {
+	m.renew_at = &t
+}
+
+// RenewAt returns the value of the "renew_at" field in the mutation.
+func (m *WebSubscriptionMutation) RenewAt() (r time.Time, exists bool) {
+	v := m.renew_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldType returns the old "type" field's value of the Source entity.
-// If the Source object wasn't provided to the builder, the object is fetched from the database.
+// OldRenewAt returns the old "renew_at" field's value of the WebSubscription entity.
+// If the WebSubscription object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SourceMutation) OldType(ctx context.Context) (v string, err error) {
+func (m *WebSubscriptionMutation) OldRenewAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldType is only allowed on UpdateOne operations")
+		return v, errors.New("OldRenewAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldType requires an ID field in the mutation")
+		return v, errors.New("OldRenewAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
+		return v, fmt.Errorf("querying old value for OldRenewAt: %w", err)
 	}
-	return oldValue.Type, nil
+	return oldValue.RenewAt, nil
 }
 
-// ResetType resets all changes to the "type" field.
-func (m *SourceMutation) ResetType() {
-	m._type = nil
+// ClearRenewAt clears the value of the "renew_at" field.
+func (m *WebSubscriptionMutation) ClearRenewAt() {
+	m.renew_at = nil
+	m.clearedFields[websubscription.FieldRenewAt] = struct{}{}
 }
 
-// SetRawJSON sets the "raw_json" field.
-func (m *SourceMutation) SetRawJSON(s string) {
-	m.raw_json = &s
+// RenewAtCleared returns if the "renew_at" field was cleared in this mutation.
+func (m *WebSubscriptionMutation) RenewAtCleared() bool {
+	_, ok := m.clearedFields[websubscription.FieldRenewAt]
+	return ok
 }
 
-// RawJSON returns the value of the "raw_json" field in the mutation.
-func (m *SourceMutation) RawJSON() (r string, exists bool) {
-	v := m.raw_json
+// ResetRenewAt resets all changes to the "renew_at" field.
+func (m *WebSubscriptionMutation) ResetRenewAt() {
+	m.renew_at = nil
+	delete(m.clearedFields, websubscription.FieldRenewAt)
+}
+
+// SetRenewalClaimedAt sets the "renewal_claimed_at" field.
+func (m *WebSubscriptionMutation) SetRenewalClaimedAt(t time.Time) {
+	m.renewal_claimed_at = &t
+}
+
+// RenewalClaimedAt returns the value of the "renewal_claimed_at" field in the mutation.
+func (m *WebSubscriptionMutation) RenewalClaimedAt() (r time.Time, exists bool) {
+	v := m.renewal_claimed_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRawJSON returns the old "raw_json" field's value of the Source entity.
-// If the Source object wasn't provided to the builder, the object is fetched from the database.
+// OldRenewalClaimedAt returns the old "renewal_claimed_at" field's value of the WebSubscription entity.
+// If the WebSubscription object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SourceMutation) OldRawJSON(ctx context.Context) (v string, err error) {
+func (m *WebSubscriptionMutation) OldRenewalClaimedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRawJSON is only allowed on UpdateOne operations")
+		return v, errors.New("OldRenewalClaimedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRawJSON requires an ID field in the mutation")
+		return v, errors.New("OldRenewalClaimedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRawJSON: %w", err)
+		return v, fmt.Errorf("querying old value for OldRenewalClaimedAt: %w", err)
 	}
-	return oldValue.RawJSON, nil
+	return oldValue.RenewalClaimedAt, nil
 }
 
-// ResetRawJSON resets all changes to the "raw_json" field.
-func (m *SourceMutation) ResetRawJSON() {
-	m.raw_json = nil
+// ClearRenewalClaimedAt clears the value of the "renewal_claimed_at" field.
+func (m *WebSubscriptionMutation) ClearRenewalClaimedAt() {
+	m.renewal_claimed_at = nil
+	m.clearedFields[websubscription.FieldRenewalClaimedAt] = struct{}{}
 }
 
-// Where appends a list predicates to the SourceMutation builder.
-func (m *SourceMutation) Where(ps ...predicate.Source) {
+// RenewalClaimedAtCleared returns if the "renewal_claimed_at" field was cleared in this mutation.
+func (m *WebSubscriptionMutation) RenewalClaimedAtCleared() bool {
+	_, ok := m.clearedFields[websubscription.FieldRenewalClaimedAt]
+	return ok
+}
+
+// ResetRenewalClaimedAt resets all changes to the "renewal_claimed_at" field.
+func (m *WebSubscriptionMutation) ResetRenewalClaimedAt() {
+	m.renewal_claimed_at = nil
+	delete(m.clearedFields, websubscription.FieldRenewalClaimedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *WebSubscriptionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *WebSubscriptionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the WebSubscription entity.
+// If the WebSubscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebSubscriptionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *WebSubscriptionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *WebSubscriptionMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *WebSubscriptionMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the WebSubscription entity.
+// If the WebSubscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebSubscriptionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *WebSubscriptionMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the WebSubscriptionMutation builder.
+func (m *WebSubscriptionMutation) Where(ps ...predicate.WebSubscription) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the SourceMutation builder. Using this method,
+// WhereP appends storage-level predicates to the WebSubscriptionMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *SourceMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Source, len(ps))
+func (m *WebSubscriptionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.WebSubscription, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1258,36 +5662,54 @@ func (m *SourceMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *SourceMutation) Op() Op {
+func (m *WebSubscriptionMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *SourceMutation) SetOp(op Op) {
+func (m *WebSubscriptionMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Source).
-func (m *SourceMutation) Type() string {
+// Type returns the node type of this mutation (WebSubscription).
+func (m *WebSubscriptionMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *SourceMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m.name != nil {
-		fields = append(fields, source.FieldName)
+func (m *WebSubscriptionMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.hub != nil {
+		fields = append(fields, websubscription.FieldHub)
 	}
-	if m.url != nil {
-		fields = append(fields, source.FieldURL)
+	if m.topic != nil {
+		fields = append(fields, websubscription.FieldTopic)
 	}
-	if m._type != nil {
-		fields = append(fields, source.FieldType)
+	if m.secret != nil {
+		fields = append(fields, websubscription.FieldSecret)
 	}
-	if m.raw_json != nil {
-		fields = append(fields, source.FieldRawJSON)
+	if m.mode != nil {
+		fields = append(fields, websubscription.FieldMode)
+	}
+	if m.active != nil {
+		fields = append(fields, websubscription.FieldActive)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, websubscription.FieldExpiresAt)
+	}
+	if m.renew_at != nil {
+		fields = append(fields, websubscription.FieldRenewAt)
+	}
+	if m.renewal_claimed_at != nil {
+		fields = append(fields, websubscription.FieldRenewalClaimedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, websubscription.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, websubscription.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -1295,16 +5717,28 @@ func (m *SourceMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *SourceMutation) Field(name string) (ent.Value, bool) {
+func (m *WebSubscriptionMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case source.FieldName:
-		return m.Name()
-	case source.FieldURL:
-		return m.URL()
-	case source.FieldType:
-		return m.GetType()
-	case source.FieldRawJSON:
-		return m.RawJSON()
+	case websubscription.FieldHub:
+		return m.Hub()
+	case websubscription.FieldTopic:
+		return m.Topic()
+	case websubscription.FieldSecret:
+		return m.Secret()
+	case websubscription.FieldMode:
+		return m.Mode()
+	case websubscription.FieldActive:
+		return m.Active()
+	case websubscription.FieldExpiresAt:
+		return m.ExpiresAt()
+	case websubscription.FieldRenewAt:
+		return m.RenewAt()
+	case websubscription.FieldRenewalClaimedAt:
+		return m.RenewalClaimedAt()
+	case websubscription.FieldCreatedAt:
+		return m.CreatedAt()
+	case websubscription.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -1312,162 +5746,255 @@ func (m *SourceMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *SourceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *WebSubscriptionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case source.FieldName:
-		return m.OldName(ctx)
-	case source.FieldURL:
-		return m.OldURL(ctx)
-	case source.FieldType:
-		return m.OldType(ctx)
-	case source.FieldRawJSON:
-		return m.OldRawJSON(ctx)
+	case websubscription.FieldHub:
+		return m.OldHub(ctx)
+	case websubscription.FieldTopic:
+		return m.OldTopic(ctx)
+	case websubscription.FieldSecret:
+		return m.OldSecret(ctx)
+	case websubscription.FieldMode:
+		return m.OldMode(ctx)
+	case websubscription.FieldActive:
+		return m.OldActive(ctx)
+	case websubscription.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case websubscription.FieldRenewAt:
+		return m.OldRenewAt(ctx)
+	case websubscription.FieldRenewalClaimedAt:
+		return m.OldRenewalClaimedAt(ctx)
+	case websubscription.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case websubscription.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Source field %s", name)
+	return nil, fmt.Errorf("unknown WebSubscription field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SourceMutation) SetField(name string, value ent.Value) error {
+func (m *WebSubscriptionMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case source.FieldName:
+	case websubscription.FieldHub:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetHub(v)
 		return nil
-	case source.FieldURL:
+	case websubscription.FieldTopic:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetURL(v)
+		m.SetTopic(v)
 		return nil
-	case source.FieldType:
+	case websubscription.FieldSecret:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetType(v)
+		m.SetSecret(v)
 		return nil
-	case source.FieldRawJSON:
-		v, ok := value.(string)
+	case websubscription.FieldMode:
+		v, ok := value.(websubscription.Mode)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRawJSON(v)
+		m.SetMode(v)
+		return nil
+	case websubscription.FieldActive:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActive(v)
+		return nil
+	case websubscription.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case websubscription.FieldRenewAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRenewAt(v)
+		return nil
+	case websubscription.FieldRenewalClaimedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRenewalClaimedAt(v)
+		return nil
+	case websubscription.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case websubscription.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Source field %s", name)
+	return fmt.Errorf("unknown WebSubscription field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *SourceMutation) AddedFields() []string {
+func (m *WebSubscriptionMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *SourceMutation) AddedField(name string) (ent.Value, bool) {
+func (m *WebSubscriptionMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SourceMutation) AddField(name string, value ent.Value) error {
+func (m *WebSubscriptionMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Source numeric field %s", name)
+	return fmt.Errorf("unknown WebSubscription numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *SourceMutation) ClearedFields() []string {
-	return nil
+func (m *WebSubscriptionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(websubscription.FieldExpiresAt) {
+		fields = append(fields, websubscription.FieldExpiresAt)
+	}
+	if m.FieldCleared(websubscription.FieldRenewAt) {
+		fields = append(fields, websubscription.FieldRenewAt)
+	}
+	if m.FieldCleared(websubscription.FieldRenewalClaimedAt) {
+		fields = append(fields, websubscription.FieldRenewalClaimedAt)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *SourceMutation) FieldCleared(name string) bool {
+func (m *WebSubscriptionMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *SourceMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Source nullable field %s", name)
+func (m *WebSubscriptionMutation) ClearField(name string) error {
+	switch name {
+	case websubscription.FieldExpiresAt:
+		m.ClearExpiresAt()
+		return nil
+	case websubscription.FieldRenewAt:
+		m.ClearRenewAt()
+		return nil
+	case websubscription.FieldRenewalClaimedAt:
+		m.ClearRenewalClaimedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown WebSubscription nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *SourceMutation) ResetField(name string) error {
+func (m *WebSubscriptionMutation) ResetField(name string) error {
 	switch name {
-	case source.FieldName:
-		m.ResetName()
+	case websubscription.FieldHub:
+		m.ResetHub()
 		return nil
-	case source.FieldURL:
-		m.ResetURL()
+	case websubscription.FieldTopic:
+		m.ResetTopic()
 		return nil
-	case source.FieldType:
-		m.ResetType()
+	case websubscription.FieldSecret:
+		m.ResetSecret()
 		return nil
-	case source.FieldRawJSON:
-		m.ResetRawJSON()
+	case websubscription.FieldMode:
+		m.ResetMode()
+		return nil
+	case websubscription.FieldActive:
+		m.ResetActive()
+		return nil
+	case websubscription.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case websubscription.FieldRenewAt:
+		m.ResetRenewAt()
+		return nil
+	case websubscription.FieldRenewalClaimedAt:
+		m.ResetRenewalClaimedAt()
+		return nil
+	case websubscription.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case websubscription.FieldUpdatedAt:
+		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Source field %s", name)
+	return fmt.Errorf("unknown WebSubscription field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *SourceMutation) AddedEdges() []string {
+func (m *WebSubscriptionMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *SourceMutation) AddedIDs(name string) []ent.Value {
+func (m *WebSubscriptionMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *SourceMutation) RemovedEdges() []string {
+func (m *WebSubscriptionMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *SourceMutation) RemovedIDs(name string) []ent.Value {
+func (m *WebSubscriptionMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *SourceMutation) ClearedEdges() []string {
+func (m *WebSubscriptionMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *SourceMutation) EdgeCleared(name string) bool {
+func (m *WebSubscriptionMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *SourceMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Source unique edge %s", name)
+func (m *WebSubscriptionMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown WebSubscription unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *SourceMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Source edge %s", name)
+func (m *WebSubscriptionMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown WebSubscription edge %s", name)
 }
@@ -9,5 +9,17 @@ import (
 // Activity is the predicate function for activity builders.
 type Activity func(*sql.Selector)
 
+// DeadLetter is the predicate function for deadletter builders.
+type DeadLetter func(*sql.Selector)
+
+// Job is the predicate function for job builders.
+type Job func(*sql.Selector)
+
 // Source is the predicate function for source builders.
 type Source func(*sql.Selector)
+
+// SourceRun is the predicate function for sourcerun builders.
+type SourceRun func(*sql.Selector)
+
+// WebSubscription is the predicate function for websubscription builders.
+type WebSubscription func(*sql.Selector)
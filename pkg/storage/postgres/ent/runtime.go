@@ -2,8 +2,110 @@
 
 package ent
 
+import (
+	"time"
+
+	"github.com/glanceapp/glance/pkg/storage/postgres/ent/activity"
+	"github.com/glanceapp/glance/pkg/storage/postgres/ent/deadletter"
+	"github.com/glanceapp/glance/pkg/storage/postgres/ent/job"
+	"github.com/glanceapp/glance/pkg/storage/postgres/ent/schema"
+	"github.com/glanceapp/glance/pkg/storage/postgres/ent/source"
+	"github.com/glanceapp/glance/pkg/storage/postgres/ent/sourcerun"
+	"github.com/glanceapp/glance/pkg/storage/postgres/ent/websubscription"
+)
+
 // The init function reads all schema descriptors with runtime code
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	activityFields := schema.Activity{}.Fields()
+	_ = activityFields
+	// activityDescContentHash is the schema descriptor for content_hash field.
+	activityDescContentHash := activityFields[13].Descriptor()
+	// activity.DefaultContentHash holds the default value on creation for the content_hash field.
+	activity.DefaultContentHash = activityDescContentHash.Default.(string)
+	// activityDescRevision is the schema descriptor for revision field.
+	activityDescRevision := activityFields[14].Descriptor()
+	// activity.DefaultRevision holds the default value on creation for the revision field.
+	activity.DefaultRevision = activityDescRevision.Default.(int)
+	// activityDescUpdatedAt is the schema descriptor for updated_at field.
+	activityDescUpdatedAt := activityFields[15].Descriptor()
+	// activity.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	activity.DefaultUpdatedAt = activityDescUpdatedAt.Default.(func() time.Time)
+	// activity.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	activity.UpdateDefaultUpdatedAt = activityDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// activityDescStarred is the schema descriptor for starred field.
+	activityDescStarred := activityFields[16].Descriptor()
+	// activity.DefaultStarred holds the default value on creation for the starred field.
+	activity.DefaultStarred = activityDescStarred.Default.(bool)
+	// activityDescReferenced is the schema descriptor for referenced field.
+	activityDescReferenced := activityFields[17].Descriptor()
+	// activity.DefaultReferenced holds the default value on creation for the referenced field.
+	activity.DefaultReferenced = activityDescReferenced.Default.(bool)
+	deadletterFields := schema.DeadLetter{}.Fields()
+	_ = deadletterFields
+	// deadletterDescFailedAt is the schema descriptor for failed_at field.
+	deadletterDescFailedAt := deadletterFields[10].Descriptor()
+	// deadletter.DefaultFailedAt holds the default value on creation for the failed_at field.
+	deadletter.DefaultFailedAt = deadletterDescFailedAt.Default.(func() time.Time)
+	jobFields := schema.Job{}.Fields()
+	_ = jobFields
+	// jobDescAttempts is the schema descriptor for attempts field.
+	jobDescAttempts := jobFields[6].Descriptor()
+	// job.DefaultAttempts holds the default value on creation for the attempts field.
+	job.DefaultAttempts = jobDescAttempts.Default.(int)
+	// jobDescRunAfter is the schema descriptor for run_after field.
+	jobDescRunAfter := jobFields[11].Descriptor()
+	// job.DefaultRunAfter holds the default value on creation for the run_after field.
+	job.DefaultRunAfter = jobDescRunAfter.Default.(func() time.Time)
+	// jobDescCreatedAt is the schema descriptor for created_at field.
+	jobDescCreatedAt := jobFields[13].Descriptor()
+	// job.DefaultCreatedAt holds the default value on creation for the created_at field.
+	job.DefaultCreatedAt = jobDescCreatedAt.Default.(func() time.Time)
+	// jobDescUpdatedAt is the schema descriptor for updated_at field.
+	jobDescUpdatedAt := jobFields[14].Descriptor()
+	// job.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	job.DefaultUpdatedAt = jobDescUpdatedAt.Default.(func() time.Time)
+	// job.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	job.UpdateDefaultUpdatedAt = jobDescUpdatedAt.UpdateDefault.(func() time.Time)
+	sourceFields := schema.Source{}.Fields()
+	_ = sourceFields
+	// sourceDescEnabled is the schema descriptor for enabled field.
+	sourceDescEnabled := sourceFields[5].Descriptor()
+	// source.DefaultEnabled holds the default value on creation for the enabled field.
+	source.DefaultEnabled = sourceDescEnabled.Default.(bool)
+	// sourceDescManaged is the schema descriptor for managed field.
+	sourceDescManaged := sourceFields[6].Descriptor()
+	// source.DefaultManaged holds the default value on creation for the managed field.
+	source.DefaultManaged = sourceDescManaged.Default.(bool)
+	// sourceDescConsecutiveFailures is the schema descriptor for consecutive_failures field.
+	sourceDescConsecutiveFailures := sourceFields[11].Descriptor()
+	// source.DefaultConsecutiveFailures holds the default value on creation for the consecutive_failures field.
+	source.DefaultConsecutiveFailures = sourceDescConsecutiveFailures.Default.(int)
+	// sourceDescLastItems is the schema descriptor for last_items field.
+	sourceDescLastItems := sourceFields[12].Descriptor()
+	// source.DefaultLastItems holds the default value on creation for the last_items field.
+	source.DefaultLastItems = sourceDescLastItems.Default.(int)
+	sourcerunFields := schema.SourceRun{}.Fields()
+	_ = sourcerunFields
+	// sourcerunDescItems is the schema descriptor for items field.
+	sourcerunDescItems := sourcerunFields[3].Descriptor()
+	// sourcerun.DefaultItems holds the default value on creation for the items field.
+	sourcerun.DefaultItems = sourcerunDescItems.Default.(int)
+	websubscriptionFields := schema.WebSubscription{}.Fields()
+	_ = websubscriptionFields
+	// websubscriptionDescActive is the schema descriptor for active field.
+	websubscriptionDescActive := websubscriptionFields[5].Descriptor()
+	// websubscription.DefaultActive holds the default value on creation for the active field.
+	websubscription.DefaultActive = websubscriptionDescActive.Default.(bool)
+	// websubscriptionDescCreatedAt is the schema descriptor for created_at field.
+	websubscriptionDescCreatedAt := websubscriptionFields[9].Descriptor()
+	// websubscription.DefaultCreatedAt holds the default value on creation for the created_at field.
+	websubscription.DefaultCreatedAt = websubscriptionDescCreatedAt.Default.(func() time.Time)
+	// websubscriptionDescUpdatedAt is the schema descriptor for updated_at field.
+	websubscriptionDescUpdatedAt := websubscriptionFields[10].Descriptor()
+	// websubscription.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	websubscription.DefaultUpdatedAt = websubscriptionDescUpdatedAt.Default.(func() time.Time)
+	// websubscription.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	websubscription.UpdateDefaultUpdatedAt = websubscriptionDescUpdatedAt.UpdateDefault.(func() time.Time)
 }
@@ -1,9 +1,13 @@
 package schema
 
 import (
+	"time"
+
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"github.com/pgvector/pgvector-go"
 )
 
@@ -13,8 +17,10 @@ type Activity struct {
 
 func (Activity) Fields() []ent.Field {
 	return []ent.Field{
+		// id is derived from source_uid and uid, see types.ActivityID.
 		field.String("id").Unique(),
-		field.String("uid").Unique(),
+		// uid is the native ID of the activity, only unique within its source.
+		field.String("uid"),
 		field.String("source_uid"),
 		field.String("source_type"),
 		field.String("title"),
@@ -32,9 +38,41 @@ func (Activity) Fields() []ent.Field {
 			}).
 			Nillable().
 			Optional(),
+		// content_hash is used to detect changed activities without re-summarizing unchanged ones.
+		field.String("content_hash").
+			Default(""),
+		// revision is incremented each time a changed activity is stored again.
+		field.Int("revision").
+			Default(1),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			// Database default lets the column be added to existing rows.
+			Annotations(entsql.Default("CURRENT_TIMESTAMP")),
+		// starred activities are kept by retention rules.
+		field.Bool("starred").
+			Default(false),
+		// referenced activities are linked by other activities, and kept by retention rules.
+		field.Bool("referenced").
+			Default(false),
+		// archived_at is set once retention rules dropped the embedding of the activity.
+		field.Time("archived_at").
+			Optional().
+			Nillable(),
 	}
 }
 
 func (Activity) Edges() []ent.Edge {
 	return nil
 }
+
+func (Activity) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("source_uid", "uid").
+			Unique(),
+		// Retention rules select expired activities by creation time.
+		index.Fields("created_at"),
+		// Stored activities mark the activities they link to as referenced.
+		index.Fields("url"),
+	}
+}
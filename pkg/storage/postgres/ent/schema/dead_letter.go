@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/field"
+	"github.com/pgvector/pgvector-go"
+)
+
+// DeadLetter is a job that failed on every attempt.
+// It keeps the progress of the job, so that a retry resumes from the failed stage.
+type DeadLetter struct {
+	ent.Schema
+}
+
+func (DeadLetter) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").Unique(),
+		field.String("source_uid"),
+		field.String("source_type"),
+		field.String("raw_json"),
+		field.Enum("stage").
+			Values("summarize", "embed", "store"),
+		field.String("error"),
+		field.Int("attempts"),
+		field.String("short_summary").Optional(),
+		field.String("full_summary").Optional(),
+		field.Other("embedding", pgvector.Vector{}).
+			SchemaType(map[string]string{
+				// Use text-embedding-3-large output dimensions
+				dialect.Postgres: "vector(3072)",
+			}).
+			Nillable().
+			Optional(),
+		field.Time("failed_at").Default(time.Now),
+	}
+}
+
+func (DeadLetter) Edges() []ent.Edge {
+	return nil
+}
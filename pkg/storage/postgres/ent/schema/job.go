@@ -0,0 +1,58 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/pgvector/pgvector-go"
+)
+
+// Job is a pending unit of ingestion work for a single activity.
+// Jobs are removed once the activity is stored.
+type Job struct {
+	ent.Schema
+}
+
+func (Job) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").Unique(),
+		field.String("source_uid"),
+		field.String("source_type"),
+		field.String("raw_json"),
+		field.Enum("stage").
+			Values("summarize", "embed", "store").
+			Default("summarize"),
+		// Jobs that fail on every attempt are moved to dead letters.
+		field.Enum("state").
+			Values("pending", "running").
+			Default("pending"),
+		field.Int("attempts").Default(0),
+		field.String("last_error").Optional(),
+		field.String("short_summary").Optional(),
+		field.String("full_summary").Optional(),
+		field.Other("embedding", pgvector.Vector{}).
+			SchemaType(map[string]string{
+				// Use text-embedding-3-large output dimensions
+				dialect.Postgres: "vector(3072)",
+			}).
+			Nillable().
+			Optional(),
+		field.Time("run_after").Default(time.Now),
+		field.Time("claimed_at").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+func (Job) Edges() []ent.Edge {
+	return nil
+}
+
+func (Job) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("state", "run_after"),
+	}
+}
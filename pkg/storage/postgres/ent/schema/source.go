@@ -16,6 +16,18 @@ func (Source) Fields() []ent.Field {
 		field.String("url"),
 		field.String("type"),
 		field.String("raw_json"),
+		// Paused sources are not polled, but keep their config and activities.
+		field.Bool("enabled").Default(true),
+		// Managed sources are defined in the sources file and removed when they are no longer listed.
+		field.Bool("managed").Default(false),
+		// Random token of the ingest URL of sources that are pushed to, see sources.TokenReceiver.
+		field.String("ingest_token").Optional().Nillable().Unique(),
+		// Health of the source, updated after each fetch.
+		field.Time("last_success_at").Optional().Nillable(),
+		field.String("last_error").Optional(),
+		field.Time("last_error_at").Optional().Nillable(),
+		field.Int("consecutive_failures").Default(0),
+		field.Int("last_items").Default(0),
 	}
 }
 
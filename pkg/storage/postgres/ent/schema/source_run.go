@@ -0,0 +1,32 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// SourceRun records the outcome of a single fetch of a source.
+type SourceRun struct {
+	ent.Schema
+}
+
+func (SourceRun) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("source_uid"),
+		field.Time("started_at"),
+		field.Time("finished_at"),
+		field.Int("items").Default(0),
+		field.String("error").Optional(),
+	}
+}
+
+func (SourceRun) Edges() []ent.Edge {
+	return nil
+}
+
+func (SourceRun) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("source_uid", "started_at"),
+	}
+}
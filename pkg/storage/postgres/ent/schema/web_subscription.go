@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// WebSubscription is a subscription of a source to pushed updates from a WebSub hub.
+// It's shared by all replicas, since the hub may deliver callbacks to any of them.
+type WebSubscription struct {
+	ent.Schema
+}
+
+func (WebSubscription) Fields() []ent.Field {
+	return []ent.Field{
+		// UID of the subscribed source.
+		field.String("id").Unique(),
+		field.String("hub"),
+		field.String("topic"),
+		field.String("secret").Sensitive(),
+		// Last mode requested from the hub, which it verifies.
+		field.Enum("mode").
+			Values("subscribe", "unsubscribe").
+			Default("subscribe"),
+		// Subscriptions are active once the hub verified them.
+		field.Bool("active").Default(false),
+		field.Time("expires_at").Optional().Nillable(),
+		field.Time("renew_at").Optional().Nillable(),
+		// Set while a replica renews the subscription, so that only one of them does.
+		field.Time("renewal_claimed_at").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+func (WebSubscription) Edges() []ent.Edge {
+	return nil
+}
+
+func (WebSubscription) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("mode", "renew_at"),
+	}
+}
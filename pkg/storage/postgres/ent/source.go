@@ -5,6 +5,7 @@ package ent
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -23,7 +24,23 @@ type Source struct {
 	// Type holds the value of the "type" field.
 	Type string `json:"type,omitempty"`
 	// RawJSON holds the value of the "raw_json" field.
-	RawJSON      string `json:"raw_json,omitempty"`
+	RawJSON string `json:"raw_json,omitempty"`
+	// Enabled holds the value of the "enabled" field.
+	Enabled bool `json:"enabled,omitempty"`
+	// Managed holds the value of the "managed" field.
+	Managed bool `json:"managed,omitempty"`
+	// IngestToken holds the value of the "ingest_token" field.
+	IngestToken *string `json:"ingest_token,omitempty"`
+	// LastSuccessAt holds the value of the "last_success_at" field.
+	LastSuccessAt *time.Time `json:"last_success_at,omitempty"`
+	// LastError holds the value of the "last_error" field.
+	LastError string `json:"last_error,omitempty"`
+	// LastErrorAt holds the value of the "last_error_at" field.
+	LastErrorAt *time.Time `json:"last_error_at,omitempty"`
+	// ConsecutiveFailures holds the value of the "consecutive_failures" field.
+	ConsecutiveFailures int `json:"consecutive_failures,omitempty"`
+	// LastItems holds the value of the "last_items" field.
+	LastItems    int `json:"last_items,omitempty"`
 	selectValues sql.SelectValues
 }
 
@@ -32,8 +49,14 @@ func (*Source) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case source.FieldID, source.FieldName, source.FieldURL, source.FieldType, source.FieldRawJSON:
+		case source.FieldEnabled, source.FieldManaged:
+			values[i] = new(sql.NullBool)
+		case source.FieldConsecutiveFailures, source.FieldLastItems:
+			values[i] = new(sql.NullInt64)
+		case source.FieldID, source.FieldName, source.FieldURL, source.FieldType, source.FieldRawJSON, source.FieldIngestToken, source.FieldLastError:
 			values[i] = new(sql.NullString)
+		case source.FieldLastSuccessAt, source.FieldLastErrorAt:
+			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -79,6 +102,57 @@ func (s *Source) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				s.RawJSON = value.String
 			}
+		case source.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				s.Enabled = value.Bool
+			}
+		case source.FieldManaged:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field managed", values[i])
+			} else if value.Valid {
+				s.Managed = value.Bool
+			}
+		case source.FieldIngestToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ingest_token", values[i])
+			} else if value.Valid {
+				s.IngestToken = new(string)
+				*s.IngestToken = value.String
+			}
+		case source.FieldLastSuccessAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_success_at", values[i])
+			} else if value.Valid {
+				s.LastSuccessAt = new(time.Time)
+				*s.LastSuccessAt = value.Time
+			}
+		case source.FieldLastError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_error", values[i])
+			} else if value.Valid {
+				s.LastError = value.String
+			}
+		case source.FieldLastErrorAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_error_at", values[i])
+			} else if value.Valid {
+				s.LastErrorAt = new(time.Time)
+				*s.LastErrorAt = value.Time
+			}
+		case source.FieldConsecutiveFailures:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field consecutive_failures", values[i])
+			} else if value.Valid {
+				s.ConsecutiveFailures = int(value.Int64)
+			}
+		case source.FieldLastItems:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field last_items", values[i])
+			} else if value.Valid {
+				s.LastItems = int(value.Int64)
+			}
 		default:
 			s.selectValues.Set(columns[i], values[i])
 		}
@@ -126,6 +200,36 @@ func (s *Source) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("raw_json=")
 	builder.WriteString(s.RawJSON)
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", s.Enabled))
+	builder.WriteString(", ")
+	builder.WriteString("managed=")
+	builder.WriteString(fmt.Sprintf("%v", s.Managed))
+	builder.WriteString(", ")
+	if v := s.IngestToken; v != nil {
+		builder.WriteString("ingest_token=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := s.LastSuccessAt; v != nil {
+		builder.WriteString("last_success_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("last_error=")
+	builder.WriteString(s.LastError)
+	builder.WriteString(", ")
+	if v := s.LastErrorAt; v != nil {
+		builder.WriteString("last_error_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("consecutive_failures=")
+	builder.WriteString(fmt.Sprintf("%v", s.ConsecutiveFailures))
+	builder.WriteString(", ")
+	builder.WriteString("last_items=")
+	builder.WriteString(fmt.Sprintf("%v", s.LastItems))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -19,6 +19,22 @@ const (
 	FieldType = "type"
 	// FieldRawJSON holds the string denoting the raw_json field in the database.
 	FieldRawJSON = "raw_json"
+	// FieldEnabled holds the string denoting the enabled field in the database.
+	FieldEnabled = "enabled"
+	// FieldManaged holds the string denoting the managed field in the database.
+	FieldManaged = "managed"
+	// FieldIngestToken holds the string denoting the ingest_token field in the database.
+	FieldIngestToken = "ingest_token"
+	// FieldLastSuccessAt holds the string denoting the last_success_at field in the database.
+	FieldLastSuccessAt = "last_success_at"
+	// FieldLastError holds the string denoting the last_error field in the database.
+	FieldLastError = "last_error"
+	// FieldLastErrorAt holds the string denoting the last_error_at field in the database.
+	FieldLastErrorAt = "last_error_at"
+	// FieldConsecutiveFailures holds the string denoting the consecutive_failures field in the database.
+	FieldConsecutiveFailures = "consecutive_failures"
+	// FieldLastItems holds the string denoting the last_items field in the database.
+	FieldLastItems = "last_items"
 	// Table holds the table name of the source in the database.
 	Table = "sources"
 )
@@ -30,6 +46,14 @@ var Columns = []string{
 	FieldURL,
 	FieldType,
 	FieldRawJSON,
+	FieldEnabled,
+	FieldManaged,
+	FieldIngestToken,
+	FieldLastSuccessAt,
+	FieldLastError,
+	FieldLastErrorAt,
+	FieldConsecutiveFailures,
+	FieldLastItems,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -42,6 +66,17 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+var (
+	// DefaultEnabled holds the default value on creation for the "enabled" field.
+	DefaultEnabled bool
+	// DefaultManaged holds the default value on creation for the "managed" field.
+	DefaultManaged bool
+	// DefaultConsecutiveFailures holds the default value on creation for the "consecutive_failures" field.
+	DefaultConsecutiveFailures int
+	// DefaultLastItems holds the default value on creation for the "last_items" field.
+	DefaultLastItems int
+)
+
 // OrderOption defines the ordering options for the Source queries.
 type OrderOption func(*sql.Selector)
 
@@ -69,3 +104,43 @@ func ByType(opts ...sql.OrderTermOption) OrderOption {
 func ByRawJSON(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldRawJSON, opts...).ToFunc()
 }
+
+// ByEnabled orders the results by the enabled field.
+func ByEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnabled, opts...).ToFunc()
+}
+
+// ByManaged orders the results by the managed field.
+func ByManaged(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldManaged, opts...).ToFunc()
+}
+
+// ByIngestToken orders the results by the ingest_token field.
+func ByIngestToken(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIngestToken, opts...).ToFunc()
+}
+
+// ByLastSuccessAt orders the results by the last_success_at field.
+func ByLastSuccessAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastSuccessAt, opts...).ToFunc()
+}
+
+// ByLastError orders the results by the last_error field.
+func ByLastError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastError, opts...).ToFunc()
+}
+
+// ByLastErrorAt orders the results by the last_error_at field.
+func ByLastErrorAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastErrorAt, opts...).ToFunc()
+}
+
+// ByConsecutiveFailures orders the results by the consecutive_failures field.
+func ByConsecutiveFailures(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldConsecutiveFailures, opts...).ToFunc()
+}
+
+// ByLastItems orders the results by the last_items field.
+func ByLastItems(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastItems, opts...).ToFunc()
+}
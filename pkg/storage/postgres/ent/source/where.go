@@ -3,6 +3,8 @@
 package source
 
 import (
+	"time"
+
 	"entgo.io/ent/dialect/sql"
 	"github.com/glanceapp/glance/pkg/storage/postgres/ent/predicate"
 )
@@ -82,6 +84,46 @@ func RawJSON(v string) predicate.Source {
 	return predicate.Source(sql.FieldEQ(FieldRawJSON, v))
 }
 
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.Source {
+	return predicate.Source(sql.FieldEQ(FieldEnabled, v))
+}
+
+// Managed applies equality check predicate on the "managed" field. It's identical to ManagedEQ.
+func Managed(v bool) predicate.Source {
+	return predicate.Source(sql.FieldEQ(FieldManaged, v))
+}
+
+// IngestToken applies equality check predicate on the "ingest_token" field. It's identical to IngestTokenEQ.
+func IngestToken(v string) predicate.Source {
+	return predicate.Source(sql.FieldEQ(FieldIngestToken, v))
+}
+
+// LastSuccessAt applies equality check predicate on the "last_success_at" field. It's identical to LastSuccessAtEQ.
+func LastSuccessAt(v time.Time) predicate.Source {
+	return predicate.Source(sql.FieldEQ(FieldLastSuccessAt, v))
+}
+
+// LastError applies equality check predicate on the "last_error" field. It's identical to LastErrorEQ.
+func LastError(v string) predicate.Source {
+	return predicate.Source(sql.FieldEQ(FieldLastError, v))
+}
+
+// LastErrorAt applies equality check predicate on the "last_error_at" field. It's identical to LastErrorAtEQ.
+func LastErrorAt(v time.Time) predicate.Source {
+	return predicate.Source(sql.FieldEQ(FieldLastErrorAt, v))
+}
+
+// ConsecutiveFailures applies equality check predicate on the "consecutive_failures" field. It's identical to ConsecutiveFailuresEQ.
+func ConsecutiveFailures(v int) predicate.Source {
+	return predicate.Source(sql.FieldEQ(FieldConsecutiveFailures, v))
+}
+
+// LastItems applies equality check predicate on the "last_items" field. It's identical to LastItemsEQ.
+func LastItems(v int) predicate.Source {
+	return predicate.Source(sql.FieldEQ(FieldLastItems, v))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.Source {
 	return predicate.Source(sql.FieldEQ(FieldName, v))
@@ -342,6 +384,356 @@ func RawJSONContainsFold(v string) predicate.Source {
 	return predicate.Source(sql.FieldContainsFold(FieldRawJSON, v))
 }
 
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.Source {
+	return predicate.Source(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.Source {
+	return predicate.Source(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// ManagedEQ applies the EQ predicate on the "managed" field.
+func ManagedEQ(v bool) predicate.Source {
+	return predicate.Source(sql.FieldEQ(FieldManaged, v))
+}
+
+// ManagedNEQ applies the NEQ predicate on the "managed" field.
+func ManagedNEQ(v bool) predicate.Source {
+	return predicate.Source(sql.FieldNEQ(FieldManaged, v))
+}
+
+// IngestTokenEQ applies the EQ predicate on the "ingest_token" field.
+func IngestTokenEQ(v string) predicate.Source {
+	return predicate.Source(sql.FieldEQ(FieldIngestToken, v))
+}
+
+// IngestTokenNEQ applies the NEQ predicate on the "ingest_token" field.
+func IngestTokenNEQ(v string) predicate.Source {
+	return predicate.Source(sql.FieldNEQ(FieldIngestToken, v))
+}
+
+// IngestTokenIn applies the In predicate on the "ingest_token" field.
+func IngestTokenIn(vs ...string) predicate.Source {
+	return predicate.Source(sql.FieldIn(FieldIngestToken, vs...))
+}
+
+// IngestTokenNotIn applies the NotIn predicate on the "ingest_token" field.
+func IngestTokenNotIn(vs ...string) predicate.Source {
+	return predicate.Source(sql.FieldNotIn(FieldIngestToken, vs...))
+}
+
+// IngestTokenGT applies the GT predicate on the "ingest_token" field.
+func IngestTokenGT(v string) predicate.Source {
+	return predicate.Source(sql.FieldGT(FieldIngestToken, v))
+}
+
+// IngestTokenGTE applies the GTE predicate on the "ingest_token" field.
+func IngestTokenGTE(v string) predicate.Source {
+	return predicate.Source(sql.FieldGTE(FieldIngestToken, v))
+}
+
+// IngestTokenLT applies the LT predicate on the "ingest_token" field.
+func IngestTokenLT(v string) predicate.Source {
+	return predicate.Source(sql.FieldLT(FieldIngestToken, v))
+}
+
+// IngestTokenLTE applies the LTE predicate on the "ingest_token" field.
+func IngestTokenLTE(v string) predicate.Source {
+	return predicate.Source(sql.FieldLTE(FieldIngestToken, v))
+}
+
+// IngestTokenContains applies the Contains predicate on the "ingest_token" field.
+func IngestTokenContains(v string) predicate.Source {
+	return predicate.Source(sql.FieldContains(FieldIngestToken, v))
+}
+
+// IngestTokenHasPrefix applies the HasPrefix predicate on the "ingest_token" field.
+func IngestTokenHasPrefix(v string) predicate.Source {
+	return predicate.Source(sql.FieldHasPrefix(FieldIngestToken, v))
+}
+
+// IngestTokenHasSuffix applies the HasSuffix predicate on the "ingest_token" field.
+func IngestTokenHasSuffix(v string) predicate.Source {
+	return predicate.Source(sql.FieldHasSuffix(FieldIngestToken, v))
+}
+
+// IngestTokenIsNil applies the IsNil predicate on the "ingest_token" field.
+func IngestTokenIsNil() predicate.Source {
+	return predicate.Source(sql.FieldIsNull(FieldIngestToken))
+}
+
+// IngestTokenNotNil applies the NotNil predicate on the "ingest_token" field.
+func IngestTokenNotNil() predicate.Source {
+	return predicate.Source(sql.FieldNotNull(FieldIngestToken))
+}
+
+// IngestTokenEqualFold applies the EqualFold predicate on the "ingest_token" field.
+func IngestTokenEqualFold(v string) predicate.Source {
+	return predicate.Source(sql.FieldEqualFold(FieldIngestToken, v))
+}
+
+// IngestTokenContainsFold applies the ContainsFold predicate on the "ingest_token" field.
+func IngestTokenContainsFold(v string) predicate.Source {
+	return predicate.Source(sql.FieldContainsFold(FieldIngestToken, v))
+}
+
+// LastSuccessAtEQ applies the EQ predicate on the "last_success_at" field.
+func LastSuccessAtEQ(v time.Time) predicate.Source {
+	return predicate.Source(sql.FieldEQ(FieldLastSuccessAt, v))
+}
+
+// LastSuccessAtNEQ applies the NEQ predicate on the "last_success_at" field.
+func LastSuccessAtNEQ(v time.Time) predicate.Source {
+	return predicate.Source(sql.FieldNEQ(FieldLastSuccessAt, v))
+}
+
+// LastSuccessAtIn applies the In predicate on the "last_success_at" field.
+func LastSuccessAtIn(vs ...time.Time) predicate.Source {
+	return predicate.Source(sql.FieldIn(FieldLastSuccessAt, vs...))
+}
+
+// LastSuccessAtNotIn applies the NotIn predicate on the "last_success_at" field.
+func LastSuccessAtNotIn(vs ...time.Time) predicate.Source {
+	return predicate.Source(sql.FieldNotIn(FieldLastSuccessAt, vs...))
+}
+
+// LastSuccessAtGT applies the GT predicate on the "last_success_at" field.
+func LastSuccessAtGT(v time.Time) predicate.Source {
+	return predicate.Source(sql.FieldGT(FieldLastSuccessAt, v))
+}
+
+// LastSuccessAtGTE applies the GTE predicate on the "last_success_at" field.
+func LastSuccessAtGTE(v time.Time) predicate.Source {
+	return predicate.Source(sql.FieldGTE(FieldLastSuccessAt, v))
+}
+
+// LastSuccessAtLT applies the LT predicate on the "last_success_at" field.
+func LastSuccessAtLT(v time.Time) predicate.Source {
+	return predicate.Source(sql.FieldLT(FieldLastSuccessAt, v))
+}
+
+// LastSuccessAtLTE applies the LTE predicate on the "last_success_at" field.
+func LastSuccessAtLTE(v time.Time) predicate.Source {
+	return predicate.Source(sql.FieldLTE(FieldLastSuccessAt, v))
+}
+
+// LastSuccessAtIsNil applies the IsNil predicate on the "last_success_at" field.
+func LastSuccessAtIsNil() predicate.Source {
+	return predicate.Source(sql.FieldIsNull(FieldLastSuccessAt))
+}
+
+// LastSuccessAtNotNil applies the NotNil predicate on the "last_success_at" field.
+func LastSuccessAtNotNil() predicate.Source {
+	return predicate.Source(sql.FieldNotNull(FieldLastSuccessAt))
+}
+
+// LastErrorEQ applies the EQ predicate on the "last_error" field.
+func LastErrorEQ(v string) predicate.Source {
+	return predicate.Source(sql.FieldEQ(FieldLastError, v))
+}
+
+// LastErrorNEQ applies the NEQ predicate on the "last_error" field.
+func LastErrorNEQ(v string) predicate.Source {
+	return predicate.Source(sql.FieldNEQ(FieldLastError, v))
+}
+
+// LastErrorIn applies the In predicate on the "last_error" field.
+func LastErrorIn(vs ...string) predicate.Source {
+	return predicate.Source(sql.FieldIn(FieldLastError, vs...))
+}
+
+// LastErrorNotIn applies the NotIn predicate on the "last_error" field.
+func LastErrorNotIn(vs ...string) predicate.Source {
+	return predicate.Source(sql.FieldNotIn(FieldLastError, vs...))
+}
+
+// LastErrorGT applies the GT predicate on the "last_error" field.
+func LastErrorGT(v string) predicate.Source {
+	return predicate.Source(sql.FieldGT(FieldLastError, v))
+}
+
+// LastErrorGTE applies the GTE predicate on the "last_error" field.
+func LastErrorGTE(v string) predicate.Source {
+	return predicate.Source(sql.FieldGTE(FieldLastError, v))
+}
+
+// LastErrorLT applies the LT predicate on the "last_error" field.
+func LastErrorLT(v string) predicate.Source {
+	return predicate.Source(sql.FieldLT(FieldLastError, v))
+}
+
+// LastErrorLTE applies the LTE predicate on the "last_error" field.
+func LastErrorLTE(v string) predicate.Source {
+	return predicate.Source(sql.FieldLTE(FieldLastError, v))
+}
+
+// LastErrorContains applies the Contains predicate on the "last_error" field.
+func LastErrorContains(v string) predicate.Source {
+	return predicate.Source(sql.FieldContains(FieldLastError, v))
+}
+
+// LastErrorHasPrefix applies the HasPrefix predicate on the "last_error" field.
+func LastErrorHasPrefix(v string) predicate.Source {
+	return predicate.Source(sql.FieldHasPrefix(FieldLastError, v))
+}
+
+// LastErrorHasSuffix applies the HasSuffix predicate on the "last_error" field.
+func LastErrorHasSuffix(v string) predicate.Source {
+	return predicate.Source(sql.FieldHasSuffix(FieldLastError, v))
+}
+
+// LastErrorIsNil applies the IsNil predicate on the "last_error" field.
+func LastErrorIsNil() predicate.Source {
+	return predicate.Source(sql.FieldIsNull(FieldLastError))
+}
+
+// LastErrorNotNil applies the NotNil predicate on the "last_error" field.
+func LastErrorNotNil() predicate.Source {
+	return predicate.Source(sql.FieldNotNull(FieldLastError))
+}
+
+// LastErrorEqualFold applies the EqualFold predicate on the "last_error" field.
+func LastErrorEqualFold(v string) predicate.Source {
+	return predicate.Source(sql.FieldEqualFold(FieldLastError, v))
+}
+
+// LastErrorContainsFold applies the ContainsFold predicate on the "last_error" field.
+func LastErrorContainsFold(v string) predicate.Source {
+	return predicate.Source(sql.FieldContainsFold(FieldLastError, v))
+}
+
+// LastErrorAtEQ applies the EQ predicate on the "last_error_at" field.
+func LastErrorAtEQ(v time.Time) predicate.Source {
+	return predicate.Source(sql.FieldEQ(FieldLastErrorAt, v))
+}
+
+// LastErrorAtNEQ applies the NEQ predicate on the "last_error_at" field.
+func LastErrorAtNEQ(v time.Time) predicate.Source {
+	return predicate.Source(sql.FieldNEQ(FieldLastErrorAt, v))
+}
+
+// LastErrorAtIn applies the In predicate on the "last_error_at" field.
+func LastErrorAtIn(vs ...time.Time) predicate.Source {
+	return predicate.Source(sql.FieldIn(FieldLastErrorAt, vs...))
+}
+
+// LastErrorAtNotIn applies the NotIn predicate on the "last_error_at" field.
+func LastErrorAtNotIn(vs ...time.Time) predicate.Source {
+	return predicate.Source(sql.FieldNotIn(FieldLastErrorAt, vs...))
+}
+
+// LastErrorAtGT applies the GT predicate on the "last_error_at" field.
+func LastErrorAtGT(v time.Time) predicate.Source {
+	return predicate.Source(sql.FieldGT(FieldLastErrorAt, v))
+}
+
+// LastErrorAtGTE applies the GTE predicate on the "last_error_at" field.
+func LastErrorAtGTE(v time.Time) predicate.Source {
+	return predicate.Source(sql.FieldGTE(FieldLastErrorAt, v))
+}
+
+// LastErrorAtLT applies the LT predicate on the "last_error_at" field.
+func LastErrorAtLT(v time.Time) predicate.Source {
+	return predicate.Source(sql.FieldLT(FieldLastErrorAt, v))
+}
+
+// LastErrorAtLTE applies the LTE predicate on the "last_error_at" field.
+func LastErrorAtLTE(v time.Time) predicate.Source {
+	return predicate.Source(sql.FieldLTE(FieldLastErrorAt, v))
+}
+
+// LastErrorAtIsNil applies the IsNil predicate on the "last_error_at" field.
+func LastErrorAtIsNil() predicate.Source {
+	return predicate.Source(sql.FieldIsNull(FieldLastErrorAt))
+}
+
+// LastErrorAtNotNil applies the NotNil predicate on the "last_error_at" field.
+func LastErrorAtNotNil() predicate.Source {
+	return predicate.Source(sql.FieldNotNull(FieldLastErrorAt))
+}
+
+// ConsecutiveFailuresEQ applies the EQ predicate on the "consecutive_failures" field.
+func ConsecutiveFailuresEQ(v int) predicate.Source {
+	return predicate.Source(sql.FieldEQ(FieldConsecutiveFailures, v))
+}
+
+// ConsecutiveFailuresNEQ applies the NEQ predicate on the "consecutive_failures" field.
+func ConsecutiveFailuresNEQ(v int) predicate.Source {
+	return predicate.Source(sql.FieldNEQ(FieldConsecutiveFailures, v))
+}
+
+// ConsecutiveFailuresIn applies the In predicate on the "consecutive_failures" field.
+func ConsecutiveFailuresIn(vs ...int) predicate.Source {
+	return predicate.Source(sql.FieldIn(FieldConsecutiveFailures, vs...))
+}
+
+// ConsecutiveFailuresNotIn applies the NotIn predicate on the "consecutive_failures" field.
+func ConsecutiveFailuresNotIn(vs ...int) predicate.Source {
+	return predicate.Source(sql.FieldNotIn(FieldConsecutiveFailures, vs...))
+}
+
+// ConsecutiveFailuresGT applies the GT predicate on the "consecutive_failures" field.
+func ConsecutiveFailuresGT(v int) predicate.Source {
+	return predicate.Source(sql.FieldGT(FieldConsecutiveFailures, v))
+}
+
+// ConsecutiveFailuresGTE applies the GTE predicate on the "consecutive_failures" field.
+func ConsecutiveFailuresGTE(v int) predicate.Source {
+	return predicate.Source(sql.FieldGTE(FieldConsecutiveFailures, v))
+}
+
+// ConsecutiveFailuresLT applies the LT predicate on the "consecutive_failures" field.
+func ConsecutiveFailuresLT(v int) predicate.Source {
+	return predicate.Source(sql.FieldLT(FieldConsecutiveFailures, v))
+}
+
+// ConsecutiveFailuresLTE applies the LTE predicate on the "consecutive_failures" field.
+func ConsecutiveFailuresLTE(v int) predicate.Source {
+	return predicate.Source(sql.FieldLTE(FieldConsecutiveFailures, v))
+}
+
+// LastItemsEQ applies the EQ predicate on the "last_items" field.
+func LastItemsEQ(v int) predicate.Source {
+	return predicate.Source(sql.FieldEQ(FieldLastItems, v))
+}
+
+// LastItemsNEQ applies the NEQ predicate on the "last_items" field.
+func LastItemsNEQ(v int) predicate.Source {
+	return predicate.Source(sql.FieldNEQ(FieldLastItems, v))
+}
+
+// LastItemsIn applies the In predicate on the "last_items" field.
+func LastItemsIn(vs ...int) predicate.Source {
+	return predicate.Source(sql.FieldIn(FieldLastItems, vs...))
+}
+
+// LastItemsNotIn applies the NotIn predicate on the "last_items" field.
+func LastItemsNotIn(vs ...int) predicate.Source {
+	return predicate.Source(sql.FieldNotIn(FieldLastItems, vs...))
+}
+
+// LastItemsGT applies the GT predicate on the "last_items" field.
+func LastItemsGT(v int) predicate.Source {
+	return predicate.Source(sql.FieldGT(FieldLastItems, v))
+}
+
+// LastItemsGTE applies the GTE predicate on the "last_items" field.
+func LastItemsGTE(v int) predicate.Source {
+	return predicate.Source(sql.FieldGTE(FieldLastItems, v))
+}
+
+// LastItemsLT applies the LT predicate on the "last_items" field.
+func LastItemsLT(v int) predicate.Source {
+	return predicate.Source(sql.FieldLT(FieldLastItems, v))
+}
+
+// LastItemsLTE applies the LTE predicate on the "last_items" field.
+func LastItemsLTE(v int) predicate.Source {
+	return predicate.Source(sql.FieldLTE(FieldLastItems, v))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Source) predicate.Source {
 	return predicate.Source(sql.AndPredicates(predicates...))
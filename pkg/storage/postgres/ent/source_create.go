@@ -6,7 +6,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/glanceapp/glance/pkg/storage/postgres/ent/source"
@@ -17,6 +20,7 @@ type SourceCreate struct {
 	config
 	mutation *SourceMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetName sets the "name" field.
@@ -43,6 +47,118 @@ func (sc *SourceCreate) SetRawJSON(s string) *SourceCreate {
 	return sc
 }
 
+// SetEnabled sets the "enabled" field.
+func (sc *SourceCreate) SetEnabled(b bool) *SourceCreate {
+	sc.mutation.SetEnabled(b)
+	return sc
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (sc *SourceCreate) SetNillableEnabled(b *bool) *SourceCreate {
+	if b != nil {
+		sc.SetEnabled(*b)
+	}
+	return sc
+}
+
+// SetManaged sets the "managed" field.
+func (sc *SourceCreate) SetManaged(b bool) *SourceCreate {
+	sc.mutation.SetManaged(b)
+	return sc
+}
+
+// SetNillableManaged sets the "managed" field if the given value is not nil.
+func (sc *SourceCreate) SetNillableManaged(b *bool) *SourceCreate {
+	if b != nil {
+		sc.SetManaged(*b)
+	}
+	return sc
+}
+
+// SetIngestToken sets the "ingest_token" field.
+func (sc *SourceCreate) SetIngestToken(s string) *SourceCreate {
+	sc.mutation.SetIngestToken(s)
+	return sc
+}
+
+// SetNillableIngestToken sets the "ingest_token" field if the given value is not nil.
+func (sc *SourceCreate) SetNillableIngestToken(s *string) *SourceCreate {
+	if s != nil {
+		sc.SetIngestToken(*s)
+	}
+	return sc
+}
+
+// SetLastSuccessAt sets the "last_success_at" field.
+func (sc *SourceCreate) SetLastSuccessAt(t time.Time) *SourceCreate {
+	sc.mutation.SetLastSuccessAt(t)
+	return sc
+}
+
+// SetNillableLastSuccessAt sets the "last_success_at" field if the given value is not nil.
+func (sc *SourceCreate) SetNillableLastSuccessAt(t *time.Time) *SourceCreate {
+	if t != nil {
+		sc.SetLastSuccessAt(*t)
+	}
+	return sc
+}
+
+// SetLastError sets the "last_error" field.
+func (sc *SourceCreate) SetLastError(s string) *SourceCreate {
+	sc.mutation.SetLastError(s)
+	return sc
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (sc *SourceCreate) SetNillableLastError(s *string) *SourceCreate {
+	if s != nil {
+		sc.SetLastError(*s)
+	}
+	return sc
+}
+
+// SetLastErrorAt sets the "last_error_at" field.
+func (sc *SourceCreate) SetLastErrorAt(t time.Time) *SourceCreate {
+	sc.mutation.SetLastErrorAt(t)
+	return sc
+}
+
+// SetNillableLastErrorAt sets the "last_error_at" field if the given value is not nil.
+func (sc *SourceCreate) SetNillableLastErrorAt(t *time.Time) *SourceCreate {
+	if t != nil {
+		sc.SetLastErrorAt(*t)
+	}
+	return sc
+}
+
+// SetConsecutiveFailures sets the "consecutive_failures" field.
+func (sc *SourceCreate) SetConsecutiveFailures(i int) *SourceCreate {
+	sc.mutation.SetConsecutiveFailures(i)
+	return sc
+}
+
+// SetNillableConsecutiveFailures sets the "consecutive_failures" field if the given value is not nil.
+func (sc *SourceCreate) SetNillableConsecutiveFailures(i *int) *SourceCreate {
+	if i != nil {
+		sc.SetConsecutiveFailures(*i)
+	}
+	return sc
+}
+
+// SetLastItems sets the "last_items" field.
+func (sc *SourceCreate) SetLastItems(i int) *SourceCreate {
+	sc.mutation.SetLastItems(i)
+	return sc
+}
+
+// SetNillableLastItems sets the "last_items" field if the given value is not nil.
+func (sc *SourceCreate) SetNillableLastItems(i *int) *SourceCreate {
+	if i != nil {
+		sc.SetLastItems(*i)
+	}
+	return sc
+}
+
 // SetID sets the "id" field.
 func (sc *SourceCreate) SetID(s string) *SourceCreate {
 	sc.mutation.SetID(s)
@@ -56,6 +172,7 @@ func (sc *SourceCreate) Mutation() *SourceMutation {
 
 // Save creates the Source in the database.
 func (sc *SourceCreate) Save(ctx context.Context) (*Source, error) {
+	sc.defaults()
 	return withHooks(ctx, sc.sqlSave, sc.mutation, sc.hooks)
 }
 
@@ -81,6 +198,26 @@ func (sc *SourceCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (sc *SourceCreate) defaults() {
+	if _, ok := sc.mutation.Enabled(); !ok {
+		v := source.DefaultEnabled
+		sc.mutation.SetEnabled(v)
+	}
+	if _, ok := sc.mutation.Managed(); !ok {
+		v := source.DefaultManaged
+		sc.mutation.SetManaged(v)
+	}
+	if _, ok := sc.mutation.ConsecutiveFailures(); !ok {
+		v := source.DefaultConsecutiveFailures
+		sc.mutation.SetConsecutiveFailures(v)
+	}
+	if _, ok := sc.mutation.LastItems(); !ok {
+		v := source.DefaultLastItems
+		sc.mutation.SetLastItems(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (sc *SourceCreate) check() error {
 	if _, ok := sc.mutation.Name(); !ok {
@@ -95,6 +232,18 @@ func (sc *SourceCreate) check() error {
 	if _, ok := sc.mutation.RawJSON(); !ok {
 		return &ValidationError{Name: "raw_json", err: errors.New(`ent: missing required field "Source.raw_json"`)}
 	}
+	if _, ok := sc.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "Source.enabled"`)}
+	}
+	if _, ok := sc.mutation.Managed(); !ok {
+		return &ValidationError{Name: "managed", err: errors.New(`ent: missing required field "Source.managed"`)}
+	}
+	if _, ok := sc.mutation.ConsecutiveFailures(); !ok {
+		return &ValidationError{Name: "consecutive_failures", err: errors.New(`ent: missing required field "Source.consecutive_failures"`)}
+	}
+	if _, ok := sc.mutation.LastItems(); !ok {
+		return &ValidationError{Name: "last_items", err: errors.New(`ent: missing required field "Source.last_items"`)}
+	}
 	return nil
 }
 
@@ -126,6 +275,7 @@ func (sc *SourceCreate) createSpec() (*Source, *sqlgraph.CreateSpec) {
 		_node = &Source{config: sc.config}
 		_spec = sqlgraph.NewCreateSpec(source.Table, sqlgraph.NewFieldSpec(source.FieldID, field.TypeString))
 	)
+	_spec.OnConflict = sc.conflict
 	if id, ok := sc.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = id
@@ -146,14 +296,572 @@ func (sc *SourceCreate) createSpec() (*Source, *sqlgraph.CreateSpec) {
 		_spec.SetField(source.FieldRawJSON, field.TypeString, value)
 		_node.RawJSON = value
 	}
+	if value, ok := sc.mutation.Enabled(); ok {
+		_spec.SetField(source.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := sc.mutation.Managed(); ok {
+		_spec.SetField(source.FieldManaged, field.TypeBool, value)
+		_node.Managed = value
+	}
+	if value, ok := sc.mutation.IngestToken(); ok {
+		_spec.SetField(source.FieldIngestToken, field.TypeString, value)
+		_node.IngestToken = &value
+	}
+	if value, ok := sc.mutation.LastSuccessAt(); ok {
+		_spec.SetField(source.FieldLastSuccessAt, field.TypeTime, value)
+		_node.LastSuccessAt = &value
+	}
+	if value, ok := sc.mutation.LastError(); ok {
+		_spec.SetField(source.FieldLastError, field.TypeString, value)
+		_node.LastError = value
+	}
+	if value, ok := sc.mutation.LastErrorAt(); ok {
+		_spec.SetField(source.FieldLastErrorAt, field.TypeTime, value)
+		_node.LastErrorAt = &value
+	}
+	if value, ok := sc.mutation.ConsecutiveFailures(); ok {
+		_spec.SetField(source.FieldConsecutiveFailures, field.TypeInt, value)
+		_node.ConsecutiveFailures = value
+	}
+	if value, ok := sc.mutation.LastItems(); ok {
+		_spec.SetField(source.FieldLastItems, field.TypeInt, value)
+		_node.LastItems = value
+	}
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Source.Create().
+//		SetName(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SourceUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (sc *SourceCreate) OnConflict(opts ...sql.ConflictOption) *SourceUpsertOne {
+	sc.conflict = opts
+	return &SourceUpsertOne{
+		create: sc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Source.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (sc *SourceCreate) OnConflictColumns(columns ...string) *SourceUpsertOne {
+	sc.conflict = append(sc.conflict, sql.ConflictColumns(columns...))
+	return &SourceUpsertOne{
+		create: sc,
+	}
+}
+
+type (
+	// SourceUpsertOne is the builder for "upsert"-ing
+	//  one Source node.
+	SourceUpsertOne struct {
+		create *SourceCreate
+	}
+
+	// SourceUpsert is the "OnConflict" setter.
+	SourceUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetName sets the "name" field.
+func (u *SourceUpsert) SetName(v string) *SourceUpsert {
+	u.Set(source.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *SourceUpsert) UpdateName() *SourceUpsert {
+	u.SetExcluded(source.FieldName)
+	return u
+}
+
+// SetURL sets the "url" field.
+func (u *SourceUpsert) SetURL(v string) *SourceUpsert {
+	u.Set(source.FieldURL, v)
+	return u
+}
+
+// UpdateURL sets the "url" field to the value that was provided on create.
+func (u *SourceUpsert) UpdateURL() *SourceUpsert {
+	u.SetExcluded(source.FieldURL)
+	return u
+}
+
+// SetType sets the "type" field.
+func (u *SourceUpsert) SetType(v string) *SourceUpsert {
+	u.Set(source.FieldType, v)
+	return u
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *SourceUpsert) UpdateType() *SourceUpsert {
+	u.SetExcluded(source.FieldType)
+	return u
+}
+
+// SetRawJSON sets the "raw_json" field.
+func (u *SourceUpsert) SetRawJSON(v string) *SourceUpsert {
+	u.Set(source.FieldRawJSON, v)
+	return u
+}
+
+// UpdateRawJSON sets the "raw_json" field to the value that was provided on create.
+func (u *SourceUpsert) UpdateRawJSON() *SourceUpsert {
+	u.SetExcluded(source.FieldRawJSON)
+	return u
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *SourceUpsert) SetEnabled(v bool) *SourceUpsert {
+	u.Set(source.FieldEnabled, v)
+	return u
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *SourceUpsert) UpdateEnabled() *SourceUpsert {
+	u.SetExcluded(source.FieldEnabled)
+	return u
+}
+
+// SetManaged sets the "managed" field.
+func (u *SourceUpsert) SetManaged(v bool) *SourceUpsert {
+	u.Set(source.FieldManaged, v)
+	return u
+}
+
+// UpdateManaged sets the "managed" field to the value that was provided on create.
+func (u *SourceUpsert) UpdateManaged() *SourceUpsert {
+	u.SetExcluded(source.FieldManaged)
+	return u
+}
+
+// SetIngestToken sets the "ingest_token" field.
+func (u *SourceUpsert) SetIngestToken(v string) *SourceUpsert {
+	u.Set(source.FieldIngestToken, v)
+	return u
+}
+
+// UpdateIngestToken sets the "ingest_token" field to the value that was provided on create.
+func (u *SourceUpsert) UpdateIngestToken() *SourceUpsert {
+	u.SetExcluded(source.FieldIngestToken)
+	return u
+}
+
+// ClearIngestToken clears the value of the "ingest_token" field.
+func (u *SourceUpsert) ClearIngestToken() *SourceUpsert {
+	u.SetNull(source.FieldIngestToken)
+	return u
+}
+
+// SetLastSuccessAt sets the "last_success_at" field.
+func (u *SourceUpsert) SetLastSuccessAt(v time.Time) *SourceUpsert {
+	u.Set(source.FieldLastSuccessAt, v)
+	return u
+}
+
+// UpdateLastSuccessAt sets the "last_success_at" field to the value that was provided on create.
+func (u *SourceUpsert) UpdateLastSuccessAt() *SourceUpsert {
+	u.SetExcluded(source.FieldLastSuccessAt)
+	return u
+}
+
+// ClearLastSuccessAt clears the value of the "last_success_at" field.
+func (u *SourceUpsert) ClearLastSuccessAt() *SourceUpsert {
+	u.SetNull(source.FieldLastSuccessAt)
+	return u
+}
+
+// SetLastError sets the "last_error" field.
+func (u *SourceUpsert) SetLastError(v string) *SourceUpsert {
+	u.Set(source.FieldLastError, v)
+	return u
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *SourceUpsert) UpdateLastError() *SourceUpsert {
+	u.SetExcluded(source.FieldLastError)
+	return u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (u *SourceUpsert) ClearLastError() *SourceUpsert {
+	u.SetNull(source.FieldLastError)
+	return u
+}
+
+// SetLastErrorAt sets the "last_error_at" field.
+func (u *SourceUpsert) SetLastErrorAt(v time.Time) *SourceUpsert {
+	u.Set(source.FieldLastErrorAt, v)
+	return u
+}
+
+// UpdateLastErrorAt sets the "last_error_at" field to the value that was provided on create.
+func (u *SourceUpsert) UpdateLastErrorAt() *SourceUpsert {
+	u.SetExcluded(source.FieldLastErrorAt)
+	return u
+}
+
+// ClearLastErrorAt clears the value of the "last_error_at" field.
+func (u *SourceUpsert) ClearLastErrorAt() *SourceUpsert {
+	u.SetNull(source.FieldLastErrorAt)
+	return u
+}
+
+// SetConsecutiveFailures sets the "consecutive_failures" field.
+func (u *SourceUpsert) SetConsecutiveFailures(v int) *SourceUpsert {
+	u.Set(source.FieldConsecutiveFailures, v)
+	return u
+}
+
+// UpdateConsecutiveFailures sets the "consecutive_failures" field to the value that was provided on create.
+func (u *SourceUpsert) UpdateConsecutiveFailures() *SourceUpsert {
+	u.SetExcluded(source.FieldConsecutiveFailures)
+	return u
+}
+
+// AddConsecutiveFailures adds v to the "consecutive_failures" field.
+func (u *SourceUpsert) AddConsecutiveFailures(v int) *SourceUpsert {
+	u.Add(source.FieldConsecutiveFailures, v)
+	return u
+}
+
+// SetLastItems sets the "last_items" field.
+func (u *SourceUpsert) SetLastItems(v int) *SourceUpsert {
+	u.Set(source.FieldLastItems, v)
+	return u
+}
+
+// UpdateLastItems sets the "last_items" field to the value that was provided on create.
+func (u *SourceUpsert) UpdateLastItems() *SourceUpsert {
+	u.SetExcluded(source.FieldLastItems)
+	return u
+}
+
+// AddLastItems adds v to the "last_items" field.
+func (u *SourceUpsert) AddLastItems(v int) *SourceUpsert {
+	u.Add(source.FieldLastItems, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Source.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(source.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *SourceUpsertOne) UpdateNewValues() *SourceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(source.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Source.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *SourceUpsertOne) Ignore() *SourceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SourceUpsertOne) DoNothing() *SourceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SourceCreate.OnConflict
+// documentation for more info.
+func (u *SourceUpsertOne) Update(set func(*SourceUpsert)) *SourceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SourceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *SourceUpsertOne) SetName(v string) *SourceUpsertOne {
+	return u.Update(func(s *SourceUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *SourceUpsertOne) UpdateName() *SourceUpsertOne {
+	return u.Update(func(s *SourceUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetURL sets the "url" field.
+func (u *SourceUpsertOne) SetURL(v string) *SourceUpsertOne {
+	return u.Update(func(s *SourceUpsert) {
+		s.SetURL(v)
+	})
+}
+
+// UpdateURL sets the "url" field to the value that was provided on create.
+func (u *SourceUpsertOne) UpdateURL() *SourceUpsertOne {
+	return u.Update(func(s *SourceUpsert) {
+		s.UpdateURL()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *SourceUpsertOne) SetType(v string) *SourceUpsertOne {
+	return u.Update(func(s *SourceUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *SourceUpsertOne) UpdateType() *SourceUpsertOne {
+	return u.Update(func(s *SourceUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetRawJSON sets the "raw_json" field.
+func (u *SourceUpsertOne) SetRawJSON(v string) *SourceUpsertOne {
+	return u.Update(func(s *SourceUpsert) {
+		s.SetRawJSON(v)
+	})
+}
+
+// UpdateRawJSON sets the "raw_json" field to the value that was provided on create.
+func (u *SourceUpsertOne) UpdateRawJSON() *SourceUpsertOne {
+	return u.Update(func(s *SourceUpsert) {
+		s.UpdateRawJSON()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *SourceUpsertOne) SetEnabled(v bool) *SourceUpsertOne {
+	return u.Update(func(s *SourceUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *SourceUpsertOne) UpdateEnabled() *SourceUpsertOne {
+	return u.Update(func(s *SourceUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetManaged sets the "managed" field.
+func (u *SourceUpsertOne) SetManaged(v bool) *SourceUpsertOne {
+	return u.Update(func(s *SourceUpsert) {
+		s.SetManaged(v)
+	})
+}
+
+// UpdateManaged sets the "managed" field to the value that was provided on create.
+func (u *SourceUpsertOne) UpdateManaged() *SourceUpsertOne {
+	return u.Update(func(s *SourceUpsert) {
+		s.UpdateManaged()
+	})
+}
+
+// SetIngestToken sets the "ingest_token" field.
+func (u *SourceUpsertOne) SetIngestToken(v string) *SourceUpsertOne {
+	return u.Update(func(s *SourceUpsert) {
+		s.SetIngestToken(v)
+	})
+}
+
+// UpdateIngestToken sets the "ingest_token" field to the value that was provided on create.
+func (u *SourceUpsertOne) UpdateIngestToken() *SourceUpsertOne {
+	return u.Update(func(s *SourceUpsert) {
+		s.UpdateIngestToken()
+	})
+}
+
+// ClearIngestToken clears the value of the "ingest_token" field.
+func (u *SourceUpsertOne) ClearIngestToken() *SourceUpsertOne {
+	return u.Update(func(s *SourceUpsert) {
+		s.ClearIngestToken()
+	})
+}
+
+// SetLastSuccessAt sets the "last_success_at" field.
+func (u *SourceUpsertOne) SetLastSuccessAt(v time.Time) *SourceUpsertOne {
+	return u.Update(func(s *SourceUpsert) {
+		s.SetLastSuccessAt(v)
+	})
+}
+
+// UpdateLastSuccessAt sets the "last_success_at" field to the value that was provided on create.
+func (u *SourceUpsertOne) UpdateLastSuccessAt() *SourceUpsertOne {
+	return u.Update(func(s *SourceUpsert) {
+		s.UpdateLastSuccessAt()
+	})
+}
+
+// ClearLastSuccessAt clears the value of the "last_success_at" field.
+func (u *SourceUpsertOne) ClearLastSuccessAt() *SourceUpsertOne {
+	return u.Update(func(s *SourceUpsert) {
+		s.ClearLastSuccessAt()
+	})
+}
+
+// SetLastError sets the "last_error" field.
+func (u *SourceUpsertOne) SetLastError(v string) *SourceUpsertOne {
+	return u.Update(func(s *SourceUpsert) {
+		s.SetLastError(v)
+	})
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *SourceUpsertOne) UpdateLastError() *SourceUpsertOne {
+	return u.Update(func(s *SourceUpsert) {
+		s.UpdateLastError()
+	})
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (u *SourceUpsertOne) ClearLastError() *SourceUpsertOne {
+	return u.Update(func(s *SourceUpsert) {
+		s.ClearLastError()
+	})
+}
+
+// SetLastErrorAt sets the "last_error_at" field.
+func (u *SourceUpsertOne) SetLastErrorAt(v time.Time) *SourceUpsertOne {
+	return u.Update(func(s *SourceUpsert) {
+		s.SetLastErrorAt(v)
+	})
+}
+
+// UpdateLastErrorAt sets the "last_error_at" field to the value that was provided on create.
+func (u *SourceUpsertOne) UpdateLastErrorAt() *SourceUpsertOne {
+	return u.Update(func(s *SourceUpsert) {
+		s.UpdateLastErrorAt()
+	})
+}
+
+// ClearLastErrorAt clears the value of the "last_error_at" field.
+func (u *SourceUpsertOne) ClearLastErrorAt() *SourceUpsertOne {
+	return u.Update(func(s *SourceUpsert) {
+		s.ClearLastErrorAt()
+	})
+}
+
+// SetConsecutiveFailures sets the "consecutive_failures" field.
+func (u *SourceUpsertOne) SetConsecutiveFailures(v int) *SourceUpsertOne {
+	return u.Update(func(s *SourceUpsert) {
+		s.SetConsecutiveFailures(v)
+	})
+}
+
+// AddConsecutiveFailures adds v to the "consecutive_failures" field.
+func (u *SourceUpsertOne) AddConsecutiveFailures(v int) *SourceUpsertOne {
+	return u.Update(func(s *SourceUpsert) {
+		s.AddConsecutiveFailures(v)
+	})
+}
+
+// UpdateConsecutiveFailures sets the "consecutive_failures" field to the value that was provided on create.
+func (u *SourceUpsertOne) UpdateConsecutiveFailures() *SourceUpsertOne {
+	return u.Update(func(s *SourceUpsert) {
+		s.UpdateConsecutiveFailures()
+	})
+}
+
+// SetLastItems sets the "last_items" field.
+func (u *SourceUpsertOne) SetLastItems(v int) *SourceUpsertOne {
+	return u.Update(func(s *SourceUpsert) {
+		s.SetLastItems(v)
+	})
+}
+
+// AddLastItems adds v to the "last_items" field.
+func (u *SourceUpsertOne) AddLastItems(v int) *SourceUpsertOne {
+	return u.Update(func(s *SourceUpsert) {
+		s.AddLastItems(v)
+	})
+}
+
+// UpdateLastItems sets the "last_items" field to the value that was provided on create.
+func (u *SourceUpsertOne) UpdateLastItems() *SourceUpsertOne {
+	return u.Update(func(s *SourceUpsert) {
+		s.UpdateLastItems()
+	})
+}
+
+// Exec executes the query.
+func (u *SourceUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SourceCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SourceUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *SourceUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: SourceUpsertOne.ID is not supported by MySQL driver. Use SourceUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *SourceUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // SourceCreateBulk is the builder for creating many Source entities in bulk.
 type SourceCreateBulk struct {
 	config
 	err      error
 	builders []*SourceCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the Source entities in the database.
@@ -167,6 +875,7 @@ func (scb *SourceCreateBulk) Save(ctx context.Context) ([]*Source, error) {
 	for i := range scb.builders {
 		func(i int, root context.Context) {
 			builder := scb.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*SourceMutation)
 				if !ok {
@@ -182,6 +891,7 @@ func (scb *SourceCreateBulk) Save(ctx context.Context) ([]*Source, error) {
 					_, err = mutators[i+1].Mutate(root, scb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = scb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, scb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -231,3 +941,327 @@ func (scb *SourceCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Source.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SourceUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (scb *SourceCreateBulk) OnConflict(opts ...sql.ConflictOption) *SourceUpsertBulk {
+	scb.conflict = opts
+	return &SourceUpsertBulk{
+		create: scb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Source.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (scb *SourceCreateBulk) OnConflictColumns(columns ...string) *SourceUpsertBulk {
+	scb.conflict = append(scb.conflict, sql.ConflictColumns(columns...))
+	return &SourceUpsertBulk{
+		create: scb,
+	}
+}
+
+// SourceUpsertBulk is the builder for "upsert"-ing
+// a bulk of Source nodes.
+type SourceUpsertBulk struct {
+	create *SourceCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Source.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(source.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *SourceUpsertBulk) UpdateNewValues() *SourceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(source.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Source.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *SourceUpsertBulk) Ignore() *SourceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SourceUpsertBulk) DoNothing() *SourceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SourceCreateBulk.OnConflict
+// documentation for more info.
+func (u *SourceUpsertBulk) Update(set func(*SourceUpsert)) *SourceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SourceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *SourceUpsertBulk) SetName(v string) *SourceUpsertBulk {
+	return u.Update(func(s *SourceUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *SourceUpsertBulk) UpdateName() *SourceUpsertBulk {
+	return u.Update(func(s *SourceUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetURL sets the "url" field.
+func (u *SourceUpsertBulk) SetURL(v string) *SourceUpsertBulk {
+	return u.Update(func(s *SourceUpsert) {
+		s.SetURL(v)
+	})
+}
+
+// UpdateURL sets the "url" field to the value that was provided on create.
+func (u *SourceUpsertBulk) UpdateURL() *SourceUpsertBulk {
+	return u.Update(func(s *SourceUpsert) {
+		s.UpdateURL()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *SourceUpsertBulk) SetType(v string) *SourceUpsertBulk {
+	return u.Update(func(s *SourceUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *SourceUpsertBulk) UpdateType() *SourceUpsertBulk {
+	return u.Update(func(s *SourceUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetRawJSON sets the "raw_json" field.
+func (u *SourceUpsertBulk) SetRawJSON(v string) *SourceUpsertBulk {
+	return u.Update(func(s *SourceUpsert) {
+		s.SetRawJSON(v)
+	})
+}
+
+// UpdateRawJSON sets the "raw_json" field to the value that was provided on create.
+func (u *SourceUpsertBulk) UpdateRawJSON() *SourceUpsertBulk {
+	return u.Update(func(s *SourceUpsert) {
+		s.UpdateRawJSON()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *SourceUpsertBulk) SetEnabled(v bool) *SourceUpsertBulk {
+	return u.Update(func(s *SourceUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *SourceUpsertBulk) UpdateEnabled() *SourceUpsertBulk {
+	return u.Update(func(s *SourceUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetManaged sets the "managed" field.
+func (u *SourceUpsertBulk) SetManaged(v bool) *SourceUpsertBulk {
+	return u.Update(func(s *SourceUpsert) {
+		s.SetManaged(v)
+	})
+}
+
+// UpdateManaged sets the "managed" field to the value that was provided on create.
+func (u *SourceUpsertBulk) UpdateManaged() *SourceUpsertBulk {
+	return u.Update(func(s *SourceUpsert) {
+		s.UpdateManaged()
+	})
+}
+
+// SetIngestToken sets the "ingest_token" field.
+func (u *SourceUpsertBulk) SetIngestToken(v string) *SourceUpsertBulk {
+	return u.Update(func(s *SourceUpsert) {
+		s.SetIngestToken(v)
+	})
+}
+
+// UpdateIngestToken sets the "ingest_token" field to the value that was provided on create.
+func (u *SourceUpsertBulk) UpdateIngestToken() *SourceUpsertBulk {
+	return u.Update(func(s *SourceUpsert) {
+		s.UpdateIngestToken()
+	})
+}
+
+// ClearIngestToken clears the value of the "ingest_token" field.
+func (u *SourceUpsertBulk) ClearIngestToken() *SourceUpsertBulk {
+	return u.Update(func(s *SourceUpsert) {
+		s.ClearIngestToken()
+	})
+}
+
+// SetLastSuccessAt sets the "last_success_at" field.
+func (u *SourceUpsertBulk) SetLastSuccessAt(v time.Time) *SourceUpsertBulk {
+	return u.Update(func(s *SourceUpsert) {
+		s.SetLastSuccessAt(v)
+	})
+}
+
+// UpdateLastSuccessAt sets the "last_success_at" field to the value that was provided on create.
+func (u *SourceUpsertBulk) UpdateLastSuccessAt() *SourceUpsertBulk {
+	return u.Update(func(s *SourceUpsert) {
+		s.UpdateLastSuccessAt()
+	})
+}
+
+// ClearLastSuccessAt clears the value of the "last_success_at" field.
+func (u *SourceUpsertBulk) ClearLastSuccessAt() *SourceUpsertBulk {
+	return u.Update(func(s *SourceUpsert) {
+		s.ClearLastSuccessAt()
+	})
+}
+
+// SetLastError sets the "last_error" field.
+func (u *SourceUpsertBulk) SetLastError(v string) *SourceUpsertBulk {
+	return u.Update(func(s *SourceUpsert) {
+		s.SetLastError(v)
+	})
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *SourceUpsertBulk) UpdateLastError() *SourceUpsertBulk {
+	return u.Update(func(s *SourceUpsert) {
+		s.UpdateLastError()
+	})
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (u *SourceUpsertBulk) ClearLastError() *SourceUpsertBulk {
+	return u.Update(func(s *SourceUpsert) {
+		s.ClearLastError()
+	})
+}
+
+// SetLastErrorAt sets the "last_error_at" field.
+func (u *SourceUpsertBulk) SetLastErrorAt(v time.Time) *SourceUpsertBulk {
+	return u.Update(func(s *SourceUpsert) {
+		s.SetLastErrorAt(v)
+	})
+}
+
+// UpdateLastErrorAt sets the "last_error_at" field to the value that was provided on create.
+func (u *SourceUpsertBulk) UpdateLastErrorAt() *SourceUpsertBulk {
+	return u.Update(func(s *SourceUpsert) {
+		s.UpdateLastErrorAt()
+	})
+}
+
+// ClearLastErrorAt clears the value of the "last_error_at" field.
+func (u *SourceUpsertBulk) ClearLastErrorAt() *SourceUpsertBulk {
+	return u.Update(func(s *SourceUpsert) {
+		s.ClearLastErrorAt()
+	})
+}
+
+// SetConsecutiveFailures sets the "consecutive_failures" field.
+func (u *SourceUpsertBulk) SetConsecutiveFailures(v int) *SourceUpsertBulk {
+	return u.Update(func(s *SourceUpsert) {
+		s.SetConsecutiveFailures(v)
+	})
+}
+
+// AddConsecutiveFailures adds v to the "consecutive_failures" field.
+func (u *SourceUpsertBulk) AddConsecutiveFailures(v int) *SourceUpsertBulk {
+	return u.Update(func(s *SourceUpsert) {
+		s.AddConsecutiveFailures(v)
+	})
+}
+
+// UpdateConsecutiveFailures sets the "consecutive_failures" field to the value that was provided on create.
+func (u *SourceUpsertBulk) UpdateConsecutiveFailures() *SourceUpsertBulk {
+	return u.Update(func(s *SourceUpsert) {
+		s.UpdateConsecutiveFailures()
+	})
+}
+
+// SetLastItems sets the "last_items" field.
+func (u *SourceUpsertBulk) SetLastItems(v int) *SourceUpsertBulk {
+	return u.Update(func(s *SourceUpsert) {
+		s.SetLastItems(v)
+	})
+}
+
+// AddLastItems adds v to the "last_items" field.
+func (u *SourceUpsertBulk) AddLastItems(v int) *SourceUpsertBulk {
+	return u.Update(func(s *SourceUpsert) {
+		s.AddLastItems(v)
+	})
+}
+
+// UpdateLastItems sets the "last_items" field to the value that was provided on create.
+func (u *SourceUpsertBulk) UpdateLastItems() *SourceUpsertBulk {
+	return u.Update(func(s *SourceUpsert) {
+		s.UpdateLastItems()
+	})
+}
+
+// Exec executes the query.
+func (u *SourceUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the SourceCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SourceCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SourceUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -83,6 +84,156 @@ func (su *SourceUpdate) SetNillableRawJSON(s *string) *SourceUpdate {
 	return su
 }
 
+// SetEnabled sets the "enabled" field.
+func (su *SourceUpdate) SetEnabled(b bool) *SourceUpdate {
+	su.mutation.SetEnabled(b)
+	return su
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (su *SourceUpdate) SetNillableEnabled(b *bool) *SourceUpdate {
+	if b != nil {
+		su.SetEnabled(*b)
+	}
+	return su
+}
+
+// SetManaged sets the "managed" field.
+func (su *SourceUpdate) SetManaged(b bool) *SourceUpdate {
+	su.mutation.SetManaged(b)
+	return su
+}
+
+// SetNillableManaged sets the "managed" field if the given value is not nil.
+func (su *SourceUpdate) SetNillableManaged(b *bool) *SourceUpdate {
+	if b != nil {
+		su.SetManaged(*b)
+	}
+	return su
+}
+
+// SetIngestToken sets the "ingest_token" field.
+func (su *SourceUpdate) SetIngestToken(s string) *SourceUpdate {
+	su.mutation.SetIngestToken(s)
+	return su
+}
+
+// SetNillableIngestToken sets the "ingest_token" field if the given value is not nil.
+func (su *SourceUpdate) SetNillableIngestToken(s *string) *SourceUpdate {
+	if s != nil {
+		su.SetIngestToken(*s)
+	}
+	return su
+}
+
+// ClearIngestToken clears the value of the "ingest_token" field.
+func (su *SourceUpdate) ClearIngestToken() *SourceUpdate {
+	su.mutation.ClearIngestToken()
+	return su
+}
+
+// SetLastSuccessAt sets the "last_success_at" field.
+func (su *SourceUpdate) SetLastSuccessAt(t time.Time) *SourceUpdate {
+	su.mutation.SetLastSuccessAt(t)
+	return su
+}
+
+// SetNillableLastSuccessAt sets the "last_success_at" field if the given value is not nil.
+func (su *SourceUpdate) SetNillableLastSuccessAt(t *time.Time) *SourceUpdate {
+	if t != nil {
+		su.SetLastSuccessAt(*t)
+	}
+	return su
+}
+
+// ClearLastSuccessAt clears the value of the "last_success_at" field.
+func (su *SourceUpdate) ClearLastSuccessAt() *SourceUpdate {
+	su.mutation.ClearLastSuccessAt()
+	return su
+}
+
+// SetLastError sets the "last_error" field.
+func (su *SourceUpdate) SetLastError(s string) *SourceUpdate {
+	su.mutation.SetLastError(s)
+	return su
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (su *SourceUpdate) SetNillableLastError(s *string) *SourceUpdate {
+	if s != nil {
+		su.SetLastError(*s)
+	}
+	return su
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (su *SourceUpdate) ClearLastError() *SourceUpdate {
+	su.mutation.ClearLastError()
+	return su
+}
+
+// SetLastErrorAt sets the "last_error_at" field.
+func (su *SourceUpdate) SetLastErrorAt(t time.Time) *SourceUpdate {
+	su.mutation.SetLastErrorAt(t)
+	return su
+}
+
+// SetNillableLastErrorAt sets the "last_error_at" field if the given value is not nil.
+func (su *SourceUpdate) SetNillableLastErrorAt(t *time.Time) *SourceUpdate {
+	if t != nil {
+		su.SetLastErrorAt(*t)
+	}
+	return su
+}
+
+// ClearLastErrorAt clears the value of the "last_error_at" field.
+func (su *SourceUpdate) ClearLastErrorAt() *SourceUpdate {
+	su.mutation.ClearLastErrorAt()
+	return su
+}
+
+// SetConsecutiveFailures sets the "consecutive_failures" field.
+func (su *SourceUpdate) SetConsecutiveFailures(i int) *SourceUpdate {
+	su.mutation.ResetConsecutiveFailures()
+	su.mutation.SetConsecutiveFailures(i)
+	return su
+}
+
+// SetNillableConsecutiveFailures sets the "consecutive_failures" field if the given value is not nil.
+func (su *SourceUpdate) SetNillableConsecutiveFailures(i *int) *SourceUpdate {
+	if i != nil {
+		su.SetConsecutiveFailures(*i)
+	}
+	return su
+}
+
+// AddConsecutiveFailures adds i to the "consecutive_failures" field.
+func (su *SourceUpdate) AddConsecutiveFailures(i int) *SourceUpdate {
+	su.mutation.AddConsecutiveFailures(i)
+	return su
+}
+
+// SetLastItems sets the "last_items" field.
+func (su *SourceUpdate) SetLastItems(i int) *SourceUpdate {
+	su.mutation.ResetLastItems()
+	su.mutation.SetLastItems(i)
+	return su
+}
+
+// SetNillableLastItems sets the "last_items" field if the given value is not nil.
+func (su *SourceUpdate) SetNillableLastItems(i *int) *SourceUpdate {
+	if i != nil {
+		su.SetLastItems(*i)
+	}
+	return su
+}
+
+// AddLastItems adds i to the "last_items" field.
+func (su *SourceUpdate) AddLastItems(i int) *SourceUpdate {
+	su.mutation.AddLastItems(i)
+	return su
+}
+
 // Mutation returns the SourceMutation object of the builder.
 func (su *SourceUpdate) Mutation() *SourceMutation {
 	return su.mutation
@@ -136,6 +287,48 @@ func (su *SourceUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := su.mutation.RawJSON(); ok {
 		_spec.SetField(source.FieldRawJSON, field.TypeString, value)
 	}
+	if value, ok := su.mutation.Enabled(); ok {
+		_spec.SetField(source.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := su.mutation.Managed(); ok {
+		_spec.SetField(source.FieldManaged, field.TypeBool, value)
+	}
+	if value, ok := su.mutation.IngestToken(); ok {
+		_spec.SetField(source.FieldIngestToken, field.TypeString, value)
+	}
+	if su.mutation.IngestTokenCleared() {
+		_spec.ClearField(source.FieldIngestToken, field.TypeString)
+	}
+	if value, ok := su.mutation.LastSuccessAt(); ok {
+		_spec.SetField(source.FieldLastSuccessAt, field.TypeTime, value)
+	}
+	if su.mutation.LastSuccessAtCleared() {
+		_spec.ClearField(source.FieldLastSuccessAt, field.TypeTime)
+	}
+	if value, ok := su.mutation.LastError(); ok {
+		_spec.SetField(source.FieldLastError, field.TypeString, value)
+	}
+	if su.mutation.LastErrorCleared() {
+		_spec.ClearField(source.FieldLastError, field.TypeString)
+	}
+	if value, ok := su.mutation.LastErrorAt(); ok {
+		_spec.SetField(source.FieldLastErrorAt, field.TypeTime, value)
+	}
+	if su.mutation.LastErrorAtCleared() {
+		_spec.ClearField(source.FieldLastErrorAt, field.TypeTime)
+	}
+	if value, ok := su.mutation.ConsecutiveFailures(); ok {
+		_spec.SetField(source.FieldConsecutiveFailures, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.AddedConsecutiveFailures(); ok {
+		_spec.AddField(source.FieldConsecutiveFailures, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.LastItems(); ok {
+		_spec.SetField(source.FieldLastItems, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.AddedLastItems(); ok {
+		_spec.AddField(source.FieldLastItems, field.TypeInt, value)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, su.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{source.Label}
@@ -212,6 +405,156 @@ func (suo *SourceUpdateOne) SetNillableRawJSON(s *string) *SourceUpdateOne {
 	return suo
 }
 
+// SetEnabled sets the "enabled" field.
+func (suo *SourceUpdateOne) SetEnabled(b bool) *SourceUpdateOne {
+	suo.mutation.SetEnabled(b)
+	return suo
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (suo *SourceUpdateOne) SetNillableEnabled(b *bool) *SourceUpdateOne {
+	if b != nil {
+		suo.SetEnabled(*b)
+	}
+	return suo
+}
+
+// SetManaged sets the "managed" field.
+func (suo *SourceUpdateOne) SetManaged(b bool) *SourceUpdateOne {
+	suo.mutation.SetManaged(b)
+	return suo
+}
+
+// SetNillableManaged sets the "managed" field if the given value is not nil.
+func (suo *SourceUpdateOne) SetNillableManaged(b *bool) *SourceUpdateOne {
+	if b != nil {
+		suo.SetManaged(*b)
+	}
+	return suo
+}
+
+// SetIngestToken sets the "ingest_token" field.
+func (suo *SourceUpdateOne) SetIngestToken(s string) *SourceUpdateOne {
+	suo.mutation.SetIngestToken(s)
+	return suo
+}
+
+// SetNillableIngestToken sets the "ingest_token" field if the given value is not nil.
+func (suo *SourceUpdateOne) SetNillableIngestToken(s *string) *SourceUpdateOne {
+	if s != nil {
+		suo.SetIngestToken(*s)
+	}
+	return suo
+}
+
+// ClearIngestToken clears the value of the "ingest_token" field.
+func (suo *SourceUpdateOne) ClearIngestToken() *SourceUpdateOne {
+	suo.mutation.ClearIngestToken()
+	return suo
+}
+
+// SetLastSuccessAt sets the "last_success_at" field.
+func (suo *SourceUpdateOne) SetLastSuccessAt(t time.Time) *SourceUpdateOne {
+	suo.mutation.SetLastSuccessAt(t)
+	return suo
+}
+
+// SetNillableLastSuccessAt sets the "last_success_at" field if the given value is not nil.
+func (suo *SourceUpdateOne) SetNillableLastSuccessAt(t *time.Time) *SourceUpdateOne {
+	if t != nil {
+		suo.SetLastSuccessAt(*t)
+	}
+	return suo
+}
+
+// ClearLastSuccessAt clears the value of the "last_success_at" field.
+func (suo *SourceUpdateOne) ClearLastSuccessAt() *SourceUpdateOne {
+	suo.mutation.ClearLastSuccessAt()
+	return suo
+}
+
+// SetLastError sets the "last_error" field.
+func (suo *SourceUpdateOne) SetLastError(s string) *SourceUpdateOne {
+	suo.mutation.SetLastError(s)
+	return suo
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (suo *SourceUpdateOne) SetNillableLastError(s *string) *SourceUpdateOne {
+	if s != nil {
+		suo.SetLastError(*s)
+	}
+	return suo
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (suo *SourceUpdateOne) ClearLastError() *SourceUpdateOne {
+	suo.mutation.ClearLastError()
+	return suo
+}
+
+// SetLastErrorAt sets the "last_error_at" field.
+func (suo *SourceUpdateOne) SetLastErrorAt(t time.Time) *SourceUpdateOne {
+	suo.mutation.SetLastErrorAt(t)
+	return suo
+}
+
+// SetNillableLastErrorAt sets the "last_error_at" field if the given value is not nil.
+func (suo *SourceUpdateOne) SetNillableLastErrorAt(t *time.Time) *SourceUpdateOne {
+	if t != nil {
+		suo.SetLastErrorAt(*t)
+	}
+	return suo
+}
+
+// ClearLastErrorAt clears the value of the "last_error_at" field.
+func (suo *SourceUpdateOne) ClearLastErrorAt() *SourceUpdateOne {
+	suo.mutation.ClearLastErrorAt()
+	return suo
+}
+
+// SetConsecutiveFailures sets the "consecutive_failures" field.
+func (suo *SourceUpdateOne) SetConsecutiveFailures(i int) *SourceUpdateOne {
+	suo.mutation.ResetConsecutiveFailures()
+	suo.mutation.SetConsecutiveFailures(i)
+	return suo
+}
+
+// SetNillableConsecutiveFailures sets the "consecutive_failures" field if the given value is not nil.
+func (suo *SourceUpdateOne) SetNillableConsecutiveFailures(i *int) *SourceUpdateOne {
+	if i != nil {
+		suo.SetConsecutiveFailures(*i)
+	}
+	return suo
+}
+
+// AddConsecutiveFailures adds i to the "consecutive_failures" field.
+func (suo *SourceUpdateOne) AddConsecutiveFailures(i int) *SourceUpdateOne {
+	suo.mutation.AddConsecutiveFailures(i)
+	return suo
+}
+
+// SetLastItems sets the "last_items" field.
+func (suo *SourceUpdateOne) SetLastItems(i int) *SourceUpdateOne {
+	suo.mutation.ResetLastItems()
+	suo.mutation.SetLastItems(i)
+	return suo
+}
+
+// SetNillableLastItems sets the "last_items" field if the given value is not nil.
+func (suo *SourceUpdateOne) SetNillableLastItems(i *int) *SourceUpdateOne {
+	if i != nil {
+		suo.SetLastItems(*i)
+	}
+	return suo
+}
+
+// AddLastItems adds i to the "last_items" field.
+func (suo *SourceUpdateOne) AddLastItems(i int) *SourceUpdateOne {
+	suo.mutation.AddLastItems(i)
+	return suo
+}
+
 // Mutation returns the SourceMutation object of the builder.
 func (suo *SourceUpdateOne) Mutation() *SourceMutation {
 	return suo.mutation
@@ -295,6 +638,48 @@ func (suo *SourceUpdateOne) sqlSave(ctx context.Context) (_node *Source, err err
 	if value, ok := suo.mutation.RawJSON(); ok {
 		_spec.SetField(source.FieldRawJSON, field.TypeString, value)
 	}
+	if value, ok := suo.mutation.Enabled(); ok {
+		_spec.SetField(source.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := suo.mutation.Managed(); ok {
+		_spec.SetField(source.FieldManaged, field.TypeBool, value)
+	}
+	if value, ok := suo.mutation.IngestToken(); ok {
+		_spec.SetField(source.FieldIngestToken, field.TypeString, value)
+	}
+	if suo.mutation.IngestTokenCleared() {
+		_spec.ClearField(source.FieldIngestToken, field.TypeString)
+	}
+	if value, ok := suo.mutation.LastSuccessAt(); ok {
+		_spec.SetField(source.FieldLastSuccessAt, field.TypeTime, value)
+	}
+	if suo.mutation.LastSuccessAtCleared() {
+		_spec.ClearField(source.FieldLastSuccessAt, field.TypeTime)
+	}
+	if value, ok := suo.mutation.LastError(); ok {
+		_spec.SetField(source.FieldLastError, field.TypeString, value)
+	}
+	if suo.mutation.LastErrorCleared() {
+		_spec.ClearField(source.FieldLastError, field.TypeString)
+	}
+	if value, ok := suo.mutation.LastErrorAt(); ok {
+		_spec.SetField(source.FieldLastErrorAt, field.TypeTime, value)
+	}
+	if suo.mutation.LastErrorAtCleared() {
+		_spec.ClearField(source.FieldLastErrorAt, field.TypeTime)
+	}
+	if value, ok := suo.mutation.ConsecutiveFailures(); ok {
+		_spec.SetField(source.FieldConsecutiveFailures, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.AddedConsecutiveFailures(); ok {
+		_spec.AddField(source.FieldConsecutiveFailures, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.LastItems(); ok {
+		_spec.SetField(source.FieldLastItems, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.AddedLastItems(); ok {
+		_spec.AddField(source.FieldLastItems, field.TypeInt, value)
+	}
 	_node = &Source{config: suo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,150 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/glanceapp/glance/pkg/storage/postgres/ent/sourcerun"
+)
+
+// SourceRun is the model entity for the SourceRun schema.
+type SourceRun struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// SourceUID holds the value of the "source_uid" field.
+	SourceUID string `json:"source_uid,omitempty"`
+	// StartedAt holds the value of the "started_at" field.
+	StartedAt time.Time `json:"started_at,omitempty"`
+	// FinishedAt holds the value of the "finished_at" field.
+	FinishedAt time.Time `json:"finished_at,omitempty"`
+	// Items holds the value of the "items" field.
+	Items int `json:"items,omitempty"`
+	// Error holds the value of the "error" field.
+	Error        string `json:"error,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SourceRun) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case sourcerun.FieldID, sourcerun.FieldItems:
+			values[i] = new(sql.NullInt64)
+		case sourcerun.FieldSourceUID, sourcerun.FieldError:
+			values[i] = new(sql.NullString)
+		case sourcerun.FieldStartedAt, sourcerun.FieldFinishedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SourceRun fields.
+func (sr *SourceRun) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case sourcerun.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			sr.ID = int(value.Int64)
+		case sourcerun.FieldSourceUID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source_uid", values[i])
+			} else if value.Valid {
+				sr.SourceUID = value.String
+			}
+		case sourcerun.FieldStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field started_at", values[i])
+			} else if value.Valid {
+				sr.StartedAt = value.Time
+			}
+		case sourcerun.FieldFinishedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field finished_at", values[i])
+			} else if value.Valid {
+				sr.FinishedAt = value.Time
+			}
+		case sourcerun.FieldItems:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field items", values[i])
+			} else if value.Valid {
+				sr.Items = int(value.Int64)
+			}
+		case sourcerun.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				sr.Error = value.String
+			}
+		default:
+			sr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SourceRun.
+// This includes values selected through modifiers, order, etc.
+func (sr *SourceRun) Value(name string) (ent.Value, error) {
+	return sr.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this SourceRun.
+// Note that you need to call SourceRun.Unwrap() before calling this method if this SourceRun
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sr *SourceRun) Update() *SourceRunUpdateOne {
+	return NewSourceRunClient(sr.config).UpdateOne(sr)
+}
+
+// Unwrap unwraps the SourceRun entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sr *SourceRun) Unwrap() *SourceRun {
+	_tx, ok := sr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SourceRun is not a transactional entity")
+	}
+	sr.config.driver = _tx.drv
+	return sr
+}
+
+// String implements the fmt.Stringer.
+func (sr *SourceRun) String() string {
+	var builder strings.Builder
+	builder.WriteString("SourceRun(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sr.ID))
+	builder.WriteString("source_uid=")
+	builder.WriteString(sr.SourceUID)
+	builder.WriteString(", ")
+	builder.WriteString("started_at=")
+	builder.WriteString(sr.StartedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("finished_at=")
+	builder.WriteString(sr.FinishedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("items=")
+	builder.WriteString(fmt.Sprintf("%v", sr.Items))
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(sr.Error)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SourceRuns is a parsable slice of SourceRun.
+type SourceRuns []*SourceRun
@@ -0,0 +1,84 @@
+// Code generated by ent, DO NOT EDIT.
+
+package sourcerun
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the sourcerun type in the database.
+	Label = "source_run"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldSourceUID holds the string denoting the source_uid field in the database.
+	FieldSourceUID = "source_uid"
+	// FieldStartedAt holds the string denoting the started_at field in the database.
+	FieldStartedAt = "started_at"
+	// FieldFinishedAt holds the string denoting the finished_at field in the database.
+	FieldFinishedAt = "finished_at"
+	// FieldItems holds the string denoting the items field in the database.
+	FieldItems = "items"
+	// FieldError holds the string denoting the error field in the database.
+	FieldError = "error"
+	// Table holds the table name of the sourcerun in the database.
+	Table = "source_runs"
+)
+
+// Columns holds all SQL columns for sourcerun fields.
+var Columns = []string{
+	FieldID,
+	FieldSourceUID,
+	FieldStartedAt,
+	FieldFinishedAt,
+	FieldItems,
+	FieldError,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultItems holds the default value on creation for the "items" field.
+	DefaultItems int
+)
+
+// OrderOption defines the ordering options for the SourceRun queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// BySourceUID orders the results by the source_uid field.
+func BySourceUID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSourceUID, opts...).ToFunc()
+}
+
+// ByStartedAt orders the results by the started_at field.
+func ByStartedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartedAt, opts...).ToFunc()
+}
+
+// ByFinishedAt orders the results by the finished_at field.
+func ByFinishedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFinishedAt, opts...).ToFunc()
+}
+
+// ByItems orders the results by the items field.
+func ByItems(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldItems, opts...).ToFunc()
+}
+
+// ByError orders the results by the error field.
+func ByError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldError, opts...).ToFunc()
+}
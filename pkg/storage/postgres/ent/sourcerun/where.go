@@ -0,0 +1,355 @@
+// Code generated by ent, DO NOT EDIT.
+
+package sourcerun
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/glanceapp/glance/pkg/storage/postgres/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.SourceRun {
+	return predicate.SourceRun(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.SourceRun {
+	return predicate.SourceRun(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.SourceRun {
+	return predicate.SourceRun(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.SourceRun {
+	return predicate.SourceRun(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.SourceRun {
+	return predicate.SourceRun(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.SourceRun {
+	return predicate.SourceRun(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.SourceRun {
+	return predicate.SourceRun(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.SourceRun {
+	return predicate.SourceRun(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.SourceRun {
+	return predicate.SourceRun(sql.FieldLTE(FieldID, id))
+}
+
+// SourceUID applies equality check predicate on the "source_uid" field. It's identical to SourceUIDEQ.
+func SourceUID(v string) predicate.SourceRun {
+	return predicate.SourceRun(sql.FieldEQ(FieldSourceUID, v))
+}
+
+// StartedAt applies equality check predicate on the "started_at" field. It's identical to StartedAtEQ.
+func StartedAt(v time.Time) predicate.SourceRun {
+	return predicate.SourceRun(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// FinishedAt applies equality check predicate on the "finished_at" field. It's identical to FinishedAtEQ.
+func FinishedAt(v time.Time) predicate.SourceRun {
+	return predicate.SourceRun(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// Items applies equality check predicate on the "items" field. It's identical to ItemsEQ.
+func Items(v int) predicate.SourceRun {
+	return predicate.SourceRun(sql.FieldEQ(FieldItems, v))
+}
+
+// Error applies equality check predicate on the "error" field. It's identical to ErrorEQ.
+func Error(v string) predicate.SourceRun {
+	return predicate.SourceRun(sql.FieldEQ(FieldError, v))
+}
+
+// SourceUIDEQ applies the EQ predicate on the "source_uid" field.
+func SourceUIDEQ(v string) predicate.SourceRun {
+	return predicate.SourceRun(sql.FieldEQ(FieldSourceUID, v))
+}
+
+// SourceUIDNEQ applies the NEQ predicate on the "source_uid" field.
+func SourceUIDNEQ(v string) predicate.SourceRun {
+	return predicate.SourceRun(sql.FieldNEQ(FieldSourceUID, v))
+}
+
+// SourceUIDIn applies the In predicate on the "source_uid" field.
+func SourceUIDIn(vs ...string) predicate.SourceRun {
+	return predicate.SourceRun(sql.FieldIn(FieldSourceUID, vs...))
+}
+
+// SourceUIDNotIn applies the NotIn predicate on the "source_uid" field.
+func SourceUIDNotIn(vs ...string) predicate.SourceRun {
+	return predicate.SourceRun(sql.FieldNotIn(FieldSourceUID, vs...))
+}
+
+// SourceUIDGT applies the GT predicate on the "source_uid" field.
+func SourceUIDGT(v string) predicate.SourceRun {
+	return predicate.SourceRun(sql.FieldGT(FieldSourceUID, v))
+}
+
+// SourceUIDGTE applies the GTE predicate on the "source_uid" field.
+func SourceUIDGTE(v string) predicate.SourceRun {
+	return predicate.SourceRun(sql.FieldGTE(FieldSourceUID, v))
+}
+
+// SourceUIDLT applies the LT predicate on the "source_uid" field.
+func SourceUIDLT(v string) predicate.SourceRun {
+	return predicate.SourceRun(sql.FieldLT(FieldSourceUID, v))
+}
+
+// SourceUIDLTE applies the LTE predicate on the "source_uid" field.
+func SourceUIDLTE(v string) predicate.SourceRun {
+	return predicate.SourceRun(sql.FieldLTE(FieldSourceUID, v))
+}
+
+// SourceUIDContains applies the Contains predicate on the "source_uid" field.
+func SourceUIDContains(v string) predicate.SourceRun {
+	return predicate.SourceRun(sql.FieldContains(FieldSourceUID, v))
+}
+
+// SourceUIDHasPrefix applies the HasPrefix predicate on the "source_uid" field.
+func SourceUIDHasPrefix(v string) predicate.SourceRun {
+	return predicate.SourceRun(sql.FieldHasPrefix(FieldSourceUID, v))
+}
+
+// SourceUIDHasSuffix applies the HasSuffix predicate on the "source_uid" field.
+func SourceUIDHasSuffix(v string) predicate.SourceRun {
+	return predicate.SourceRun(sql.FieldHasSuffix(FieldSourceUID, v))
+}
+
+// SourceUIDEqualFold applies the EqualFold predicate on the "source_uid" field.
+func SourceUIDEqualFold(v string) predicate.SourceRun {
+	return predicate.SourceRun(sql.FieldEqualFold(FieldSourceUID, v))
+}
+
+// SourceUIDContainsFold applies the ContainsFold predicate on the "source_uid" field.
+func SourceUIDContainsFold(v string) predicate.SourceRun {
+	return predicate.SourceRun(sql.FieldContainsFold(FieldSourceUID, v))
+}
+
+// StartedAtEQ applies the EQ predicate on the "started_at" field.
+func StartedAtEQ(v time.Time) predicate.SourceRun {
+	return predicate.SourceRun(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// StartedAtNEQ applies the NEQ predicate on the "started_at" field.
+func StartedAtNEQ(v time.Time) predicate.SourceRun {
+	return predicate.SourceRun(sql.FieldNEQ(FieldStartedAt, v))
+}
+
+// StartedAtIn applies the In predicate on the "started_at" field.
+func StartedAtIn(vs ...time.Time) predicate.SourceRun {
+	return predicate.SourceRun(sql.FieldIn(FieldStartedAt, vs...))
+}
+
+// StartedAtNotIn applies the NotIn predicate on the "started_at" field.
+func StartedAtNotIn(vs ...time.Time) predicate.SourceRun {
+	return predicate.SourceRun(sql.FieldNotIn(FieldStartedAt, vs...))
+}
+
+// StartedAtGT applies the GT predicate on the "started_at" field.
+func StartedAtGT(v time.Time) predicate.SourceRun {
+	return predicate.SourceRun(sql.FieldGT(FieldStartedAt, v))
+}
+
+// StartedAtGTE applies the GTE predicate on the "started_at" field.
+func StartedAtGTE(v time.Time) predicate.SourceRun {
+	return predicate.SourceRun(sql.FieldGTE(FieldStartedAt, v))
+}
+
+// StartedAtLT applies the LT predicate on the "started_at" field.
+func StartedAtLT(v time.Time) predicate.SourceRun {
+	return predicate.SourceRun(sql.FieldLT(FieldStartedAt, v))
+}
+
+// StartedAtLTE applies the LTE predicate on the "started_at" field.
+func StartedAtLTE(v time.Time) predicate.SourceRun {
+	return predicate.SourceRun(sql.FieldLTE(FieldStartedAt, v))
+}
+
+// FinishedAtEQ applies the EQ predicate on the "finished_at" field.
+func FinishedAtEQ(v time.Time) predicate.SourceRun {
+	return predicate.SourceRun(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtNEQ applies the NEQ predicate on the "finished_at" field.
+func FinishedAtNEQ(v time.Time) predicate.SourceRun {
+	return predicate.SourceRun(sql.FieldNEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtIn applies the In predicate on the "finished_at" field.
+func FinishedAtIn(vs ...time.Time) predicate.SourceRun {
+	return predicate.SourceRun(sql.FieldIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtNotIn applies the NotIn predicate on the "finished_at" field.
+func FinishedAtNotIn(vs ...time.Time) predicate.SourceRun {
+	return predicate.SourceRun(sql.FieldNotIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtGT applies the GT predicate on the "finished_at" field.
+func FinishedAtGT(v time.Time) predicate.SourceRun {
+	return predicate.SourceRun(sql.FieldGT(FieldFinishedAt, v))
+}
+
+// FinishedAtGTE applies the GTE predicate on the "finished_at" field.
+func FinishedAtGTE(v time.Time) predicate.SourceRun {
+	return predicate.SourceRun(sql.FieldGTE(FieldFinishedAt, v))
+}
+
+// FinishedAtLT applies the LT predicate on the "finished_at" field.
+func FinishedAtLT(v time.Time) predicate.SourceRun {
+	return predicate.SourceRun(sql.FieldLT(FieldFinishedAt, v))
+}
+
+// FinishedAtLTE applies the LTE predicate on the "finished_at" field.
+func FinishedAtLTE(v time.Time) predicate.SourceRun {
+	return predicate.SourceRun(sql.FieldLTE(FieldFinishedAt, v))
+}
+
+// ItemsEQ applies the EQ predicate on the "items" field.
+func ItemsEQ(v int) predicate.SourceRun {
+	return predicate.SourceRun(sql.FieldEQ(FieldItems, v))
+}
+
+// ItemsNEQ applies the NEQ predicate on the "items" field.
+func ItemsNEQ(v int) predicate.SourceRun {
+	return predicate.SourceRun(sql.FieldNEQ(FieldItems, v))
+}
+
+// ItemsIn applies the In predicate on the "items" field.
+func ItemsIn(vs ...int) predicate.SourceRun {
+	return predicate.SourceRun(sql.FieldIn(FieldItems, vs...))
+}
+
+// ItemsNotIn applies the NotIn predicate on the "items" field.
+func ItemsNotIn(vs ...int) predicate.SourceRun {
+	return predicate.SourceRun(sql.FieldNotIn(FieldItems, vs...))
+}
+
+// ItemsGT applies the GT predicate on the "items" field.
+func ItemsGT(v int) predicate.SourceRun {
+	return predicate.SourceRun(sql.FieldGT(FieldItems, v))
+}
+
+// ItemsGTE applies the GTE predicate on the "items" field.
+func ItemsGTE(v int) predicate.SourceRun {
+	return predicate.SourceRun(sql.FieldGTE(FieldItems, v))
+}
+
+// ItemsLT applies the LT predicate on the "items" field.
+func ItemsLT(v int) predicate.SourceRun {
+	return predicate.SourceRun(sql.FieldLT(FieldItems, v))
+}
+
+// ItemsLTE applies the LTE predicate on the "items" field.
+func ItemsLTE(v int) predicate.SourceRun {
+	return predicate.SourceRun(sql.FieldLTE(FieldItems, v))
+}
+
+// ErrorEQ applies the EQ predicate on the "error" field.
+func ErrorEQ(v string) predicate.SourceRun {
+	return predicate.SourceRun(sql.FieldEQ(FieldError, v))
+}
+
+// ErrorNEQ applies the NEQ predicate on the "error" field.
+func ErrorNEQ(v string) predicate.SourceRun {
+	return predicate.SourceRun(sql.FieldNEQ(FieldError, v))
+}
+
+// ErrorIn applies the In predicate on the "error" field.
+func ErrorIn(vs ...string) predicate.SourceRun {
+	return predicate.SourceRun(sql.FieldIn(FieldError, vs...))
+}
+
+// ErrorNotIn applies the NotIn predicate on the "error" field.
+func ErrorNotIn(vs ...string) predicate.SourceRun {
+	return predicate.SourceRun(sql.FieldNotIn(FieldError, vs...))
+}
+
+// ErrorGT applies the GT predicate on the "error" field.
+func ErrorGT(v string) predicate.SourceRun {
+	return predicate.SourceRun(sql.FieldGT(FieldError, v))
+}
+
+// ErrorGTE applies the GTE predicate on the "error" field.
+func ErrorGTE(v string) predicate.SourceRun {
+	return predicate.SourceRun(sql.FieldGTE(FieldError, v))
+}
+
+// ErrorLT applies the LT predicate on the "error" field.
+func ErrorLT(v string) predicate.SourceRun {
+	return predicate.SourceRun(sql.FieldLT(FieldError, v))
+}
+
+// ErrorLTE applies the LTE predicate on the "error" field.
+func ErrorLTE(v string) predicate.SourceRun {
+	return predicate.SourceRun(sql.FieldLTE(FieldError, v))
+}
+
+// ErrorContains applies the Contains predicate on the "error" field.
+func ErrorContains(v string) predicate.SourceRun {
+	return predicate.SourceRun(sql.FieldContains(FieldError, v))
+}
+
+// ErrorHasPrefix applies the HasPrefix predicate on the "error" field.
+func ErrorHasPrefix(v string) predicate.SourceRun {
+	return predicate.SourceRun(sql.FieldHasPrefix(FieldError, v))
+}
+
+// ErrorHasSuffix applies the HasSuffix predicate on the "error" field.
+func ErrorHasSuffix(v string) predicate.SourceRun {
+	return predicate.SourceRun(sql.FieldHasSuffix(FieldError, v))
+}
+
+// ErrorIsNil applies the IsNil predicate on the "error" field.
+func ErrorIsNil() predicate.SourceRun {
+	return predicate.SourceRun(sql.FieldIsNull(FieldError))
+}
+
+// ErrorNotNil applies the NotNil predicate on the "error" field.
+func ErrorNotNil() predicate.SourceRun {
+	return predicate.SourceRun(sql.FieldNotNull(FieldError))
+}
+
+// ErrorEqualFold applies the EqualFold predicate on the "error" field.
+func ErrorEqualFold(v string) predicate.SourceRun {
+	return predicate.SourceRun(sql.FieldEqualFold(FieldError, v))
+}
+
+// ErrorContainsFold applies the ContainsFold predicate on the "error" field.
+func ErrorContainsFold(v string) predicate.SourceRun {
+	return predicate.SourceRun(sql.FieldContainsFold(FieldError, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SourceRun) predicate.SourceRun {
+	return predicate.SourceRun(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SourceRun) predicate.SourceRun {
+	return predicate.SourceRun(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SourceRun) predicate.SourceRun {
+	return predicate.SourceRun(sql.NotPredicates(p))
+}
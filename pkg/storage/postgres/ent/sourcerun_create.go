@@ -0,0 +1,730 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/glanceapp/glance/pkg/storage/postgres/ent/sourcerun"
+)
+
+// SourceRunCreate is the builder for creating a SourceRun entity.
+type SourceRunCreate struct {
+	config
+	mutation *SourceRunMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetSourceUID sets the "source_uid" field.
+func (src *SourceRunCreate) SetSourceUID(s string) *SourceRunCreate {
+	src.mutation.SetSourceUID(s)
+	return src
+}
+
+// SetStartedAt sets the "started_at" field.
+func (src *SourceRunCreate) SetStartedAt(t time.Time) *SourceRunCreate {
+	src.mutation.SetStartedAt(t)
+	return src
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (src *SourceRunCreate) SetFinishedAt(t time.Time) *SourceRunCreate {
+	src.mutation.SetFinishedAt(t)
+	return src
+}
+
+// SetItems sets the "items" field.
+func (src *SourceRunCreate) SetItems(i int) *SourceRunCreate {
+	src.mutation.SetItems(i)
+	return src
+}
+
+// SetNillableItems sets the "items" field if the given value is not nil.
+func (src *SourceRunCreate) SetNillableItems(i *int) *SourceRunCreate {
+	if i != nil {
+		src.SetItems(*i)
+	}
+	return src
+}
+
+// SetError sets the "error" field.
+func (src *SourceRunCreate) SetError(s string) *SourceRunCreate {
+	src.mutation.SetError(s)
+	return src
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (src *SourceRunCreate) SetNillableError(s *string) *SourceRunCreate {
+	if s != nil {
+		src.SetError(*s)
+	}
+	return src
+}
+
+// Mutation returns the SourceRunMutation object of the builder.
+func (src *SourceRunCreate) Mutation() *SourceRunMutation {
+	return src.mutation
+}
+
+// Save creates the SourceRun in the database.
+func (src *SourceRunCreate) Save(ctx context.Context) (*SourceRun, error) {
+	src.defaults()
+	return withHooks(ctx, src.sqlSave, src.mutation, src.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (src *SourceRunCreate) SaveX(ctx context.Context) *SourceRun {
+	v, err := src.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (src *SourceRunCreate) Exec(ctx context.Context) error {
+	_, err := src.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (src *SourceRunCreate) ExecX(ctx context.Context) {
+	if err := src.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (src *SourceRunCreate) defaults() {
+	if _, ok := src.mutation.Items(); !ok {
+		v := sourcerun.DefaultItems
+		src.mutation.SetItems(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (src *SourceRunCreate) check() error {
+	if _, ok := src.mutation.SourceUID(); !ok {
+		return &ValidationError{Name: "source_uid", err: errors.New(`ent: missing required field "SourceRun.source_uid"`)}
+	}
+	if _, ok := src.mutation.StartedAt(); !ok {
+		return &ValidationError{Name: "started_at", err: errors.New(`ent: missing required field "SourceRun.started_at"`)}
+	}
+	if _, ok := src.mutation.FinishedAt(); !ok {
+		return &ValidationError{Name: "finished_at", err: errors.New(`ent: missing required field "SourceRun.finished_at"`)}
+	}
+	if _, ok := src.mutation.Items(); !ok {
+		return &ValidationError{Name: "items", err: errors.New(`ent: missing required field "SourceRun.items"`)}
+	}
+	return nil
+}
+
+func (src *SourceRunCreate) sqlSave(ctx context.Context) (*SourceRun, error) {
+	if err := src.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := src.createSpec()
+	if err := sqlgraph.CreateNode(ctx, src.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	src.mutation.id = &_node.ID
+	src.mutation.done = true
+	return _node, nil
+}
+
+func (src *SourceRunCreate) createSpec() (*SourceRun, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SourceRun{config: src.config}
+		_spec = sqlgraph.NewCreateSpec(sourcerun.Table, sqlgraph.NewFieldSpec(sourcerun.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = src.conflict
+	if value, ok := src.mutation.SourceUID(); ok {
+		_spec.SetField(sourcerun.FieldSourceUID, field.TypeString, value)
+		_node.SourceUID = value
+	}
+	if value, ok := src.mutation.StartedAt(); ok {
+		_spec.SetField(sourcerun.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = value
+	}
+	if value, ok := src.mutation.FinishedAt(); ok {
+		_spec.SetField(sourcerun.FieldFinishedAt, field.TypeTime, value)
+		_node.FinishedAt = value
+	}
+	if value, ok := src.mutation.Items(); ok {
+		_spec.SetField(sourcerun.FieldItems, field.TypeInt, value)
+		_node.Items = value
+	}
+	if value, ok := src.mutation.Error(); ok {
+		_spec.SetField(sourcerun.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SourceRun.Create().
+//		SetSourceUID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SourceRunUpsert) {
+//			SetSourceUID(v+v).
+//		}).
+//		Exec(ctx)
+func (src *SourceRunCreate) OnConflict(opts ...sql.ConflictOption) *SourceRunUpsertOne {
+	src.conflict = opts
+	return &SourceRunUpsertOne{
+		create: src,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SourceRun.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (src *SourceRunCreate) OnConflictColumns(columns ...string) *SourceRunUpsertOne {
+	src.conflict = append(src.conflict, sql.ConflictColumns(columns...))
+	return &SourceRunUpsertOne{
+		create: src,
+	}
+}
+
+type (
+	// SourceRunUpsertOne is the builder for "upsert"-ing
+	//  one SourceRun node.
+	SourceRunUpsertOne struct {
+		create *SourceRunCreate
+	}
+
+	// SourceRunUpsert is the "OnConflict" setter.
+	SourceRunUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetSourceUID sets the "source_uid" field.
+func (u *SourceRunUpsert) SetSourceUID(v string) *SourceRunUpsert {
+	u.Set(sourcerun.FieldSourceUID, v)
+	return u
+}
+
+// UpdateSourceUID sets the "source_uid" field to the value that was provided on create.
+func (u *SourceRunUpsert) UpdateSourceUID() *SourceRunUpsert {
+	u.SetExcluded(sourcerun.FieldSourceUID)
+	return u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *SourceRunUpsert) SetStartedAt(v time.Time) *SourceRunUpsert {
+	u.Set(sourcerun.FieldStartedAt, v)
+	return u
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *SourceRunUpsert) UpdateStartedAt() *SourceRunUpsert {
+	u.SetExcluded(sourcerun.FieldStartedAt)
+	return u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *SourceRunUpsert) SetFinishedAt(v time.Time) *SourceRunUpsert {
+	u.Set(sourcerun.FieldFinishedAt, v)
+	return u
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *SourceRunUpsert) UpdateFinishedAt() *SourceRunUpsert {
+	u.SetExcluded(sourcerun.FieldFinishedAt)
+	return u
+}
+
+// SetItems sets the "items" field.
+func (u *SourceRunUpsert) SetItems(v int) *SourceRunUpsert {
+	u.Set(sourcerun.FieldItems, v)
+	return u
+}
+
+// UpdateItems sets the "items" field to the value that was provided on create.
+func (u *SourceRunUpsert) UpdateItems() *SourceRunUpsert {
+	u.SetExcluded(sourcerun.FieldItems)
+	return u
+}
+
+// AddItems adds v to the "items" field.
+func (u *SourceRunUpsert) AddItems(v int) *SourceRunUpsert {
+	u.Add(sourcerun.FieldItems, v)
+	return u
+}
+
+// SetError sets the "error" field.
+func (u *SourceRunUpsert) SetError(v string) *SourceRunUpsert {
+	u.Set(sourcerun.FieldError, v)
+	return u
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *SourceRunUpsert) UpdateError() *SourceRunUpsert {
+	u.SetExcluded(sourcerun.FieldError)
+	return u
+}
+
+// ClearError clears the value of the "error" field.
+func (u *SourceRunUpsert) ClearError() *SourceRunUpsert {
+	u.SetNull(sourcerun.FieldError)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.SourceRun.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *SourceRunUpsertOne) UpdateNewValues() *SourceRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SourceRun.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *SourceRunUpsertOne) Ignore() *SourceRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SourceRunUpsertOne) DoNothing() *SourceRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SourceRunCreate.OnConflict
+// documentation for more info.
+func (u *SourceRunUpsertOne) Update(set func(*SourceRunUpsert)) *SourceRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SourceRunUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetSourceUID sets the "source_uid" field.
+func (u *SourceRunUpsertOne) SetSourceUID(v string) *SourceRunUpsertOne {
+	return u.Update(func(s *SourceRunUpsert) {
+		s.SetSourceUID(v)
+	})
+}
+
+// UpdateSourceUID sets the "source_uid" field to the value that was provided on create.
+func (u *SourceRunUpsertOne) UpdateSourceUID() *SourceRunUpsertOne {
+	return u.Update(func(s *SourceRunUpsert) {
+		s.UpdateSourceUID()
+	})
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *SourceRunUpsertOne) SetStartedAt(v time.Time) *SourceRunUpsertOne {
+	return u.Update(func(s *SourceRunUpsert) {
+		s.SetStartedAt(v)
+	})
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *SourceRunUpsertOne) UpdateStartedAt() *SourceRunUpsertOne {
+	return u.Update(func(s *SourceRunUpsert) {
+		s.UpdateStartedAt()
+	})
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *SourceRunUpsertOne) SetFinishedAt(v time.Time) *SourceRunUpsertOne {
+	return u.Update(func(s *SourceRunUpsert) {
+		s.SetFinishedAt(v)
+	})
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *SourceRunUpsertOne) UpdateFinishedAt() *SourceRunUpsertOne {
+	return u.Update(func(s *SourceRunUpsert) {
+		s.UpdateFinishedAt()
+	})
+}
+
+// SetItems sets the "items" field.
+func (u *SourceRunUpsertOne) SetItems(v int) *SourceRunUpsertOne {
+	return u.Update(func(s *SourceRunUpsert) {
+		s.SetItems(v)
+	})
+}
+
+// AddItems adds v to the "items" field.
+func (u *SourceRunUpsertOne) AddItems(v int) *SourceRunUpsertOne {
+	return u.Update(func(s *SourceRunUpsert) {
+		s.AddItems(v)
+	})
+}
+
+// UpdateItems sets the "items" field to the value that was provided on create.
+func (u *SourceRunUpsertOne) UpdateItems() *SourceRunUpsertOne {
+	return u.Update(func(s *SourceRunUpsert) {
+		s.UpdateItems()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *SourceRunUpsertOne) SetError(v string) *SourceRunUpsertOne {
+	return u.Update(func(s *SourceRunUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *SourceRunUpsertOne) UpdateError() *SourceRunUpsertOne {
+	return u.Update(func(s *SourceRunUpsert) {
+		s.UpdateError()
+	})
+}
+
+// ClearError clears the value of the "error" field.
+func (u *SourceRunUpsertOne) ClearError() *SourceRunUpsertOne {
+	return u.Update(func(s *SourceRunUpsert) {
+		s.ClearError()
+	})
+}
+
+// Exec executes the query.
+func (u *SourceRunUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SourceRunCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SourceRunUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *SourceRunUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *SourceRunUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// SourceRunCreateBulk is the builder for creating many SourceRun entities in bulk.
+type SourceRunCreateBulk struct {
+	config
+	err      error
+	builders []*SourceRunCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the SourceRun entities in the database.
+func (srcb *SourceRunCreateBulk) Save(ctx context.Context) ([]*SourceRun, error) {
+	if srcb.err != nil {
+		return nil, srcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(srcb.builders))
+	nodes := make([]*SourceRun, len(srcb.builders))
+	mutators := make([]Mutator, len(srcb.builders))
+	for i := range srcb.builders {
+		func(i int, root context.Context) {
+			builder := srcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SourceRunMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, srcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = srcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, srcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, srcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (srcb *SourceRunCreateBulk) SaveX(ctx context.Context) []*SourceRun {
+	v, err := srcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (srcb *SourceRunCreateBulk) Exec(ctx context.Context) error {
+	_, err := srcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (srcb *SourceRunCreateBulk) ExecX(ctx context.Context) {
+	if err := srcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SourceRun.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SourceRunUpsert) {
+//			SetSourceUID(v+v).
+//		}).
+//		Exec(ctx)
+func (srcb *SourceRunCreateBulk) OnConflict(opts ...sql.ConflictOption) *SourceRunUpsertBulk {
+	srcb.conflict = opts
+	return &SourceRunUpsertBulk{
+		create: srcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SourceRun.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (srcb *SourceRunCreateBulk) OnConflictColumns(columns ...string) *SourceRunUpsertBulk {
+	srcb.conflict = append(srcb.conflict, sql.ConflictColumns(columns...))
+	return &SourceRunUpsertBulk{
+		create: srcb,
+	}
+}
+
+// SourceRunUpsertBulk is the builder for "upsert"-ing
+// a bulk of SourceRun nodes.
+type SourceRunUpsertBulk struct {
+	create *SourceRunCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.SourceRun.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *SourceRunUpsertBulk) UpdateNewValues() *SourceRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SourceRun.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *SourceRunUpsertBulk) Ignore() *SourceRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SourceRunUpsertBulk) DoNothing() *SourceRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SourceRunCreateBulk.OnConflict
+// documentation for more info.
+func (u *SourceRunUpsertBulk) Update(set func(*SourceRunUpsert)) *SourceRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SourceRunUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetSourceUID sets the "source_uid" field.
+func (u *SourceRunUpsertBulk) SetSourceUID(v string) *SourceRunUpsertBulk {
+	return u.Update(func(s *SourceRunUpsert) {
+		s.SetSourceUID(v)
+	})
+}
+
+// UpdateSourceUID sets the "source_uid" field to the value that was provided on create.
+func (u *SourceRunUpsertBulk) UpdateSourceUID() *SourceRunUpsertBulk {
+	return u.Update(func(s *SourceRunUpsert) {
+		s.UpdateSourceUID()
+	})
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *SourceRunUpsertBulk) SetStartedAt(v time.Time) *SourceRunUpsertBulk {
+	return u.Update(func(s *SourceRunUpsert) {
+		s.SetStartedAt(v)
+	})
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *SourceRunUpsertBulk) UpdateStartedAt() *SourceRunUpsertBulk {
+	return u.Update(func(s *SourceRunUpsert) {
+		s.UpdateStartedAt()
+	})
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *SourceRunUpsertBulk) SetFinishedAt(v time.Time) *SourceRunUpsertBulk {
+	return u.Update(func(s *SourceRunUpsert) {
+		s.SetFinishedAt(v)
+	})
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *SourceRunUpsertBulk) UpdateFinishedAt() *SourceRunUpsertBulk {
+	return u.Update(func(s *SourceRunUpsert) {
+		s.UpdateFinishedAt()
+	})
+}
+
+// SetItems sets the "items" field.
+func (u *SourceRunUpsertBulk) SetItems(v int) *SourceRunUpsertBulk {
+	return u.Update(func(s *SourceRunUpsert) {
+		s.SetItems(v)
+	})
+}
+
+// AddItems adds v to the "items" field.
+func (u *SourceRunUpsertBulk) AddItems(v int) *SourceRunUpsertBulk {
+	return u.Update(func(s *SourceRunUpsert) {
+		s.AddItems(v)
+	})
+}
+
+// UpdateItems sets the "items" field to the value that was provided on create.
+func (u *SourceRunUpsertBulk) UpdateItems() *SourceRunUpsertBulk {
+	return u.Update(func(s *SourceRunUpsert) {
+		s.UpdateItems()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *SourceRunUpsertBulk) SetError(v string) *SourceRunUpsertBulk {
+	return u.Update(func(s *SourceRunUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *SourceRunUpsertBulk) UpdateError() *SourceRunUpsertBulk {
+	return u.Update(func(s *SourceRunUpsert) {
+		s.UpdateError()
+	})
+}
+
+// ClearError clears the value of the "error" field.
+func (u *SourceRunUpsertBulk) ClearError() *SourceRunUpsertBulk {
+	return u.Update(func(s *SourceRunUpsert) {
+		s.ClearError()
+	})
+}
+
+// Exec executes the query.
+func (u *SourceRunUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the SourceRunCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SourceRunCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SourceRunUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,384 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/glanceapp/glance/pkg/storage/postgres/ent/predicate"
+	"github.com/glanceapp/glance/pkg/storage/postgres/ent/sourcerun"
+)
+
+// SourceRunUpdate is the builder for updating SourceRun entities.
+type SourceRunUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SourceRunMutation
+}
+
+// Where appends a list predicates to the SourceRunUpdate builder.
+func (sru *SourceRunUpdate) Where(ps ...predicate.SourceRun) *SourceRunUpdate {
+	sru.mutation.Where(ps...)
+	return sru
+}
+
+// SetSourceUID sets the "source_uid" field.
+func (sru *SourceRunUpdate) SetSourceUID(s string) *SourceRunUpdate {
+	sru.mutation.SetSourceUID(s)
+	return sru
+}
+
+// SetNillableSourceUID sets the "source_uid" field if the given value is not nil.
+func (sru *SourceRunUpdate) SetNillableSourceUID(s *string) *SourceRunUpdate {
+	if s != nil {
+		sru.SetSourceUID(*s)
+	}
+	return sru
+}
+
+// SetStartedAt sets the "started_at" field.
+func (sru *SourceRunUpdate) SetStartedAt(t time.Time) *SourceRunUpdate {
+	sru.mutation.SetStartedAt(t)
+	return sru
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (sru *SourceRunUpdate) SetNillableStartedAt(t *time.Time) *SourceRunUpdate {
+	if t != nil {
+		sru.SetStartedAt(*t)
+	}
+	return sru
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (sru *SourceRunUpdate) SetFinishedAt(t time.Time) *SourceRunUpdate {
+	sru.mutation.SetFinishedAt(t)
+	return sru
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (sru *SourceRunUpdate) SetNillableFinishedAt(t *time.Time) *SourceRunUpdate {
+	if t != nil {
+		sru.SetFinishedAt(*t)
+	}
+	return sru
+}
+
+// SetItems sets the "items" field.
+func (sru *SourceRunUpdate) SetItems(i int) *SourceRunUpdate {
+	sru.mutation.ResetItems()
+	sru.mutation.SetItems(i)
+	return sru
+}
+
+// SetNillableItems sets the "items" field if the given value is not nil.
+func (sru *SourceRunUpdate) SetNillableItems(i *int) *SourceRunUpdate {
+	if i != nil {
+		sru.SetItems(*i)
+	}
+	return sru
+}
+
+// AddItems adds i to the "items" field.
+func (sru *SourceRunUpdate) AddItems(i int) *SourceRunUpdate {
+	sru.mutation.AddItems(i)
+	return sru
+}
+
+// SetError sets the "error" field.
+func (sru *SourceRunUpdate) SetError(s string) *SourceRunUpdate {
+	sru.mutation.SetError(s)
+	return sru
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (sru *SourceRunUpdate) SetNillableError(s *string) *SourceRunUpdate {
+	if s != nil {
+		sru.SetError(*s)
+	}
+	return sru
+}
+
+// ClearError clears the value of the "error" field.
+func (sru *SourceRunUpdate) ClearError() *SourceRunUpdate {
+	sru.mutation.ClearError()
+	return sru
+}
+
+// Mutation returns the SourceRunMutation object of the builder.
+func (sru *SourceRunUpdate) Mutation() *SourceRunMutation {
+	return sru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (sru *SourceRunUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, sru.sqlSave, sru.mutation, sru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sru *SourceRunUpdate) SaveX(ctx context.Context) int {
+	affected, err := sru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (sru *SourceRunUpdate) Exec(ctx context.Context) error {
+	_, err := sru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sru *SourceRunUpdate) ExecX(ctx context.Context) {
+	if err := sru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (sru *SourceRunUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(sourcerun.Table, sourcerun.Columns, sqlgraph.NewFieldSpec(sourcerun.FieldID, field.TypeInt))
+	if ps := sru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sru.mutation.SourceUID(); ok {
+		_spec.SetField(sourcerun.FieldSourceUID, field.TypeString, value)
+	}
+	if value, ok := sru.mutation.StartedAt(); ok {
+		_spec.SetField(sourcerun.FieldStartedAt, field.TypeTime, value)
+	}
+	if value, ok := sru.mutation.FinishedAt(); ok {
+		_spec.SetField(sourcerun.FieldFinishedAt, field.TypeTime, value)
+	}
+	if value, ok := sru.mutation.Items(); ok {
+		_spec.SetField(sourcerun.FieldItems, field.TypeInt, value)
+	}
+	if value, ok := sru.mutation.AddedItems(); ok {
+		_spec.AddField(sourcerun.FieldItems, field.TypeInt, value)
+	}
+	if value, ok := sru.mutation.Error(); ok {
+		_spec.SetField(sourcerun.FieldError, field.TypeString, value)
+	}
+	if sru.mutation.ErrorCleared() {
+		_spec.ClearField(sourcerun.FieldError, field.TypeString)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, sru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sourcerun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	sru.mutation.done = true
+	return n, nil
+}
+
+// SourceRunUpdateOne is the builder for updating a single SourceRun entity.
+type SourceRunUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SourceRunMutation
+}
+
+// SetSourceUID sets the "source_uid" field.
+func (sruo *SourceRunUpdateOne) SetSourceUID(s string) *SourceRunUpdateOne {
+	sruo.mutation.SetSourceUID(s)
+	return sruo
+}
+
+// SetNillableSourceUID sets the "source_uid" field if the given value is not nil.
+func (sruo *SourceRunUpdateOne) SetNillableSourceUID(s *string) *SourceRunUpdateOne {
+	if s != nil {
+		sruo.SetSourceUID(*s)
+	}
+	return sruo
+}
+
+// SetStartedAt sets the "started_at" field.
+func (sruo *SourceRunUpdateOne) SetStartedAt(t time.Time) *SourceRunUpdateOne {
+	sruo.mutation.SetStartedAt(t)
+	return sruo
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (sruo *SourceRunUpdateOne) SetNillableStartedAt(t *time.Time) *SourceRunUpdateOne {
+	if t != nil {
+		sruo.SetStartedAt(*t)
+	}
+	return sruo
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (sruo *SourceRunUpdateOne) SetFinishedAt(t time.Time) *SourceRunUpdateOne {
+	sruo.mutation.SetFinishedAt(t)
+	return sruo
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (sruo *SourceRunUpdateOne) SetNillableFinishedAt(t *time.Time) *SourceRunUpdateOne {
+	if t != nil {
+		sruo.SetFinishedAt(*t)
+	}
+	return sruo
+}
+
+// SetItems sets the "items" field.
+func (sruo *SourceRunUpdateOne) SetItems(i int) *SourceRunUpdateOne {
+	sruo.mutation.ResetItems()
+	sruo.mutation.SetItems(i)
+	return sruo
+}
+
+// SetNillableItems sets the "items" field if the given value is not nil.
+func (sruo *SourceRunUpdateOne) SetNillableItems(i *int) *SourceRunUpdateOne {
+	if i != nil {
+		sruo.SetItems(*i)
+	}
+	return sruo
+}
+
+// AddItems adds i to the "items" field.
+func (sruo *SourceRunUpdateOne) AddItems(i int) *SourceRunUpdateOne {
+	sruo.mutation.AddItems(i)
+	return sruo
+}
+
+// SetError sets the "error" field.
+func (sruo *SourceRunUpdateOne) SetError(s string) *SourceRunUpdateOne {
+	sruo.mutation.SetError(s)
+	return sruo
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (sruo *SourceRunUpdateOne) SetNillableError(s *string) *SourceRunUpdateOne {
+	if s != nil {
+		sruo.SetError(*s)
+	}
+	return sruo
+}
+
+// ClearError clears the value of the "error" field.
+func (sruo *SourceRunUpdateOne) ClearError() *SourceRunUpdateOne {
+	sruo.mutation.ClearError()
+	return sruo
+}
+
+// Mutation returns the SourceRunMutation object of the builder.
+func (sruo *SourceRunUpdateOne) Mutation() *SourceRunMutation {
+	return sruo.mutation
+}
+
+// Where appends a list predicates to the SourceRunUpdate builder.
+func (sruo *SourceRunUpdateOne) Where(ps ...predicate.SourceRun) *SourceRunUpdateOne {
+	sruo.mutation.Where(ps...)
+	return sruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (sruo *SourceRunUpdateOne) Select(field string, fields ...string) *SourceRunUpdateOne {
+	sruo.fields = append([]string{field}, fields...)
+	return sruo
+}
+
+// Save executes the query and returns the updated SourceRun entity.
+func (sruo *SourceRunUpdateOne) Save(ctx context.Context) (*SourceRun, error) {
+	return withHooks(ctx, sruo.sqlSave, sruo.mutation, sruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sruo *SourceRunUpdateOne) SaveX(ctx context.Context) *SourceRun {
+	node, err := sruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (sruo *SourceRunUpdateOne) Exec(ctx context.Context) error {
+	_, err := sruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sruo *SourceRunUpdateOne) ExecX(ctx context.Context) {
+	if err := sruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (sruo *SourceRunUpdateOne) sqlSave(ctx context.Context) (_node *SourceRun, err error) {
+	_spec := sqlgraph.NewUpdateSpec(sourcerun.Table, sourcerun.Columns, sqlgraph.NewFieldSpec(sourcerun.FieldID, field.TypeInt))
+	id, ok := sruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SourceRun.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := sruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, sourcerun.FieldID)
+		for _, f := range fields {
+			if !sourcerun.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != sourcerun.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := sruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sruo.mutation.SourceUID(); ok {
+		_spec.SetField(sourcerun.FieldSourceUID, field.TypeString, value)
+	}
+	if value, ok := sruo.mutation.StartedAt(); ok {
+		_spec.SetField(sourcerun.FieldStartedAt, field.TypeTime, value)
+	}
+	if value, ok := sruo.mutation.FinishedAt(); ok {
+		_spec.SetField(sourcerun.FieldFinishedAt, field.TypeTime, value)
+	}
+	if value, ok := sruo.mutation.Items(); ok {
+		_spec.SetField(sourcerun.FieldItems, field.TypeInt, value)
+	}
+	if value, ok := sruo.mutation.AddedItems(); ok {
+		_spec.AddField(sourcerun.FieldItems, field.TypeInt, value)
+	}
+	if value, ok := sruo.mutation.Error(); ok {
+		_spec.SetField(sourcerun.FieldError, field.TypeString, value)
+	}
+	if sruo.mutation.ErrorCleared() {
+		_spec.ClearField(sourcerun.FieldError, field.TypeString)
+	}
+	_node = &SourceRun{config: sruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, sruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sourcerun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	sruo.mutation.done = true
+	return _node, nil
+}
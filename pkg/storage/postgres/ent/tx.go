@@ -14,8 +14,16 @@ type Tx struct {
 	config
 	// Activity is the client for interacting with the Activity builders.
 	Activity *ActivityClient
+	// DeadLetter is the client for interacting with the DeadLetter builders.
+	DeadLetter *DeadLetterClient
+	// Job is the client for interacting with the Job builders.
+	Job *JobClient
 	// Source is the client for interacting with the Source builders.
 	Source *SourceClient
+	// SourceRun is the client for interacting with the SourceRun builders.
+	SourceRun *SourceRunClient
+	// WebSubscription is the client for interacting with the WebSubscription builders.
+	WebSubscription *WebSubscriptionClient
 
 	// lazily loaded.
 	client     *Client
@@ -148,7 +156,11 @@ func (tx *Tx) Client() *Client {
 
 func (tx *Tx) init() {
 	tx.Activity = NewActivityClient(tx.config)
+	tx.DeadLetter = NewDeadLetterClient(tx.config)
+	tx.Job = NewJobClient(tx.config)
 	tx.Source = NewSourceClient(tx.config)
+	tx.SourceRun = NewSourceRunClient(tx.config)
+	tx.WebSubscription = NewWebSubscriptionClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -0,0 +1,213 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/glanceapp/glance/pkg/storage/postgres/ent/websubscription"
+)
+
+// WebSubscription is the model entity for the WebSubscription schema.
+type WebSubscription struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Hub holds the value of the "hub" field.
+	Hub string `json:"hub,omitempty"`
+	// Topic holds the value of the "topic" field.
+	Topic string `json:"topic,omitempty"`
+	// Secret holds the value of the "secret" field.
+	Secret string `json:"-"`
+	// Mode holds the value of the "mode" field.
+	Mode websubscription.Mode `json:"mode,omitempty"`
+	// Active holds the value of the "active" field.
+	Active bool `json:"active,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// RenewAt holds the value of the "renew_at" field.
+	RenewAt *time.Time `json:"renew_at,omitempty"`
+	// RenewalClaimedAt holds the value of the "renewal_claimed_at" field.
+	RenewalClaimedAt *time.Time `json:"renewal_claimed_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WebSubscription) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case websubscription.FieldActive:
+			values[i] = new(sql.NullBool)
+		case websubscription.FieldID, websubscription.FieldHub, websubscription.FieldTopic, websubscription.FieldSecret, websubscription.FieldMode:
+			values[i] = new(sql.NullString)
+		case websubscription.FieldExpiresAt, websubscription.FieldRenewAt, websubscription.FieldRenewalClaimedAt, websubscription.FieldCreatedAt, websubscription.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WebSubscription fields.
+func (ws *WebSubscription) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case websubscription.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				ws.ID = value.String
+			}
+		case websubscription.FieldHub:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field hub", values[i])
+			} else if value.Valid {
+				ws.Hub = value.String
+			}
+		case websubscription.FieldTopic:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field topic", values[i])
+			} else if value.Valid {
+				ws.Topic = value.String
+			}
+		case websubscription.FieldSecret:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field secret", values[i])
+			} else if value.Valid {
+				ws.Secret = value.String
+			}
+		case websubscription.FieldMode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field mode", values[i])
+			} else if value.Valid {
+				ws.Mode = websubscription.Mode(value.String)
+			}
+		case websubscription.FieldActive:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field active", values[i])
+			} else if value.Valid {
+				ws.Active = value.Bool
+			}
+		case websubscription.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				ws.ExpiresAt = new(time.Time)
+				*ws.ExpiresAt = value.Time
+			}
+		case websubscription.FieldRenewAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field renew_at", values[i])
+			} else if value.Valid {
+				ws.RenewAt = new(time.Time)
+				*ws.RenewAt = value.Time
+			}
+		case websubscription.FieldRenewalClaimedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field renewal_claimed_at", values[i])
+			} else if value.Valid {
+				ws.RenewalClaimedAt = new(time.Time)
+				*ws.RenewalClaimedAt = value.Time
+			}
+		case websubscription.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ws.CreatedAt = value.Time
+			}
+		case websubscription.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ws.UpdatedAt = value.Time
+			}
+		default:
+			ws.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the WebSubscription.
+// This includes values selected through modifiers, order, etc.
+func (ws *WebSubscription) Value(name string) (ent.Value, error) {
+	return ws.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this WebSubscription.
+// Note that you need to call WebSubscription.Unwrap() before calling this method if this WebSubscription
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ws *WebSubscription) Update() *WebSubscriptionUpdateOne {
+	return NewWebSubscriptionClient(ws.config).UpdateOne(ws)
+}
+
+// Unwrap unwraps the WebSubscription entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ws *WebSubscription) Unwrap() *WebSubscription {
+	_tx, ok := ws.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: WebSubscription is not a transactional entity")
+	}
+	ws.config.driver = _tx.drv
+	return ws
+}
+
+// String implements the fmt.Stringer.
+func (ws *WebSubscription) String() string {
+	var builder strings.Builder
+	builder.WriteString("WebSubscription(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ws.ID))
+	builder.WriteString("hub=")
+	builder.WriteString(ws.Hub)
+	builder.WriteString(", ")
+	builder.WriteString("topic=")
+	builder.WriteString(ws.Topic)
+	builder.WriteString(", ")
+	builder.WriteString("secret=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("mode=")
+	builder.WriteString(fmt.Sprintf("%v", ws.Mode))
+	builder.WriteString(", ")
+	builder.WriteString("active=")
+	builder.WriteString(fmt.Sprintf("%v", ws.Active))
+	builder.WriteString(", ")
+	if v := ws.ExpiresAt; v != nil {
+		builder.WriteString("expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := ws.RenewAt; v != nil {
+		builder.WriteString("renew_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := ws.RenewalClaimedAt; v != nil {
+		builder.WriteString("renewal_claimed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ws.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ws.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WebSubscriptions is a parsable slice of WebSubscription.
+type WebSubscriptions []*WebSubscription
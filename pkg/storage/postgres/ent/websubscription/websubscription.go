@@ -0,0 +1,159 @@
+// Code generated by ent, DO NOT EDIT.
+
+package websubscription
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the websubscription type in the database.
+	Label = "web_subscription"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldHub holds the string denoting the hub field in the database.
+	FieldHub = "hub"
+	// FieldTopic holds the string denoting the topic field in the database.
+	FieldTopic = "topic"
+	// FieldSecret holds the string denoting the secret field in the database.
+	FieldSecret = "secret"
+	// FieldMode holds the string denoting the mode field in the database.
+	FieldMode = "mode"
+	// FieldActive holds the string denoting the active field in the database.
+	FieldActive = "active"
+	// FieldExpiresAt holds the string denoting the expires_at field in the database.
+	FieldExpiresAt = "expires_at"
+	// FieldRenewAt holds the string denoting the renew_at field in the database.
+	FieldRenewAt = "renew_at"
+	// FieldRenewalClaimedAt holds the string denoting the renewal_claimed_at field in the database.
+	FieldRenewalClaimedAt = "renewal_claimed_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the websubscription in the database.
+	Table = "web_subscriptions"
+)
+
+// Columns holds all SQL columns for websubscription fields.
+var Columns = []string{
+	FieldID,
+	FieldHub,
+	FieldTopic,
+	FieldSecret,
+	FieldMode,
+	FieldActive,
+	FieldExpiresAt,
+	FieldRenewAt,
+	FieldRenewalClaimedAt,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultActive holds the default value on creation for the "active" field.
+	DefaultActive bool
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// Mode defines the type for the "mode" enum field.
+type Mode string
+
+// ModeSubscribe is the default value of the Mode enum.
+const DefaultMode = ModeSubscribe
+
+// Mode values.
+const (
+	ModeSubscribe   Mode = "subscribe"
+	ModeUnsubscribe Mode = "unsubscribe"
+)
+
+func (m Mode) String() string {
+	return string(m)
+}
+
+// ModeValidator is a validator for the "mode" field enum values. It is called by the builders before save.
+func ModeValidator(m Mode) error {
+	switch m {
+	case ModeSubscribe, ModeUnsubscribe:
+		return nil
+	default:
+		return fmt.Errorf("websubscription: invalid enum value for mode field: %q", m)
+	}
+}
+
+// OrderOption defines the ordering options for the WebSubscription queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByHub orders the results by the hub field.
+func ByHub(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHub, opts...).ToFunc()
+}
+
+// ByTopic orders the results by the topic field.
+func ByTopic(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTopic, opts...).ToFunc()
+}
+
+// BySecret orders the results by the secret field.
+func BySecret(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSecret, opts...).ToFunc()
+}
+
+// ByMode orders the results by the mode field.
+func ByMode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMode, opts...).ToFunc()
+}
+
+// ByActive orders the results by the active field.
+func ByActive(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldActive, opts...).ToFunc()
+}
+
+// ByExpiresAt orders the results by the expires_at field.
+func ByExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpiresAt, opts...).ToFunc()
+}
+
+// ByRenewAt orders the results by the renew_at field.
+func ByRenewAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRenewAt, opts...).ToFunc()
+}
+
+// ByRenewalClaimedAt orders the results by the renewal_claimed_at field.
+func ByRenewalClaimedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRenewalClaimedAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,580 @@
+// Code generated by ent, DO NOT EDIT.
+
+package websubscription
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/glanceapp/glance/pkg/storage/postgres/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldContainsFold(FieldID, id))
+}
+
+// Hub applies equality check predicate on the "hub" field. It's identical to HubEQ.
+func Hub(v string) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldEQ(FieldHub, v))
+}
+
+// Topic applies equality check predicate on the "topic" field. It's identical to TopicEQ.
+func Topic(v string) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldEQ(FieldTopic, v))
+}
+
+// Secret applies equality check predicate on the "secret" field. It's identical to SecretEQ.
+func Secret(v string) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldEQ(FieldSecret, v))
+}
+
+// Active applies equality check predicate on the "active" field. It's identical to ActiveEQ.
+func Active(v bool) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldEQ(FieldActive, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// RenewAt applies equality check predicate on the "renew_at" field. It's identical to RenewAtEQ.
+func RenewAt(v time.Time) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldEQ(FieldRenewAt, v))
+}
+
+// RenewalClaimedAt applies equality check predicate on the "renewal_claimed_at" field. It's identical to RenewalClaimedAtEQ.
+func RenewalClaimedAt(v time.Time) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldEQ(FieldRenewalClaimedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// HubEQ applies the EQ predicate on the "hub" field.
+func HubEQ(v string) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldEQ(FieldHub, v))
+}
+
+// HubNEQ applies the NEQ predicate on the "hub" field.
+func HubNEQ(v string) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldNEQ(FieldHub, v))
+}
+
+// HubIn applies the In predicate on the "hub" field.
+func HubIn(vs ...string) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldIn(FieldHub, vs...))
+}
+
+// HubNotIn applies the NotIn predicate on the "hub" field.
+func HubNotIn(vs ...string) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldNotIn(FieldHub, vs...))
+}
+
+// HubGT applies the GT predicate on the "hub" field.
+func HubGT(v string) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldGT(FieldHub, v))
+}
+
+// HubGTE applies the GTE predicate on the "hub" field.
+func HubGTE(v string) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldGTE(FieldHub, v))
+}
+
+// HubLT applies the LT predicate on the "hub" field.
+func HubLT(v string) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldLT(FieldHub, v))
+}
+
+// HubLTE applies the LTE predicate on the "hub" field.
+func HubLTE(v string) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldLTE(FieldHub, v))
+}
+
+// HubContains applies the Contains predicate on the "hub" field.
+func HubContains(v string) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldContains(FieldHub, v))
+}
+
+// HubHasPrefix applies the HasPrefix predicate on the "hub" field.
+func HubHasPrefix(v string) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldHasPrefix(FieldHub, v))
+}
+
+// HubHasSuffix applies the HasSuffix predicate on the "hub" field.
+func HubHasSuffix(v string) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldHasSuffix(FieldHub, v))
+}
+
+// HubEqualFold applies the EqualFold predicate on the "hub" field.
+func HubEqualFold(v string) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldEqualFold(FieldHub, v))
+}
+
+// HubContainsFold applies the ContainsFold predicate on the "hub" field.
+func HubContainsFold(v string) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldContainsFold(FieldHub, v))
+}
+
+// TopicEQ applies the EQ predicate on the "topic" field.
+func TopicEQ(v string) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldEQ(FieldTopic, v))
+}
+
+// TopicNEQ applies the NEQ predicate on the "topic" field.
+func TopicNEQ(v string) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldNEQ(FieldTopic, v))
+}
+
+// TopicIn applies the In predicate on the "topic" field.
+func TopicIn(vs ...string) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldIn(FieldTopic, vs...))
+}
+
+// TopicNotIn applies the NotIn predicate on the "topic" field.
+func TopicNotIn(vs ...string) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldNotIn(FieldTopic, vs...))
+}
+
+// TopicGT applies the GT predicate on the "topic" field.
+func TopicGT(v string) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldGT(FieldTopic, v))
+}
+
+// TopicGTE applies the GTE predicate on the "topic" field.
+func TopicGTE(v string) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldGTE(FieldTopic, v))
+}
+
+// TopicLT applies the LT predicate on the "topic" field.
+func TopicLT(v string) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldLT(FieldTopic, v))
+}
+
+// TopicLTE applies the LTE predicate on the "topic" field.
+func TopicLTE(v string) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldLTE(FieldTopic, v))
+}
+
+// TopicContains applies the Contains predicate on the "topic" field.
+func TopicContains(v string) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldContains(FieldTopic, v))
+}
+
+// TopicHasPrefix applies the HasPrefix predicate on the "topic" field.
+func TopicHasPrefix(v string) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldHasPrefix(FieldTopic, v))
+}
+
+// TopicHasSuffix applies the HasSuffix predicate on the "topic" field.
+func TopicHasSuffix(v string) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldHasSuffix(FieldTopic, v))
+}
+
+// TopicEqualFold applies the EqualFold predicate on the "topic" field.
+func TopicEqualFold(v string) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldEqualFold(FieldTopic, v))
+}
+
+// TopicContainsFold applies the ContainsFold predicate on the "topic" field.
+func TopicContainsFold(v string) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldContainsFold(FieldTopic, v))
+}
+
+// SecretEQ applies the EQ predicate on the "secret" field.
+func SecretEQ(v string) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldEQ(FieldSecret, v))
+}
+
+// SecretNEQ applies the NEQ predicate on the "secret" field.
+func SecretNEQ(v string) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldNEQ(FieldSecret, v))
+}
+
+// SecretIn applies the In predicate on the "secret" field.
+func SecretIn(vs ...string) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldIn(FieldSecret, vs...))
+}
+
+// SecretNotIn applies the NotIn predicate on the "secret" field.
+func SecretNotIn(vs ...string) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldNotIn(FieldSecret, vs...))
+}
+
+// SecretGT applies the GT predicate on the "secret" field.
+func SecretGT(v string) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldGT(FieldSecret, v))
+}
+
+// SecretGTE applies the GTE predicate on the "secret" field.
+func SecretGTE(v string) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldGTE(FieldSecret, v))
+}
+
+// SecretLT applies the LT predicate on the "secret" field.
+func SecretLT(v string) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldLT(FieldSecret, v))
+}
+
+// SecretLTE applies the LTE predicate on the "secret" field.
+func SecretLTE(v string) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldLTE(FieldSecret, v))
+}
+
+// SecretContains applies the Contains predicate on the "secret" field.
+func SecretContains(v string) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldContains(FieldSecret, v))
+}
+
+// SecretHasPrefix applies the HasPrefix predicate on the "secret" field.
+func SecretHasPrefix(v string) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldHasPrefix(FieldSecret, v))
+}
+
+// SecretHasSuffix applies the HasSuffix predicate on the "secret" field.
+func SecretHasSuffix(v string) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldHasSuffix(FieldSecret, v))
+}
+
+// SecretEqualFold applies the EqualFold predicate on the "secret" field.
+func SecretEqualFold(v string) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldEqualFold(FieldSecret, v))
+}
+
+// SecretContainsFold applies the ContainsFold predicate on the "secret" field.
+func SecretContainsFold(v string) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldContainsFold(FieldSecret, v))
+}
+
+// ModeEQ applies the EQ predicate on the "mode" field.
+func ModeEQ(v Mode) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldEQ(FieldMode, v))
+}
+
+// ModeNEQ applies the NEQ predicate on the "mode" field.
+func ModeNEQ(v Mode) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldNEQ(FieldMode, v))
+}
+
+// ModeIn applies the In predicate on the "mode" field.
+func ModeIn(vs ...Mode) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldIn(FieldMode, vs...))
+}
+
+// ModeNotIn applies the NotIn predicate on the "mode" field.
+func ModeNotIn(vs ...Mode) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldNotIn(FieldMode, vs...))
+}
+
+// ActiveEQ applies the EQ predicate on the "active" field.
+func ActiveEQ(v bool) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldEQ(FieldActive, v))
+}
+
+// ActiveNEQ applies the NEQ predicate on the "active" field.
+func ActiveNEQ(v bool) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldNEQ(FieldActive, v))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtIsNil applies the IsNil predicate on the "expires_at" field.
+func ExpiresAtIsNil() predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldIsNull(FieldExpiresAt))
+}
+
+// ExpiresAtNotNil applies the NotNil predicate on the "expires_at" field.
+func ExpiresAtNotNil() predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldNotNull(FieldExpiresAt))
+}
+
+// RenewAtEQ applies the EQ predicate on the "renew_at" field.
+func RenewAtEQ(v time.Time) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldEQ(FieldRenewAt, v))
+}
+
+// RenewAtNEQ applies the NEQ predicate on the "renew_at" field.
+func RenewAtNEQ(v time.Time) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldNEQ(FieldRenewAt, v))
+}
+
+// RenewAtIn applies the In predicate on the "renew_at" field.
+func RenewAtIn(vs ...time.Time) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldIn(FieldRenewAt, vs...))
+}
+
+// RenewAtNotIn applies the NotIn predicate on the "renew_at" field.
+func RenewAtNotIn(vs ...time.Time) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldNotIn(FieldRenewAt, vs...))
+}
+
+// RenewAtGT applies the GT predicate on the "renew_at" field.
+func RenewAtGT(v time.Time) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldGT(FieldRenewAt, v))
+}
+
+// RenewAtGTE applies the GTE predicate on the "renew_at" field.
+func RenewAtGTE(v time.Time) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldGTE(FieldRenewAt, v))
+}
+
+// RenewAtLT applies the LT predicate on the "renew_at" field.
+func RenewAtLT(v time.Time) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldLT(FieldRenewAt, v))
+}
+
+// RenewAtLTE applies the LTE predicate on the "renew_at" field.
+func RenewAtLTE(v time.Time) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldLTE(FieldRenewAt, v))
+}
+
+// RenewAtIsNil applies the IsNil predicate on the "renew_at" field.
+func RenewAtIsNil() predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldIsNull(FieldRenewAt))
+}
+
+// RenewAtNotNil applies the NotNil predicate on the "renew_at" field.
+func RenewAtNotNil() predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldNotNull(FieldRenewAt))
+}
+
+// RenewalClaimedAtEQ applies the EQ predicate on the "renewal_claimed_at" field.
+func RenewalClaimedAtEQ(v time.Time) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldEQ(FieldRenewalClaimedAt, v))
+}
+
+// RenewalClaimedAtNEQ applies the NEQ predicate on the "renewal_claimed_at" field.
+func RenewalClaimedAtNEQ(v time.Time) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldNEQ(FieldRenewalClaimedAt, v))
+}
+
+// RenewalClaimedAtIn applies the In predicate on the "renewal_claimed_at" field.
+func RenewalClaimedAtIn(vs ...time.Time) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldIn(FieldRenewalClaimedAt, vs...))
+}
+
+// RenewalClaimedAtNotIn applies the NotIn predicate on the "renewal_claimed_at" field.
+func RenewalClaimedAtNotIn(vs ...time.Time) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldNotIn(FieldRenewalClaimedAt, vs...))
+}
+
+// RenewalClaimedAtGT applies the GT predicate on the "renewal_claimed_at" field.
+func RenewalClaimedAtGT(v time.Time) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldGT(FieldRenewalClaimedAt, v))
+}
+
+// RenewalClaimedAtGTE applies the GTE predicate on the "renewal_claimed_at" field.
+func RenewalClaimedAtGTE(v time.Time) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldGTE(FieldRenewalClaimedAt, v))
+}
+
+// RenewalClaimedAtLT applies the LT predicate on the "renewal_claimed_at" field.
+func RenewalClaimedAtLT(v time.Time) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldLT(FieldRenewalClaimedAt, v))
+}
+
+// RenewalClaimedAtLTE applies the LTE predicate on the "renewal_claimed_at" field.
+func RenewalClaimedAtLTE(v time.Time) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldLTE(FieldRenewalClaimedAt, v))
+}
+
+// RenewalClaimedAtIsNil applies the IsNil predicate on the "renewal_claimed_at" field.
+func RenewalClaimedAtIsNil() predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldIsNull(FieldRenewalClaimedAt))
+}
+
+// RenewalClaimedAtNotNil applies the NotNil predicate on the "renewal_claimed_at" field.
+func RenewalClaimedAtNotNil() predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldNotNull(FieldRenewalClaimedAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.WebSubscription) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.WebSubscription) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.WebSubscription) predicate.WebSubscription {
+	return predicate.WebSubscription(sql.NotPredicates(p))
+}
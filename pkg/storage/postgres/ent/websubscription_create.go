@@ -0,0 +1,1067 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/glanceapp/glance/pkg/storage/postgres/ent/websubscription"
+)
+
+// WebSubscriptionCreate is the builder for creating a WebSubscription entity.
+type WebSubscriptionCreate struct {
+	config
+	mutation *WebSubscriptionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetHub sets the "hub" field.
+func (wsc *WebSubscriptionCreate) SetHub(s string) *WebSubscriptionCreate {
+	wsc.mutation.SetHub(s)
+	return wsc
+}
+
+// SetTopic sets the "topic" field.
+func (wsc *WebSubscriptionCreate) SetTopic(s string) *WebSubscriptionCreate {
+	wsc.mutation.SetTopic(s)
+	return wsc
+}
+
+// SetSecret sets the "secret" field.
+func (wsc *WebSubscriptionCreate) SetSecret(s string) *WebSubscriptionCreate {
+	wsc.mutation.SetSecret(s)
+	return wsc
+}
+
+// SetMode sets the "mode" field.
+func (wsc *WebSubscriptionCreate) SetMode(w websubscription.Mode) *WebSubscriptionCreate {
+	wsc.mutation.SetMode(w)
+	return wsc
+}
+
+// SetNillableMode sets the "mode" field if the given value is not nil.
+func (wsc *WebSubscriptionCreate) SetNillableMode(w *websubscription.Mode) *WebSubscriptionCreate {
+	if w != nil {
+		wsc.SetMode(*w)
+	}
+	return wsc
+}
+
+// SetActive sets the "active" field.
+func (wsc *WebSubscriptionCreate) SetActive(b bool) *WebSubscriptionCreate {
+	wsc.mutation.SetActive(b)
+	return wsc
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (wsc *WebSubscriptionCreate) SetNillableActive(b *bool) *WebSubscriptionCreate {
+	if b != nil {
+		wsc.SetActive(*b)
+	}
+	return wsc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (wsc *WebSubscriptionCreate) SetExpiresAt(t time.Time) *WebSubscriptionCreate {
+	wsc.mutation.SetExpiresAt(t)
+	return wsc
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (wsc *WebSubscriptionCreate) SetNillableExpiresAt(t *time.Time) *WebSubscriptionCreate {
+	if t != nil {
+		wsc.SetExpiresAt(*t)
+	}
+	return wsc
+}
+
+// SetRenewAt sets the "renew_at" field.
+func (wsc *WebSubscriptionCreate) SetRenewAt(t time.Time) *WebSubscriptionCreate {
+	wsc.mutation.SetRenewAt(t)
+	return wsc
+}
+
+// SetNillableRenewAt sets the "renew_at" field if the given value is not nil.
+func (wsc *WebSubscriptionCreate) SetNillableRenewAt(t *time.Time) *WebSubscriptionCreate {
+	if t != nil {
+		wsc.SetRenewAt(*t)
+	}
+	return wsc
+}
+
+// SetRenewalClaimedAt sets the "renewal_claimed_at" field.
+func (wsc *WebSubscriptionCreate) SetRenewalClaimedAt(t time.Time) *WebSubscriptionCreate {
+	wsc.mutation.SetRenewalClaimedAt(t)
+	return wsc
+}
+
+// SetNillableRenewalClaimedAt sets the "renewal_claimed_at" field if the given value is not nil.
+func (wsc *WebSubscriptionCreate) SetNillableRenewalClaimedAt(t *time.Time) *WebSubscriptionCreate {
+	if t != nil {
+		wsc.SetRenewalClaimedAt(*t)
+	}
+	return wsc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (wsc *WebSubscriptionCreate) SetCreatedAt(t time.Time) *WebSubscriptionCreate {
+	wsc.mutation.SetCreatedAt(t)
+	return wsc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (wsc *WebSubscriptionCreate) SetNillableCreatedAt(t *time.Time) *WebSubscriptionCreate {
+	if t != nil {
+		wsc.SetCreatedAt(*t)
+	}
+	return wsc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wsc *WebSubscriptionCreate) SetUpdatedAt(t time.Time) *WebSubscriptionCreate {
+	wsc.mutation.SetUpdatedAt(t)
+	return wsc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (wsc *WebSubscriptionCreate) SetNillableUpdatedAt(t *time.Time) *WebSubscriptionCreate {
+	if t != nil {
+		wsc.SetUpdatedAt(*t)
+	}
+	return wsc
+}
+
+// SetID sets the "id" field.
+func (wsc *WebSubscriptionCreate) SetID(s string) *WebSubscriptionCreate {
+	wsc.mutation.SetID(s)
+	return wsc
+}
+
+// Mutation returns the WebSubscriptionMutation object of the builder.
+func (wsc *WebSubscriptionCreate) Mutation() *WebSubscriptionMutation {
+	return wsc.mutation
+}
+
+// Save creates the WebSubscription in the database.
+func (wsc *WebSubscriptionCreate) Save(ctx context.Context) (*WebSubscription, error) {
+	wsc.defaults()
+	return withHooks(ctx, wsc.sqlSave, wsc.mutation, wsc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wsc *WebSubscriptionCreate) SaveX(ctx context.Context) *WebSubscription {
+	v, err := wsc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wsc *WebSubscriptionCreate) Exec(ctx context.Context) error {
+	_, err := wsc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wsc *WebSubscriptionCreate) ExecX(ctx context.Context) {
+	if err := wsc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wsc *WebSubscriptionCreate) defaults() {
+	if _, ok := wsc.mutation.Mode(); !ok {
+		v := websubscription.DefaultMode
+		wsc.mutation.SetMode(v)
+	}
+	if _, ok := wsc.mutation.Active(); !ok {
+		v := websubscription.DefaultActive
+		wsc.mutation.SetActive(v)
+	}
+	if _, ok := wsc.mutation.CreatedAt(); !ok {
+		v := websubscription.DefaultCreatedAt()
+		wsc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := wsc.mutation.UpdatedAt(); !ok {
+		v := websubscription.DefaultUpdatedAt()
+		wsc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wsc *WebSubscriptionCreate) check() error {
+	if _, ok := wsc.mutation.Hub(); !ok {
+		return &ValidationError{Name: "hub", err: errors.New(`ent: missing required field "WebSubscription.hub"`)}
+	}
+	if _, ok := wsc.mutation.Topic(); !ok {
+		return &ValidationError{Name: "topic", err: errors.New(`ent: missing required field "WebSubscription.topic"`)}
+	}
+	if _, ok := wsc.mutation.Secret(); !ok {
+		return &ValidationError{Name: "secret", err: errors.New(`ent: missing required field "WebSubscription.secret"`)}
+	}
+	if _, ok := wsc.mutation.Mode(); !ok {
+		return &ValidationError{Name: "mode", err: errors.New(`ent: missing required field "WebSubscription.mode"`)}
+	}
+	if v, ok := wsc.mutation.Mode(); ok {
+		if err := websubscription.ModeValidator(v); err != nil {
+			return &ValidationError{Name: "mode", err: fmt.Errorf(`ent: validator failed for field "WebSubscription.mode": %w`, err)}
+		}
+	}
+	if _, ok := wsc.mutation.Active(); !ok {
+		return &ValidationError{Name: "active", err: errors.New(`ent: missing required field "WebSubscription.active"`)}
+	}
+	if _, ok := wsc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "WebSubscription.created_at"`)}
+	}
+	if _, ok := wsc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "WebSubscription.updated_at"`)}
+	}
+	return nil
+}
+
+func (wsc *WebSubscriptionCreate) sqlSave(ctx context.Context) (*WebSubscription, error) {
+	if err := wsc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := wsc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, wsc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected WebSubscription.ID type: %T", _spec.ID.Value)
+		}
+	}
+	wsc.mutation.id = &_node.ID
+	wsc.mutation.done = true
+	return _node, nil
+}
+
+func (wsc *WebSubscriptionCreate) createSpec() (*WebSubscription, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WebSubscription{config: wsc.config}
+		_spec = sqlgraph.NewCreateSpec(websubscription.Table, sqlgraph.NewFieldSpec(websubscription.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = wsc.conflict
+	if id, ok := wsc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := wsc.mutation.Hub(); ok {
+		_spec.SetField(websubscription.FieldHub, field.TypeString, value)
+		_node.Hub = value
+	}
+	if value, ok := wsc.mutation.Topic(); ok {
+		_spec.SetField(websubscription.FieldTopic, field.TypeString, value)
+		_node.Topic = value
+	}
+	if value, ok := wsc.mutation.Secret(); ok {
+		_spec.SetField(websubscription.FieldSecret, field.TypeString, value)
+		_node.Secret = value
+	}
+	if value, ok := wsc.mutation.Mode(); ok {
+		_spec.SetField(websubscription.FieldMode, field.TypeEnum, value)
+		_node.Mode = value
+	}
+	if value, ok := wsc.mutation.Active(); ok {
+		_spec.SetField(websubscription.FieldActive, field.TypeBool, value)
+		_node.Active = value
+	}
+	if value, ok := wsc.mutation.ExpiresAt(); ok {
+		_spec.SetField(websubscription.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if value, ok := wsc.mutation.RenewAt(); ok {
+		_spec.SetField(websubscription.FieldRenewAt, field.TypeTime, value)
+		_node.RenewAt = &value
+	}
+	if value, ok := wsc.mutation.RenewalClaimedAt(); ok {
+		_spec.SetField(websubscription.FieldRenewalClaimedAt, field.TypeTime, value)
+		_node.RenewalClaimedAt = &value
+	}
+	if value, ok := wsc.mutation.CreatedAt(); ok {
+		_spec.SetField(websubscription.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := wsc.mutation.UpdatedAt(); ok {
+		_spec.SetField(websubscription.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.WebSubscription.Create().
+//		SetHub(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WebSubscriptionUpsert) {
+//			SetHub(v+v).
+//		}).
+//		Exec(ctx)
+func (wsc *WebSubscriptionCreate) OnConflict(opts ...sql.ConflictOption) *WebSubscriptionUpsertOne {
+	wsc.conflict = opts
+	return &WebSubscriptionUpsertOne{
+		create: wsc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.WebSubscription.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (wsc *WebSubscriptionCreate) OnConflictColumns(columns ...string) *WebSubscriptionUpsertOne {
+	wsc.conflict = append(wsc.conflict, sql.ConflictColumns(columns...))
+	return &WebSubscriptionUpsertOne{
+		create: wsc,
+	}
+}
+
+type (
+	// WebSubscriptionUpsertOne is the builder for "upsert"-ing
+	//  one WebSubscription node.
+	WebSubscriptionUpsertOne struct {
+		create *WebSubscriptionCreate
+	}
+
+	// WebSubscriptionUpsert is the "OnConflict" setter.
+	WebSubscriptionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetHub sets the "hub" field.
+func (u *WebSubscriptionUpsert) SetHub(v string) *WebSubscriptionUpsert {
+	u.Set(websubscription.FieldHub, v)
+	return u
+}
+
+// UpdateHub sets the "hub" field to the value that was provided on create.
+func (u *WebSubscriptionUpsert) UpdateHub() *WebSubscriptionUpsert {
+	u.SetExcluded(websubscription.FieldHub)
+	return u
+}
+
+// SetTopic sets the "topic" field.
+func (u *WebSubscriptionUpsert) SetTopic(v string) *WebSubscriptionUpsert {
+	u.Set(websubscription.FieldTopic, v)
+	return u
+}
+
+// UpdateTopic sets the "topic" field to the value that was provided on create.
+func (u *WebSubscriptionUpsert) UpdateTopic() *WebSubscriptionUpsert {
+	u.SetExcluded(websubscription.FieldTopic)
+	return u
+}
+
+// SetSecret sets the "secret" field.
+func (u *WebSubscriptionUpsert) SetSecret(v string) *WebSubscriptionUpsert {
+	u.Set(websubscription.FieldSecret, v)
+	return u
+}
+
+// UpdateSecret sets the "secret" field to the value that was provided on create.
+func (u *WebSubscriptionUpsert) UpdateSecret() *WebSubscriptionUpsert {
+	u.SetExcluded(websubscription.FieldSecret)
+	return u
+}
+
+// SetMode sets the "mode" field.
+func (u *WebSubscriptionUpsert) SetMode(v websubscription.Mode) *WebSubscriptionUpsert {
+	u.Set(websubscription.FieldMode, v)
+	return u
+}
+
+// UpdateMode sets the "mode" field to the value that was provided on create.
+func (u *WebSubscriptionUpsert) UpdateMode() *WebSubscriptionUpsert {
+	u.SetExcluded(websubscription.FieldMode)
+	return u
+}
+
+// SetActive sets the "active" field.
+func (u *WebSubscriptionUpsert) SetActive(v bool) *WebSubscriptionUpsert {
+	u.Set(websubscription.FieldActive, v)
+	return u
+}
+
+// UpdateActive sets the "active" field to the value that was provided on create.
+func (u *WebSubscriptionUpsert) UpdateActive() *WebSubscriptionUpsert {
+	u.SetExcluded(websubscription.FieldActive)
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *WebSubscriptionUpsert) SetExpiresAt(v time.Time) *WebSubscriptionUpsert {
+	u.Set(websubscription.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *WebSubscriptionUpsert) UpdateExpiresAt() *WebSubscriptionUpsert {
+	u.SetExcluded(websubscription.FieldExpiresAt)
+	return u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *WebSubscriptionUpsert) ClearExpiresAt() *WebSubscriptionUpsert {
+	u.SetNull(websubscription.FieldExpiresAt)
+	return u
+}
+
+// SetRenewAt sets the "renew_at" field.
+func (u *WebSubscriptionUpsert) SetRenewAt(v time.Time) *WebSubscriptionUpsert {
+	u.Set(websubscription.FieldRenewAt, v)
+	return u
+}
+
+// UpdateRenewAt sets the "renew_at" field to the value that was provided on create.
+func (u *WebSubscriptionUpsert) UpdateRenewAt() *WebSubscriptionUpsert {
+	u.SetExcluded(websubscription.FieldRenewAt)
+	return u
+}
+
+// ClearRenewAt clears the value of the "renew_at" field.
+func (u *WebSubscriptionUpsert) ClearRenewAt() *WebSubscriptionUpsert {
+	u.SetNull(websubscription.FieldRenewAt)
+	return u
+}
+
+// SetRenewalClaimedAt sets the "renewal_claimed_at" field.
+func (u *WebSubscriptionUpsert) SetRenewalClaimedAt(v time.Time) *WebSubscriptionUpsert {
+	u.Set(websubscription.FieldRenewalClaimedAt, v)
+	return u
+}
+
+// UpdateRenewalClaimedAt sets the "renewal_claimed_at" field to the value that was provided on create.
+func (u *WebSubscriptionUpsert) UpdateRenewalClaimedAt() *WebSubscriptionUpsert {
+	u.SetExcluded(websubscription.FieldRenewalClaimedAt)
+	return u
+}
+
+// ClearRenewalClaimedAt clears the value of the "renewal_claimed_at" field.
+func (u *WebSubscriptionUpsert) ClearRenewalClaimedAt() *WebSubscriptionUpsert {
+	u.SetNull(websubscription.FieldRenewalClaimedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *WebSubscriptionUpsert) SetUpdatedAt(v time.Time) *WebSubscriptionUpsert {
+	u.Set(websubscription.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *WebSubscriptionUpsert) UpdateUpdatedAt() *WebSubscriptionUpsert {
+	u.SetExcluded(websubscription.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.WebSubscription.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(websubscription.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *WebSubscriptionUpsertOne) UpdateNewValues() *WebSubscriptionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(websubscription.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(websubscription.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.WebSubscription.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *WebSubscriptionUpsertOne) Ignore() *WebSubscriptionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WebSubscriptionUpsertOne) DoNothing() *WebSubscriptionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WebSubscriptionCreate.OnConflict
+// documentation for more info.
+func (u *WebSubscriptionUpsertOne) Update(set func(*WebSubscriptionUpsert)) *WebSubscriptionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WebSubscriptionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetHub sets the "hub" field.
+func (u *WebSubscriptionUpsertOne) SetHub(v string) *WebSubscriptionUpsertOne {
+	return u.Update(func(s *WebSubscriptionUpsert) {
+		s.SetHub(v)
+	})
+}
+
+// UpdateHub sets the "hub" field to the value that was provided on create.
+func (u *WebSubscriptionUpsertOne) UpdateHub() *WebSubscriptionUpsertOne {
+	return u.Update(func(s *WebSubscriptionUpsert) {
+		s.UpdateHub()
+	})
+}
+
+// SetTopic sets the "topic" field.
+func (u *WebSubscriptionUpsertOne) SetTopic(v string) *WebSubscriptionUpsertOne {
+	return u.Update(func(s *WebSubscriptionUpsert) {
+		s.SetTopic(v)
+	})
+}
+
+// UpdateTopic sets the "topic" field to the value that was provided on create.
+func (u *WebSubscriptionUpsertOne) UpdateTopic() *WebSubscriptionUpsertOne {
+	return u.Update(func(s *WebSubscriptionUpsert) {
+		s.UpdateTopic()
+	})
+}
+
+// SetSecret sets the "secret" field.
+func (u *WebSubscriptionUpsertOne) SetSecret(v string) *WebSubscriptionUpsertOne {
+	return u.Update(func(s *WebSubscriptionUpsert) {
+		s.SetSecret(v)
+	})
+}
+
+// UpdateSecret sets the "secret" field to the value that was provided on create.
+func (u *WebSubscriptionUpsertOne) UpdateSecret() *WebSubscriptionUpsertOne {
+	return u.Update(func(s *WebSubscriptionUpsert) {
+		s.UpdateSecret()
+	})
+}
+
+// SetMode sets the "mode" field.
+func (u *WebSubscriptionUpsertOne) SetMode(v websubscription.Mode) *WebSubscriptionUpsertOne {
+	return u.Update(func(s *WebSubscriptionUpsert) {
+		s.SetMode(v)
+	})
+}
+
+// UpdateMode sets the "mode" field to the value that was provided on create.
+func (u *WebSubscriptionUpsertOne) UpdateMode() *WebSubscriptionUpsertOne {
+	return u.Update(func(s *WebSubscriptionUpsert) {
+		s.UpdateMode()
+	})
+}
+
+// SetActive sets the "active" field.
+func (u *WebSubscriptionUpsertOne) SetActive(v bool) *WebSubscriptionUpsertOne {
+	return u.Update(func(s *WebSubscriptionUpsert) {
+		s.SetActive(v)
+	})
+}
+
+// UpdateActive sets the "active" field to the value that was provided on create.
+func (u *WebSubscriptionUpsertOne) UpdateActive() *WebSubscriptionUpsertOne {
+	return u.Update(func(s *WebSubscriptionUpsert) {
+		s.UpdateActive()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *WebSubscriptionUpsertOne) SetExpiresAt(v time.Time) *WebSubscriptionUpsertOne {
+	return u.Update(func(s *WebSubscriptionUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *WebSubscriptionUpsertOne) UpdateExpiresAt() *WebSubscriptionUpsertOne {
+	return u.Update(func(s *WebSubscriptionUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *WebSubscriptionUpsertOne) ClearExpiresAt() *WebSubscriptionUpsertOne {
+	return u.Update(func(s *WebSubscriptionUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// SetRenewAt sets the "renew_at" field.
+func (u *WebSubscriptionUpsertOne) SetRenewAt(v time.Time) *WebSubscriptionUpsertOne {
+	return u.Update(func(s *WebSubscriptionUpsert) {
+		s.SetRenewAt(v)
+	})
+}
+
+// UpdateRenewAt sets the "renew_at" field to the value that was provided on create.
+func (u *WebSubscriptionUpsertOne) UpdateRenewAt() *WebSubscriptionUpsertOne {
+	return u.Update(func(s *WebSubscriptionUpsert) {
+		s.UpdateRenewAt()
+	})
+}
+
+// ClearRenewAt clears the value of the "renew_at" field.
+func (u *WebSubscriptionUpsertOne) ClearRenewAt() *WebSubscriptionUpsertOne {
+	return u.Update(func(s *WebSubscriptionUpsert) {
+		s.ClearRenewAt()
+	})
+}
+
+// SetRenewalClaimedAt sets the "renewal_claimed_at" field.
+func (u *WebSubscriptionUpsertOne) SetRenewalClaimedAt(v time.Time) *WebSubscriptionUpsertOne {
+	return u.Update(func(s *WebSubscriptionUpsert) {
+		s.SetRenewalClaimedAt(v)
+	})
+}
+
+// UpdateRenewalClaimedAt sets the "renewal_claimed_at" field to the value that was provided on create.
+func (u *WebSubscriptionUpsertOne) UpdateRenewalClaimedAt() *WebSubscriptionUpsertOne {
+	return u.Update(func(s *WebSubscriptionUpsert) {
+		s.UpdateRenewalClaimedAt()
+	})
+}
+
+// ClearRenewalClaimedAt clears the value of the "renewal_claimed_at" field.
+func (u *WebSubscriptionUpsertOne) ClearRenewalClaimedAt() *WebSubscriptionUpsertOne {
+	return u.Update(func(s *WebSubscriptionUpsert) {
+		s.ClearRenewalClaimedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *WebSubscriptionUpsertOne) SetUpdatedAt(v time.Time) *WebSubscriptionUpsertOne {
+	return u.Update(func(s *WebSubscriptionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *WebSubscriptionUpsertOne) UpdateUpdatedAt() *WebSubscriptionUpsertOne {
+	return u.Update(func(s *WebSubscriptionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *WebSubscriptionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for WebSubscriptionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WebSubscriptionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *WebSubscriptionUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: WebSubscriptionUpsertOne.ID is not supported by MySQL driver. Use WebSubscriptionUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *WebSubscriptionUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// WebSubscriptionCreateBulk is the builder for creating many WebSubscription entities in bulk.
+type WebSubscriptionCreateBulk struct {
+	config
+	err      error
+	builders []*WebSubscriptionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the WebSubscription entities in the database.
+func (wscb *WebSubscriptionCreateBulk) Save(ctx context.Context) ([]*WebSubscription, error) {
+	if wscb.err != nil {
+		return nil, wscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(wscb.builders))
+	nodes := make([]*WebSubscription, len(wscb.builders))
+	mutators := make([]Mutator, len(wscb.builders))
+	for i := range wscb.builders {
+		func(i int, root context.Context) {
+			builder := wscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WebSubscriptionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, wscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = wscb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, wscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, wscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wscb *WebSubscriptionCreateBulk) SaveX(ctx context.Context) []*WebSubscription {
+	v, err := wscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wscb *WebSubscriptionCreateBulk) Exec(ctx context.Context) error {
+	_, err := wscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wscb *WebSubscriptionCreateBulk) ExecX(ctx context.Context) {
+	if err := wscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.WebSubscription.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WebSubscriptionUpsert) {
+//			SetHub(v+v).
+//		}).
+//		Exec(ctx)
+func (wscb *WebSubscriptionCreateBulk) OnConflict(opts ...sql.ConflictOption) *WebSubscriptionUpsertBulk {
+	wscb.conflict = opts
+	return &WebSubscriptionUpsertBulk{
+		create: wscb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.WebSubscription.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (wscb *WebSubscriptionCreateBulk) OnConflictColumns(columns ...string) *WebSubscriptionUpsertBulk {
+	wscb.conflict = append(wscb.conflict, sql.ConflictColumns(columns...))
+	return &WebSubscriptionUpsertBulk{
+		create: wscb,
+	}
+}
+
+// WebSubscriptionUpsertBulk is the builder for "upsert"-ing
+// a bulk of WebSubscription nodes.
+type WebSubscriptionUpsertBulk struct {
+	create *WebSubscriptionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.WebSubscription.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(websubscription.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *WebSubscriptionUpsertBulk) UpdateNewValues() *WebSubscriptionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(websubscription.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(websubscription.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.WebSubscription.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *WebSubscriptionUpsertBulk) Ignore() *WebSubscriptionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WebSubscriptionUpsertBulk) DoNothing() *WebSubscriptionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WebSubscriptionCreateBulk.OnConflict
+// documentation for more info.
+func (u *WebSubscriptionUpsertBulk) Update(set func(*WebSubscriptionUpsert)) *WebSubscriptionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WebSubscriptionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetHub sets the "hub" field.
+func (u *WebSubscriptionUpsertBulk) SetHub(v string) *WebSubscriptionUpsertBulk {
+	return u.Update(func(s *WebSubscriptionUpsert) {
+		s.SetHub(v)
+	})
+}
+
+// UpdateHub sets the "hub" field to the value that was provided on create.
+func (u *WebSubscriptionUpsertBulk) UpdateHub() *WebSubscriptionUpsertBulk {
+	return u.Update(func(s *WebSubscriptionUpsert) {
+		s.UpdateHub()
+	})
+}
+
+// SetTopic sets the "topic" field.
+func (u *WebSubscriptionUpsertBulk) SetTopic(v string) *WebSubscriptionUpsertBulk {
+	return u.Update(func(s *WebSubscriptionUpsert) {
+		s.SetTopic(v)
+	})
+}
+
+// UpdateTopic sets the "topic" field to the value that was provided on create.
+func (u *WebSubscriptionUpsertBulk) UpdateTopic() *WebSubscriptionUpsertBulk {
+	return u.Update(func(s *WebSubscriptionUpsert) {
+		s.UpdateTopic()
+	})
+}
+
+// SetSecret sets the "secret" field.
+func (u *WebSubscriptionUpsertBulk) SetSecret(v string) *WebSubscriptionUpsertBulk {
+	return u.Update(func(s *WebSubscriptionUpsert) {
+		s.SetSecret(v)
+	})
+}
+
+// UpdateSecret sets the "secret" field to the value that was provided on create.
+func (u *WebSubscriptionUpsertBulk) UpdateSecret() *WebSubscriptionUpsertBulk {
+	return u.Update(func(s *WebSubscriptionUpsert) {
+		s.UpdateSecret()
+	})
+}
+
+// SetMode sets the "mode" field.
+func (u *WebSubscriptionUpsertBulk) SetMode(v websubscription.Mode) *WebSubscriptionUpsertBulk {
+	return u.Update(func(s *WebSubscriptionUpsert) {
+		s.SetMode(v)
+	})
+}
+
+// UpdateMode sets the "mode" field to the value that was provided on create.
+func (u *WebSubscriptionUpsertBulk) UpdateMode() *WebSubscriptionUpsertBulk {
+	return u.Update(func(s *WebSubscriptionUpsert) {
+		s.UpdateMode()
+	})
+}
+
+// SetActive sets the "active" field.
+func (u *WebSubscriptionUpsertBulk) SetActive(v bool) *WebSubscriptionUpsertBulk {
+	return u.Update(func(s *WebSubscriptionUpsert) {
+		s.SetActive(v)
+	})
+}
+
+// UpdateActive sets the "active" field to the value that was provided on create.
+func (u *WebSubscriptionUpsertBulk) UpdateActive() *WebSubscriptionUpsertBulk {
+	return u.Update(func(s *WebSubscriptionUpsert) {
+		s.UpdateActive()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *WebSubscriptionUpsertBulk) SetExpiresAt(v time.Time) *WebSubscriptionUpsertBulk {
+	return u.Update(func(s *WebSubscriptionUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *WebSubscriptionUpsertBulk) UpdateExpiresAt() *WebSubscriptionUpsertBulk {
+	return u.Update(func(s *WebSubscriptionUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *WebSubscriptionUpsertBulk) ClearExpiresAt() *WebSubscriptionUpsertBulk {
+	return u.Update(func(s *WebSubscriptionUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// SetRenewAt sets the "renew_at" field.
+func (u *WebSubscriptionUpsertBulk) SetRenewAt(v time.Time) *WebSubscriptionUpsertBulk {
+	return u.Update(func(s *WebSubscriptionUpsert) {
+		s.SetRenewAt(v)
+	})
+}
+
+// UpdateRenewAt sets the "renew_at" field to the value that was provided on create.
+func (u *WebSubscriptionUpsertBulk) UpdateRenewAt() *WebSubscriptionUpsertBulk {
+	return u.Update(func(s *WebSubscriptionUpsert) {
+		s.UpdateRenewAt()
+	})
+}
+
+// ClearRenewAt clears the value of the "renew_at" field.
+func (u *WebSubscriptionUpsertBulk) ClearRenewAt() *WebSubscriptionUpsertBulk {
+	return u.Update(func(s *WebSubscriptionUpsert) {
+		s.ClearRenewAt()
+	})
+}
+
+// SetRenewalClaimedAt sets the "renewal_claimed_at" field.
+func (u *WebSubscriptionUpsertBulk) SetRenewalClaimedAt(v time.Time) *WebSubscriptionUpsertBulk {
+	return u.Update(func(s *WebSubscriptionUpsert) {
+		s.SetRenewalClaimedAt(v)
+	})
+}
+
+// UpdateRenewalClaimedAt sets the "renewal_claimed_at" field to the value that was provided on create.
+func (u *WebSubscriptionUpsertBulk) UpdateRenewalClaimedAt() *WebSubscriptionUpsertBulk {
+	return u.Update(func(s *WebSubscriptionUpsert) {
+		s.UpdateRenewalClaimedAt()
+	})
+}
+
+// ClearRenewalClaimedAt clears the value of the "renewal_claimed_at" field.
+func (u *WebSubscriptionUpsertBulk) ClearRenewalClaimedAt() *WebSubscriptionUpsertBulk {
+	return u.Update(func(s *WebSubscriptionUpsert) {
+		s.ClearRenewalClaimedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *WebSubscriptionUpsertBulk) SetUpdatedAt(v time.Time) *WebSubscriptionUpsertBulk {
+	return u.Update(func(s *WebSubscriptionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *WebSubscriptionUpsertBulk) UpdateUpdatedAt() *WebSubscriptionUpsertBulk {
+	return u.Update(func(s *WebSubscriptionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *WebSubscriptionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the WebSubscriptionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for WebSubscriptionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WebSubscriptionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
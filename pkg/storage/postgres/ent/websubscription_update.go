@@ -0,0 +1,564 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/glanceapp/glance/pkg/storage/postgres/ent/predicate"
+	"github.com/glanceapp/glance/pkg/storage/postgres/ent/websubscription"
+)
+
+// WebSubscriptionUpdate is the builder for updating WebSubscription entities.
+type WebSubscriptionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WebSubscriptionMutation
+}
+
+// Where appends a list predicates to the WebSubscriptionUpdate builder.
+func (wsu *WebSubscriptionUpdate) Where(ps ...predicate.WebSubscription) *WebSubscriptionUpdate {
+	wsu.mutation.Where(ps...)
+	return wsu
+}
+
+// SetHub sets the "hub" field.
+func (wsu *WebSubscriptionUpdate) SetHub(s string) *WebSubscriptionUpdate {
+	wsu.mutation.SetHub(s)
+	return wsu
+}
+
+// SetNillableHub sets the "hub" field if the given value is not nil.
+func (wsu *WebSubscriptionUpdate) SetNillableHub(s *string) *WebSubscriptionUpdate {
+	if s != nil {
+		wsu.SetHub(*s)
+	}
+	return wsu
+}
+
+// SetTopic sets the "topic" field.
+func (wsu *WebSubscriptionUpdate) SetTopic(s string) *WebSubscriptionUpdate {
+	wsu.mutation.SetTopic(s)
+	return wsu
+}
+
+// SetNillableTopic sets the "topic" field if the given value is not nil.
+func (wsu *WebSubscriptionUpdate) SetNillableTopic(s *string) *WebSubscriptionUpdate {
+	if s != nil {
+		wsu.SetTopic(*s)
+	}
+	return wsu
+}
+
+// SetSecret sets the "secret" field.
+func (wsu *WebSubscriptionUpdate) SetSecret(s string) *WebSubscriptionUpdate {
+	wsu.mutation.SetSecret(s)
+	return wsu
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (wsu *WebSubscriptionUpdate) SetNillableSecret(s *string) *WebSubscriptionUpdate {
+	if s != nil {
+		wsu.SetSecret(*s)
+	}
+	return wsu
+}
+
+// SetMode sets the "mode" field.
+func (wsu *WebSubscriptionUpdate) SetMode(w websubscription.Mode) *WebSubscriptionUpdate {
+	wsu.mutation.SetMode(w)
+	return wsu
+}
+
+// SetNillableMode sets the "mode" field if the given value is not nil.
+func (wsu *WebSubscriptionUpdate) SetNillableMode(w *websubscription.Mode) *WebSubscriptionUpdate {
+	if w != nil {
+		wsu.SetMode(*w)
+	}
+	return wsu
+}
+
+// SetActive sets the "active" field.
+func (wsu *WebSubscriptionUpdate) SetActive(b bool) *WebSubscriptionUpdate {
+	wsu.mutation.SetActive(b)
+	return wsu
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (wsu *WebSubscriptionUpdate) SetNillableActive(b *bool) *WebSubscriptionUpdate {
+	if b != nil {
+		wsu.SetActive(*b)
+	}
+	return wsu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (wsu *WebSubscriptionUpdate) SetExpiresAt(t time.Time) *WebSubscriptionUpdate {
+	wsu.mutation.SetExpiresAt(t)
+	return wsu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (wsu *WebSubscriptionUpdate) SetNillableExpiresAt(t *time.Time) *WebSubscriptionUpdate {
+	if t != nil {
+		wsu.SetExpiresAt(*t)
+	}
+	return wsu
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (wsu *WebSubscriptionUpdate) ClearExpiresAt() *WebSubscriptionUpdate {
+	wsu.mutation.ClearExpiresAt()
+	return wsu
+}
+
+// SetRenewAt sets the "renew_at" field.
+func (wsu *WebSubscriptionUpdate) SetRenewAt(t time.Time) *WebSubscriptionUpdate {
+	wsu.mutation.SetRenewAt(t)
+	return wsu
+}
+
+// SetNillableRenewAt sets the "renew_at" field if the given value is not nil.
+func (wsu *WebSubscriptionUpdate) SetNillableRenewAt(t *time.Time) *WebSubscriptionUpdate {
+	if t != nil {
+		wsu.SetRenewAt(*t)
+	}
+	return wsu
+}
+
+// ClearRenewAt clears the value of the "renew_at" field.
+func (wsu *WebSubscriptionUpdate) ClearRenewAt() *WebSubscriptionUpdate {
+	wsu.mutation.ClearRenewAt()
+	return wsu
+}
+
+// SetRenewalClaimedAt sets the "renewal_claimed_at" field.
+func (wsu *WebSubscriptionUpdate) SetRenewalClaimedAt(t time.Time) *WebSubscriptionUpdate {
+	wsu.mutation.SetRenewalClaimedAt(t)
+	return wsu
+}
+
+// SetNillableRenewalClaimedAt sets the "renewal_claimed_at" field if the given value is not nil.
+func (wsu *WebSubscriptionUpdate) SetNillableRenewalClaimedAt(t *time.Time) *WebSubscriptionUpdate {
+	if t != nil {
+		wsu.SetRenewalClaimedAt(*t)
+	}
+	return wsu
+}
+
+// ClearRenewalClaimedAt clears the value of the "renewal_claimed_at" field.
+func (wsu *WebSubscriptionUpdate) ClearRenewalClaimedAt() *WebSubscriptionUpdate {
+	wsu.mutation.ClearRenewalClaimedAt()
+	return wsu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wsu *WebSubscriptionUpdate) SetUpdatedAt(t time.Time) *WebSubscriptionUpdate {
+	wsu.mutation.SetUpdatedAt(t)
+	return wsu
+}
+
+// Mutation returns the WebSubscriptionMutation object of the builder.
+func (wsu *WebSubscriptionUpdate) Mutation() *WebSubscriptionMutation {
+	return wsu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (wsu *WebSubscriptionUpdate) Save(ctx context.Context) (int, error) {
+	wsu.defaults()
+	return withHooks(ctx, wsu.sqlSave, wsu.mutation, wsu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wsu *WebSubscriptionUpdate) SaveX(ctx context.Context) int {
+	affected, err := wsu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wsu *WebSubscriptionUpdate) Exec(ctx context.Context) error {
+	_, err := wsu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wsu *WebSubscriptionUpdate) ExecX(ctx context.Context) {
+	if err := wsu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wsu *WebSubscriptionUpdate) defaults() {
+	if _, ok := wsu.mutation.UpdatedAt(); !ok {
+		v := websubscription.UpdateDefaultUpdatedAt()
+		wsu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wsu *WebSubscriptionUpdate) check() error {
+	if v, ok := wsu.mutation.Mode(); ok {
+		if err := websubscription.ModeValidator(v); err != nil {
+			return &ValidationError{Name: "mode", err: fmt.Errorf(`ent: validator failed for field "WebSubscription.mode": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (wsu *WebSubscriptionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := wsu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(websubscription.Table, websubscription.Columns, sqlgraph.NewFieldSpec(websubscription.FieldID, field.TypeString))
+	if ps := wsu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wsu.mutation.Hub(); ok {
+		_spec.SetField(websubscription.FieldHub, field.TypeString, value)
+	}
+	if value, ok := wsu.mutation.Topic(); ok {
+		_spec.SetField(websubscription.FieldTopic, field.TypeString, value)
+	}
+	if value, ok := wsu.mutation.Secret(); ok {
+		_spec.SetField(websubscription.FieldSecret, field.TypeString, value)
+	}
+	if value, ok := wsu.mutation.Mode(); ok {
+		_spec.SetField(websubscription.FieldMode, field.TypeEnum, value)
+	}
+	if value, ok := wsu.mutation.Active(); ok {
+		_spec.SetField(websubscription.FieldActive, field.TypeBool, value)
+	}
+	if value, ok := wsu.mutation.ExpiresAt(); ok {
+		_spec.SetField(websubscription.FieldExpiresAt, field.TypeTime, value)
+	}
+	if wsu.mutation.ExpiresAtCleared() {
+		_spec.ClearField(websubscription.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := wsu.mutation.RenewAt(); ok {
+		_spec.SetField(websubscription.FieldRenewAt, field.TypeTime, value)
+	}
+	if wsu.mutation.RenewAtCleared() {
+		_spec.ClearField(websubscription.FieldRenewAt, field.TypeTime)
+	}
+	if value, ok := wsu.mutation.RenewalClaimedAt(); ok {
+		_spec.SetField(websubscription.FieldRenewalClaimedAt, field.TypeTime, value)
+	}
+	if wsu.mutation.RenewalClaimedAtCleared() {
+		_spec.ClearField(websubscription.FieldRenewalClaimedAt, field.TypeTime)
+	}
+	if value, ok := wsu.mutation.UpdatedAt(); ok {
+		_spec.SetField(websubscription.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, wsu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{websubscription.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	wsu.mutation.done = true
+	return n, nil
+}
+
+// WebSubscriptionUpdateOne is the builder for updating a single WebSubscription entity.
+type WebSubscriptionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WebSubscriptionMutation
+}
+
+// SetHub sets the "hub" field.
+func (wsuo *WebSubscriptionUpdateOne) SetHub(s string) *WebSubscriptionUpdateOne {
+	wsuo.mutation.SetHub(s)
+	return wsuo
+}
+
+// SetNillableHub sets the "hub" field if the given value is not nil.
+func (wsuo *WebSubscriptionUpdateOne) SetNillableHub(s *string) *WebSubscriptionUpdateOne {
+	if s != nil {
+		wsuo.SetHub(*s)
+	}
+	return wsuo
+}
+
+// SetTopic sets the "topic" field.
+func (wsuo *WebSubscriptionUpdateOne) SetTopic(s string) *WebSubscriptionUpdateOne {
+	wsuo.mutation.SetTopic(s)
+	return wsuo
+}
+
+// SetNillableTopic sets the "topic" field if the given value is not nil.
+func (wsuo *WebSubscriptionUpdateOne) SetNillableTopic(s *string) *WebSubscriptionUpdateOne {
+	if s != nil {
+		wsuo.SetTopic(*s)
+	}
+	return wsuo
+}
+
+// SetSecret sets the "secret" field.
+func (wsuo *WebSubscriptionUpdateOne) SetSecret(s string) *WebSubscriptionUpdateOne {
+	wsuo.mutation.SetSecret(s)
+	return wsuo
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (wsuo *WebSubscriptionUpdateOne) SetNillableSecret(s *string) *WebSubscriptionUpdateOne {
+	if s != nil {
+		wsuo.SetSecret(*s)
+	}
+	return wsuo
+}
+
+// SetMode sets the "mode" field.
+func (wsuo *WebSubscriptionUpdateOne) SetMode(w websubscription.Mode) *WebSubscriptionUpdateOne {
+	wsuo.mutation.SetMode(w)
+	return wsuo
+}
+
+// SetNillableMode sets the "mode" field if the given value is not nil.
+func (wsuo *WebSubscriptionUpdateOne) SetNillableMode(w *websubscription.Mode) *WebSubscriptionUpdateOne {
+	if w != nil {
+		wsuo.SetMode(*w)
+	}
+	return wsuo
+}
+
+// SetActive sets the "active" field.
+func (wsuo *WebSubscriptionUpdateOne) SetActive(b bool) *WebSubscriptionUpdateOne {
+	wsuo.mutation.SetActive(b)
+	return wsuo
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (wsuo *WebSubscriptionUpdateOne) SetNillableActive(b *bool) *WebSubscriptionUpdateOne {
+	if b != nil {
+		wsuo.SetActive(*b)
+	}
+	return wsuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (wsuo *WebSubscriptionUpdateOne) SetExpiresAt(t time.Time) *WebSubscriptionUpdateOne {
+	wsuo.mutation.SetExpiresAt(t)
+	return wsuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (wsuo *WebSubscriptionUpdateOne) SetNillableExpiresAt(t *time.Time) *WebSubscriptionUpdateOne {
+	if t != nil {
+		wsuo.SetExpiresAt(*t)
+	}
+	return wsuo
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (wsuo *WebSubscriptionUpdateOne) ClearExpiresAt() *WebSubscriptionUpdateOne {
+	wsuo.mutation.ClearExpiresAt()
+	return wsuo
+}
+
+// SetRenewAt sets the "renew_at" field.
+func (wsuo *WebSubscriptionUpdateOne) SetRenewAt(t time.Time) *WebSubscriptionUpdateOne {
+	wsuo.mutation.SetRenewAt(t)
+	return wsuo
+}
+
+// SetNillableRenewAt sets the "renew_at" field if the given value is not nil.
+func (wsuo *WebSubscriptionUpdateOne) SetNillableRenewAt(t *time.Time) *WebSubscriptionUpdateOne {
+	if t != nil {
+		wsuo.SetRenewAt(*t)
+	}
+	return wsuo
+}
+
+// ClearRenewAt clears the value of the "renew_at" field.
+func (wsuo *WebSubscriptionUpdateOne) ClearRenewAt() *WebSubscriptionUpdateOne {
+	wsuo.mutation.ClearRenewAt()
+	return wsuo
+}
+
+// SetRenewalClaimedAt sets the "renewal_claimed_at" field.
+func (wsuo *WebSubscriptionUpdateOne) SetRenewalClaimedAt(t time.Time) *WebSubscriptionUpdateOne {
+	wsuo.mutation.SetRenewalClaimedAt(t)
+	return wsuo
+}
+
+// SetNillableRenewalClaimedAt sets the "renewal_claimed_at" field if the given value is not nil.
+func (wsuo *WebSubscriptionUpdateOne) SetNillableRenewalClaimedAt(t *time.Time) *WebSubscriptionUpdateOne {
+	if t != nil {
+		wsuo.SetRenewalClaimedAt(*t)
+	}
+	return wsuo
+}
+
+// ClearRenewalClaimedAt clears the value of the "renewal_claimed_at" field.
+func (wsuo *WebSubscriptionUpdateOne) ClearRenewalClaimedAt() *WebSubscriptionUpdateOne {
+	wsuo.mutation.ClearRenewalClaimedAt()
+	return wsuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wsuo *WebSubscriptionUpdateOne) SetUpdatedAt(t time.Time) *WebSubscriptionUpdateOne {
+	wsuo.mutation.SetUpdatedAt(t)
+	return wsuo
+}
+
+// Mutation returns the WebSubscriptionMutation object of the builder.
+func (wsuo *WebSubscriptionUpdateOne) Mutation() *WebSubscriptionMutation {
+	return wsuo.mutation
+}
+
+// Where appends a list predicates to the WebSubscriptionUpdate builder.
+func (wsuo *WebSubscriptionUpdateOne) Where(ps ...predicate.WebSubscription) *WebSubscriptionUpdateOne {
+	wsuo.mutation.Where(ps...)
+	return wsuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (wsuo *WebSubscriptionUpdateOne) Select(field string, fields ...string) *WebSubscriptionUpdateOne {
+	wsuo.fields = append([]string{field}, fields...)
+	return wsuo
+}
+
+// Save executes the query and returns the updated WebSubscription entity.
+func (wsuo *WebSubscriptionUpdateOne) Save(ctx context.Context) (*WebSubscription, error) {
+	wsuo.defaults()
+	return withHooks(ctx, wsuo.sqlSave, wsuo.mutation, wsuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wsuo *WebSubscriptionUpdateOne) SaveX(ctx context.Context) *WebSubscription {
+	node, err := wsuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (wsuo *WebSubscriptionUpdateOne) Exec(ctx context.Context) error {
+	_, err := wsuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wsuo *WebSubscriptionUpdateOne) ExecX(ctx context.Context) {
+	if err := wsuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wsuo *WebSubscriptionUpdateOne) defaults() {
+	if _, ok := wsuo.mutation.UpdatedAt(); !ok {
+		v := websubscription.UpdateDefaultUpdatedAt()
+		wsuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wsuo *WebSubscriptionUpdateOne) check() error {
+	if v, ok := wsuo.mutation.Mode(); ok {
+		if err := websubscription.ModeValidator(v); err != nil {
+			return &ValidationError{Name: "mode", err: fmt.Errorf(`ent: validator failed for field "WebSubscription.mode": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (wsuo *WebSubscriptionUpdateOne) sqlSave(ctx context.Context) (_node *WebSubscription, err error) {
+	if err := wsuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(websubscription.Table, websubscription.Columns, sqlgraph.NewFieldSpec(websubscription.FieldID, field.TypeString))
+	id, ok := wsuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WebSubscription.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := wsuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, websubscription.FieldID)
+		for _, f := range fields {
+			if !websubscription.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != websubscription.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := wsuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wsuo.mutation.Hub(); ok {
+		_spec.SetField(websubscription.FieldHub, field.TypeString, value)
+	}
+	if value, ok := wsuo.mutation.Topic(); ok {
+		_spec.SetField(websubscription.FieldTopic, field.TypeString, value)
+	}
+	if value, ok := wsuo.mutation.Secret(); ok {
+		_spec.SetField(websubscription.FieldSecret, field.TypeString, value)
+	}
+	if value, ok := wsuo.mutation.Mode(); ok {
+		_spec.SetField(websubscription.FieldMode, field.TypeEnum, value)
+	}
+	if value, ok := wsuo.mutation.Active(); ok {
+		_spec.SetField(websubscription.FieldActive, field.TypeBool, value)
+	}
+	if value, ok := wsuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(websubscription.FieldExpiresAt, field.TypeTime, value)
+	}
+	if wsuo.mutation.ExpiresAtCleared() {
+		_spec.ClearField(websubscription.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := wsuo.mutation.RenewAt(); ok {
+		_spec.SetField(websubscription.FieldRenewAt, field.TypeTime, value)
+	}
+	if wsuo.mutation.RenewAtCleared() {
+		_spec.ClearField(websubscription.FieldRenewAt, field.TypeTime)
+	}
+	if value, ok := wsuo.mutation.RenewalClaimedAt(); ok {
+		_spec.SetField(websubscription.FieldRenewalClaimedAt, field.TypeTime, value)
+	}
+	if wsuo.mutation.RenewalClaimedAtCleared() {
+		_spec.ClearField(websubscription.FieldRenewalClaimedAt, field.TypeTime)
+	}
+	if value, ok := wsuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(websubscription.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &WebSubscription{config: wsuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, wsuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{websubscription.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	wsuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,412 @@
+package postgres
+
+import (
+	"context"
+	stdsql "database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/glanceapp/glance/pkg/sources"
+	"github.com/glanceapp/glance/pkg/sources/activities/types"
+	"github.com/pgvector/pgvector-go"
+
+	"github.com/glanceapp/glance/pkg/storage/postgres/ent"
+	"github.com/glanceapp/glance/pkg/storage/postgres/ent/deadletter"
+	"github.com/glanceapp/glance/pkg/storage/postgres/ent/job"
+)
+
+type JobRepository struct {
+	db *DB
+}
+
+func NewJobRepository(db *DB) *JobRepository {
+	return &JobRepository{db: db}
+}
+
+// Enqueue creates a pending job for the activity, identified by its activity ID.
+// A pending job of an earlier revision of the activity is replaced, and starts over from the summarize stage.
+func (r *JobRepository) Enqueue(activity types.Activity) error {
+	ctx := context.Background()
+
+	rawJson, err := activity.MarshalJSON()
+	if err != nil {
+		return fmt.Errorf("marshal activity: %w", err)
+	}
+
+	err = r.db.Client().Job.Create().
+		SetID(types.ActivityID(activity.SourceUID(), activity.UID())).
+		SetSourceUID(activity.SourceUID()).
+		SetSourceType(activity.SourceType()).
+		SetRawJSON(string(rawJson)).
+		OnConflict(
+			sql.ConflictColumns(job.FieldID),
+			// Running jobs keep the revision they're processing. Since the stored revision then
+			// differs from the newer one, the next fetch of the activity enqueues it again.
+			sql.UpdateWhere(sql.NEQ(job.FieldState, job.StateRunning.String())),
+		).
+		Update(func(u *ent.JobUpsert) {
+			u.UpdateSourceType().
+				UpdateRawJSON().
+				SetStage(job.StageSummarize).
+				SetAttempts(0).
+				SetRunAfter(time.Now()).
+				ClearLastError().
+				ClearShortSummary().
+				ClearFullSummary().
+				ClearEmbedding()
+		}).
+		Exec(ctx)
+	// No row is returned if the job is running.
+	if errors.Is(err, stdsql.ErrNoRows) {
+		return nil
+	}
+
+	return err
+}
+
+// Claim locks up to limit jobs that are ready to run and marks them as running.
+// Running jobs whose claim is older than lease are assumed to be abandoned
+// by a crashed worker and are claimed again.
+func (r *JobRepository) Claim(limit int, lease time.Duration) ([]*types.Job, error) {
+	ctx := context.Background()
+	now := time.Now()
+
+	tx, err := r.db.Client().Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("begin tx: %w", err)
+	}
+
+	claimable, err := tx.Job.Query().
+		Where(job.Or(
+			job.And(job.StateEQ(job.StatePending), job.RunAfterLTE(now)),
+			job.And(job.StateEQ(job.StateRunning), job.ClaimedAtLT(now.Add(-lease))),
+		)).
+		Order(ent.Asc(job.FieldRunAfter)).
+		Limit(limit).
+		ForUpdate(sql.WithLockAction(sql.SkipLocked)).
+		All(ctx)
+	if err != nil {
+		return nil, rollback(tx, fmt.Errorf("query jobs: %w", err))
+	}
+
+	if len(claimable) == 0 {
+		return nil, tx.Rollback()
+	}
+
+	// Jobs are decoded before they are claimed. A job that can't be decoded, e.g. of a removed source type,
+	// would fail on every claim, so it is moved to the dead letters instead of failing the whole batch.
+	result := make([]*types.Job, 0, len(claimable))
+	ids := make([]string, 0, len(claimable))
+	for _, j := range claimable {
+		out, err := jobFromEnt(j)
+		if err != nil {
+			j.Attempts++
+			if err := deadLetter(ctx, tx, j, fmt.Errorf("deserialize job: %w", err)); err != nil {
+				return nil, rollback(tx, err)
+			}
+			continue
+		}
+		out.Attempts++
+		result = append(result, out)
+		ids = append(ids, j.ID)
+	}
+
+	if len(ids) > 0 {
+		err = tx.Job.Update().
+			Where(job.IDIn(ids...)).
+			SetState(job.StateRunning).
+			SetClaimedAt(now).
+			AddAttempts(1).
+			Exec(ctx)
+		if err != nil {
+			return nil, rollback(tx, fmt.Errorf("claim jobs: %w", err))
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit tx: %w", err)
+	}
+
+	return result, nil
+}
+
+// Save persists the stage progress of a running job.
+func (r *JobRepository) Save(in *types.Job) error {
+	ctx := context.Background()
+
+	update := r.db.Client().Job.UpdateOneID(in.ID).
+		SetStage(job.Stage(in.Stage))
+
+	if in.Summary != nil {
+		update = update.
+			SetShortSummary(in.Summary.ShortSummary).
+			SetFullSummary(in.Summary.FullSummary)
+	}
+
+	if in.Embedding != nil {
+		update = update.SetEmbedding(pgvector.NewVector(in.Embedding))
+	}
+
+	return update.Exec(ctx)
+}
+
+// Complete removes the finished job.
+func (r *JobRepository) Complete(id string) error {
+	ctx := context.Background()
+	return r.db.Client().Job.DeleteOneID(id).Exec(ctx)
+}
+
+// Retry releases the job, so that it can be claimed again after runAfter.
+func (r *JobRepository) Retry(id string, cause error, runAfter time.Time) error {
+	ctx := context.Background()
+	return r.db.Client().Job.UpdateOneID(id).
+		SetState(job.StatePending).
+		SetLastError(cause.Error()).
+		SetRunAfter(runAfter).
+		ClearClaimedAt().
+		Exec(ctx)
+}
+
+// Release returns the claimed job to the queue without counting its attempt,
+// so that it can be claimed again after runAfter.
+func (r *JobRepository) Release(id string, runAfter time.Time) error {
+	ctx := context.Background()
+	return r.db.Client().Job.UpdateOneID(id).
+		SetState(job.StatePending).
+		AddAttempts(-1).
+		SetRunAfter(runAfter).
+		ClearClaimedAt().
+		Exec(ctx)
+}
+
+// Fail moves the job to the dead letters.
+func (r *JobRepository) Fail(id string, cause error) error {
+	ctx := context.Background()
+
+	tx, err := r.db.Client().Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+
+	j, err := tx.Job.Get(ctx, id)
+	if err != nil {
+		return rollback(tx, fmt.Errorf("get job: %w", err))
+	}
+
+	if err := deadLetter(ctx, tx, j, cause); err != nil {
+		return rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit tx: %w", err)
+	}
+
+	return nil
+}
+
+// deadLetter moves the job to the dead letters within the transaction.
+func deadLetter(ctx context.Context, tx *ent.Tx, j *ent.Job, cause error) error {
+	err := tx.DeadLetter.Create().
+		SetID(j.ID).
+		SetSourceUID(j.SourceUID).
+		SetSourceType(j.SourceType).
+		SetRawJSON(j.RawJSON).
+		SetStage(deadletter.Stage(j.Stage)).
+		SetError(cause.Error()).
+		SetAttempts(j.Attempts).
+		SetShortSummary(j.ShortSummary).
+		SetFullSummary(j.FullSummary).
+		SetNillableEmbedding(j.Embedding).
+		OnConflictColumns(deadletter.FieldID).
+		UpdateNewValues().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("create dead letter: %w", err)
+	}
+
+	if err := tx.Job.DeleteOneID(j.ID).Exec(ctx); err != nil {
+		return fmt.Errorf("delete job: %w", err)
+	}
+
+	return nil
+}
+
+// Counts returns the number of jobs in each state.
+func (r *JobRepository) Counts() (types.JobCounts, error) {
+	ctx := context.Background()
+
+	var rows []struct {
+		State job.State `json:"state"`
+		Count int       `json:"count"`
+	}
+	err := r.db.Client().Job.Query().
+		GroupBy(job.FieldState).
+		Aggregate(ent.Count()).
+		Scan(ctx, &rows)
+	if err != nil {
+		return types.JobCounts{}, err
+	}
+
+	var out types.JobCounts
+	for _, row := range rows {
+		switch row.State {
+		case job.StatePending:
+			out.Pending = row.Count
+		case job.StateRunning:
+			out.Running = row.Count
+		}
+	}
+
+	out.Failed, err = r.db.Client().DeadLetter.Query().Count(ctx)
+	if err != nil {
+		return types.JobCounts{}, fmt.Errorf("count dead letters: %w", err)
+	}
+
+	return out, nil
+}
+
+// DeadLetters returns all dead letters, most recently failed first.
+func (r *JobRepository) DeadLetters() ([]*types.DeadLetter, error) {
+	ctx := context.Background()
+
+	letters, err := r.db.Client().DeadLetter.Query().
+		Order(ent.Desc(deadletter.FieldFailedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Jobs that couldn't be decoded are dead-lettered too, and are listed without their activity.
+	result := make([]*types.DeadLetter, len(letters))
+	for i, l := range letters {
+		result[i] = &types.DeadLetter{
+			ID:        l.ID,
+			SourceUID: l.SourceUID,
+			Activity:  decodeActivity(l.SourceType, l.RawJSON),
+			Stage:     types.JobStage(l.Stage),
+			Error:     l.Error,
+			Attempts:  l.Attempts,
+			FailedAt:  l.FailedAt,
+		}
+	}
+
+	return result, nil
+}
+
+// Requeue moves dead letters back to the queue, resuming from the failed stage.
+// All dead letters are requeued if no IDs are given. It returns the number of requeued jobs.
+func (r *JobRepository) Requeue(ids ...string) (int, error) {
+	ctx := context.Background()
+
+	tx, err := r.db.Client().Tx(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("begin tx: %w", err)
+	}
+
+	query := tx.DeadLetter.Query()
+	if len(ids) > 0 {
+		query = query.Where(deadletter.IDIn(ids...))
+	}
+
+	letters, err := query.All(ctx)
+	if err != nil {
+		return 0, rollback(tx, fmt.Errorf("query dead letters: %w", err))
+	}
+
+	for _, l := range letters {
+		err = tx.Job.Create().
+			SetID(l.ID).
+			SetSourceUID(l.SourceUID).
+			SetSourceType(l.SourceType).
+			SetRawJSON(l.RawJSON).
+			SetStage(job.Stage(l.Stage)).
+			SetShortSummary(l.ShortSummary).
+			SetFullSummary(l.FullSummary).
+			SetNillableEmbedding(l.Embedding).
+			// A newer revision of the activity may have been queued in the meantime.
+			OnConflictColumns(job.FieldID).
+			DoNothing().
+			Exec(ctx)
+		if err != nil && !errors.Is(err, stdsql.ErrNoRows) {
+			return 0, rollback(tx, fmt.Errorf("create job: %w", err))
+		}
+
+		if err := tx.DeadLetter.DeleteOneID(l.ID).Exec(ctx); err != nil {
+			return 0, rollback(tx, fmt.Errorf("delete dead letter: %w", err))
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("commit tx: %w", err)
+	}
+
+	return len(letters), nil
+}
+
+// Discard removes dead letters. All dead letters are removed if no IDs are given.
+// It returns the number of removed dead letters.
+func (r *JobRepository) Discard(ids ...string) (int, error) {
+	ctx := context.Background()
+
+	del := r.db.Client().DeadLetter.Delete()
+	if len(ids) > 0 {
+		del = del.Where(deadletter.IDIn(ids...))
+	}
+
+	return del.Exec(ctx)
+}
+
+func jobFromEnt(in *ent.Job) (*types.Job, error) {
+	act, err := sources.NewActivity(in.SourceType)
+	if err != nil {
+		return nil, fmt.Errorf("new activity: %w", err)
+	}
+
+	err = act.UnmarshalJSON([]byte(in.RawJSON))
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal activity: %w", err)
+	}
+
+	out := &types.Job{
+		ID:       in.ID,
+		Activity: act,
+		Stage:    types.JobStage(in.Stage),
+		Attempts: in.Attempts,
+	}
+
+	if in.FullSummary != "" {
+		out.Summary = &types.ActivitySummary{
+			ShortSummary: in.ShortSummary,
+			FullSummary:  in.FullSummary,
+		}
+	}
+
+	if in.Embedding != nil {
+		out.Embedding = in.Embedding.Slice()
+	}
+
+	return out, nil
+}
+
+// decodeActivity returns the stored activity, or nil if it can't be decoded.
+func decodeActivity(sourceType, rawJSON string) types.Activity {
+	act, err := sources.NewActivity(sourceType)
+	if err != nil {
+		return nil
+	}
+
+	if err := act.UnmarshalJSON([]byte(rawJSON)); err != nil {
+		return nil
+	}
+
+	return act
+}
+
+func rollback(tx *ent.Tx, err error) error {
+	if rerr := tx.Rollback(); rerr != nil {
+		return fmt.Errorf("%w: rollback: %v", err, rerr)
+	}
+	return err
+}
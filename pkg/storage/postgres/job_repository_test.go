@@ -0,0 +1,113 @@
+package postgres
+
+import (
+	"database/sql/driver"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/glanceapp/glance/pkg/sources/webhook"
+)
+
+func jobRow(id, sourceType, rawJSON string) map[string]driver.Value {
+	now := time.Now()
+	return map[string]driver.Value{
+		"id":            id,
+		"source_uid":    "webhook/deploys",
+		"source_type":   sourceType,
+		"raw_json":      rawJSON,
+		"stage":         "summarize",
+		"state":         "pending",
+		"attempts":      int64(0),
+		"last_error":    "",
+		"short_summary": "",
+		"full_summary":  "",
+		"run_after":     now,
+		"created_at":    now,
+		"updated_at":    now,
+	}
+}
+
+func TestJobRepositoryClaim(t *testing.T) {
+	item := `{"id":"1","title":"Deployed","source_id":"webhook/deploys"}`
+
+	tests := []struct {
+		name         string
+		jobs         []map[string]driver.Value
+		wantClaimed  []string
+		wantDeadJobs []string
+	}{
+		{
+			name:        "decodable jobs",
+			jobs:        []map[string]driver.Value{jobRow("a", webhook.TypeWebhook, item), jobRow("b", webhook.TypeWebhook, item)},
+			wantClaimed: []string{"a", "b"},
+		},
+		{
+			name:         "unknown source type",
+			jobs:         []map[string]driver.Value{jobRow("a", "removed", item), jobRow("b", webhook.TypeWebhook, item)},
+			wantClaimed:  []string{"b"},
+			wantDeadJobs: []string{"a"},
+		},
+		{
+			name:         "undecodable activity",
+			jobs:         []map[string]driver.Value{jobRow("a", webhook.TypeWebhook, item), jobRow("b", webhook.TypeWebhook, `{"id":`)},
+			wantClaimed:  []string{"a"},
+			wantDeadJobs: []string{"b"},
+		},
+		{
+			name:         "only undecodable jobs",
+			jobs:         []map[string]driver.Value{jobRow("a", "removed", item)},
+			wantDeadJobs: []string{"a"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, conn := newFakeDB(map[string][]map[string]driver.Value{"jobs": tt.jobs})
+
+			jobs, err := NewJobRepository(db).Claim(10, time.Minute)
+			if err != nil {
+				t.Fatalf("Claim() err = %v", err)
+			}
+
+			var claimed []string
+			for _, j := range jobs {
+				claimed = append(claimed, j.ID)
+				if j.Attempts != 1 {
+					t.Errorf("attempts of %s = %d, want 1", j.ID, j.Attempts)
+				}
+			}
+			if !slices.Equal(claimed, tt.wantClaimed) {
+				t.Errorf("claimed %v, want %v", claimed, tt.wantClaimed)
+			}
+
+			if inserts := conn.executed(`INSERT INTO "dead_letters"`); len(inserts) != len(tt.wantDeadJobs) {
+				t.Errorf("created %d dead letters, want %d", len(inserts), len(tt.wantDeadJobs))
+			}
+
+			var dead []string
+			for _, s := range conn.executed(`DELETE FROM "jobs"`) {
+				dead = append(dead, s.args[0].(string))
+			}
+			if !slices.Equal(dead, tt.wantDeadJobs) {
+				t.Errorf("deleted jobs %v, want %v", dead, tt.wantDeadJobs)
+			}
+
+			updates := conn.executed(`UPDATE "jobs"`)
+			if len(tt.wantClaimed) == 0 && len(updates) > 0 {
+				t.Errorf("jobs were claimed: %v", updates)
+			}
+			for _, s := range updates {
+				for _, id := range tt.wantDeadJobs {
+					if slices.Contains(s.args, driver.Value(id)) {
+						t.Errorf("dead-lettered job %s was claimed", id)
+					}
+				}
+			}
+
+			if commits := conn.executed("COMMIT"); len(commits) != 1 {
+				t.Errorf("committed %d times, want once", len(commits))
+			}
+		})
+	}
+}
@@ -6,10 +6,15 @@ import (
 	"fmt"
 
 	"github.com/glanceapp/glance/pkg/sources"
+	"github.com/glanceapp/glance/pkg/sources/activities/types"
 	"github.com/glanceapp/glance/pkg/storage/postgres/ent"
 	"github.com/glanceapp/glance/pkg/storage/postgres/ent/source"
+	"github.com/glanceapp/glance/pkg/storage/postgres/ent/sourcerun"
 )
 
+// maxSourceRuns is the number of runs kept in the history of each source.
+const maxSourceRuns = 100
+
 type SourceRepository struct {
 	db *DB
 }
@@ -32,16 +37,61 @@ func (r *SourceRepository) Add(s sources.Source) error {
 		SetURL(s.URL()).
 		SetType(s.Type()).
 		SetRawJSON(string(rawJson)).
+		SetNillableIngestToken(ingestToken(s)).
 		Save(ctx)
 
 	return err
 }
 
+// Update replaces the configuration of an existing source.
+func (r *SourceRepository) Update(s sources.Source) error {
+	ctx := context.Background()
+
+	rawJson, err := json.Marshal(s)
+	if err != nil {
+		return fmt.Errorf("marshal source: %w", err)
+	}
+
+	update := r.db.Client().Source.UpdateOneID(s.UID()).
+		SetName(s.Name()).
+		SetURL(s.URL()).
+		SetRawJSON(string(rawJson))
+
+	if token := ingestToken(s); token != nil {
+		update.SetIngestToken(*token)
+	} else {
+		update.ClearIngestToken()
+	}
+
+	return update.Exec(ctx)
+}
+
+// ingestToken returns the ingest token of sources that are pushed to by token, see sources.TokenReceiver.
+func ingestToken(s sources.Source) *string {
+	receiver, ok := s.(sources.TokenReceiver)
+	if !ok || receiver.IngestToken() == "" {
+		return nil
+	}
+
+	token := receiver.IngestToken()
+	return &token
+}
+
 func (r *SourceRepository) Remove(uid string) error {
 	ctx := context.Background()
+
+	_, err := r.db.Client().SourceRun.Delete().
+		Where(sourcerun.SourceUIDEQ(uid)).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("delete source runs: %w", err)
+	}
+
 	return r.db.Client().Source.DeleteOneID(uid).Exec(ctx)
 }
 
+// List returns all sources whose config can be decoded.
+// Sources that can't be decoded, e.g. because their config predates a breaking change, are skipped, see Undecodable.
 func (r *SourceRepository) List() ([]sources.Source, error) {
 	ctx := context.Background()
 
@@ -50,18 +100,37 @@ func (r *SourceRepository) List() ([]sources.Source, error) {
 		return nil, err
 	}
 
-	result := make([]sources.Source, len(sourcesEnt))
-	for i, s := range sourcesEnt {
+	result := make([]sources.Source, 0, len(sourcesEnt))
+	for _, s := range sourcesEnt {
 		out, err := sourceFromEnt(s)
 		if err != nil {
-			return nil, fmt.Errorf("deserialize source: %w", err)
+			continue
 		}
-		result[i] = out
+		result = append(result, out)
 	}
 
 	return result, nil
 }
 
+// Undecodable returns the decode errors of sources whose config can't be decoded, by UID.
+func (r *SourceRepository) Undecodable() (map[string]error, error) {
+	ctx := context.Background()
+
+	sourcesEnt, err := r.db.Client().Source.Query().All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(map[string]error)
+	for _, s := range sourcesEnt {
+		if _, err := sourceFromEnt(s); err != nil {
+			out[s.ID] = err
+		}
+	}
+
+	return out, nil
+}
+
 func (r *SourceRepository) GetByID(uid string) (sources.Source, error) {
 	ctx := context.Background()
 
@@ -76,14 +145,224 @@ func (r *SourceRepository) GetByID(uid string) (sources.Source, error) {
 	return sourceFromEnt(s)
 }
 
+// GetByIDFold returns the sources whose UID is equal to uid, ignoring case.
+func (r *SourceRepository) GetByIDFold(uid string) ([]sources.Source, error) {
+	ctx := context.Background()
+
+	sourcesEnt, err := r.db.Client().Source.Query().Where(source.IDEqualFold(uid)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]sources.Source, 0, len(sourcesEnt))
+	for _, s := range sourcesEnt {
+		out, err := sourceFromEnt(s)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, out)
+	}
+
+	return result, nil
+}
+
+// GetByIngestToken returns the source with the ingest token, or nil if there is none.
+func (r *SourceRepository) GetByIngestToken(token string) (sources.Source, error) {
+	ctx := context.Background()
+
+	s, err := r.db.Client().Source.Query().Where(source.IngestTokenEQ(token)).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return sourceFromEnt(s)
+}
+
+// SetEnabled pauses or resumes polling of the source.
+func (r *SourceRepository) SetEnabled(uid string, enabled bool) error {
+	ctx := context.Background()
+
+	return r.db.Client().Source.UpdateOneID(uid).
+		SetEnabled(enabled).
+		Exec(ctx)
+}
+
+// Paused returns the UIDs of paused sources.
+func (r *SourceRepository) Paused() (map[string]bool, error) {
+	ctx := context.Background()
+
+	uids, err := r.db.Client().Source.Query().
+		Where(source.EnabledEQ(false)).
+		IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(map[string]bool, len(uids))
+	for _, uid := range uids {
+		out[uid] = true
+	}
+
+	return out, nil
+}
+
+// SetManaged marks the source as defined in the sources file.
+func (r *SourceRepository) SetManaged(uid string, managed bool) error {
+	ctx := context.Background()
+
+	return r.db.Client().Source.UpdateOneID(uid).
+		SetManaged(managed).
+		Exec(ctx)
+}
+
+// Managed returns the UIDs of sources defined in the sources file.
+func (r *SourceRepository) Managed() (map[string]bool, error) {
+	ctx := context.Background()
+
+	uids, err := r.db.Client().Source.Query().
+		Where(source.ManagedEQ(true)).
+		IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(map[string]bool, len(uids))
+	for _, uid := range uids {
+		out[uid] = true
+	}
+
+	return out, nil
+}
+
+// RecordRun stores the fetch outcome in the run history and updates the health of the source.
+// Only the latest maxSourceRuns runs of each source are kept.
+func (r *SourceRepository) RecordRun(run *types.SourceRun) error {
+	ctx := context.Background()
+
+	tx, err := r.db.Client().Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+
+	create := tx.SourceRun.Create().
+		SetSourceUID(run.SourceUID).
+		SetStartedAt(run.StartedAt).
+		SetFinishedAt(run.FinishedAt).
+		SetItems(run.Items)
+	if run.Failed() {
+		create = create.SetError(run.Error)
+	}
+	if err := create.Exec(ctx); err != nil {
+		return rollback(tx, fmt.Errorf("create run: %w", err))
+	}
+
+	update := tx.Source.UpdateOneID(run.SourceUID).
+		SetLastItems(run.Items)
+	if run.Failed() {
+		update = update.
+			SetLastError(run.Error).
+			SetLastErrorAt(run.FinishedAt).
+			AddConsecutiveFailures(1)
+	} else {
+		update = update.
+			SetLastSuccessAt(run.FinishedAt).
+			SetConsecutiveFailures(0)
+	}
+	// The source may have been removed while it was being fetched.
+	if err := update.Exec(ctx); err != nil && !ent.IsNotFound(err) {
+		return rollback(tx, fmt.Errorf("update source health: %w", err))
+	}
+
+	stale, err := tx.SourceRun.Query().
+		Where(sourcerun.SourceUIDEQ(run.SourceUID)).
+		Order(ent.Desc(sourcerun.FieldStartedAt)).
+		Offset(maxSourceRuns).
+		IDs(ctx)
+	if err != nil {
+		return rollback(tx, fmt.Errorf("query stale runs: %w", err))
+	}
+
+	if len(stale) > 0 {
+		_, err = tx.SourceRun.Delete().
+			Where(sourcerun.IDIn(stale...)).
+			Exec(ctx)
+		if err != nil {
+			return rollback(tx, fmt.Errorf("delete stale runs: %w", err))
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit tx: %w", err)
+	}
+
+	return nil
+}
+
+// Runs returns the latest runs of the source, newest first.
+func (r *SourceRepository) Runs(uid string, limit int) ([]*types.SourceRun, error) {
+	ctx := context.Background()
+
+	runs, err := r.db.Client().SourceRun.Query().
+		Where(sourcerun.SourceUIDEQ(uid)).
+		Order(ent.Desc(sourcerun.FieldStartedAt)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*types.SourceRun, len(runs))
+	for i, run := range runs {
+		result[i] = &types.SourceRun{
+			SourceUID:  run.SourceUID,
+			StartedAt:  run.StartedAt,
+			FinishedAt: run.FinishedAt,
+			Items:      run.Items,
+			Error:      run.Error,
+		}
+	}
+
+	return result, nil
+}
+
+// Health returns the health of the source, or nil if the source doesn't exist.
+func (r *SourceRepository) Health(uid string) (*types.SourceHealth, error) {
+	ctx := context.Background()
+
+	s, err := r.db.Client().Source.Query().Where(source.ID(uid)).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	out := &types.SourceHealth{
+		LastError:           s.LastError,
+		ConsecutiveFailures: s.ConsecutiveFailures,
+		LastItems:           s.LastItems,
+	}
+	if s.LastSuccessAt != nil {
+		out.LastSuccessAt = *s.LastSuccessAt
+	}
+	if s.LastErrorAt != nil {
+		out.LastErrorAt = *s.LastErrorAt
+	}
+
+	return out, nil
+}
+
 func sourceFromEnt(in *ent.Source) (sources.Source, error) {
 	out, err := sources.NewSource(in.Type)
 	if err != nil {
-		return nil, fmt.Errorf("new source: %w", err)
+		return nil, fmt.Errorf("%w: %w", sources.ErrUndecodableSource, err)
 	}
 	err = out.UnmarshalJSON([]byte(in.RawJSON))
 	if err != nil {
-		return nil, fmt.Errorf("unmarshal source: %w", err)
+		return nil, fmt.Errorf("%w: unmarshal source: %w", sources.ErrUndecodableSource, err)
 	}
 	return out, nil
 }
@@ -0,0 +1,180 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/glanceapp/glance/pkg/sources/activities/types"
+
+	"github.com/glanceapp/glance/pkg/storage/postgres/ent"
+	"github.com/glanceapp/glance/pkg/storage/postgres/ent/websubscription"
+)
+
+type WebSubRepository struct {
+	db *DB
+}
+
+func NewWebSubRepository(db *DB) *WebSubRepository {
+	return &WebSubRepository{db: db}
+}
+
+// Get returns the subscription of the source, or nil if there is none.
+func (r *WebSubRepository) Get(uid string) (*types.WebSubscription, error) {
+	ctx := context.Background()
+
+	sub, err := r.db.Client().WebSubscription.Get(ctx, uid)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return webSubFromEnt(sub), nil
+}
+
+// Save creates or replaces the subscription of the source, and releases a claimed renewal of it.
+func (r *WebSubRepository) Save(sub *types.WebSubscription) error {
+	ctx := context.Background()
+
+	return r.db.Client().WebSubscription.Create().
+		SetID(sub.SourceUID).
+		SetHub(sub.Hub).
+		SetTopic(sub.Topic).
+		SetSecret(sub.Secret).
+		SetMode(websubscription.Mode(sub.Mode)).
+		SetActive(sub.Active).
+		SetNillableExpiresAt(nilIfZero(sub.ExpiresAt)).
+		SetNillableRenewAt(nilIfZero(sub.RenewAt)).
+		OnConflictColumns(websubscription.FieldID).
+		Update(func(u *ent.WebSubscriptionUpsert) {
+			u.UpdateHub().
+				UpdateTopic().
+				UpdateSecret().
+				UpdateMode().
+				UpdateActive().
+				UpdateUpdatedAt().
+				ClearRenewalClaimedAt()
+
+			if sub.ExpiresAt.IsZero() {
+				u.ClearExpiresAt()
+			} else {
+				u.UpdateExpiresAt()
+			}
+			if sub.RenewAt.IsZero() {
+				u.ClearRenewAt()
+			} else {
+				u.UpdateRenewAt()
+			}
+		}).
+		Exec(ctx)
+}
+
+// Remove deletes the subscription of the source, if any.
+func (r *WebSubRepository) Remove(uid string) error {
+	ctx := context.Background()
+
+	_, err := r.db.Client().WebSubscription.Delete().
+		Where(websubscription.ID(uid)).
+		Exec(ctx)
+
+	return err
+}
+
+func (r *WebSubRepository) List() ([]*types.WebSubscription, error) {
+	ctx := context.Background()
+
+	subs, err := r.db.Client().WebSubscription.Query().All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*types.WebSubscription, len(subs))
+	for i, sub := range subs {
+		result[i] = webSubFromEnt(sub)
+	}
+
+	return result, nil
+}
+
+// ClaimRenewals locks the subscriptions that are due for renewal, so that a single replica renews each of them.
+// Claims older than lease are assumed to have failed, and are claimed again.
+func (r *WebSubRepository) ClaimRenewals(lease time.Duration) ([]*types.WebSubscription, error) {
+	ctx := context.Background()
+	now := time.Now()
+
+	tx, err := r.db.Client().Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("begin tx: %w", err)
+	}
+
+	due, err := tx.WebSubscription.Query().
+		Where(
+			websubscription.ModeEQ(websubscription.ModeSubscribe),
+			websubscription.RenewAtLTE(now),
+			websubscription.Or(
+				websubscription.RenewalClaimedAtIsNil(),
+				websubscription.RenewalClaimedAtLT(now.Add(-lease)),
+			),
+		).
+		ForUpdate(sql.WithLockAction(sql.SkipLocked)).
+		All(ctx)
+	if err != nil {
+		return nil, rollback(tx, fmt.Errorf("query subscriptions: %w", err))
+	}
+
+	if len(due) == 0 {
+		return nil, tx.Rollback()
+	}
+
+	ids := make([]string, len(due))
+	for i, sub := range due {
+		ids[i] = sub.ID
+	}
+
+	err = tx.WebSubscription.Update().
+		Where(websubscription.IDIn(ids...)).
+		SetRenewalClaimedAt(now).
+		Exec(ctx)
+	if err != nil {
+		return nil, rollback(tx, fmt.Errorf("claim subscriptions: %w", err))
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit tx: %w", err)
+	}
+
+	result := make([]*types.WebSubscription, len(due))
+	for i, sub := range due {
+		result[i] = webSubFromEnt(sub)
+	}
+
+	return result, nil
+}
+
+func webSubFromEnt(in *ent.WebSubscription) *types.WebSubscription {
+	out := &types.WebSubscription{
+		SourceUID: in.ID,
+		Hub:       in.Hub,
+		Topic:     in.Topic,
+		Secret:    in.Secret,
+		Mode:      in.Mode.String(),
+		Active:    in.Active,
+	}
+	if in.ExpiresAt != nil {
+		out.ExpiresAt = *in.ExpiresAt
+	}
+	if in.RenewAt != nil {
+		out.RenewAt = *in.RenewAt
+	}
+	return out
+}
+
+func nilIfZero(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
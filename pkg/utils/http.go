@@ -2,7 +2,6 @@ package utils
 
 import (
 	"encoding/json"
-	"fmt"
 	"io"
 	"net/http"
 	"time"
@@ -11,9 +10,9 @@ import (
 const defaultClientTimeout = 5 * time.Second
 
 var DefaultHTTPClient = &http.Client{
-	Transport: &http.Transport{
+	Transport: RateLimits.Transport(&http.Transport{
 		MaxIdleConnsPerHost: 10,
-	},
+	}),
 	Timeout: defaultClientTimeout,
 }
 
@@ -40,14 +39,7 @@ func DecodeJSONFromRequest[T any](client requestDoer, request *http.Request) (T,
 	}
 
 	if response.StatusCode != http.StatusOK {
-		truncatedBody, _ := LimitStringLength(string(body), 256)
-
-		return result, fmt.Errorf(
-			"unexpected status code %d from %s, response: %s",
-			response.StatusCode,
-			request.URL,
-			truncatedBody,
-		)
+		return result, NewHTTPError(response, string(body))
 	}
 
 	err = json.Unmarshal(body, &result)
@@ -0,0 +1,362 @@
+package utils
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// maxRateLimitWait is the longest a request waits for its host budget.
+// Requests that would wait longer fail with a RateLimitError instead,
+// so that a fetch doesn't hang until the budget resets.
+const maxRateLimitWait = 30 * time.Second
+
+// RateLimits is the shared rate limit registry used by all outbound source requests.
+var RateLimits = NewRateLimitRegistry(DefaultRateLimits)
+
+// DefaultRateLimits are the documented budgets of the APIs used by sources.
+// Budgets of other hosts (e.g. Mastodon instances) are only learned from response headers.
+var DefaultRateLimits = RateLimitConfig{
+	// Unauthenticated budget. Authenticated clients learn their budget from the response headers.
+	"api.github.com":   {Requests: 60, Per: time.Hour},
+	"oauth.reddit.com": {Requests: 100, Per: time.Minute},
+	"www.reddit.com":   {Requests: 10, Per: time.Minute},
+	"lobste.rs":        {Requests: 60, Per: time.Minute},
+}
+
+// RateLimit allows a number of requests per time window.
+type RateLimit struct {
+	Requests int
+	Per      time.Duration
+}
+
+func (l RateLimit) String() string {
+	return fmt.Sprintf("%d/%s", l.Requests, l.Per)
+}
+
+// RateLimitConfig maps hosts to their rate limits.
+type RateLimitConfig map[string]RateLimit
+
+// Decode parses comma separated limits like "api.github.com=5000/1h,lobste.rs=60/1m".
+func (c *RateLimitConfig) Decode(in string) error {
+	out, err := ParseRateLimits(in)
+	if err != nil {
+		return err
+	}
+	*c = out
+	return nil
+}
+
+// ParseRateLimits parses comma separated limits like "api.github.com=5000/1h,lobste.rs=60/1m".
+func ParseRateLimits(in string) (RateLimitConfig, error) {
+	out := make(RateLimitConfig)
+
+	for _, entry := range strings.Split(in, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		host, limit, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid rate limit %q: expected host=requests/period", entry)
+		}
+
+		requests, period, ok := strings.Cut(limit, "/")
+		if !ok {
+			return nil, fmt.Errorf("invalid rate limit %q: expected host=requests/period", entry)
+		}
+
+		n, err := strconv.Atoi(requests)
+		if err != nil || n < 1 {
+			return nil, fmt.Errorf("invalid rate limit %q: requests must be a positive number", entry)
+		}
+
+		per, err := time.ParseDuration(period)
+		if err != nil || per <= 0 {
+			return nil, fmt.Errorf("invalid rate limit %q: invalid period", entry)
+		}
+
+		out[strings.ToLower(host)] = RateLimit{Requests: n, Per: per}
+	}
+
+	return out, nil
+}
+
+// RateLimitError is returned for requests that would exceed the budget of their host.
+type RateLimitError struct {
+	Host string
+	Wait time.Duration
+}
+
+func (e *RateLimitError) Error() string {
+	return fmt.Sprintf("rate limit of %s exhausted, retry in %s", e.Host, e.Wait.Round(time.Second))
+}
+
+func (e *RateLimitError) RetryAfter() time.Duration {
+	return e.Wait
+}
+
+func (e *RateLimitError) Temporary() bool {
+	return true
+}
+
+// RateLimitRegistry coordinates the request budgets of all clients talking to the same host.
+// Clients with different credentials have separate budgets, since APIs limit each token separately.
+type RateLimitRegistry struct {
+	mu      sync.Mutex
+	limits  map[string]RateLimit
+	buckets map[bucketKey]*rateBucket
+}
+
+// bucketKey identifies the budget of a host for a credential, which is empty for unauthenticated requests.
+type bucketKey struct {
+	host       string
+	credential string
+}
+
+func NewRateLimitRegistry(limits RateLimitConfig) *RateLimitRegistry {
+	r := &RateLimitRegistry{
+		limits:  make(map[string]RateLimit),
+		buckets: make(map[bucketKey]*rateBucket),
+	}
+	for host, limit := range limits {
+		r.limits[host] = limit
+	}
+	return r
+}
+
+// SetLimit overrides the budget of the host.
+func (r *RateLimitRegistry) SetLimit(host string, limit RateLimit) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	host = strings.ToLower(host)
+	r.limits[host] = limit
+	for key := range r.buckets {
+		if key.host == host {
+			delete(r.buckets, key)
+		}
+	}
+}
+
+// Reserve takes a request from the budget of the host for the credential, and returns how long to wait before sending it.
+// If the wait would be longer than maxRateLimitWait, nothing is taken and a RateLimitError is returned.
+func (r *RateLimitRegistry) Reserve(host, credential string) (time.Duration, error) {
+	b := r.bucket(host, credential)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := time.Now()
+	b.refill(now)
+
+	var wait time.Duration
+	if now.Before(b.blockedUntil) {
+		wait = b.blockedUntil.Sub(now)
+	}
+
+	if b.tokens < 1 {
+		switch {
+		case !b.windowReset.IsZero():
+			wait = max(wait, b.windowReset.Sub(now))
+		case b.limit.Requests > 0:
+			wait = max(wait, time.Duration((1-b.tokens)/b.rate()))
+		}
+	}
+
+	if wait > maxRateLimitWait {
+		return 0, &RateLimitError{Host: host, Wait: wait}
+	}
+
+	b.tokens--
+	return wait, nil
+}
+
+// Wait blocks until a request to the host with the credential is allowed.
+func (r *RateLimitRegistry) Wait(ctx context.Context, host, credential string) error {
+	wait, err := r.Reserve(host, credential)
+	if err != nil || wait <= 0 {
+		return err
+	}
+
+	select {
+	case <-time.After(wait):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Update adjusts the budget of the host for the credential from the rate limit headers of its response.
+func (r *RateLimitRegistry) Update(host, credential string, response *http.Response) {
+	b := r.bucket(host, credential)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := time.Now()
+
+	if response.StatusCode == http.StatusTooManyRequests {
+		wait := ParseRetryAfter(response.Header.Get("Retry-After"))
+		if wait <= 0 {
+			wait = time.Minute
+		}
+		b.blockedUntil = now.Add(wait)
+		return
+	}
+
+	remaining, err := strconv.ParseFloat(response.Header.Get("X-RateLimit-Remaining"), 64)
+	if err != nil {
+		return
+	}
+
+	reset := parseRateLimitReset(response.Header.Get("X-RateLimit-Reset"), now)
+	if reset.IsZero() || !reset.After(now) {
+		return
+	}
+
+	// The budget reported by the server replaces the configured one until the window resets,
+	// e.g. authenticated GitHub clients get a larger budget than the unauthenticated default.
+	b.tokens = remaining
+	b.windowReset = reset
+	if limit, err := strconv.ParseFloat(response.Header.Get("X-RateLimit-Limit"), 64); err == nil {
+		b.windowLimit = limit
+	}
+}
+
+// Transport wraps the base transport (http.DefaultTransport if nil) to apply the registry budgets.
+func (r *RateLimitRegistry) Transport(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &rateLimitTransport{registry: r, base: base}
+}
+
+func (r *RateLimitRegistry) bucket(host, credential string) *rateBucket {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := bucketKey{host: strings.ToLower(host), credential: credential}
+	b, ok := r.buckets[key]
+	if !ok {
+		// Configured budgets apply to every credential, until the server reports the budget of the credential.
+		limit := r.limits[key.host]
+		b = &rateBucket{
+			limit:     limit,
+			tokens:    float64(limit.Requests),
+			updatedAt: time.Now(),
+		}
+		r.buckets[key] = b
+	}
+
+	return b
+}
+
+// rateBucket holds the remaining requests of a host. It refills continuously
+// at the configured rate, unless the server reported its own rate limit window.
+type rateBucket struct {
+	mu           sync.Mutex
+	limit        RateLimit
+	tokens       float64
+	updatedAt    time.Time
+	blockedUntil time.Time
+	// windowReset is set while the budget reported by the server is in effect.
+	windowReset time.Time
+	windowLimit float64
+}
+
+// rate returns the number of tokens added per nanosecond.
+func (b *rateBucket) rate() float64 {
+	return float64(b.limit.Requests) / float64(b.limit.Per)
+}
+
+func (b *rateBucket) refill(now time.Time) {
+	defer func() { b.updatedAt = now }()
+
+	if !b.windowReset.IsZero() {
+		if now.Before(b.windowReset) {
+			return
+		}
+		b.tokens = max(b.windowLimit, float64(b.limit.Requests))
+		b.windowReset = time.Time{}
+		return
+	}
+
+	if b.limit.Requests > 0 {
+		elapsed := now.Sub(b.updatedAt)
+		b.tokens = min(b.tokens+float64(elapsed)*b.rate(), float64(b.limit.Requests))
+	}
+}
+
+// parseRateLimitReset parses the reset time of a rate limit window.
+// APIs use either a unix timestamp (GitHub), seconds until the reset (Reddit) or a date (Mastodon).
+func parseRateLimitReset(value string, now time.Time) time.Time {
+	if value == "" {
+		return time.Time{}
+	}
+
+	if n, err := strconv.ParseFloat(value, 64); err == nil {
+		// Values this large can't be relative to now.
+		if n > 1e9 {
+			return time.Unix(int64(n), 0)
+		}
+		return now.Add(time.Duration(n * float64(time.Second)))
+	}
+
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t
+	}
+
+	return time.Time{}
+}
+
+type rateLimitTransport struct {
+	registry *RateLimitRegistry
+	base     http.RoundTripper
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	host := req.URL.Hostname()
+	credential := credentialFingerprint(req)
+
+	if err := t.registry.Wait(req.Context(), host, credential); err != nil {
+		return nil, err
+	}
+
+	response, err := t.base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	t.registry.Update(host, credential, response)
+
+	return response, nil
+}
+
+// credentialFingerprint identifies the credentials of the request without keeping them,
+// or returns an empty string for unauthenticated requests.
+func credentialFingerprint(req *http.Request) string {
+	auth := req.Header.Get("Authorization")
+	if auth == "" {
+		return ""
+	}
+
+	sum := sha256.Sum256([]byte(auth))
+	return hex.EncodeToString(sum[:8])
+}
+
+// NewHTTPClient returns a client whose requests are subject to the shared rate limits.
+// Each call returns a new client, since some API libraries modify the client they are given.
+func NewHTTPClient(timeout time.Duration) *http.Client {
+	return &http.Client{
+		Transport: RateLimits.Transport(nil),
+		Timeout:   timeout,
+	}
+}
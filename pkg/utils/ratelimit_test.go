@@ -0,0 +1,146 @@
+package utils
+
+import (
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestParseRateLimits(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    RateLimitConfig
+		wantErr bool
+	}{
+		{in: "", want: RateLimitConfig{}},
+		{in: "api.github.com=5000/1h", want: RateLimitConfig{"api.github.com": {Requests: 5000, Per: time.Hour}}},
+		{
+			in: " API.GitHub.com=5000/1h , lobste.rs=60/1m,",
+			want: RateLimitConfig{
+				"api.github.com": {Requests: 5000, Per: time.Hour},
+				"lobste.rs":      {Requests: 60, Per: time.Minute},
+			},
+		},
+		{in: "api.github.com", wantErr: true},
+		{in: "api.github.com=5000", wantErr: true},
+		{in: "api.github.com=0/1h", wantErr: true},
+		{in: "api.github.com=many/1h", wantErr: true},
+		{in: "api.github.com=5000/hour", wantErr: true},
+		{in: "api.github.com=5000/-1h", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseRateLimits(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, want error %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+			for host, limit := range tt.want {
+				if got[host] != limit {
+					t.Errorf("limit of %s = %s, want %s", host, got[host], limit)
+				}
+			}
+		})
+	}
+}
+
+func TestParseRateLimitReset(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		value string
+		want  time.Time
+	}{
+		{name: "empty", value: "", want: time.Time{}},
+		{name: "unix timestamp", value: "1735736400", want: time.Unix(1735736400, 0)},
+		{name: "seconds until reset", value: "90", want: now.Add(90 * time.Second)},
+		{name: "fractional seconds", value: "1.5", want: now.Add(1500 * time.Millisecond)},
+		{name: "date", value: "2025-01-01T13:00:00Z", want: now.Add(time.Hour)},
+		{name: "invalid", value: "soon", want: time.Time{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := parseRateLimitReset(tt.value, now)
+			if !got.Equal(tt.want) {
+				t.Errorf("parseRateLimitReset(%q) = %s, want %s", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRateLimitRegistryUpdate(t *testing.T) {
+	reset := time.Now().Add(time.Hour).Unix()
+
+	tests := []struct {
+		name    string
+		status  int
+		header  map[string]string
+		wantErr bool
+	}{
+		{name: "no headers", status: http.StatusOK},
+		{
+			name:   "remaining budget",
+			status: http.StatusOK,
+			header: map[string]string{
+				"X-RateLimit-Remaining": "10",
+				"X-RateLimit-Reset":     strconv.FormatInt(reset, 10),
+			},
+		},
+		{
+			name:   "exhausted budget",
+			status: http.StatusOK,
+			header: map[string]string{
+				"X-RateLimit-Remaining": "0",
+				"X-RateLimit-Reset":     strconv.FormatInt(reset, 10),
+			},
+			wantErr: true,
+		},
+		{
+			name:   "exhausted budget with past reset",
+			status: http.StatusOK,
+			header: map[string]string{
+				"X-RateLimit-Remaining": "0",
+				"X-RateLimit-Reset":     "1",
+			},
+		},
+		{
+			name:    "too many requests",
+			status:  http.StatusTooManyRequests,
+			header:  map[string]string{"Retry-After": "3600"},
+			wantErr: true,
+		},
+		{
+			name:    "too many requests without retry after",
+			status:  http.StatusTooManyRequests,
+			header:  map[string]string{"Retry-After": "0"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewRateLimitRegistry(RateLimitConfig{"example.com": {Requests: 100, Per: time.Hour}})
+
+			response := &http.Response{StatusCode: tt.status, Header: make(http.Header)}
+			for key, value := range tt.header {
+				response.Header.Set(key, value)
+			}
+			r.Update("example.com", "", response)
+
+			_, err := r.Reserve("example.com", "")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("err = %v, want error %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,223 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is returned for calls rejected by an open CircuitBreaker.
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// HTTPError is returned for unexpected HTTP response statuses.
+type HTTPError struct {
+	StatusCode int
+	URL        string
+	Body       string
+	// Wait is the delay requested by the server (e.g. with a Retry-After header), zero if none.
+	Wait time.Duration
+}
+
+// NewHTTPError creates an HTTPError from the response, honoring its Retry-After header.
+func NewHTTPError(response *http.Response, body string) *HTTPError {
+	truncatedBody, _ := LimitStringLength(body, 256)
+
+	return &HTTPError{
+		StatusCode: response.StatusCode,
+		URL:        response.Request.URL.String(),
+		Body:       truncatedBody,
+		Wait:       ParseRetryAfter(response.Header.Get("Retry-After")),
+	}
+}
+
+func (e *HTTPError) Error() string {
+	return fmt.Sprintf("unexpected status code %d from %s, response: %s", e.StatusCode, e.URL, e.Body)
+}
+
+func (e *HTTPError) RetryAfter() time.Duration {
+	return e.Wait
+}
+
+// Temporary reports whether the request may succeed if retried.
+func (e *HTTPError) Temporary() bool {
+	switch e.StatusCode {
+	case http.StatusRequestTimeout, http.StatusTooManyRequests:
+		return true
+	default:
+		return e.StatusCode >= 500
+	}
+}
+
+// ParseRetryAfter parses a Retry-After header value given in seconds or as an HTTP date.
+func ParseRetryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return max(time.Duration(seconds)*time.Second, 0)
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0)
+	}
+
+	return 0
+}
+
+// RetryAfter returns the delay requested by the server that caused the error.
+func RetryAfter(err error) (time.Duration, bool) {
+	var e interface{ RetryAfter() time.Duration }
+	if errors.As(err, &e) && e.RetryAfter() > 0 {
+		return e.RetryAfter(), true
+	}
+	return 0, false
+}
+
+// IsRetryable reports whether the failed call may succeed if retried.
+func IsRetryable(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, ErrCircuitOpen) {
+		return false
+	}
+
+	var httpErr *HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.Temporary()
+	}
+
+	return true
+}
+
+// RetryPolicy retries failed calls with exponential backoff and jitter.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of calls, including the first one.
+	MaxAttempts int
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+}
+
+// Do calls fn until it succeeds, returns a non-retryable error or runs out of attempts.
+// Calls are not retried if the server asks to wait for longer than MaxDelay.
+func (p RetryPolicy) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	var err error
+	for attempt := 1; ; attempt++ {
+		err = fn(ctx)
+		if err == nil || attempt >= p.MaxAttempts || !IsRetryable(err) {
+			return err
+		}
+
+		delay, ok := p.Backoff(attempt, err)
+		if !ok {
+			return err
+		}
+
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return err
+		}
+	}
+}
+
+// Backoff returns the delay before the next attempt.
+// It returns false if the server asked to wait for longer than MaxDelay.
+func (p RetryPolicy) Backoff(attempt int, err error) (time.Duration, bool) {
+	if wait, ok := RetryAfter(err); ok {
+		return wait, wait <= p.MaxDelay
+	}
+
+	delay := min(p.BaseDelay<<(attempt-1), p.MaxDelay)
+	// Full jitter, so that concurrent callers don't retry in lockstep.
+	return time.Duration(rand.Int64N(int64(delay) + 1)), true
+}
+
+// CircuitBreaker stops calls to a failing dependency for a cool-down period
+// after a number of consecutive failures.
+type CircuitBreaker struct {
+	threshold int
+	coolDown  time.Duration
+
+	mu        sync.Mutex
+	failures  int
+	openUntil time.Time
+}
+
+func NewCircuitBreaker(threshold int, coolDown time.Duration) *CircuitBreaker {
+	return &CircuitBreaker{
+		threshold: threshold,
+		coolDown:  coolDown,
+	}
+}
+
+// Allow reports whether a call may be made. Once the cool-down has passed,
+// calls are allowed again, and the next failure reopens the circuit.
+func (b *CircuitBreaker) Allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return !time.Now().Before(b.openUntil)
+}
+
+// OpenUntil returns the end of the cool-down period, or zero time if the circuit is closed.
+func (b *CircuitBreaker) OpenUntil() time.Time {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if time.Now().Before(b.openUntil) {
+		return b.openUntil
+	}
+	return time.Time{}
+}
+
+func (b *CircuitBreaker) Success() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.failures = 0
+	b.openUntil = time.Time{}
+}
+
+// Failure records a failed call and reports whether it opened the circuit.
+// The cool-down is extended if the server asked to wait for longer.
+func (b *CircuitBreaker) Failure(err error) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.failures++
+	if b.failures < b.threshold {
+		return false
+	}
+
+	coolDown := b.coolDown
+	if wait, ok := RetryAfter(err); ok {
+		coolDown = max(coolDown, wait)
+	}
+	b.openUntil = time.Now().Add(coolDown)
+
+	return true
+}
+
+// Do calls fn with the retry policy, unless the circuit is open.
+func (b *CircuitBreaker) Do(ctx context.Context, policy RetryPolicy, fn func(ctx context.Context) error) error {
+	if !b.Allow() {
+		return ErrCircuitOpen
+	}
+
+	err := policy.Do(ctx, fn)
+	if err != nil {
+		// Only errors that may go away on their own say something about the health of the dependency.
+		// Others, like cancellations or requests rejected for their content, are caused by the call.
+		if IsRetryable(err) {
+			b.Failure(err)
+		}
+		return err
+	}
+
+	b.Success()
+	return nil
+}
@@ -0,0 +1,164 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 5, BaseDelay: time.Second, MaxDelay: 10 * time.Second}
+
+	tests := []struct {
+		name    string
+		attempt int
+		err     error
+		max     time.Duration
+		exact   bool
+		ok      bool
+	}{
+		{name: "first attempt", attempt: 1, err: errors.New("failed"), max: time.Second, ok: true},
+		{name: "doubles per attempt", attempt: 3, err: errors.New("failed"), max: 4 * time.Second, ok: true},
+		{name: "capped at max delay", attempt: 10, err: errors.New("failed"), max: 10 * time.Second, ok: true},
+		{name: "server wait", attempt: 1, err: &HTTPError{StatusCode: 429, Wait: 5 * time.Second}, max: 5 * time.Second, exact: true, ok: true},
+		{name: "server wait beyond max delay", attempt: 1, err: &HTTPError{StatusCode: 429, Wait: time.Minute}, max: time.Minute, exact: true, ok: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Jitter makes delays random, so the bounds are checked several times.
+			for range 100 {
+				delay, ok := policy.Backoff(tt.attempt, tt.err)
+				if ok != tt.ok {
+					t.Fatalf("ok = %v, want %v", ok, tt.ok)
+				}
+				if tt.exact && delay != tt.max {
+					t.Fatalf("delay = %s, want %s", delay, tt.max)
+				}
+				if delay < 0 || delay > tt.max {
+					t.Fatalf("delay = %s, want between 0 and %s", delay, tt.max)
+				}
+			}
+		})
+	}
+}
+
+func TestRetryPolicyDo(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond}
+
+	tests := []struct {
+		name    string
+		errs    []error
+		calls   int
+		wantErr bool
+	}{
+		{name: "succeeds", errs: []error{nil}, calls: 1},
+		{name: "succeeds after retry", errs: []error{errors.New("failed"), nil}, calls: 2},
+		{name: "runs out of attempts", errs: []error{errors.New("a"), errors.New("b"), errors.New("c")}, calls: 3, wantErr: true},
+		{name: "client error isn't retried", errs: []error{&HTTPError{StatusCode: http.StatusNotFound}}, calls: 1, wantErr: true},
+		{name: "server error is retried", errs: []error{&HTTPError{StatusCode: http.StatusBadGateway}, nil}, calls: 2},
+		{name: "canceled isn't retried", errs: []error{context.Canceled}, calls: 1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			err := policy.Do(context.Background(), func(ctx context.Context) error {
+				err := tt.errs[calls]
+				calls++
+				return err
+			})
+
+			if calls != tt.calls {
+				t.Errorf("calls = %d, want %d", calls, tt.calls)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("err = %v, want error %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	tests := []struct {
+		value string
+		min   time.Duration
+		max   time.Duration
+	}{
+		{value: "", min: 0, max: 0},
+		{value: "120", min: 2 * time.Minute, max: 2 * time.Minute},
+		{value: "-5", min: 0, max: 0},
+		{value: "soon", min: 0, max: 0},
+		{value: time.Now().Add(time.Hour).UTC().Format(http.TimeFormat), min: 58 * time.Minute, max: time.Hour},
+		{value: time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat), min: 0, max: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got := ParseRetryAfter(tt.value)
+			if got < tt.min || got > tt.max {
+				t.Errorf("ParseRetryAfter(%q) = %s, want between %s and %s", tt.value, got, tt.min, tt.max)
+			}
+		})
+	}
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	retryable := errors.New("unavailable")
+	rejected := &HTTPError{StatusCode: http.StatusBadRequest}
+	limited := &HTTPError{StatusCode: http.StatusTooManyRequests, Wait: time.Hour}
+
+	tests := []struct {
+		name     string
+		errs     []error
+		open     bool
+		coolDown time.Duration
+	}{
+		{name: "below threshold", errs: []error{retryable, retryable}},
+		{name: "opens at threshold", errs: []error{retryable, retryable, retryable}, open: true, coolDown: time.Minute},
+		{name: "success resets failures", errs: []error{retryable, retryable, nil, retryable, retryable}},
+		{name: "non-retryable errors don't count", errs: []error{rejected, rejected, rejected, context.Canceled}},
+		{name: "server wait extends cool-down", errs: []error{retryable, retryable, limited}, open: true, coolDown: time.Hour},
+	}
+
+	policy := RetryPolicy{MaxAttempts: 1}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := NewCircuitBreaker(3, time.Minute)
+
+			for i, err := range tt.errs {
+				got := b.Do(context.Background(), policy, func(ctx context.Context) error { return err })
+				if !errors.Is(got, err) {
+					t.Fatalf("call %d: err = %v, want %v", i, got, err)
+				}
+			}
+
+			if allowed := b.Allow(); allowed == tt.open {
+				t.Fatalf("Allow() = %v, want %v", allowed, !tt.open)
+			}
+
+			openUntil := b.OpenUntil()
+			if !tt.open {
+				if !openUntil.IsZero() {
+					t.Errorf("OpenUntil() = %s, want zero", openUntil)
+				}
+				return
+			}
+
+			if remaining := time.Until(openUntil); remaining <= tt.coolDown-time.Second || remaining > tt.coolDown {
+				t.Errorf("open for %s, want %s", remaining, tt.coolDown)
+			}
+
+			err := b.Do(context.Background(), policy, func(ctx context.Context) error {
+				return fmt.Errorf("called while open")
+			})
+			if !errors.Is(err, ErrCircuitOpen) {
+				t.Errorf("err = %v, want %v", err, ErrCircuitOpen)
+			}
+		})
+	}
+}
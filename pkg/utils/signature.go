@@ -0,0 +1,29 @@
+package utils
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+)
+
+// SignHMAC returns the hex encoded HMAC-SHA256 of the payload.
+func SignHMAC(secret, payload []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifyHMAC reports whether the signature is the HMAC-SHA256 of the payload.
+// A "sha256=" prefix, as sent by GitHub and most webhook senders, is ignored.
+func VerifyHMAC(secret, payload []byte, signature string) bool {
+	signature = strings.TrimPrefix(strings.TrimSpace(signature), "sha256=")
+
+	got, err := hex.DecodeString(signature)
+	if err != nil || len(secret) == 0 {
+		return false
+	}
+
+	want, _ := hex.DecodeString(SignHMAC(secret, payload))
+	return hmac.Equal(got, want)
+}
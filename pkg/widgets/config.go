@@ -0,0 +1,422 @@
+package widgets
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/glanceapp/glance/pkg/sources"
+	"github.com/glanceapp/glance/pkg/sources/github"
+	"github.com/glanceapp/glance/pkg/sources/hackernews"
+	"github.com/glanceapp/glance/pkg/sources/lobsters"
+	"github.com/glanceapp/glance/pkg/sources/mastodon"
+	"github.com/glanceapp/glance/pkg/sources/reddit"
+	"github.com/glanceapp/glance/pkg/sources/rss"
+	"gopkg.in/yaml.v3"
+)
+
+// maxIncludeDepth guards against include cycles.
+const maxIncludeDepth = 10
+
+var includePattern = regexp.MustCompile(`(?m)^([ \t]*)!include:[ \t]*(.+?)[ \t]*$`)
+
+// PagesConfig is a set of pages loaded from a Glance-style YAML config.
+type PagesConfig struct {
+	Pages []*Page
+	// Sources are fetched by the widgets of the pages, deduplicated by UID.
+	Sources []sources.Source
+}
+
+// LoadPagesConfig reads the pages from a Glance-style YAML file.
+// Lines of the form `!include: other.yml` are replaced with the contents of the
+// referenced file, resolved relative to the including file.
+func LoadPagesConfig(path string) (*PagesConfig, error) {
+	contents, err := readYAMLWithIncludes(path, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var raw struct {
+		Pages []pageConfig `yaml:"pages"`
+	}
+	if err := yaml.Unmarshal(contents, &raw); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", path, err)
+	}
+
+	if len(raw.Pages) == 0 {
+		return nil, fmt.Errorf("%s has no pages", path)
+	}
+
+	loader := &configLoader{seen: make(map[string]bool)}
+	out := &PagesConfig{}
+	slugs := make(map[string]bool)
+
+	for i, rawPage := range raw.Pages {
+		page, err := loader.page(rawPage)
+		if err != nil {
+			return nil, fmt.Errorf("page %d: %w", i+1, err)
+		}
+
+		page.Init()
+		if err := page.Validate(); err != nil {
+			return nil, fmt.Errorf("validate page: %w", err)
+		}
+
+		if slugs[page.Slug] {
+			return nil, fmt.Errorf("page %s: duplicate slug", page.Slug)
+		}
+		slugs[page.Slug] = true
+
+		out.Pages = append(out.Pages, page)
+	}
+	out.Sources = loader.sources
+
+	return out, nil
+}
+
+func readYAMLWithIncludes(path string, depth int) ([]byte, error) {
+	if depth > maxIncludeDepth {
+		return nil, fmt.Errorf("include %s: too many nested includes", path)
+	}
+
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read %s: %w", path, err)
+	}
+
+	var includeErr error
+	contents = includePattern.ReplaceAllFunc(contents, func(line []byte) []byte {
+		match := includePattern.FindSubmatch(line)
+		indent, includePath := string(match[1]), string(match[2])
+
+		if !filepath.IsAbs(includePath) {
+			includePath = filepath.Join(filepath.Dir(path), includePath)
+		}
+
+		included, err := readYAMLWithIncludes(includePath, depth+1)
+		if err != nil {
+			includeErr = err
+			return nil
+		}
+
+		lines := strings.Split(strings.TrimRight(string(included), "\n"), "\n")
+		for i := range lines {
+			lines[i] = indent + lines[i]
+		}
+
+		return []byte(strings.Join(lines, "\n"))
+	})
+	if includeErr != nil {
+		return nil, includeErr
+	}
+
+	return contents, nil
+}
+
+type pageConfig struct {
+	Title                  string         `yaml:"name"`
+	Slug                   string         `yaml:"slug"`
+	Width                  string         `yaml:"width"`
+	DesktopNavigationWidth string         `yaml:"desktop-navigation-width"`
+	HideDesktopNavigation  bool           `yaml:"hide-desktop-navigation"`
+	CenterVertically       bool           `yaml:"center-vertically"`
+	HeadWidgets            []widgetConfig `yaml:"head-widgets"`
+	Columns                []struct {
+		Size    string         `yaml:"size"`
+		Widgets []widgetConfig `yaml:"widgets"`
+	} `yaml:"columns"`
+}
+
+// widgetConfig holds the settings of all supported Glance widget types.
+// Settings that only affect how Glance fetches or renders content are ignored.
+type widgetConfig struct {
+	Type          string  `yaml:"type"`
+	HideHeader    bool    `yaml:"hide-header"`
+	CSSClass      string  `yaml:"css-class"`
+	CollapseAfter int     `yaml:"collapse-after"`
+	Limit         int     `yaml:"limit"`
+	Query         string  `yaml:"query"`
+	MinSimilarity float32 `yaml:"min-similarity"`
+	// Cache is the polling interval of the sources of the widget.
+	Cache string `yaml:"cache"`
+
+	// group, split-column
+	Widgets    []widgetConfig `yaml:"widgets"`
+	MaxColumns int            `yaml:"max-columns"`
+
+	// rss
+	Feeds []struct {
+		URL     string            `yaml:"url"`
+		Headers map[string]string `yaml:"headers"`
+	} `yaml:"feeds"`
+
+	// hacker-news, lobsters, reddit
+	SortBy string `yaml:"sort-by"`
+
+	// lobsters, mastodon
+	InstanceURL string   `yaml:"instance-url"`
+	CustomURL   string   `yaml:"custom-url"`
+	Tags        []string `yaml:"tags"`
+
+	// mastodon
+	Accounts []string `yaml:"accounts"`
+	Hashtags []string `yaml:"hashtags"`
+
+	// reddit
+	Subreddit          string `yaml:"subreddit"`
+	TopPeriod          string `yaml:"top-period"`
+	Search             string `yaml:"search"`
+	RequestURLTemplate string `yaml:"request-url-template"`
+	AppAuth            struct {
+		Name   string `yaml:"name"`
+		ID     string `yaml:"id"`
+		Secret string `yaml:"secret"`
+	} `yaml:"app-auth"`
+
+	// releases, issues
+	Repositories       []string `yaml:"repositories"`
+	Token              string   `yaml:"token"`
+	IncludePrereleases bool     `yaml:"include-prereleases"`
+}
+
+// configLoader converts page configs to pages, collecting the sources of their widgets.
+type configLoader struct {
+	sources []sources.Source
+	seen    map[string]bool
+}
+
+func (l *configLoader) page(in pageConfig) (*Page, error) {
+	page := &Page{
+		Title:                  in.Title,
+		Slug:                   in.Slug,
+		Width:                  in.Width,
+		DesktopNavigationWidth: in.DesktopNavigationWidth,
+		HideDesktopNavigation:  in.HideDesktopNavigation,
+		CenterVertically:       in.CenterVertically,
+	}
+
+	headWidgets, err := l.widgets(in.HeadWidgets)
+	if err != nil {
+		return nil, fmt.Errorf("head widgets: %w", err)
+	}
+	page.HeadWidgets = headWidgets
+
+	page.Columns = make([]struct {
+		Size    string   `json:"size"`
+		Widgets []Widget `json:"widgets"`
+	}, len(in.Columns))
+
+	for i, column := range in.Columns {
+		page.Columns[i].Size = column.Size
+		widgets, err := l.widgets(column.Widgets)
+		if err != nil {
+			return nil, fmt.Errorf("column %d: %w", i+1, err)
+		}
+		page.Columns[i].Widgets = widgets
+	}
+
+	return page, nil
+}
+
+func (l *configLoader) widgets(in []widgetConfig) ([]Widget, error) {
+	out := make([]Widget, 0, len(in))
+	for i, config := range in {
+		widget, err := l.widget(config)
+		if err != nil {
+			return nil, fmt.Errorf("widget %d (%s): %w", i+1, config.Type, err)
+		}
+		out = append(out, widget)
+	}
+	return out, nil
+}
+
+func (l *configLoader) widget(in widgetConfig) (Widget, error) {
+	id := widgetIDCounter.Add(1)
+
+	switch in.Type {
+	case "group":
+		out := newWidgetGroup(id, in.Type)
+		l.applyBase(&out.widgetBase, in)
+		children, err := l.widgets(in.Widgets)
+		if err != nil {
+			return nil, err
+		}
+		out.Widgets = children
+		return out, out.Initialize()
+
+	case "split-column":
+		out := newWidgetSplitColumn(id, in.Type)
+		l.applyBase(&out.widgetBase, in)
+		if in.MaxColumns > 0 {
+			out.MaxColumns = in.MaxColumns
+		}
+		children, err := l.widgets(in.Widgets)
+		if err != nil {
+			return nil, err
+		}
+		out.Widgets = children
+		return out, out.Initialize()
+	}
+
+	configs, err := in.sourceConfigs()
+	if err != nil {
+		return nil, err
+	}
+
+	out := newWidgetBase(id, in.Type)
+	l.applyBase(out, in)
+
+	for _, config := range configs {
+		source, err := l.source(config, in.Cache)
+		if err != nil {
+			return nil, err
+		}
+		out.SourceIDs = append(out.SourceIDs, source.UID())
+	}
+
+	return out, out.Initialize()
+}
+
+func (l *configLoader) applyBase(w *widgetBase, in widgetConfig) {
+	w.HideHeader = in.HideHeader
+	w.CSSClass = in.CSSClass
+	w.Query = in.Query
+	w.MinSimilarity = in.MinSimilarity
+	if in.CollapseAfter != 0 {
+		w.CollapseAfter = in.CollapseAfter
+	}
+	if in.Limit > 0 {
+		w.Limit = in.Limit
+	}
+}
+
+// sourceConfig is the JSON config of a source, as accepted by the sources API.
+type sourceConfig struct {
+	Type   string
+	Config map[string]any
+}
+
+// source creates the source from its config, reusing an identical source of another widget.
+func (l *configLoader) source(in sourceConfig, cache string) (sources.Source, error) {
+	if cache != "" {
+		in.Config["interval"] = cache
+	}
+
+	raw, err := json.Marshal(in.Config)
+	if err != nil {
+		return nil, fmt.Errorf("marshal source config: %w", err)
+	}
+
+	source, err := sources.NewSource(in.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := source.UnmarshalJSON(raw); err != nil {
+		return nil, fmt.Errorf("unmarshal source config: %w", err)
+	}
+
+	if !l.seen[source.UID()] {
+		l.seen[source.UID()] = true
+		l.sources = append(l.sources, source)
+	}
+
+	return source, nil
+}
+
+// sourceConfigs returns the JSON configs of the sources the widget shows activities of.
+func (in widgetConfig) sourceConfigs() ([]sourceConfig, error) {
+	var out []sourceConfig
+
+	switch in.Type {
+	case "rss":
+		if len(in.Feeds) == 0 {
+			return nil, fmt.Errorf("no feeds provided")
+		}
+		for _, feed := range in.Feeds {
+			out = append(out, sourceConfig{Type: rss.TypeRSSFeed, Config: map[string]any{"url": feed.URL, "headers": feed.Headers}})
+		}
+
+	case "hacker-news":
+		feedName := "top"
+		if in.SortBy != "" {
+			feedName = in.SortBy
+		}
+		out = append(out, sourceConfig{Type: hackernews.TypeHackerNewsPosts, Config: map[string]any{"feed_name": feedName}})
+
+	case "lobsters":
+		instanceURL := "https://lobste.rs"
+		if in.InstanceURL != "" {
+			instanceURL = strings.TrimRight(in.InstanceURL, "/")
+		}
+		if len(in.Tags) == 0 {
+			feedName := "hottest"
+			if in.SortBy == "new" {
+				feedName = "newest"
+			}
+			out = append(out, sourceConfig{Type: lobsters.TypeLobstersFeed, Config: map[string]any{"instance_url": instanceURL, "custom_url": in.CustomURL, "feed": feedName}})
+		}
+		for _, tag := range in.Tags {
+			out = append(out, sourceConfig{Type: lobsters.TypeLobstersTag, Config: map[string]any{"instance_url": instanceURL, "custom_url": in.CustomURL, "tag": tag}})
+		}
+
+	case "mastodon":
+		if in.InstanceURL == "" {
+			return nil, fmt.Errorf("instance-url is required")
+		}
+		if len(in.Accounts) == 0 && len(in.Hashtags) == 0 {
+			return nil, fmt.Errorf("no accounts or hashtags provided")
+		}
+		instanceURL := strings.TrimRight(in.InstanceURL, "/")
+		for _, account := range in.Accounts {
+			out = append(out, sourceConfig{Type: mastodon.TypeMastodonAccount, Config: map[string]any{"instance_url": instanceURL, "account": account}})
+		}
+		for _, tag := range in.Hashtags {
+			out = append(out, sourceConfig{Type: mastodon.TypeMastodonTag, Config: map[string]any{"instance_url": instanceURL, "tag": tag}})
+		}
+
+	case "reddit":
+		if in.Subreddit == "" {
+			return nil, fmt.Errorf("subreddit is required")
+		}
+		sortBy, topPeriod := "hot", "day"
+		if in.SortBy != "" {
+			sortBy = in.SortBy
+		}
+		if in.TopPeriod != "" {
+			topPeriod = in.TopPeriod
+		}
+		out = append(out, sourceConfig{Type: reddit.TypeRedditSubreddit, Config: map[string]any{
+			"subreddit":            in.Subreddit,
+			"sort-by":              sortBy,
+			"top-period":           topPeriod,
+			"search":               in.Search,
+			"request-url-template": in.RequestURLTemplate,
+			"auth":                 map[string]any{"name": in.AppAuth.Name, "ID": in.AppAuth.ID, "secret": in.AppAuth.Secret},
+		}})
+
+	case "releases", "issues":
+		if len(in.Repositories) == 0 {
+			return nil, fmt.Errorf("no repositories provided")
+		}
+		sourceType := github.TypeGithubReleases
+		if in.Type == "issues" {
+			sourceType = github.TypeGithubIssues
+		}
+		for _, repository := range in.Repositories {
+			// Glance prefixes repositories of other forges, e.g. "gitlab:owner/repo".
+			if strings.Contains(repository, ":") {
+				return nil, fmt.Errorf("repository %s: only GitHub repositories are supported", repository)
+			}
+			out = append(out, sourceConfig{Type: sourceType, Config: map[string]any{"Repository": repository, "token": in.Token, "include_prereleases": in.IncludePrereleases}})
+		}
+
+	default:
+		return nil, fmt.Errorf("unsupported widget type")
+	}
+
+	return out, nil
+}
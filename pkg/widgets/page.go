@@ -12,6 +12,7 @@ type Page struct {
 	Slug                   string   `json:"slug"`
 	Width                  string   `json:"width"`
 	DesktopNavigationWidth string   `json:"desktop_navigation_width"`
+	HideDesktopNavigation  bool     `json:"hide_desktop_navigation"`
 	CenterVertically       bool     `json:"center_vertically"`
 	HeadWidgets            []Widget `json:"head_widgets"`
 	Columns                []struct {
@@ -151,6 +152,7 @@ func (page *Page) UnmarshalJSON(data []byte) error {
 	page.Slug = raw.Slug
 	page.Width = raw.Width
 	page.DesktopNavigationWidth = raw.DesktopNavigationWidth
+	page.HideDesktopNavigation = raw.HideDesktopNavigation
 	page.CenterVertically = raw.CenterVertically
 
 	headWidgets, err := unmarshalWidgets(raw.HeadWidgets)
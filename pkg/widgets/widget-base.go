@@ -18,6 +18,8 @@ type widgetBase struct {
 	CollapseAfter int    `json:"collapse_after"`
 	// SourceID is the filter parameter for fetching activities.
 	SourceID string `json:"source_id"`
+	// SourceIDs are used instead of SourceID to show activities of multiple sources.
+	SourceIDs []string `json:"source_ids"`
 	// Query is the search query for filtering with natural language.
 	Query string `json:"query"`
 	// MinSimilarity is the minimum similarity (0-1) for filtering with natural language.
@@ -69,7 +71,7 @@ func (w *widgetBase) Render(registry *sources.Registry) template.HTML {
 	activities, err := registry.Search(
 		context.Background(),
 		w.Query,
-		[]string{w.SourceID},
+		w.sourceIDs(),
 		w.MinSimilarity,
 		w.Limit,
 		sortBy,
@@ -78,10 +80,20 @@ func (w *widgetBase) Render(registry *sources.Registry) template.HTML {
 	return w.renderTemplate(renderData{w, activities}, widgetBaseContentTemplate)
 }
 
+func (w *widgetBase) sourceIDs() []string {
+	if len(w.SourceIDs) > 0 {
+		return w.SourceIDs
+	}
+	return []string{w.SourceID}
+}
+
 func (w *widgetBase) Initialize() error {
 	if w.CollapseAfter <= 0 {
 		w.CollapseAfter = 3
 	}
+	if w.Limit <= 0 {
+		w.Limit = 10
+	}
 	return nil
 }
 